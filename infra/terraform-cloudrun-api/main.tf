@@ -63,6 +63,26 @@ resource "google_cloud_run_service" "main" {
           container_port = 8080
         }
 
+        # 起動時は依存先（DB・ストレージ・JWKS）の確認が通るまでトラフィックを振り分けない
+        startup_probe {
+          http_get {
+            path = "/readyz"
+          }
+          period_seconds    = 5
+          timeout_seconds   = 3
+          failure_threshold = 12
+        }
+
+        # 応答しなくなった場合のみ再起動する（依存先は確認しない）
+        liveness_probe {
+          http_get {
+            path = "/healthz"
+          }
+          period_seconds    = 30
+          timeout_seconds   = 3
+          failure_threshold = 3
+        }
+
         env {
           name  = "DB_HOST"
           value = google_sql_database_instance.main.public_ip_address
@@ -109,6 +129,35 @@ resource "google_cloud_run_service" "main" {
           name  = "ENV"
           value = "development"
         }
+
+        # Cloud Logging の構造化ログ形式で出力し、トレースと紐付ける
+        env {
+          name  = "LOG_FORMAT"
+          value = "cloud"
+        }
+
+        env {
+          name  = "GOOGLE_CLOUD_PROJECT"
+          value = var.project_id
+        }
+
+        # 起動時に未適用のマイグレーションを適用する（アドバイザリーロックで複数インスタンスの同時実行を防ぐ）
+        env {
+          name  = "MIGRATE_ON_START"
+          value = "true"
+        }
+
+        # 最大5インスタンスで同じ上限を共有するため、レート制限のバケットをDBに保持する
+        env {
+          name  = "RATE_LIMIT_STORE"
+          value = "postgres"
+        }
+
+        # Cloud Run のフロントエンドが X-Forwarded-For の末尾にクライアントIPを追加する
+        env {
+          name  = "TRUSTED_PROXY_HOPS"
+          value = "1"
+        }
       }
     }
 
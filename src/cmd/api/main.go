@@ -10,33 +10,51 @@ import (
 	"net/url"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
 	"cloud.google.com/go/storage"
 	_ "github.com/lib/pq"
 
+	"github.com/MizukiShigi/cms-go/infrastructure/config"
+	"github.com/MizukiShigi/cms-go/infrastructure/db/migration"
 	"github.com/MizukiShigi/cms-go/infrastructure/logger"
 	"github.com/MizukiShigi/cms-go/infrastructure/repository"
 	"github.com/MizukiShigi/cms-go/infrastructure/service"
+	"github.com/MizukiShigi/cms-go/infrastructure/tracing"
+	"github.com/MizukiShigi/cms-go/internal/domain/event"
+	domainservice "github.com/MizukiShigi/cms-go/internal/domain/service"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
 	"github.com/MizukiShigi/cms-go/internal/presentation/controller"
+	"github.com/MizukiShigi/cms-go/internal/presentation/helper"
 	"github.com/MizukiShigi/cms-go/internal/presentation/middleware"
+	"github.com/MizukiShigi/cms-go/internal/presentation/worker"
 
 	"github.com/MizukiShigi/cms-go/internal/usecase"
 	"github.com/gorilla/mux"
 	"github.com/joho/godotenv"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
 func main() {
 	// ローカル環境用環境変数ファイル読み込み
 	loadLocalEnv()
 
-	// ロギング設定
-	baseHadler := slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
-		AddSource: true,
-	})
-	customHandler := logger.NewHandler(baseHadler)
-	slog.SetDefault(slog.New(customHandler))
+	// ロギング設定（LOG_FORMAT: json / cloud / text）
+	// cloud の場合は GOOGLE_CLOUD_PROJECT を使ってトレースと紐付ける
+	logHandler, err := logger.New(os.Stdout, logger.Format(os.Getenv("LOG_FORMAT")), os.Getenv("GOOGLE_CLOUD_PROJECT"))
+	if err != nil {
+		log.Fatalf("ログ設定エラー: %v", err)
+	}
+	slog.SetDefault(slog.New(logHandler))
+
+	// マイグレーション用サブコマンド（例: cms-api migrate up）
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		runMigrate(os.Args[2:])
+		return
+	}
 
 	// 環境変数の検証とデフォルト値設定
 	env := os.Getenv("ENV")
@@ -47,6 +65,16 @@ func main() {
 	auth0Domain := os.Getenv("AUTH0_DOMAIN")
 	audience := os.Getenv("AUDIENCE")
 	port := getEnvOrDefault("PORT", "8080")
+	postStateMachineConfig := os.Getenv("POST_STATE_MACHINE_CONFIG")
+	// フィード・サイトマップに載せる公開サイトのURL
+	siteURL := getEnvOrDefault("SITE_URL", "http://localhost:"+port)
+	feedSettings := usecase.FeedSettings{
+		Title:     getEnvOrDefault("FEED_TITLE", "CMS"),
+		SiteURL:   siteURL,
+		ItemCount: getEnvIntOrDefault("FEED_ITEM_COUNT", usecase.DefaultFeedItemCount),
+	}
+	// Webhookの通知先に http と内部ネットワーク（localhost など）を許可するのはローカル環境のみ
+	allowLocalWebhook := env == "local"
 
 	// 必須環境変数の検証
 	if env == "" {
@@ -59,6 +87,16 @@ func main() {
 		log.Fatal("AUDIENCE environment variable is required")
 	}
 
+	// トレース設定（OTEL_EXPORTER_OTLP_ENDPOINT 未指定の場合はコレクターへ送信しない）
+	shutdownTracing, err := tracing.Setup(context.Background(), tracing.Settings{
+		ServiceName: getEnvOrDefault("OTEL_SERVICE_NAME", "cms-api"),
+		Environment: env,
+		Endpoint:    os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT"),
+	})
+	if err != nil {
+		log.Fatalf("トレース設定エラー: %v", err)
+	}
+
 	slog.Info("Starting application",
 		"db_host", host,
 		"db_name", name,
@@ -66,102 +104,273 @@ func main() {
 		"port", port,
 		"env", os.Getenv("ENV"))
 
-	encodedPassword := url.QueryEscape(password)
-
-	db, err := sql.Open("postgres", fmt.Sprintf("postgres://%s:%s@%s:5432/%s?sslmode=disable", user, encodedPassword, host, name))
+	db, err := openDB(host, name, user, password)
 	if err != nil {
-		log.Fatal(err)
+		log.Fatalf("データベース接続エラー: %v", err)
 	}
 	defer db.Close()
 
-	// データベース接続プール設定
-	db.SetMaxOpenConns(25)
-	db.SetMaxIdleConns(5)
-	db.SetConnMaxLifetime(5 * time.Minute)
-	db.SetConnMaxIdleTime(1 * time.Minute)
+	// スキーマのバージョン検証（不一致の場合は起動しない）
+	// MIGRATE_ON_START=true の場合は検証前に未適用のマイグレーションを適用する
+	migrations, err := migration.Embedded()
+	if err != nil {
+		log.Fatalf("マイグレーションの読み込みエラー: %v", err)
+	}
+	migrator := migration.NewMigrator(db, migrations)
+	if os.Getenv("MIGRATE_ON_START") == "true" {
+		if _, err := migrator.Up(context.Background()); err != nil {
+			log.Fatalf("マイグレーションの適用エラー: %v", err)
+		}
+	}
+	if err := migrator.Check(context.Background()); err != nil {
+		log.Fatalf("スキーマの検証エラー: %v", err)
+	}
 
-	// DB接続の検証
-	if err := db.Ping(); err != nil {
-		log.Fatalf("データベース接続エラー: %v", err)
+	// 投稿ステータスの遷移ルール読み込み（未指定の場合は既定のルール）
+	postStateMachine, err := config.LoadPostStateMachine(postStateMachineConfig)
+	if err != nil {
+		log.Fatalf("投稿ステータス遷移ルールの読み込みエラー: %v", err)
 	}
 
 	// GCPクライアント初期化
 	gcsClient := getGCSlient()
 
+	// メトリクス初期化（METRICS_ADDR の /metrics で Prometheus 形式で公開する）
+	metrics := service.NewPrometheusMetrics(db)
+
+	// ドメインイベントバス初期化（コミット後にハンドラーへ配信する）
+	eventBus := service.NewEventBus()
+	registerEventHandlers(eventBus, metrics)
+
 	// リポジトリ初期化
-	transactionManager := repository.NewTransactionManager(db)
+	transactionManager := repository.NewTransactionManager(db, eventBus, metrics)
 	// userRepository := repository.NewUserRepository(db)
 	postRepository := repository.NewPostRepository(db)
 	tagRepository := repository.NewTagRepository(db)
 	imageRepository := repository.NewImageRepository(db)
+	categoryRepository := repository.NewCategoryRepository(db)
+	postLockRepository := repository.NewPostLockRepository(db)
+	postWorkflowHistoryRepository := repository.NewPostWorkflowHistoryRepository(db)
+	auditEventRepository := repository.NewAuditEventRepository(db)
+	webhookSubscriptionRepository := repository.NewWebhookSubscriptionRepository(db)
+	webhookEventRepository := repository.NewWebhookEventRepository(db)
+	webhookDeliveryRepository := repository.NewWebhookDeliveryRepository(db)
 
 	// サービス初期化
 	// authService := service.NewJWTService(jwtSecret)
-	storageService := service.NewStorageService(gcsClient)
+	rateLimitStore := newRateLimitStore(db)
+	storageService := service.NewStorageService(gcsClient, metrics)
+	webhookSender := service.NewWebhookSender(allowLocalWebhook)
+	healthCheckers := []domainservice.HealthChecker{
+		service.NewDatabaseHealthChecker(db),
+		service.NewStorageHealthChecker(gcsClient, os.Getenv("GCS_IMAGE_BUCKET_NAME")),
+		service.NewJWKSHealthChecker(fmt.Sprintf("https://%s/.well-known/jwks.json", auth0Domain)),
+	}
 
 	// ユースケース初期化
 	// registerUserUsecase := usecase.NewRegisterUserUsecase(userRepository)
-	// loginUserUsecase := usecase.NewLoginUserUsecase(userRepository, authService)
+	// loginUserUsecase := usecase.NewLoginUserUsecase(userRepository, authService, rateLimitStore, getRateLimitPolicy("login", "LOGIN_LOCKOUT_POLICY", "5/15m"), getRateLimitPolicy("login_account", "LOGIN_ACCOUNT_LOCKOUT_POLICY", "20/1h"))
 	listPostsUsecase := usecase.NewListPostsUsecase(postRepository)
-	createPostUsecase := usecase.NewCreatePostUsecase(transactionManager, postRepository, tagRepository)
-	getPostUsecase := usecase.NewGetPostUsecase(postRepository)
-	updatePostUsecase := usecase.NewUpdatePostUsecase(transactionManager, postRepository, tagRepository)
-	patchPostUsecase := usecase.NewPatchPostUsecase(postRepository)
-	createImageUsecase := usecase.NewCreateImageUsecase(imageRepository, storageService)
+	createPostUsecase := usecase.NewCreatePostUsecase(transactionManager, postRepository, tagRepository, auditEventRepository, imageRepository)
+	getPostUsecase := usecase.NewGetPostUsecase(postRepository, postLockRepository)
+	updatePostUsecase := usecase.NewUpdatePostUsecase(transactionManager, postRepository, tagRepository, postLockRepository, auditEventRepository, webhookEventRepository, imageRepository)
+	patchPostUsecase := usecase.NewPatchPostUsecase(transactionManager, postRepository, tagRepository, postLockRepository, postWorkflowHistoryRepository, postStateMachine, auditEventRepository, webhookEventRepository, imageRepository)
+	bulkUpdatePostsUsecase := usecase.NewBulkUpdatePostsUsecase(transactionManager, postRepository, tagRepository, postLockRepository, postWorkflowHistoryRepository, postStateMachine, auditEventRepository, webhookEventRepository)
+	createImageUsecase := usecase.NewCreateImageUsecase(transactionManager, imageRepository, storageService, auditEventRepository)
+	listTagsUsecase := usecase.NewListTagsUsecase(tagRepository)
+	renameTagUsecase := usecase.NewRenameTagUsecase(transactionManager, tagRepository, auditEventRepository)
+	mergeTagsUsecase := usecase.NewMergeTagsUsecase(transactionManager, tagRepository, auditEventRepository)
+	deleteUnusedTagsUsecase := usecase.NewDeleteUnusedTagsUsecase(transactionManager, tagRepository, auditEventRepository)
+	listCategoriesUsecase := usecase.NewListCategoriesUsecase(categoryRepository)
+	createCategoryUsecase := usecase.NewCreateCategoryUsecase(transactionManager, categoryRepository, auditEventRepository)
+	getCategoryUsecase := usecase.NewGetCategoryUsecase(categoryRepository)
+	updateCategoryUsecase := usecase.NewUpdateCategoryUsecase(transactionManager, categoryRepository, auditEventRepository)
+	deleteCategoryUsecase := usecase.NewDeleteCategoryUsecase(transactionManager, categoryRepository, auditEventRepository)
+	acquirePostLockUsecase := usecase.NewAcquirePostLockUsecase(postRepository, postLockRepository)
+	heartbeatPostLockUsecase := usecase.NewHeartbeatPostLockUsecase(postLockRepository)
+	releasePostLockUsecase := usecase.NewReleasePostLockUsecase(postLockRepository)
+	forceReleasePostLockUsecase := usecase.NewForceReleasePostLockUsecase(postLockRepository)
+	submitPostForReviewUsecase := usecase.NewSubmitPostForReviewUsecase(transactionManager, postRepository, postWorkflowHistoryRepository, postStateMachine, auditEventRepository, webhookEventRepository)
+	reviewPostUsecase := usecase.NewReviewPostUsecase(transactionManager, postRepository, postWorkflowHistoryRepository, postStateMachine, auditEventRepository, webhookEventRepository)
+	listPostWorkflowHistoriesUsecase := usecase.NewListPostWorkflowHistoriesUsecase(postRepository, postWorkflowHistoryRepository)
+	listPostTransitionsUsecase := usecase.NewListPostTransitionsUsecase(postRepository, postStateMachine)
+	listAuditEventsUsecase := usecase.NewListAuditEventsUsecase(auditEventRepository)
+	createWebhookSubscriptionUsecase := usecase.NewCreateWebhookSubscriptionUsecase(webhookSubscriptionRepository, allowLocalWebhook)
+	listWebhookSubscriptionsUsecase := usecase.NewListWebhookSubscriptionsUsecase(webhookSubscriptionRepository)
+	deleteWebhookSubscriptionUsecase := usecase.NewDeleteWebhookSubscriptionUsecase(webhookSubscriptionRepository)
+	listWebhookDeliveriesUsecase := usecase.NewListWebhookDeliveriesUsecase(webhookSubscriptionRepository, webhookDeliveryRepository)
+	redeliverWebhookUsecase := usecase.NewRedeliverWebhookUsecase(webhookDeliveryRepository)
+	getFeedUsecase := usecase.NewGetFeedUsecase(postRepository, feedSettings)
+	getSitemapUsecase := usecase.NewGetSitemapUsecase(postRepository, siteURL)
+	checkReadinessUsecase := usecase.NewCheckReadinessUsecase(healthCheckers, usecase.HealthCheckSettings{
+		Timeout:  usecase.DefaultHealthCheckTimeout,
+		CacheTTL: usecase.DefaultHealthCheckCacheTTL,
+	})
+	dispatchWebhooksUsecase := usecase.NewDispatchWebhooksUsecase(transactionManager, webhookEventRepository, webhookSubscriptionRepository, webhookDeliveryRepository, webhookSender)
+
+	// 公開状態の変化をサイトマップに反映する
+	eventBus.Subscribe(event.PostPublishedName, getSitemapUsecase.HandlePostEvent)
+	eventBus.Subscribe(event.PostUnpublishedName, getSitemapUsecase.HandlePostEvent)
 
 	// コントローラー初期化
 	// authController := controller.NewAuthController(registerUserUsecase, loginUserUsecase)
-	postController := controller.NewPostController(listPostsUsecase, createPostUsecase, getPostUsecase, updatePostUsecase, patchPostUsecase)
+	postController := controller.NewPostController(listPostsUsecase, createPostUsecase, getPostUsecase, updatePostUsecase, patchPostUsecase, bulkUpdatePostsUsecase)
 	imageController := controller.NewImageController(createImageUsecase)
+	tagController := controller.NewTagController(listTagsUsecase, renameTagUsecase, mergeTagsUsecase, deleteUnusedTagsUsecase)
+	categoryController := controller.NewCategoryController(listCategoriesUsecase, createCategoryUsecase, getCategoryUsecase, updateCategoryUsecase, deleteCategoryUsecase)
+	postLockController := controller.NewPostLockController(acquirePostLockUsecase, heartbeatPostLockUsecase, releasePostLockUsecase, forceReleasePostLockUsecase)
+	postWorkflowController := controller.NewPostWorkflowController(submitPostForReviewUsecase, reviewPostUsecase, listPostWorkflowHistoriesUsecase, listPostTransitionsUsecase)
+	auditController := controller.NewAuditController(listAuditEventsUsecase)
+	feedController := controller.NewFeedController(getFeedUsecase)
+	sitemapController := controller.NewSitemapController(getSitemapUsecase)
+	healthController := controller.NewHealthController(checkReadinessUsecase)
+	webhookController := controller.NewWebhookController(createWebhookSubscriptionUsecase, listWebhookSubscriptionsUsecase, deleteWebhookSubscriptionUsecase, listWebhookDeliveriesUsecase, redeliverWebhookUsecase)
 	// ルーティング設定
 	r := mux.NewRouter()
+	// ルートが見つからない場合もエラーレスポンスの形式を揃える
+	r.NotFoundHandler = http.HandlerFunc(helper.NotFoundHandler)
+	r.MethodNotAllowedHandler = http.HandlerFunc(helper.MethodNotAllowedHandler)
+
+	// ログから伏せるキーは LOG_REDACT_KEYS（カンマ区切り）で追加できる
+	redactor := middleware.NewRedactor(append(middleware.DefaultSensitiveKeyPatterns, getEnvList("LOG_REDACT_KEYS")...))
 
 	// 全てのリクエストにミドルウェア設定
+	r.Use(middleware.TracingMiddleware)
+	r.Use(middleware.ClientIPMiddleware(getEnvIntOrDefault("TRUSTED_PROXY_HOPS", 0)))
 	r.Use(middleware.CORSMiddleware)
-	r.Use(middleware.LoggingMiddleware)
+	r.Use(middleware.LoggingMiddleware(redactor))
+	r.Use(middleware.MetricsMiddleware(metrics))
 	r.Use(middleware.TimeoutMiddleware)
 
+	// ヘルスチェック（Cloud Run のプローブ用のため認証不要）
+	r.HandleFunc("/healthz", healthController.Liveness).Methods("GET")
+	r.HandleFunc("/readyz", healthController.Readiness).Methods("GET")
+
+	// サイトマップ（検索エンジン向けのためサイトのルートに置く）
+	r.HandleFunc("/sitemap.xml", sitemapController.GetSitemap).Methods("GET")
+	r.HandleFunc("/sitemaps/{page:[0-9]+}.xml", sitemapController.GetSitemapPage).Methods("GET")
+
 	// バージョニング
 	v1Router := r.PathPrefix("/cms/v1").Subrouter()
+	v1Router.Use(middleware.RateLimitMiddleware(rateLimitStore, getRateLimitPolicy("ip", "RATE_LIMIT_IP_POLICY", "300/1m"), middleware.RateLimitByIP))
 
 	// 認証不要パス
 	// publicV1Router := v1Router.PathPrefix("/").Subrouter()
 
+	// フィード（公開中の投稿のみのため認証不要）
+	feedRouter := v1Router.PathPrefix("/feeds").Subrouter()
+	feedRouter.HandleFunc("/rss.xml", feedController.GetRSS).Methods("GET", "OPTIONS")
+	feedRouter.HandleFunc("/atom.xml", feedController.GetAtom).Methods("GET", "OPTIONS")
+	feedRouter.HandleFunc("/feed.json", feedController.GetJSONFeed).Methods("GET", "OPTIONS")
+	feedRouter.HandleFunc("/tags/{tag}/rss.xml", feedController.GetRSS).Methods("GET", "OPTIONS")
+	feedRouter.HandleFunc("/tags/{tag}/atom.xml", feedController.GetAtom).Methods("GET", "OPTIONS")
+	feedRouter.HandleFunc("/tags/{tag}/feed.json", feedController.GetJSONFeed).Methods("GET", "OPTIONS")
+
 	// 認証
 	// authRouter := publicV1Router.PathPrefix("/auth").Subrouter()
 	// authRouter.HandleFunc("/register", authController.Register).Methods("POST", "OPTIONS")
-	// authRouter.HandleFunc("/login", authController.Login).Methods("POST", "OPTIONS")
+	// authRouter.Handle("/login", middleware.RateLimitMiddleware(rateLimitStore, getRateLimitPolicy("auth", "RATE_LIMIT_AUTH_POLICY", "20/1m"), middleware.RateLimitByRoute)(http.HandlerFunc(authController.Login))).Methods("POST", "OPTIONS")
 
 	// 認証必須パス
 	protectedV1Router := v1Router.PathPrefix("/").Subrouter()
 	protectedV1Router.Use(middleware.AuthMiddleware(auth0Domain, audience))
+	protectedV1Router.Use(middleware.RateLimitMiddleware(rateLimitStore, getRateLimitPolicy("user", "RATE_LIMIT_USER_POLICY", "600/1m"), middleware.RateLimitByUser))
 
 	// 投稿
 	postRouter := protectedV1Router.PathPrefix("/posts").Subrouter()
 	postRouter.HandleFunc("", postController.ListPosts).Methods("GET", "OPTIONS")
 	postRouter.HandleFunc("", postController.CreatePost).Methods("POST", "OPTIONS")
+	postRouter.HandleFunc("/bulk", postController.BulkUpdatePosts).Methods("POST", "OPTIONS")
 	postRouter.HandleFunc("/{id}", postController.GetPost).Methods("GET", "OPTIONS")
 	postRouter.HandleFunc("/{id}", postController.UpdatePost).Methods("PUT", "OPTIONS")
 	postRouter.HandleFunc("/{id}", postController.PatchPost).Methods("PATCH", "OPTIONS")
 
+	// 投稿編集ロック
+	postRouter.HandleFunc("/{id}/lock", postLockController.AcquirePostLock).Methods("POST", "OPTIONS")
+	postRouter.HandleFunc("/{id}/lock", postLockController.ReleasePostLock).Methods("DELETE", "OPTIONS")
+	postRouter.HandleFunc("/{id}/lock/heartbeat", postLockController.HeartbeatPostLock).Methods("POST", "OPTIONS")
+	postRouter.Handle("/{id}/lock/force-release", middleware.RequireRole(valueobject.RoleAdmin)(http.HandlerFunc(postLockController.ForceReleasePostLock))).Methods("POST", "OPTIONS")
+
+	// 投稿レビューワークフロー
+	postRouter.HandleFunc("/{id}/submit", postWorkflowController.SubmitPostForReview).Methods("POST", "OPTIONS")
+	postRouter.HandleFunc("/{id}/review", postWorkflowController.ReviewPost).Methods("POST", "OPTIONS")
+	postRouter.HandleFunc("/{id}/workflow-history", postWorkflowController.ListPostWorkflowHistories).Methods("GET", "OPTIONS")
+	postRouter.HandleFunc("/{id}/transitions", postWorkflowController.ListPostTransitions).Methods("GET", "OPTIONS")
+
 	// 画像
 	imageRouter := protectedV1Router.PathPrefix("/images").Subrouter()
-	imageRouter.HandleFunc("", imageController.CreateImage).Methods("POST", "OPTIONS")
+	imageRouter.Handle("", middleware.RateLimitMiddleware(rateLimitStore, getRateLimitPolicy("upload", "RATE_LIMIT_UPLOAD_POLICY", "30/1m"), middleware.RateLimitByRoute)(http.HandlerFunc(imageController.CreateImage))).Methods("POST", "OPTIONS")
 	// imageRouter.HandleFunc("/{id}", imageController.GetImage).Methods("GET")
 	// imageRouter.HandleFunc("/{id}", imageController.UpdateImage).Methods("DELETE")
 
+	// タグ（名前の変更・統合・削除は全ユーザー共有のタグに影響するため管理者のみ）
+	tagRouter := protectedV1Router.PathPrefix("/tags").Subrouter()
+	tagRouter.HandleFunc("", tagController.ListTags).Methods("GET", "OPTIONS")
+	tagRouter.Handle("/unused", middleware.RequireRole(valueobject.RoleAdmin)(http.HandlerFunc(tagController.DeleteUnusedTags))).Methods("DELETE", "OPTIONS")
+	tagRouter.Handle("/{id}", middleware.RequireRole(valueobject.RoleAdmin)(http.HandlerFunc(tagController.RenameTag))).Methods("PATCH", "OPTIONS")
+	tagRouter.Handle("/{id}/merge", middleware.RequireRole(valueobject.RoleAdmin)(http.HandlerFunc(tagController.MergeTag))).Methods("POST", "OPTIONS")
+
+	// カテゴリ（ツリーの編集は管理者のみ）
+	categoryRouter := protectedV1Router.PathPrefix("/categories").Subrouter()
+	categoryRouter.HandleFunc("", categoryController.ListCategories).Methods("GET", "OPTIONS")
+	categoryRouter.Handle("", middleware.RequireRole(valueobject.RoleAdmin)(http.HandlerFunc(categoryController.CreateCategory))).Methods("POST", "OPTIONS")
+	categoryRouter.HandleFunc("/{id}", categoryController.GetCategory).Methods("GET", "OPTIONS")
+	categoryRouter.Handle("/{id}", middleware.RequireRole(valueobject.RoleAdmin)(http.HandlerFunc(categoryController.UpdateCategory))).Methods("PUT", "OPTIONS")
+	categoryRouter.Handle("/{id}", middleware.RequireRole(valueobject.RoleAdmin)(http.HandlerFunc(categoryController.DeleteCategory))).Methods("DELETE", "OPTIONS")
+
+	// 監査ログ（管理者のみ）
+	protectedV1Router.Handle("/audit", middleware.RequireRole(valueobject.RoleAdmin)(http.HandlerFunc(auditController.ListAuditEvents))).Methods("GET", "OPTIONS")
+
+	// Webhook（管理者のみ）
+	webhookRouter := protectedV1Router.PathPrefix("/webhooks").Subrouter()
+	webhookRouter.Use(middleware.RequireRole(valueobject.RoleAdmin))
+	webhookRouter.HandleFunc("", webhookController.ListWebhookSubscriptions).Methods("GET", "OPTIONS")
+	webhookRouter.HandleFunc("", webhookController.CreateWebhookSubscription).Methods("POST", "OPTIONS")
+	webhookRouter.HandleFunc("/deliveries/{id}/redeliver", webhookController.RedeliverWebhook).Methods("POST", "OPTIONS")
+	webhookRouter.HandleFunc("/{id}", webhookController.DeleteWebhookSubscription).Methods("DELETE", "OPTIONS")
+	webhookRouter.HandleFunc("/{id}/deliveries", webhookController.ListWebhookDeliveries).Methods("GET", "OPTIONS")
+
+	// traceparent ヘッダーを引き継いでリクエストごとのスパンを開始する（プローブは除く）
+	handler := otelhttp.NewHandler(r, "http.server", otelhttp.WithFilter(func(r *http.Request) bool {
+		switch r.URL.Path {
+		case "/healthz", "/readyz":
+			return false
+		}
+		return true
+	}))
+
 	srv := &http.Server{
 		Addr:         ":" + port,
-		Handler:      r,
+		Handler:      handler,
 		ReadTimeout:  15 * time.Second,
 		WriteTimeout: 30 * time.Second,
 		IdleTimeout:  120 * time.Second,
 	}
 
+	// メトリクス（Prometheus のスクレイプ用）
+	// ルートごとの流量や DB 接続プールの状態を含むため、公開ポートとは別の METRICS_ADDR でのみ提供する
+	var metricsSrv *http.Server
+	if metricsAddr := os.Getenv("METRICS_ADDR"); metricsAddr != "" {
+		metricsRouter := mux.NewRouter()
+		metricsRouter.Handle("/metrics", metrics.Handler()).Methods("GET")
+		metricsSrv = &http.Server{
+			Addr:         metricsAddr,
+			Handler:      metricsRouter,
+			ReadTimeout:  15 * time.Second,
+			WriteTimeout: 30 * time.Second,
+		}
+	}
+
 	// シグナルハンドリング
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
 
+	// Webhookディスパッチャー起動（シグナルとは別に、HTTPサーバーの停止後に止める）
+	dispatcherCtx, stopDispatcher := context.WithCancel(context.Background())
+	defer stopDispatcher()
+	webhookDispatcher := worker.NewWebhookDispatcher(dispatchWebhooksUsecase, 5*time.Second)
+	go webhookDispatcher.Run(dispatcherCtx)
+
 	// サーバー起動
 	go func() {
 		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
@@ -170,10 +379,23 @@ func main() {
 	}()
 	log.Printf("server is running on port %s\n", port)
 
+	if metricsSrv != nil {
+		go func() {
+			if err := metricsSrv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				log.Fatalf("metrics listen: %s\n", err)
+			}
+		}()
+		log.Printf("metrics server is running on %s\n", metricsSrv.Addr)
+	}
+
 	// シグナルを受け取り、コンテキストをキャンセルする
 	<-ctx.Done()
 	log.Println("server is shutting down...")
 
+	// 新しいリクエストが振り分けられないよう、先に準備未完了にしてから停止する
+	checkReadinessUsecase.SetShuttingDown()
+	time.Sleep(time.Duration(getEnvIntOrDefault("SHUTDOWN_DRAIN_SECONDS", 0)) * time.Second)
+
 	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
@@ -181,9 +403,91 @@ func main() {
 		log.Fatalf("server shutdown failed: %s\n", err)
 	}
 
+	// 実行中のイベントハンドラーの終了を待つ
+	eventBus.Wait()
+
+	// 送信中のWebhookの記録を終えるまで待ってから、DB接続を閉じる
+	stopDispatcher()
+	select {
+	case <-webhookDispatcher.Done():
+	case <-shutdownCtx.Done():
+		slog.Warn("Timed out waiting for the webhook dispatcher to stop")
+	}
+
+	// 停止処理中もスクレイプできるよう、メトリクスサーバーは最後に止める
+	if metricsSrv != nil {
+		if err := metricsSrv.Shutdown(shutdownCtx); err != nil {
+			slog.Error("Failed to shutdown metrics server", "error", err)
+		}
+	}
+
+	// 未送信のスパンを送信する
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		slog.Error("Failed to shutdown tracing", "error", err)
+	}
+
 	log.Println("server exited properly")
 }
 
+// openDB はデータベースに接続し、接続プールを設定する
+func openDB(host, name, user, password string) (*sql.DB, error) {
+	encodedPassword := url.QueryEscape(password)
+
+	db, err := sql.Open("postgres", fmt.Sprintf("postgres://%s:%s@%s:5432/%s?sslmode=disable", user, encodedPassword, host, name))
+	if err != nil {
+		return nil, err
+	}
+
+	// データベース接続プール設定
+	db.SetMaxOpenConns(25)
+	db.SetMaxIdleConns(5)
+	db.SetConnMaxLifetime(5 * time.Minute)
+	db.SetConnMaxIdleTime(1 * time.Minute)
+
+	// DB接続の検証
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+// runMigrate は migrate サブコマンドを実行する（認証などサーバー用の環境変数は不要）
+func runMigrate(args []string) {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	openMigrationDB := func() (*sql.DB, error) {
+		return openDB(
+			getEnvOrDefault("DB_HOST", "localhost"),
+			getEnvOrDefault("DB_NAME", "cms_dev"),
+			getEnvOrDefault("DB_USER", "postgres"),
+			getEnvOrDefault("DB_PASSWORD", "postgres"),
+		)
+	}
+	if err := migration.Run(ctx, args, openMigrationDB, os.Stdout); err != nil {
+		log.Fatalf("migrate: %v", err)
+	}
+}
+
+// registerEventHandlers はドメインイベントのハンドラーを登録する
+func registerEventHandlers(eventBus *service.EventBus, metrics domainservice.Metrics) {
+	logEvent := func(ctx context.Context, e event.Event) error {
+		slog.InfoContext(ctx, "Domain event published", "event", e.EventName(), "occurred_at", e.OccurredAt())
+		metrics.IncDomainEvent(e.EventName())
+		return nil
+	}
+	for _, name := range []string{
+		event.PostCreatedName,
+		event.PostStatusChangedName,
+		event.PostPublishedName,
+		event.PostUnpublishedName,
+		event.ImageUploadedName,
+	} {
+		eventBus.Subscribe(name, logEvent)
+	}
+}
+
 // getEnvOrDefault は環境変数を取得し、設定されていない場合はデフォルト値を返す
 func getEnvOrDefault(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
@@ -192,6 +496,49 @@ func getEnvOrDefault(key, defaultValue string) string {
 	return defaultValue
 }
 
+// getEnvIntOrDefault は環境変数を整数として取得し、設定されていないか不正な場合はデフォルト値を返す
+func getEnvIntOrDefault(key string, defaultValue int) int {
+	value, err := strconv.Atoi(os.Getenv(key))
+	if err != nil {
+		return defaultValue
+	}
+	return value
+}
+
+// getRateLimitPolicy は "回数/期間" 形式の環境変数からレート制限のポリシーを取得する
+func getRateLimitPolicy(name string, key string, defaultValue string) valueobject.RateLimitPolicy {
+	policy, err := valueobject.ParseRateLimitPolicy(name, getEnvOrDefault(key, defaultValue))
+	if err != nil {
+		log.Fatalf("Invalid %s: %v", key, err)
+	}
+	return policy
+}
+
+// newRateLimitStore はレート制限の保存先を作成する
+// 複数インスタンスで上限を共有する場合は RATE_LIMIT_STORE=postgres を指定する
+func newRateLimitStore(db *sql.DB) domainservice.RateLimitStore {
+	switch store := getEnvOrDefault("RATE_LIMIT_STORE", "memory"); store {
+	case "memory":
+		return service.NewMemoryRateLimitStore()
+	case "postgres":
+		return service.NewPostgresRateLimitStore(db)
+	default:
+		log.Fatalf("Unknown RATE_LIMIT_STORE: %s", store)
+		return nil
+	}
+}
+
+// getEnvList はカンマ区切りの環境変数をスライスとして取得する
+func getEnvList(key string) []string {
+	var values []string
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
 func loadLocalEnv() {
 	env := os.Getenv("ENV")
 	if env == "local" || env == "" {
@@ -0,0 +1,58 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+// postStateMachineConfig は投稿ステータス遷移の設定ファイル（JSON）の形式
+type postStateMachineConfig struct {
+	Transitions []postTransitionConfig `json:"transitions"`
+}
+
+type postTransitionConfig struct {
+	Name    string   `json:"name"`
+	From    string   `json:"from"`
+	To      string   `json:"to"`
+	Guards  []string `json:"guards"`
+	Effects []string `json:"effects"`
+}
+
+// LoadPostStateMachine は設定ファイルから投稿ステータスのステートマシンを読み込む
+// path が空の場合は既定の遷移ルールを使用する
+func LoadPostStateMachine(path string) (*entity.PostStateMachine, error) {
+	if path == "" {
+		return entity.DefaultPostStateMachine(), nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read post state machine config: %w", err)
+	}
+
+	var cfg postStateMachineConfig
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse post state machine config: %w", err)
+	}
+
+	rules := make([]entity.PostTransitionRule, 0, len(cfg.Transitions))
+	for _, transition := range cfg.Transitions {
+		rules = append(rules, entity.PostTransitionRule{
+			Name:    transition.Name,
+			From:    valueobject.PostStatus(transition.From),
+			To:      valueobject.PostStatus(transition.To),
+			Guards:  transition.Guards,
+			Effects: transition.Effects,
+		})
+	}
+
+	machine, err := entity.NewPostStateMachine(rules)
+	if err != nil {
+		return nil, fmt.Errorf("invalid post state machine config: %w", err)
+	}
+	return machine, nil
+}
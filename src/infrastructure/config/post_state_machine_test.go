@@ -0,0 +1,77 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadPostStateMachine(t *testing.T) {
+	authorID := valueobject.NewUserID()
+	reviewerID := valueobject.NewUserID()
+	title, _ := valueobject.NewPostTitle("タイトル")
+	content, _ := valueobject.NewPostContent("内容")
+
+	t.Run("パス未指定の場合は既定のルールを使用する", func(t *testing.T) {
+		machine, err := LoadPostStateMachine("")
+
+		assert.NoError(t, err)
+		assert.NotNil(t, machine)
+	})
+
+	t.Run("サンプル設定は既定のルールと同じ遷移になる", func(t *testing.T) {
+		machine, err := LoadPostStateMachine("post_state_machine.example.json")
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		actors := []entity.Actor{
+			entity.NewActor(authorID, nil),
+			entity.NewActor(reviewerID, []valueobject.Role{valueobject.RoleReviewer}),
+			entity.NewActor(valueobject.NewUserID(), []valueobject.Role{valueobject.RolePublisher}),
+		}
+		statuses := []valueobject.PostStatus{
+			valueobject.StatusDraft,
+			valueobject.StatusInReview,
+			valueobject.StatusApproved,
+			valueobject.StatusPublished,
+			valueobject.StatusPrivate,
+			valueobject.StatusDeleted,
+		}
+		for _, status := range statuses {
+			for _, actor := range actors {
+				post, _ := entity.NewPost(title, content, authorID, status)
+				post.ReviewerID = &reviewerID
+
+				assert.Equal(t,
+					entity.DefaultPostStateMachine().AvailableTransitions(post, actor),
+					machine.AvailableTransitions(post, actor),
+					"status=%s actor=%s", status, actor.UserID,
+				)
+			}
+		}
+	})
+
+	t.Run("不正な遷移ルールはエラーになる", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "post_state_machine.json")
+		if err := os.WriteFile(path, []byte(`{"transitions":[{"name":"archive","from":"published","to":"archived"}]}`), 0o600); err != nil {
+			t.Fatal(err)
+		}
+
+		machine, err := LoadPostStateMachine(path)
+
+		assert.Nil(t, machine)
+		assert.Error(t, err)
+	})
+
+	t.Run("ファイルが存在しない場合はエラーになる", func(t *testing.T) {
+		machine, err := LoadPostStateMachine(filepath.Join(t.TempDir(), "missing.json"))
+
+		assert.Nil(t, machine)
+		assert.Error(t, err)
+	})
+}
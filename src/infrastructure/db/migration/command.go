@@ -0,0 +1,151 @@
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+)
+
+// Usage は migrate サブコマンドの使い方
+const Usage = `usage: migrate <command>
+
+commands:
+  up           未適用のマイグレーションをすべて適用する
+  down [N]     適用済みのマイグレーションを新しい順に N 件取り消す（既定: 1）
+  status       マイグレーションの適用状況を表示する
+  create NAME  新しいマイグレーションファイル（up/down）を作成する
+`
+
+var namePattern = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+// Run は migrate サブコマンドを実行する
+// create はDBに接続しないため、接続は openDB で必要になった時点で行う
+func Run(ctx context.Context, args []string, openDB func() (*sql.DB, error), out io.Writer) error {
+	if len(args) == 0 {
+		return fmt.Errorf("command is required\n%s", Usage)
+	}
+
+	if args[0] == "create" {
+		if len(args) != 2 {
+			return fmt.Errorf("create requires NAME\n%s", Usage)
+		}
+		paths, err := Create(SourceDir, args[1])
+		if err != nil {
+			return err
+		}
+		for _, path := range paths {
+			fmt.Fprintf(out, "created %s\n", path)
+		}
+		return nil
+	}
+
+	steps := 1
+	switch args[0] {
+	case "up", "status":
+		if len(args) != 1 {
+			return fmt.Errorf("%s takes no arguments\n%s", args[0], Usage)
+		}
+	case "down":
+		if len(args) > 2 {
+			return fmt.Errorf("down takes at most one argument\n%s", Usage)
+		}
+		if len(args) == 2 {
+			n, err := strconv.Atoi(args[1])
+			if err != nil || n <= 0 {
+				return fmt.Errorf("invalid steps: %s", args[1])
+			}
+			steps = n
+		}
+	default:
+		return fmt.Errorf("unknown command: %s\n%s", args[0], Usage)
+	}
+
+	migrations, err := Embedded()
+	if err != nil {
+		return err
+	}
+	db, err := openDB()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	migrator := NewMigrator(db, migrations)
+
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		for _, migration := range applied {
+			fmt.Fprintf(out, "applied %06d_%s\n", migration.Version, migration.Name)
+		}
+		if err == nil && len(applied) == 0 {
+			fmt.Fprintln(out, "no pending migrations")
+		}
+		return err
+	case "down":
+		reverted, err := migrator.Down(ctx, steps)
+		for _, migration := range reverted {
+			fmt.Fprintf(out, "reverted %06d_%s\n", migration.Version, migration.Name)
+		}
+		if err == nil && len(reverted) == 0 {
+			fmt.Fprintln(out, "no applied migrations")
+		}
+		return err
+	default:
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		for _, status := range statuses {
+			state := "pending"
+			if status.AppliedAt != nil {
+				state = "applied " + status.AppliedAt.Format("2006-01-02 15:04:05 MST")
+			}
+			if status.Unknown {
+				state += " (unknown to this binary)"
+			}
+			fmt.Fprintf(out, "%06d_%s\t%s\n", status.Version, status.Name, state)
+		}
+		return nil
+	}
+}
+
+// Create は dir に次のバージョンのマイグレーションファイル（up/down）を作成し、作成したパスを返す
+func Create(dir string, name string) ([]string, error) {
+	if !namePattern.MatchString(name) {
+		return nil, fmt.Errorf("invalid migration name (use lowercase letters, digits and underscores): %s", name)
+	}
+
+	migrations, err := Load(os.DirFS(dir))
+	if err != nil {
+		return nil, err
+	}
+	var version int64 = 1
+	if len(migrations) > 0 {
+		version = migrations[len(migrations)-1].Version + 1
+	}
+
+	paths := make([]string, 0, 2)
+	for _, direction := range []string{"up", "down"} {
+		path := filepath.Join(dir, fmt.Sprintf("%06d_%s.%s.sql", version, name, direction))
+		content := fmt.Sprintf("-- %s (%s)\n", name, direction)
+		// 既存ファイルは上書きしない
+		file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+		if err != nil {
+			return paths, fmt.Errorf("failed to create migration file: %w", err)
+		}
+		_, err = file.WriteString(content)
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return paths, fmt.Errorf("failed to write migration file: %w", err)
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
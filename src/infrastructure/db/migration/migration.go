@@ -0,0 +1,128 @@
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+)
+
+// sql ディレクトリのマイグレーションファイルをバイナリに埋め込む
+//
+//go:embed sql/*.sql
+var embedded embed.FS
+
+// SourceDir は create コマンドでマイグレーションファイルを作成するディレクトリ（src からの相対パス）
+const SourceDir = "infrastructure/db/migration/sql"
+
+// fileNamePattern はマイグレーションファイル名（例: 000001_initial_schema.up.sql）の形式
+var fileNamePattern = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+// Migration はバージョンごとのスキーマ変更
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+	// UpStep は Up の SQL の後に同じトランザクションで実行する処理（不要な場合は nil）
+	UpStep Step
+}
+
+// Step は SQL では表現できない変更（アプリケーションと同じ正規化によるデータの書き換えなど）を行う
+type Step func(ctx context.Context, tx *sql.Tx) error
+
+// upSteps は Up の SQL の後に実行する処理をバージョンごとに登録する
+var upSteps = map[int64]Step{
+	2: backfillTagNameKey,
+}
+
+// Embedded はバイナリに埋め込まれたマイグレーションをバージョン順に返す
+func Embedded() ([]Migration, error) {
+	fsys, err := fs.Sub(embedded, "sql")
+	if err != nil {
+		return nil, err
+	}
+	migrations, err := Load(fsys)
+	if err != nil {
+		return nil, err
+	}
+	return attachSteps(migrations, upSteps)
+}
+
+// attachSteps は登録された処理を同じバージョンのマイグレーションに設定する
+func attachSteps(migrations []Migration, steps map[int64]Step) ([]Migration, error) {
+	for version, step := range steps {
+		i := slices.IndexFunc(migrations, func(m Migration) bool { return m.Version == version })
+		if i < 0 {
+			return nil, fmt.Errorf("migration step registered for unknown version %d", version)
+		}
+		migrations[i].UpStep = step
+	}
+	return migrations, nil
+}
+
+// Load はファイルシステム直下のマイグレーションファイルを読み込み、バージョン順に返す
+// 同じバージョンには up と down の両方のファイルが必要
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %w", err)
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		matches := fileNamePattern.FindStringSubmatch(entry.Name())
+		if matches == nil {
+			return nil, fmt.Errorf("invalid migration file name: %s", entry.Name())
+		}
+
+		version, err := strconv.ParseInt(matches[1], 10, 64)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("invalid migration version: %s", entry.Name())
+		}
+
+		data, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %w", entry.Name(), err)
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: matches[2]}
+			byVersion[version] = migration
+		}
+		if migration.Name != matches[2] {
+			return nil, fmt.Errorf("duplicate migration version %d: %s and %s", version, migration.Name, matches[2])
+		}
+
+		if matches[3] == "up" {
+			migration.Up = string(data)
+		} else {
+			migration.Down = string(data)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" {
+			return nil, fmt.Errorf("migration %d_%s has no up file", migration.Version, migration.Name)
+		}
+		if migration.Down == "" {
+			return nil, fmt.Errorf("migration %d_%s has no down file", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
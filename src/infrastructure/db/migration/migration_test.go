@@ -0,0 +1,128 @@
+package migration
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoad(t *testing.T) {
+	t.Run("バージョン順に読み込む", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"000002_add_column.up.sql":   {Data: []byte("ALTER TABLE a ADD COLUMN b INT;")},
+			"000002_add_column.down.sql": {Data: []byte("ALTER TABLE a DROP COLUMN b;")},
+			"000001_create.up.sql":       {Data: []byte("CREATE TABLE a (id INT);")},
+			"000001_create.down.sql":     {Data: []byte("DROP TABLE a;")},
+		}
+
+		migrations, err := Load(fsys)
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Len(t, migrations, 2)
+		assert.Equal(t, Migration{Version: 1, Name: "create", Up: "CREATE TABLE a (id INT);", Down: "DROP TABLE a;"}, migrations[0])
+		assert.Equal(t, int64(2), migrations[1].Version)
+		assert.Equal(t, "add_column", migrations[1].Name)
+	})
+
+	t.Run("downファイルがない場合はエラー", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"000001_create.up.sql": {Data: []byte("CREATE TABLE a (id INT);")},
+		}
+
+		_, err := Load(fsys)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("同じバージョンで名前が異なる場合はエラー", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"000001_create.up.sql":   {Data: []byte("CREATE TABLE a (id INT);")},
+			"000001_create.down.sql": {Data: []byte("DROP TABLE a;")},
+			"000001_other.up.sql":    {Data: []byte("CREATE TABLE b (id INT);")},
+			"000001_other.down.sql":  {Data: []byte("DROP TABLE b;")},
+		}
+
+		_, err := Load(fsys)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("ファイル名の形式が不正な場合はエラー", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"create.sql": {Data: []byte("CREATE TABLE a (id INT);")},
+		}
+
+		_, err := Load(fsys)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestEmbedded(t *testing.T) {
+	t.Run("埋め込まれたマイグレーションを読み込める", func(t *testing.T) {
+		migrations, err := Embedded()
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.NotEmpty(t, migrations)
+		assert.Equal(t, int64(1), migrations[0].Version)
+		assert.Equal(t, "initial_schema", migrations[0].Name)
+	})
+}
+
+func TestCompare(t *testing.T) {
+	migrations := []Migration{{Version: 1}, {Version: 2}, {Version: 3}}
+
+	t.Run("すべて適用済みの場合は差分なし", func(t *testing.T) {
+		applied := map[int64]appliedMigration{1: {}, 2: {}, 3: {}}
+
+		pending, unknown := compare(migrations, applied)
+
+		assert.Empty(t, pending)
+		assert.Empty(t, unknown)
+	})
+
+	t.Run("未適用とバイナリに含まれないバージョンを返す", func(t *testing.T) {
+		applied := map[int64]appliedMigration{1: {name: "a", appliedAt: time.Now()}, 4: {}, 5: {}}
+
+		pending, unknown := compare(migrations, applied)
+
+		assert.Equal(t, []int64{2, 3}, pending)
+		assert.Equal(t, []int64{4, 5}, unknown)
+	})
+}
+
+func TestCreate(t *testing.T) {
+	t.Run("次のバージョンのup/downファイルを作成する", func(t *testing.T) {
+		dir := t.TempDir()
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, "000001_create.up.sql"), []byte("-- up"), 0o644))
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, "000001_create.down.sql"), []byte("-- down"), 0o644))
+
+		paths, err := Create(dir, "add_column")
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, []string{
+			filepath.Join(dir, "000002_add_column.up.sql"),
+			filepath.Join(dir, "000002_add_column.down.sql"),
+		}, paths)
+
+		migrations, err := Load(os.DirFS(dir))
+		assert.NoError(t, err)
+		assert.Len(t, migrations, 2)
+	})
+
+	t.Run("名前の形式が不正な場合はエラー", func(t *testing.T) {
+		_, err := Create(t.TempDir(), "Add Column")
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,300 @@
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+)
+
+// advisoryLockKey はマイグレーションの同時実行を防ぐアドバイザリーロックのキー
+// Cloud Run で複数インスタンスが同時に起動しても1つずつ適用される
+const advisoryLockKey int64 = 7_244_301_859
+
+// ErrSchemaMismatch はDBのスキーマとバイナリのマイグレーションが一致しない場合のエラー
+var ErrSchemaMismatch = errors.New("schema mismatch")
+
+// Status はマイグレーションの適用状況
+type Status struct {
+	Version int64
+	Name    string
+	// 未適用の場合は nil
+	AppliedAt *time.Time
+	// DBには適用済みだがバイナリに含まれないマイグレーションの場合 true
+	Unknown bool
+}
+
+type appliedMigration struct {
+	name      string
+	appliedAt time.Time
+}
+
+type queryer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// Migrator は schema_migrations テーブルで適用済みのバージョンを管理し、マイグレーションを実行する
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+}
+
+func NewMigrator(db *sql.DB, migrations []Migration) *Migrator {
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+	}
+}
+
+// Up は未適用のマイグレーションをバージョン順に適用し、適用したマイグレーションを返す
+// マイグレーションごとにトランザクションを分け、失敗した時点で中断する
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	var applied []Migration
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		if err := createTable(ctx, conn); err != nil {
+			return err
+		}
+
+		appliedMigrations, err := loadApplied(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if _, unknown := compare(m.migrations, appliedMigrations); len(unknown) > 0 {
+			return fmt.Errorf("%w: database has migrations unknown to this binary: %v", ErrSchemaMismatch, unknown)
+		}
+
+		for _, migration := range m.migrations {
+			if _, ok := appliedMigrations[migration.Version]; ok {
+				continue
+			}
+
+			err := inTransaction(ctx, conn, func(tx *sql.Tx) error {
+				if _, err := tx.ExecContext(ctx, migration.Up); err != nil {
+					return err
+				}
+				if migration.UpStep != nil {
+					if err := migration.UpStep(ctx, tx); err != nil {
+						return err
+					}
+				}
+				_, err := tx.ExecContext(ctx, "INSERT INTO schema_migrations (version, name) VALUES ($1, $2)", migration.Version, migration.Name)
+				return err
+			})
+			if err != nil {
+				return fmt.Errorf("failed to apply migration %d_%s: %w", migration.Version, migration.Name, err)
+			}
+
+			slog.InfoContext(ctx, "Migration applied", "version", migration.Version, "name", migration.Name)
+			applied = append(applied, migration)
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Down は適用済みのマイグレーションを新しい順に steps 件取り消し、取り消したマイグレーションを返す
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	if steps <= 0 {
+		return nil, fmt.Errorf("steps must be positive: %d", steps)
+	}
+
+	byVersion := make(map[int64]Migration, len(m.migrations))
+	for _, migration := range m.migrations {
+		byVersion[migration.Version] = migration
+	}
+
+	var reverted []Migration
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		if err := createTable(ctx, conn); err != nil {
+			return err
+		}
+
+		appliedMigrations, err := loadApplied(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		versions := make([]int64, 0, len(appliedMigrations))
+		for version := range appliedMigrations {
+			versions = append(versions, version)
+		}
+		sort.Slice(versions, func(i, j int) bool { return versions[i] > versions[j] })
+		if len(versions) > steps {
+			versions = versions[:steps]
+		}
+
+		for _, version := range versions {
+			migration, ok := byVersion[version]
+			if !ok {
+				return fmt.Errorf("%w: migration %d is unknown to this binary", ErrSchemaMismatch, version)
+			}
+
+			err := inTransaction(ctx, conn, func(tx *sql.Tx) error {
+				if _, err := tx.ExecContext(ctx, migration.Down); err != nil {
+					return err
+				}
+				_, err := tx.ExecContext(ctx, "DELETE FROM schema_migrations WHERE version = $1", migration.Version)
+				return err
+			})
+			if err != nil {
+				return fmt.Errorf("failed to revert migration %d_%s: %w", migration.Version, migration.Name, err)
+			}
+
+			slog.InfoContext(ctx, "Migration reverted", "version", migration.Version, "name", migration.Name)
+			reverted = append(reverted, migration)
+		}
+		return nil
+	})
+	return reverted, err
+}
+
+// Status はバイナリに含まれるマイグレーションとDBにのみ存在するマイグレーションの適用状況をバージョン順に返す
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	appliedMigrations, err := m.loadAppliedIfExists(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]Status, 0, len(m.migrations))
+	known := make(map[int64]bool, len(m.migrations))
+	for _, migration := range m.migrations {
+		known[migration.Version] = true
+		status := Status{Version: migration.Version, Name: migration.Name}
+		if applied, ok := appliedMigrations[migration.Version]; ok {
+			appliedAt := applied.appliedAt
+			status.AppliedAt = &appliedAt
+		}
+		statuses = append(statuses, status)
+	}
+	for version, applied := range appliedMigrations {
+		if known[version] {
+			continue
+		}
+		appliedAt := applied.appliedAt
+		statuses = append(statuses, Status{Version: version, Name: applied.name, AppliedAt: &appliedAt, Unknown: true})
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Version < statuses[j].Version
+	})
+	return statuses, nil
+}
+
+// Check はDBのスキーマがバイナリのマイグレーションと一致するか検証する
+// 未適用のマイグレーションやバイナリに含まれないマイグレーションがある場合は ErrSchemaMismatch を返す
+func (m *Migrator) Check(ctx context.Context) error {
+	appliedMigrations, err := m.loadAppliedIfExists(ctx)
+	if err != nil {
+		return err
+	}
+
+	pending, unknown := compare(m.migrations, appliedMigrations)
+	if len(pending) > 0 {
+		return fmt.Errorf("%w: %d pending migration(s) %v, run `migrate up`", ErrSchemaMismatch, len(pending), pending)
+	}
+	if len(unknown) > 0 {
+		return fmt.Errorf("%w: database has migrations unknown to this binary: %v", ErrSchemaMismatch, unknown)
+	}
+	return nil
+}
+
+// withLock はアドバイザリーロックを取得した接続で fn を実行する
+// セッション単位のロックのため、ロックの取得から解放まで同じ接続を使用する
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sql.Conn) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get connection: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", advisoryLockKey); err != nil {
+		return fmt.Errorf("failed to acquire migration lock: %w", err)
+	}
+	defer func() {
+		// 呼び出し元のコンテキストがキャンセルされていても解放する
+		if _, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", advisoryLockKey); err != nil {
+			slog.Error("Failed to release migration lock", "error", err)
+		}
+	}()
+
+	return fn(conn)
+}
+
+// loadAppliedIfExists は schema_migrations テーブルがない場合は適用済みなしとして扱う
+func (m *Migrator) loadAppliedIfExists(ctx context.Context) (map[int64]appliedMigration, error) {
+	var exists bool
+	if err := m.db.QueryRowContext(ctx, "SELECT to_regclass('schema_migrations') IS NOT NULL").Scan(&exists); err != nil {
+		return nil, fmt.Errorf("failed to check schema_migrations: %w", err)
+	}
+	if !exists {
+		return map[int64]appliedMigration{}, nil
+	}
+	return loadApplied(ctx, m.db)
+}
+
+func createTable(ctx context.Context, q queryer) error {
+	_, err := q.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+    version BIGINT PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    applied_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+)`)
+	if err != nil {
+		return fmt.Errorf("failed to create schema_migrations: %w", err)
+	}
+	return nil
+}
+
+func loadApplied(ctx context.Context, q queryer) (map[int64]appliedMigration, error) {
+	rows, err := q.QueryContext(ctx, "SELECT version, name, applied_at FROM schema_migrations")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load schema_migrations: %w", err)
+	}
+	defer rows.Close()
+
+	applied := make(map[int64]appliedMigration)
+	for rows.Next() {
+		var version int64
+		var migration appliedMigration
+		if err := rows.Scan(&version, &migration.name, &migration.appliedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan schema_migrations: %w", err)
+		}
+		applied[version] = migration
+	}
+	return applied, rows.Err()
+}
+
+func inTransaction(ctx context.Context, conn *sql.Conn, fn func(tx *sql.Tx) error) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// compare は未適用のバージョンと、適用済みだがバイナリに含まれないバージョンをそれぞれ昇順で返す
+func compare(migrations []Migration, applied map[int64]appliedMigration) (pending []int64, unknown []int64) {
+	known := make(map[int64]bool, len(migrations))
+	for _, migration := range migrations {
+		known[migration.Version] = true
+		if _, ok := applied[migration.Version]; !ok {
+			pending = append(pending, migration.Version)
+		}
+	}
+	for version := range applied {
+		if !known[version] {
+			unknown = append(unknown, version)
+		}
+	}
+	sort.Slice(pending, func(i, j int) bool { return pending[i] < pending[j] })
+	sort.Slice(unknown, func(i, j int) bool { return unknown[i] < unknown[j] })
+	return pending, unknown
+}
@@ -0,0 +1,16 @@
+-- 初期スキーマを削除する（全データが失われる）
+
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS webhook_events;
+DROP TABLE IF EXISTS webhook_subscriptions;
+DROP TABLE IF EXISTS audit_events;
+DROP FUNCTION IF EXISTS reject_audit_event_modification();
+DROP TABLE IF EXISTS post_workflow_histories;
+DROP TABLE IF EXISTS post_locks;
+DROP TABLE IF EXISTS post_categories;
+DROP TABLE IF EXISTS post_tags;
+DROP TABLE IF EXISTS images CASCADE;
+DROP TABLE IF EXISTS posts CASCADE;
+DROP TABLE IF EXISTS categories;
+DROP TABLE IF EXISTS tags;
+DROP TABLE IF EXISTS users;
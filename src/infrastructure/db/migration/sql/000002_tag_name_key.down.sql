@@ -0,0 +1,6 @@
+-- タグ名の正規化キーを削除する
+-- 統合済みのタグは元に戻らない
+
+DROP INDEX IF EXISTS idx_tags_name_key;
+
+ALTER TABLE tags DROP COLUMN IF EXISTS name_key;
@@ -0,0 +1,5 @@
+-- タグ名の正規化キー（NFKC正規化 + 小文字化）を追加し、表記揺れで重複したタグを統合する
+-- トランザクションはマイグレーション実行時に開始する（再実行しても安全）
+-- キーの設定・重複したタグの統合・制約の追加は、アプリケーションと同じ正規化を使うため Go で行う（tag_name_key.go）
+
+ALTER TABLE tags ADD COLUMN IF NOT EXISTS name_key VARCHAR(50);
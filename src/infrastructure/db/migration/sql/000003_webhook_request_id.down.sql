@@ -0,0 +1,5 @@
+-- Webhookイベントと配信のリクエストIDを削除する
+
+ALTER TABLE webhook_deliveries DROP COLUMN IF EXISTS request_id;
+
+ALTER TABLE webhook_events DROP COLUMN IF EXISTS request_id;
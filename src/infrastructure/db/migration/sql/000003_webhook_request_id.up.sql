@@ -0,0 +1,6 @@
+-- Webhookイベント（アウトボックス）と配信に、イベントを発生させたリクエストのIDを記録する
+-- 受信側へ X-Request-ID として送信し、監査ログと同じIDでリクエストを追跡できるようにする
+
+ALTER TABLE webhook_events ADD COLUMN IF NOT EXISTS request_id VARCHAR(64) NOT NULL DEFAULT '';
+
+ALTER TABLE webhook_deliveries ADD COLUMN IF NOT EXISTS request_id VARCHAR(64) NOT NULL DEFAULT '';
@@ -0,0 +1,3 @@
+-- レート制限のトークンバケットを削除する
+
+DROP TABLE IF EXISTS rate_limit_buckets;
@@ -0,0 +1,11 @@
+-- レート制限のトークンバケット（RATE_LIMIT_STORE=postgres の場合に複数インスタンスで共有する）
+-- expires_at はバケットが満杯に戻る日時で、過ぎた行は削除しても結果が変わらない
+
+CREATE TABLE IF NOT EXISTS rate_limit_buckets (
+    key VARCHAR(255) PRIMARY KEY,
+    tokens DOUBLE PRECISION NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_rate_limit_buckets_expires_at ON rate_limit_buckets(expires_at);
@@ -0,0 +1,104 @@
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+// tagNameKeyMergeSQL は同じキーを持つタグを最も古いタグへ統合する
+const tagNameKeyMergeSQL = `
+CREATE TEMP TABLE tag_merge_map ON COMMIT DROP AS
+SELECT id AS source_id, target_id
+FROM (
+    SELECT id, first_value(id) OVER (PARTITION BY name_key ORDER BY created_at, id) AS target_id
+    FROM tags
+) ranked
+WHERE id <> target_id;
+
+-- 統合先タグが既に付いている投稿は主キー重複となるため除外する
+INSERT INTO post_tags (post_id, tag_id)
+SELECT pt.post_id, m.target_id
+FROM post_tags pt
+INNER JOIN tag_merge_map m ON pt.tag_id = m.source_id
+ON CONFLICT (post_id, tag_id) DO NOTHING;
+
+-- post_tags は ON DELETE CASCADE で削除される
+DELETE FROM tags WHERE id IN (SELECT source_id FROM tag_merge_map);
+`
+
+// tagNameKeyConstraintSQL は統合後のタグのキーに制約を追加する
+const tagNameKeyConstraintSQL = `
+ALTER TABLE tags ALTER COLUMN name_key SET NOT NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_tags_name_key ON tags(name_key);
+`
+
+// normalizedTag はアプリケーションと同じ正規化を適用したタグ名とキー
+type normalizedTag struct {
+	id   string
+	name string
+	key  string
+}
+
+// normalizeTag はタグ名に valueobject.TagName と同じ正規化を適用する
+// 表示名はNFKC正規化のみ（大文字小文字は維持）、キーは TagName.Key と同じ値になる
+func normalizeTag(id string, name string) normalizedTag {
+	return normalizedTag{
+		id:   id,
+		name: valueobject.NormalizeTagName(name),
+		key:  valueobject.NormalizeTagKey(name),
+	}
+}
+
+// backfillTagNameKey は既存のタグにキーを設定し、表記揺れで重複したタグを統合する
+// Postgres の normalize / lower は Unicode のバージョンや照合順序によって Go の正規化と結果が異なることがあるため、
+// キーは Go で計算する
+func backfillTagNameKey(ctx context.Context, tx *sql.Tx) error {
+	tags, err := loadNormalizedTags(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	for _, tag := range tags {
+		if _, err := tx.ExecContext(ctx, "UPDATE tags SET name_key = $1 WHERE id = $2", tag.key, tag.id); err != nil {
+			return fmt.Errorf("failed to set tag name key: %w", err)
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, tagNameKeyMergeSQL); err != nil {
+		return fmt.Errorf("failed to merge tags: %w", err)
+	}
+
+	// 表示名もNFKC正規化する（統合後はキーが一意のため、表示名も重複しない）
+	for _, tag := range tags {
+		if _, err := tx.ExecContext(ctx, "UPDATE tags SET name = $1 WHERE id = $2 AND name <> $1", tag.name, tag.id); err != nil {
+			return fmt.Errorf("failed to normalize tag name: %w", err)
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, tagNameKeyConstraintSQL); err != nil {
+		return fmt.Errorf("failed to add tag name key constraint: %w", err)
+	}
+	return nil
+}
+
+func loadNormalizedTags(ctx context.Context, tx *sql.Tx) ([]normalizedTag, error) {
+	rows, err := tx.QueryContext(ctx, "SELECT id, name FROM tags")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tags: %w", err)
+	}
+	defer rows.Close()
+
+	var tags []normalizedTag
+	for rows.Next() {
+		var id, name string
+		if err := rows.Scan(&id, &name); err != nil {
+			return nil, fmt.Errorf("failed to scan tag: %w", err)
+		}
+		tags = append(tags, normalizeTag(id, name))
+	}
+	return tags, rows.Err()
+}
@@ -0,0 +1,60 @@
+package migration
+
+import (
+	"testing"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeTag(t *testing.T) {
+	// マイグレーションで設定するキーと、アプリケーションが検索・登録に使うキーが一致すること
+	tests := []struct {
+		name         string
+		expectedName string
+		expectedKey  string
+	}{
+		{name: "Ｇｏｌａｎｇ", expectedName: "Golang", expectedKey: "golang"},
+		{name: " GoLang ", expectedName: "GoLang", expectedKey: "golang"},
+		{name: "ｶﾀｶﾅ", expectedName: "カタカナ", expectedKey: "カタカナ"},
+		{name: "ＡＩ_ml-０１", expectedName: "AI_ml-01", expectedKey: "ai_ml-01"},
+		{name: "日本語", expectedName: "日本語", expectedKey: "日本語"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tag := normalizeTag("id", tt.name)
+
+			assert.Equal(t, tt.expectedName, tag.name)
+			assert.Equal(t, tt.expectedKey, tag.key)
+
+			tagName, err := valueobject.NewTagName(tt.name)
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, tagName.String(), tag.name)
+			assert.Equal(t, tagName.Key(), tag.key)
+		})
+	}
+}
+
+func TestEmbedded_UpStep(t *testing.T) {
+	migrations, err := Embedded()
+
+	if !assert.NoError(t, err) {
+		return
+	}
+	for _, migration := range migrations {
+		if migration.Version == 2 {
+			assert.NotNil(t, migration.UpStep, "タグ名の正規化キーはGoで設定する")
+		}
+	}
+}
+
+func TestAttachSteps(t *testing.T) {
+	t.Run("存在しないバージョンに登録された処理はエラー", func(t *testing.T) {
+		_, err := attachSteps([]Migration{{Version: 1}}, map[int64]Step{2: backfillTagNameKey})
+
+		assert.Error(t, err)
+	})
+}
@@ -8,37 +8,74 @@ import "testing"
 // TestToOne tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOne(t *testing.T) {
+	t.Run("CategoryToCategoryUsingParent", testCategoryToOneCategoryUsingParent)
 	t.Run("ImageToPostUsingPost", testImageToOnePostUsingPost)
 	t.Run("ImageToUserUsingUser", testImageToOneUserUsingUser)
+	t.Run("PostLockToPostUsingPost", testPostLockToOnePostUsingPost)
+	t.Run("PostLockToUserUsingUser", testPostLockToOneUserUsingUser)
+	t.Run("PostWorkflowHistoryToUserUsingActor", testPostWorkflowHistoryToOneUserUsingActor)
+	t.Run("PostWorkflowHistoryToPostUsingPost", testPostWorkflowHistoryToOnePostUsingPost)
+	t.Run("PostToImageUsingOgImage", testPostToOneImageUsingOgImage)
+	t.Run("PostToCategoryUsingPrimaryCategory", testPostToOneCategoryUsingPrimaryCategory)
+	t.Run("PostToUserUsingReviewer", testPostToOneUserUsingReviewer)
+	t.Run("WebhookDeliveryToWebhookSubscriptionUsingSubscription", testWebhookDeliveryToOneWebhookSubscriptionUsingSubscription)
 }
 
 // TestOneToOne tests cannot be run in parallel
 // or deadlocks can occur.
-func TestOneToOne(t *testing.T) {}
+func TestOneToOne(t *testing.T) {
+	t.Run("PostToPostLockUsingPostLock", testPostOneToOnePostLockUsingPostLock)
+}
 
 // TestToMany tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToMany(t *testing.T) {
+	t.Run("CategoryToParentCategories", testCategoryToManyParentCategories)
+	t.Run("CategoryToPosts", testCategoryToManyPosts)
+	t.Run("CategoryToPrimaryCategoryPosts", testCategoryToManyPrimaryCategoryPosts)
+	t.Run("ImageToOgImagePosts", testImageToManyOgImagePosts)
 	t.Run("PostToImages", testPostToManyImages)
+	t.Run("PostToCategories", testPostToManyCategories)
 	t.Run("PostToTags", testPostToManyTags)
+	t.Run("PostToPostWorkflowHistories", testPostToManyPostWorkflowHistories)
 	t.Run("TagToPosts", testTagToManyPosts)
 	t.Run("UserToImages", testUserToManyImages)
+	t.Run("UserToPostLocks", testUserToManyPostLocks)
+	t.Run("UserToActorPostWorkflowHistories", testUserToManyActorPostWorkflowHistories)
+	t.Run("UserToReviewerPosts", testUserToManyReviewerPosts)
+	t.Run("WebhookSubscriptionToSubscriptionWebhookDeliveries", testWebhookSubscriptionToManySubscriptionWebhookDeliveries)
 }
 
 // TestToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOneSet(t *testing.T) {
+	t.Run("CategoryToCategoryUsingParentCategories", testCategoryToOneSetOpCategoryUsingParent)
 	t.Run("ImageToPostUsingImages", testImageToOneSetOpPostUsingPost)
 	t.Run("ImageToUserUsingImages", testImageToOneSetOpUserUsingUser)
+	t.Run("PostLockToPostUsingPostLock", testPostLockToOneSetOpPostUsingPost)
+	t.Run("PostLockToUserUsingPostLocks", testPostLockToOneSetOpUserUsingUser)
+	t.Run("PostWorkflowHistoryToUserUsingActorPostWorkflowHistories", testPostWorkflowHistoryToOneSetOpUserUsingActor)
+	t.Run("PostWorkflowHistoryToPostUsingPostWorkflowHistories", testPostWorkflowHistoryToOneSetOpPostUsingPost)
+	t.Run("PostToImageUsingOgImagePosts", testPostToOneSetOpImageUsingOgImage)
+	t.Run("PostToCategoryUsingPrimaryCategoryPosts", testPostToOneSetOpCategoryUsingPrimaryCategory)
+	t.Run("PostToUserUsingReviewerPosts", testPostToOneSetOpUserUsingReviewer)
+	t.Run("WebhookDeliveryToWebhookSubscriptionUsingSubscriptionWebhookDeliveries", testWebhookDeliveryToOneSetOpWebhookSubscriptionUsingSubscription)
 }
 
 // TestToOneRemove tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToOneRemove(t *testing.T) {}
+func TestToOneRemove(t *testing.T) {
+	t.Run("CategoryToCategoryUsingParentCategories", testCategoryToOneRemoveOpCategoryUsingParent)
+	t.Run("PostToImageUsingOgImagePosts", testPostToOneRemoveOpImageUsingOgImage)
+	t.Run("PostToCategoryUsingPrimaryCategoryPosts", testPostToOneRemoveOpCategoryUsingPrimaryCategory)
+	t.Run("PostToUserUsingReviewerPosts", testPostToOneRemoveOpUserUsingReviewer)
+}
 
 // TestOneToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
-func TestOneToOneSet(t *testing.T) {}
+func TestOneToOneSet(t *testing.T) {
+	t.Run("PostToPostLockUsingPostLock", testPostOneToOneSetOpPostLockUsingPostLock)
+}
 
 // TestOneToOneRemove tests cannot be run in parallel
 // or deadlocks can occur.
@@ -47,22 +84,44 @@ func TestOneToOneRemove(t *testing.T) {}
 // TestToManyAdd tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToManyAdd(t *testing.T) {
+	t.Run("CategoryToParentCategories", testCategoryToManyAddOpParentCategories)
+	t.Run("CategoryToPosts", testCategoryToManyAddOpPosts)
+	t.Run("CategoryToPrimaryCategoryPosts", testCategoryToManyAddOpPrimaryCategoryPosts)
+	t.Run("ImageToOgImagePosts", testImageToManyAddOpOgImagePosts)
 	t.Run("PostToImages", testPostToManyAddOpImages)
+	t.Run("PostToCategories", testPostToManyAddOpCategories)
 	t.Run("PostToTags", testPostToManyAddOpTags)
+	t.Run("PostToPostWorkflowHistories", testPostToManyAddOpPostWorkflowHistories)
 	t.Run("TagToPosts", testTagToManyAddOpPosts)
 	t.Run("UserToImages", testUserToManyAddOpImages)
+	t.Run("UserToPostLocks", testUserToManyAddOpPostLocks)
+	t.Run("UserToActorPostWorkflowHistories", testUserToManyAddOpActorPostWorkflowHistories)
+	t.Run("UserToReviewerPosts", testUserToManyAddOpReviewerPosts)
+	t.Run("WebhookSubscriptionToSubscriptionWebhookDeliveries", testWebhookSubscriptionToManyAddOpSubscriptionWebhookDeliveries)
 }
 
 // TestToManySet tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToManySet(t *testing.T) {
+	t.Run("CategoryToParentCategories", testCategoryToManySetOpParentCategories)
+	t.Run("CategoryToPosts", testCategoryToManySetOpPosts)
+	t.Run("CategoryToPrimaryCategoryPosts", testCategoryToManySetOpPrimaryCategoryPosts)
+	t.Run("ImageToOgImagePosts", testImageToManySetOpOgImagePosts)
+	t.Run("PostToCategories", testPostToManySetOpCategories)
 	t.Run("PostToTags", testPostToManySetOpTags)
 	t.Run("TagToPosts", testTagToManySetOpPosts)
+	t.Run("UserToReviewerPosts", testUserToManySetOpReviewerPosts)
 }
 
 // TestToManyRemove tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToManyRemove(t *testing.T) {
+	t.Run("CategoryToParentCategories", testCategoryToManyRemoveOpParentCategories)
+	t.Run("CategoryToPosts", testCategoryToManyRemoveOpPosts)
+	t.Run("CategoryToPrimaryCategoryPosts", testCategoryToManyRemoveOpPrimaryCategoryPosts)
+	t.Run("ImageToOgImagePosts", testImageToManyRemoveOpOgImagePosts)
+	t.Run("PostToCategories", testPostToManyRemoveOpCategories)
 	t.Run("PostToTags", testPostToManyRemoveOpTags)
 	t.Run("TagToPosts", testTagToManyRemoveOpPosts)
+	t.Run("UserToReviewerPosts", testUserToManyRemoveOpReviewerPosts)
 }
@@ -12,124 +12,268 @@ import "testing"
 // It does NOT run each operation group in parallel.
 // Separating the tests thusly grants avoidance of Postgres deadlocks.
 func TestParent(t *testing.T) {
+	t.Run("AuditEvents", testAuditEvents)
+	t.Run("Categories", testCategories)
 	t.Run("Images", testImages)
+	t.Run("PostLocks", testPostLocks)
+	t.Run("PostWorkflowHistories", testPostWorkflowHistories)
 	t.Run("Posts", testPosts)
+	t.Run("RateLimitBuckets", testRateLimitBuckets)
 	t.Run("Tags", testTags)
 	t.Run("Users", testUsers)
+	t.Run("WebhookDeliveries", testWebhookDeliveries)
+	t.Run("WebhookEvents", testWebhookEvents)
+	t.Run("WebhookSubscriptions", testWebhookSubscriptions)
 }
 
 func TestDelete(t *testing.T) {
+	t.Run("AuditEvents", testAuditEventsDelete)
+	t.Run("Categories", testCategoriesDelete)
 	t.Run("Images", testImagesDelete)
+	t.Run("PostLocks", testPostLocksDelete)
+	t.Run("PostWorkflowHistories", testPostWorkflowHistoriesDelete)
 	t.Run("Posts", testPostsDelete)
+	t.Run("RateLimitBuckets", testRateLimitBucketsDelete)
 	t.Run("Tags", testTagsDelete)
 	t.Run("Users", testUsersDelete)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesDelete)
+	t.Run("WebhookEvents", testWebhookEventsDelete)
+	t.Run("WebhookSubscriptions", testWebhookSubscriptionsDelete)
 }
 
 func TestQueryDeleteAll(t *testing.T) {
+	t.Run("AuditEvents", testAuditEventsQueryDeleteAll)
+	t.Run("Categories", testCategoriesQueryDeleteAll)
 	t.Run("Images", testImagesQueryDeleteAll)
+	t.Run("PostLocks", testPostLocksQueryDeleteAll)
+	t.Run("PostWorkflowHistories", testPostWorkflowHistoriesQueryDeleteAll)
 	t.Run("Posts", testPostsQueryDeleteAll)
+	t.Run("RateLimitBuckets", testRateLimitBucketsQueryDeleteAll)
 	t.Run("Tags", testTagsQueryDeleteAll)
 	t.Run("Users", testUsersQueryDeleteAll)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesQueryDeleteAll)
+	t.Run("WebhookEvents", testWebhookEventsQueryDeleteAll)
+	t.Run("WebhookSubscriptions", testWebhookSubscriptionsQueryDeleteAll)
 }
 
 func TestSliceDeleteAll(t *testing.T) {
+	t.Run("AuditEvents", testAuditEventsSliceDeleteAll)
+	t.Run("Categories", testCategoriesSliceDeleteAll)
 	t.Run("Images", testImagesSliceDeleteAll)
+	t.Run("PostLocks", testPostLocksSliceDeleteAll)
+	t.Run("PostWorkflowHistories", testPostWorkflowHistoriesSliceDeleteAll)
 	t.Run("Posts", testPostsSliceDeleteAll)
+	t.Run("RateLimitBuckets", testRateLimitBucketsSliceDeleteAll)
 	t.Run("Tags", testTagsSliceDeleteAll)
 	t.Run("Users", testUsersSliceDeleteAll)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesSliceDeleteAll)
+	t.Run("WebhookEvents", testWebhookEventsSliceDeleteAll)
+	t.Run("WebhookSubscriptions", testWebhookSubscriptionsSliceDeleteAll)
 }
 
 func TestExists(t *testing.T) {
+	t.Run("AuditEvents", testAuditEventsExists)
+	t.Run("Categories", testCategoriesExists)
 	t.Run("Images", testImagesExists)
+	t.Run("PostLocks", testPostLocksExists)
+	t.Run("PostWorkflowHistories", testPostWorkflowHistoriesExists)
 	t.Run("Posts", testPostsExists)
+	t.Run("RateLimitBuckets", testRateLimitBucketsExists)
 	t.Run("Tags", testTagsExists)
 	t.Run("Users", testUsersExists)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesExists)
+	t.Run("WebhookEvents", testWebhookEventsExists)
+	t.Run("WebhookSubscriptions", testWebhookSubscriptionsExists)
 }
 
 func TestFind(t *testing.T) {
+	t.Run("AuditEvents", testAuditEventsFind)
+	t.Run("Categories", testCategoriesFind)
 	t.Run("Images", testImagesFind)
+	t.Run("PostLocks", testPostLocksFind)
+	t.Run("PostWorkflowHistories", testPostWorkflowHistoriesFind)
 	t.Run("Posts", testPostsFind)
+	t.Run("RateLimitBuckets", testRateLimitBucketsFind)
 	t.Run("Tags", testTagsFind)
 	t.Run("Users", testUsersFind)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesFind)
+	t.Run("WebhookEvents", testWebhookEventsFind)
+	t.Run("WebhookSubscriptions", testWebhookSubscriptionsFind)
 }
 
 func TestBind(t *testing.T) {
+	t.Run("AuditEvents", testAuditEventsBind)
+	t.Run("Categories", testCategoriesBind)
 	t.Run("Images", testImagesBind)
+	t.Run("PostLocks", testPostLocksBind)
+	t.Run("PostWorkflowHistories", testPostWorkflowHistoriesBind)
 	t.Run("Posts", testPostsBind)
+	t.Run("RateLimitBuckets", testRateLimitBucketsBind)
 	t.Run("Tags", testTagsBind)
 	t.Run("Users", testUsersBind)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesBind)
+	t.Run("WebhookEvents", testWebhookEventsBind)
+	t.Run("WebhookSubscriptions", testWebhookSubscriptionsBind)
 }
 
 func TestOne(t *testing.T) {
+	t.Run("AuditEvents", testAuditEventsOne)
+	t.Run("Categories", testCategoriesOne)
 	t.Run("Images", testImagesOne)
+	t.Run("PostLocks", testPostLocksOne)
+	t.Run("PostWorkflowHistories", testPostWorkflowHistoriesOne)
 	t.Run("Posts", testPostsOne)
+	t.Run("RateLimitBuckets", testRateLimitBucketsOne)
 	t.Run("Tags", testTagsOne)
 	t.Run("Users", testUsersOne)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesOne)
+	t.Run("WebhookEvents", testWebhookEventsOne)
+	t.Run("WebhookSubscriptions", testWebhookSubscriptionsOne)
 }
 
 func TestAll(t *testing.T) {
+	t.Run("AuditEvents", testAuditEventsAll)
+	t.Run("Categories", testCategoriesAll)
 	t.Run("Images", testImagesAll)
+	t.Run("PostLocks", testPostLocksAll)
+	t.Run("PostWorkflowHistories", testPostWorkflowHistoriesAll)
 	t.Run("Posts", testPostsAll)
+	t.Run("RateLimitBuckets", testRateLimitBucketsAll)
 	t.Run("Tags", testTagsAll)
 	t.Run("Users", testUsersAll)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesAll)
+	t.Run("WebhookEvents", testWebhookEventsAll)
+	t.Run("WebhookSubscriptions", testWebhookSubscriptionsAll)
 }
 
 func TestCount(t *testing.T) {
+	t.Run("AuditEvents", testAuditEventsCount)
+	t.Run("Categories", testCategoriesCount)
 	t.Run("Images", testImagesCount)
+	t.Run("PostLocks", testPostLocksCount)
+	t.Run("PostWorkflowHistories", testPostWorkflowHistoriesCount)
 	t.Run("Posts", testPostsCount)
+	t.Run("RateLimitBuckets", testRateLimitBucketsCount)
 	t.Run("Tags", testTagsCount)
 	t.Run("Users", testUsersCount)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesCount)
+	t.Run("WebhookEvents", testWebhookEventsCount)
+	t.Run("WebhookSubscriptions", testWebhookSubscriptionsCount)
 }
 
 func TestHooks(t *testing.T) {
+	t.Run("AuditEvents", testAuditEventsHooks)
+	t.Run("Categories", testCategoriesHooks)
 	t.Run("Images", testImagesHooks)
+	t.Run("PostLocks", testPostLocksHooks)
+	t.Run("PostWorkflowHistories", testPostWorkflowHistoriesHooks)
 	t.Run("Posts", testPostsHooks)
+	t.Run("RateLimitBuckets", testRateLimitBucketsHooks)
 	t.Run("Tags", testTagsHooks)
 	t.Run("Users", testUsersHooks)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesHooks)
+	t.Run("WebhookEvents", testWebhookEventsHooks)
+	t.Run("WebhookSubscriptions", testWebhookSubscriptionsHooks)
 }
 
 func TestInsert(t *testing.T) {
+	t.Run("AuditEvents", testAuditEventsInsert)
+	t.Run("AuditEvents", testAuditEventsInsertWhitelist)
+	t.Run("Categories", testCategoriesInsert)
+	t.Run("Categories", testCategoriesInsertWhitelist)
 	t.Run("Images", testImagesInsert)
 	t.Run("Images", testImagesInsertWhitelist)
+	t.Run("PostLocks", testPostLocksInsert)
+	t.Run("PostLocks", testPostLocksInsertWhitelist)
+	t.Run("PostWorkflowHistories", testPostWorkflowHistoriesInsert)
+	t.Run("PostWorkflowHistories", testPostWorkflowHistoriesInsertWhitelist)
 	t.Run("Posts", testPostsInsert)
 	t.Run("Posts", testPostsInsertWhitelist)
+	t.Run("RateLimitBuckets", testRateLimitBucketsInsert)
+	t.Run("RateLimitBuckets", testRateLimitBucketsInsertWhitelist)
 	t.Run("Tags", testTagsInsert)
 	t.Run("Tags", testTagsInsertWhitelist)
 	t.Run("Users", testUsersInsert)
 	t.Run("Users", testUsersInsertWhitelist)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesInsert)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesInsertWhitelist)
+	t.Run("WebhookEvents", testWebhookEventsInsert)
+	t.Run("WebhookEvents", testWebhookEventsInsertWhitelist)
+	t.Run("WebhookSubscriptions", testWebhookSubscriptionsInsert)
+	t.Run("WebhookSubscriptions", testWebhookSubscriptionsInsertWhitelist)
 }
 
 func TestReload(t *testing.T) {
+	t.Run("AuditEvents", testAuditEventsReload)
+	t.Run("Categories", testCategoriesReload)
 	t.Run("Images", testImagesReload)
+	t.Run("PostLocks", testPostLocksReload)
+	t.Run("PostWorkflowHistories", testPostWorkflowHistoriesReload)
 	t.Run("Posts", testPostsReload)
+	t.Run("RateLimitBuckets", testRateLimitBucketsReload)
 	t.Run("Tags", testTagsReload)
 	t.Run("Users", testUsersReload)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesReload)
+	t.Run("WebhookEvents", testWebhookEventsReload)
+	t.Run("WebhookSubscriptions", testWebhookSubscriptionsReload)
 }
 
 func TestReloadAll(t *testing.T) {
+	t.Run("AuditEvents", testAuditEventsReloadAll)
+	t.Run("Categories", testCategoriesReloadAll)
 	t.Run("Images", testImagesReloadAll)
+	t.Run("PostLocks", testPostLocksReloadAll)
+	t.Run("PostWorkflowHistories", testPostWorkflowHistoriesReloadAll)
 	t.Run("Posts", testPostsReloadAll)
+	t.Run("RateLimitBuckets", testRateLimitBucketsReloadAll)
 	t.Run("Tags", testTagsReloadAll)
 	t.Run("Users", testUsersReloadAll)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesReloadAll)
+	t.Run("WebhookEvents", testWebhookEventsReloadAll)
+	t.Run("WebhookSubscriptions", testWebhookSubscriptionsReloadAll)
 }
 
 func TestSelect(t *testing.T) {
+	t.Run("AuditEvents", testAuditEventsSelect)
+	t.Run("Categories", testCategoriesSelect)
 	t.Run("Images", testImagesSelect)
+	t.Run("PostLocks", testPostLocksSelect)
+	t.Run("PostWorkflowHistories", testPostWorkflowHistoriesSelect)
 	t.Run("Posts", testPostsSelect)
+	t.Run("RateLimitBuckets", testRateLimitBucketsSelect)
 	t.Run("Tags", testTagsSelect)
 	t.Run("Users", testUsersSelect)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesSelect)
+	t.Run("WebhookEvents", testWebhookEventsSelect)
+	t.Run("WebhookSubscriptions", testWebhookSubscriptionsSelect)
 }
 
 func TestUpdate(t *testing.T) {
+	t.Run("AuditEvents", testAuditEventsUpdate)
+	t.Run("Categories", testCategoriesUpdate)
 	t.Run("Images", testImagesUpdate)
+	t.Run("PostLocks", testPostLocksUpdate)
+	t.Run("PostWorkflowHistories", testPostWorkflowHistoriesUpdate)
 	t.Run("Posts", testPostsUpdate)
+	t.Run("RateLimitBuckets", testRateLimitBucketsUpdate)
 	t.Run("Tags", testTagsUpdate)
 	t.Run("Users", testUsersUpdate)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesUpdate)
+	t.Run("WebhookEvents", testWebhookEventsUpdate)
+	t.Run("WebhookSubscriptions", testWebhookSubscriptionsUpdate)
 }
 
 func TestSliceUpdateAll(t *testing.T) {
+	t.Run("AuditEvents", testAuditEventsSliceUpdateAll)
+	t.Run("Categories", testCategoriesSliceUpdateAll)
 	t.Run("Images", testImagesSliceUpdateAll)
+	t.Run("PostLocks", testPostLocksSliceUpdateAll)
+	t.Run("PostWorkflowHistories", testPostWorkflowHistoriesSliceUpdateAll)
 	t.Run("Posts", testPostsSliceUpdateAll)
+	t.Run("RateLimitBuckets", testRateLimitBucketsSliceUpdateAll)
 	t.Run("Tags", testTagsSliceUpdateAll)
 	t.Run("Users", testUsersSliceUpdateAll)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesSliceUpdateAll)
+	t.Run("WebhookEvents", testWebhookEventsSliceUpdateAll)
+	t.Run("WebhookSubscriptions", testWebhookSubscriptionsSliceUpdateAll)
 }
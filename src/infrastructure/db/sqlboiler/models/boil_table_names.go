@@ -4,15 +4,33 @@
 package models
 
 var TableNames = struct {
-	Images   string
-	PostTags string
-	Posts    string
-	Tags     string
-	Users    string
+	AuditEvents           string
+	Categories            string
+	Images                string
+	PostCategories        string
+	PostLocks             string
+	PostTags              string
+	PostWorkflowHistories string
+	Posts                 string
+	RateLimitBuckets      string
+	Tags                  string
+	Users                 string
+	WebhookDeliveries     string
+	WebhookEvents         string
+	WebhookSubscriptions  string
 }{
-	Images:   "images",
-	PostTags: "post_tags",
-	Posts:    "posts",
-	Tags:     "tags",
-	Users:    "users",
+	AuditEvents:           "audit_events",
+	Categories:            "categories",
+	Images:                "images",
+	PostCategories:        "post_categories",
+	PostLocks:             "post_locks",
+	PostTags:              "post_tags",
+	PostWorkflowHistories: "post_workflow_histories",
+	Posts:                 "posts",
+	RateLimitBuckets:      "rate_limit_buckets",
+	Tags:                  "tags",
+	Users:                 "users",
+	WebhookDeliveries:     "webhook_deliveries",
+	WebhookEvents:         "webhook_events",
+	WebhookSubscriptions:  "webhook_subscriptions",
 }
@@ -0,0 +1,2262 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// Category is an object representing the database table.
+type Category struct {
+	ID          string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ParentID    null.String `boil:"parent_id" json:"parent_id,omitempty" toml:"parent_id" yaml:"parent_id,omitempty"`
+	Name        string      `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Slug        string      `boil:"slug" json:"slug" toml:"slug" yaml:"slug"`
+	Description string      `boil:"description" json:"description" toml:"description" yaml:"description"`
+	SortOrder   int         `boil:"sort_order" json:"sort_order" toml:"sort_order" yaml:"sort_order"`
+	CreatedAt   time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt   time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *categoryR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L categoryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var CategoryColumns = struct {
+	ID          string
+	ParentID    string
+	Name        string
+	Slug        string
+	Description string
+	SortOrder   string
+	CreatedAt   string
+	UpdatedAt   string
+}{
+	ID:          "id",
+	ParentID:    "parent_id",
+	Name:        "name",
+	Slug:        "slug",
+	Description: "description",
+	SortOrder:   "sort_order",
+	CreatedAt:   "created_at",
+	UpdatedAt:   "updated_at",
+}
+
+var CategoryTableColumns = struct {
+	ID          string
+	ParentID    string
+	Name        string
+	Slug        string
+	Description string
+	SortOrder   string
+	CreatedAt   string
+	UpdatedAt   string
+}{
+	ID:          "categories.id",
+	ParentID:    "categories.parent_id",
+	Name:        "categories.name",
+	Slug:        "categories.slug",
+	Description: "categories.description",
+	SortOrder:   "categories.sort_order",
+	CreatedAt:   "categories.created_at",
+	UpdatedAt:   "categories.updated_at",
+}
+
+// Generated where
+
+type whereHelperint struct{ field string }
+
+func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint) IN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint) NIN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var CategoryWhere = struct {
+	ID          whereHelperstring
+	ParentID    whereHelpernull_String
+	Name        whereHelperstring
+	Slug        whereHelperstring
+	Description whereHelperstring
+	SortOrder   whereHelperint
+	CreatedAt   whereHelpertime_Time
+	UpdatedAt   whereHelpertime_Time
+}{
+	ID:          whereHelperstring{field: "\"categories\".\"id\""},
+	ParentID:    whereHelpernull_String{field: "\"categories\".\"parent_id\""},
+	Name:        whereHelperstring{field: "\"categories\".\"name\""},
+	Slug:        whereHelperstring{field: "\"categories\".\"slug\""},
+	Description: whereHelperstring{field: "\"categories\".\"description\""},
+	SortOrder:   whereHelperint{field: "\"categories\".\"sort_order\""},
+	CreatedAt:   whereHelpertime_Time{field: "\"categories\".\"created_at\""},
+	UpdatedAt:   whereHelpertime_Time{field: "\"categories\".\"updated_at\""},
+}
+
+// CategoryRels is where relationship names are stored.
+var CategoryRels = struct {
+	Parent               string
+	ParentCategories     string
+	Posts                string
+	PrimaryCategoryPosts string
+}{
+	Parent:               "Parent",
+	ParentCategories:     "ParentCategories",
+	Posts:                "Posts",
+	PrimaryCategoryPosts: "PrimaryCategoryPosts",
+}
+
+// categoryR is where relationships are stored.
+type categoryR struct {
+	Parent               *Category     `boil:"Parent" json:"Parent" toml:"Parent" yaml:"Parent"`
+	ParentCategories     CategorySlice `boil:"ParentCategories" json:"ParentCategories" toml:"ParentCategories" yaml:"ParentCategories"`
+	Posts                PostSlice     `boil:"Posts" json:"Posts" toml:"Posts" yaml:"Posts"`
+	PrimaryCategoryPosts PostSlice     `boil:"PrimaryCategoryPosts" json:"PrimaryCategoryPosts" toml:"PrimaryCategoryPosts" yaml:"PrimaryCategoryPosts"`
+}
+
+// NewStruct creates a new relationship struct
+func (*categoryR) NewStruct() *categoryR {
+	return &categoryR{}
+}
+
+func (r *categoryR) GetParent() *Category {
+	if r == nil {
+		return nil
+	}
+	return r.Parent
+}
+
+func (r *categoryR) GetParentCategories() CategorySlice {
+	if r == nil {
+		return nil
+	}
+	return r.ParentCategories
+}
+
+func (r *categoryR) GetPosts() PostSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Posts
+}
+
+func (r *categoryR) GetPrimaryCategoryPosts() PostSlice {
+	if r == nil {
+		return nil
+	}
+	return r.PrimaryCategoryPosts
+}
+
+// categoryL is where Load methods for each relationship are stored.
+type categoryL struct{}
+
+var (
+	categoryAllColumns            = []string{"id", "parent_id", "name", "slug", "description", "sort_order", "created_at", "updated_at"}
+	categoryColumnsWithoutDefault = []string{"id", "name", "slug"}
+	categoryColumnsWithDefault    = []string{"parent_id", "description", "sort_order", "created_at", "updated_at"}
+	categoryPrimaryKeyColumns     = []string{"id"}
+	categoryGeneratedColumns      = []string{}
+)
+
+type (
+	// CategorySlice is an alias for a slice of pointers to Category.
+	// This should almost always be used instead of []Category.
+	CategorySlice []*Category
+	// CategoryHook is the signature for custom Category hook methods
+	CategoryHook func(context.Context, boil.ContextExecutor, *Category) error
+
+	categoryQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	categoryType                 = reflect.TypeOf(&Category{})
+	categoryMapping              = queries.MakeStructMapping(categoryType)
+	categoryPrimaryKeyMapping, _ = queries.BindMapping(categoryType, categoryMapping, categoryPrimaryKeyColumns)
+	categoryInsertCacheMut       sync.RWMutex
+	categoryInsertCache          = make(map[string]insertCache)
+	categoryUpdateCacheMut       sync.RWMutex
+	categoryUpdateCache          = make(map[string]updateCache)
+	categoryUpsertCacheMut       sync.RWMutex
+	categoryUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var categoryAfterSelectMu sync.Mutex
+var categoryAfterSelectHooks []CategoryHook
+
+var categoryBeforeInsertMu sync.Mutex
+var categoryBeforeInsertHooks []CategoryHook
+var categoryAfterInsertMu sync.Mutex
+var categoryAfterInsertHooks []CategoryHook
+
+var categoryBeforeUpdateMu sync.Mutex
+var categoryBeforeUpdateHooks []CategoryHook
+var categoryAfterUpdateMu sync.Mutex
+var categoryAfterUpdateHooks []CategoryHook
+
+var categoryBeforeDeleteMu sync.Mutex
+var categoryBeforeDeleteHooks []CategoryHook
+var categoryAfterDeleteMu sync.Mutex
+var categoryAfterDeleteHooks []CategoryHook
+
+var categoryBeforeUpsertMu sync.Mutex
+var categoryBeforeUpsertHooks []CategoryHook
+var categoryAfterUpsertMu sync.Mutex
+var categoryAfterUpsertHooks []CategoryHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Category) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range categoryAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Category) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range categoryBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Category) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range categoryAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Category) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range categoryBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Category) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range categoryAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Category) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range categoryBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Category) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range categoryAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Category) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range categoryBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Category) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range categoryAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddCategoryHook registers your hook function for all future operations.
+func AddCategoryHook(hookPoint boil.HookPoint, categoryHook CategoryHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		categoryAfterSelectMu.Lock()
+		categoryAfterSelectHooks = append(categoryAfterSelectHooks, categoryHook)
+		categoryAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		categoryBeforeInsertMu.Lock()
+		categoryBeforeInsertHooks = append(categoryBeforeInsertHooks, categoryHook)
+		categoryBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		categoryAfterInsertMu.Lock()
+		categoryAfterInsertHooks = append(categoryAfterInsertHooks, categoryHook)
+		categoryAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		categoryBeforeUpdateMu.Lock()
+		categoryBeforeUpdateHooks = append(categoryBeforeUpdateHooks, categoryHook)
+		categoryBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		categoryAfterUpdateMu.Lock()
+		categoryAfterUpdateHooks = append(categoryAfterUpdateHooks, categoryHook)
+		categoryAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		categoryBeforeDeleteMu.Lock()
+		categoryBeforeDeleteHooks = append(categoryBeforeDeleteHooks, categoryHook)
+		categoryBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		categoryAfterDeleteMu.Lock()
+		categoryAfterDeleteHooks = append(categoryAfterDeleteHooks, categoryHook)
+		categoryAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		categoryBeforeUpsertMu.Lock()
+		categoryBeforeUpsertHooks = append(categoryBeforeUpsertHooks, categoryHook)
+		categoryBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		categoryAfterUpsertMu.Lock()
+		categoryAfterUpsertHooks = append(categoryAfterUpsertHooks, categoryHook)
+		categoryAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single category record from the query using the global executor.
+func (q categoryQuery) OneG(ctx context.Context) (*Category, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single category record from the query.
+func (q categoryQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Category, error) {
+	o := &Category{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for categories")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all Category records from the query using the global executor.
+func (q categoryQuery) AllG(ctx context.Context) (CategorySlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all Category records from the query.
+func (q categoryQuery) All(ctx context.Context, exec boil.ContextExecutor) (CategorySlice, error) {
+	var o []*Category
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Category slice")
+	}
+
+	if len(categoryAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all Category records in the query using the global executor
+func (q categoryQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all Category records in the query.
+func (q categoryQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count categories rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q categoryQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q categoryQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if categories exists")
+	}
+
+	return count > 0, nil
+}
+
+// Parent pointed to by the foreign key.
+func (o *Category) Parent(mods ...qm.QueryMod) categoryQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ParentID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Categories(queryMods...)
+}
+
+// ParentCategories retrieves all the category's Categories with an executor via parent_id column.
+func (o *Category) ParentCategories(mods ...qm.QueryMod) categoryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"categories\".\"parent_id\"=?", o.ID),
+	)
+
+	return Categories(queryMods...)
+}
+
+// Posts retrieves all the post's Posts with an executor.
+func (o *Category) Posts(mods ...qm.QueryMod) postQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.InnerJoin("\"post_categories\" on \"posts\".\"id\" = \"post_categories\".\"post_id\""),
+		qm.Where("\"post_categories\".\"category_id\"=?", o.ID),
+	)
+
+	return Posts(queryMods...)
+}
+
+// PrimaryCategoryPosts retrieves all the post's Posts with an executor via primary_category_id column.
+func (o *Category) PrimaryCategoryPosts(mods ...qm.QueryMod) postQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"posts\".\"primary_category_id\"=?", o.ID),
+	)
+
+	return Posts(queryMods...)
+}
+
+// LoadParent allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (categoryL) LoadParent(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCategory interface{}, mods queries.Applicator) error {
+	var slice []*Category
+	var object *Category
+
+	if singular {
+		var ok bool
+		object, ok = maybeCategory.(*Category)
+		if !ok {
+			object = new(Category)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeCategory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeCategory))
+			}
+		}
+	} else {
+		s, ok := maybeCategory.(*[]*Category)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeCategory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeCategory))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &categoryR{}
+		}
+		if !queries.IsNil(object.ParentID) {
+			args[object.ParentID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &categoryR{}
+			}
+
+			if !queries.IsNil(obj.ParentID) {
+				args[obj.ParentID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`categories`),
+		qm.WhereIn(`categories.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Category")
+	}
+
+	var resultSlice []*Category
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Category")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for categories")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for categories")
+	}
+
+	if len(categoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Parent = foreign
+		if foreign.R == nil {
+			foreign.R = &categoryR{}
+		}
+		foreign.R.ParentCategories = append(foreign.R.ParentCategories, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ParentID, foreign.ID) {
+				local.R.Parent = foreign
+				if foreign.R == nil {
+					foreign.R = &categoryR{}
+				}
+				foreign.R.ParentCategories = append(foreign.R.ParentCategories, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadParentCategories allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (categoryL) LoadParentCategories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCategory interface{}, mods queries.Applicator) error {
+	var slice []*Category
+	var object *Category
+
+	if singular {
+		var ok bool
+		object, ok = maybeCategory.(*Category)
+		if !ok {
+			object = new(Category)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeCategory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeCategory))
+			}
+		}
+	} else {
+		s, ok := maybeCategory.(*[]*Category)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeCategory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeCategory))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &categoryR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &categoryR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`categories`),
+		qm.WhereIn(`categories.parent_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load categories")
+	}
+
+	var resultSlice []*Category
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice categories")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on categories")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for categories")
+	}
+
+	if len(categoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ParentCategories = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &categoryR{}
+			}
+			foreign.R.Parent = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ParentID) {
+				local.R.ParentCategories = append(local.R.ParentCategories, foreign)
+				if foreign.R == nil {
+					foreign.R = &categoryR{}
+				}
+				foreign.R.Parent = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPosts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (categoryL) LoadPosts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCategory interface{}, mods queries.Applicator) error {
+	var slice []*Category
+	var object *Category
+
+	if singular {
+		var ok bool
+		object, ok = maybeCategory.(*Category)
+		if !ok {
+			object = new(Category)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeCategory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeCategory))
+			}
+		}
+	} else {
+		s, ok := maybeCategory.(*[]*Category)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeCategory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeCategory))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &categoryR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &categoryR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.Select("\"posts\".\"id\", \"posts\".\"title\", \"posts\".\"content\", \"posts\".\"user_id\", \"posts\".\"status\", \"posts\".\"first_published_at\", \"posts\".\"content_updated_at\", \"posts\".\"created_at\", \"posts\".\"updated_at\", \"posts\".\"primary_category_id\", \"posts\".\"version\", \"posts\".\"reviewer_id\", \"posts\".\"excerpt\", \"posts\".\"meta_description\", \"posts\".\"canonical_url\", \"posts\".\"og_image_id\", \"a\".\"category_id\""),
+		qm.From("\"posts\""),
+		qm.InnerJoin("\"post_categories\" as \"a\" on \"posts\".\"id\" = \"a\".\"post_id\""),
+		qm.WhereIn("\"a\".\"category_id\" in ?", argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load posts")
+	}
+
+	var resultSlice []*Post
+
+	var localJoinCols []string
+	for results.Next() {
+		one := new(Post)
+		var localJoinCol string
+
+		err = results.Scan(&one.ID, &one.Title, &one.Content, &one.UserID, &one.Status, &one.FirstPublishedAt, &one.ContentUpdatedAt, &one.CreatedAt, &one.UpdatedAt, &one.PrimaryCategoryID, &one.Version, &one.ReviewerID, &one.Excerpt, &one.MetaDescription, &one.CanonicalURL, &one.OgImageID, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for posts")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice posts")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on posts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for posts")
+	}
+
+	if len(postAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Posts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &postR{}
+			}
+			foreign.R.Categories = append(foreign.R.Categories, object)
+		}
+		return nil
+	}
+
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if local.ID == localJoinCol {
+				local.R.Posts = append(local.R.Posts, foreign)
+				if foreign.R == nil {
+					foreign.R = &postR{}
+				}
+				foreign.R.Categories = append(foreign.R.Categories, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPrimaryCategoryPosts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (categoryL) LoadPrimaryCategoryPosts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCategory interface{}, mods queries.Applicator) error {
+	var slice []*Category
+	var object *Category
+
+	if singular {
+		var ok bool
+		object, ok = maybeCategory.(*Category)
+		if !ok {
+			object = new(Category)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeCategory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeCategory))
+			}
+		}
+	} else {
+		s, ok := maybeCategory.(*[]*Category)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeCategory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeCategory))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &categoryR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &categoryR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`posts`),
+		qm.WhereIn(`posts.primary_category_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load posts")
+	}
+
+	var resultSlice []*Post
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice posts")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on posts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for posts")
+	}
+
+	if len(postAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PrimaryCategoryPosts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &postR{}
+			}
+			foreign.R.PrimaryCategory = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.PrimaryCategoryID) {
+				local.R.PrimaryCategoryPosts = append(local.R.PrimaryCategoryPosts, foreign)
+				if foreign.R == nil {
+					foreign.R = &postR{}
+				}
+				foreign.R.PrimaryCategory = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetParentG of the category to the related item.
+// Sets o.R.Parent to related.
+// Adds o to related.R.ParentCategories.
+// Uses the global database handle.
+func (o *Category) SetParentG(ctx context.Context, insert bool, related *Category) error {
+	return o.SetParent(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetParent of the category to the related item.
+// Sets o.R.Parent to related.
+// Adds o to related.R.ParentCategories.
+func (o *Category) SetParent(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Category) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"categories\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"parent_id"}),
+		strmangle.WhereClause("\"", "\"", 2, categoryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ParentID, related.ID)
+	if o.R == nil {
+		o.R = &categoryR{
+			Parent: related,
+		}
+	} else {
+		o.R.Parent = related
+	}
+
+	if related.R == nil {
+		related.R = &categoryR{
+			ParentCategories: CategorySlice{o},
+		}
+	} else {
+		related.R.ParentCategories = append(related.R.ParentCategories, o)
+	}
+
+	return nil
+}
+
+// RemoveParentG relationship.
+// Sets o.R.Parent to nil.
+// Removes o from all passed in related items' relationships struct.
+// Uses the global database handle.
+func (o *Category) RemoveParentG(ctx context.Context, related *Category) error {
+	return o.RemoveParent(ctx, boil.GetContextDB(), related)
+}
+
+// RemoveParent relationship.
+// Sets o.R.Parent to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Category) RemoveParent(ctx context.Context, exec boil.ContextExecutor, related *Category) error {
+	var err error
+
+	queries.SetScanner(&o.ParentID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("parent_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Parent = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ParentCategories {
+		if queries.Equal(o.ParentID, ri.ParentID) {
+			continue
+		}
+
+		ln := len(related.R.ParentCategories)
+		if ln > 1 && i < ln-1 {
+			related.R.ParentCategories[i] = related.R.ParentCategories[ln-1]
+		}
+		related.R.ParentCategories = related.R.ParentCategories[:ln-1]
+		break
+	}
+	return nil
+}
+
+// AddParentCategoriesG adds the given related objects to the existing relationships
+// of the category, optionally inserting them as new records.
+// Appends related to o.R.ParentCategories.
+// Sets related.R.Parent appropriately.
+// Uses the global database handle.
+func (o *Category) AddParentCategoriesG(ctx context.Context, insert bool, related ...*Category) error {
+	return o.AddParentCategories(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddParentCategories adds the given related objects to the existing relationships
+// of the category, optionally inserting them as new records.
+// Appends related to o.R.ParentCategories.
+// Sets related.R.Parent appropriately.
+func (o *Category) AddParentCategories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Category) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ParentID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"categories\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"parent_id"}),
+				strmangle.WhereClause("\"", "\"", 2, categoryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ParentID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &categoryR{
+			ParentCategories: related,
+		}
+	} else {
+		o.R.ParentCategories = append(o.R.ParentCategories, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &categoryR{
+				Parent: o,
+			}
+		} else {
+			rel.R.Parent = o
+		}
+	}
+	return nil
+}
+
+// SetParentCategoriesG removes all previously related items of the
+// category replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Parent's ParentCategories accordingly.
+// Replaces o.R.ParentCategories with related.
+// Sets related.R.Parent's ParentCategories accordingly.
+// Uses the global database handle.
+func (o *Category) SetParentCategoriesG(ctx context.Context, insert bool, related ...*Category) error {
+	return o.SetParentCategories(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// SetParentCategories removes all previously related items of the
+// category replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Parent's ParentCategories accordingly.
+// Replaces o.R.ParentCategories with related.
+// Sets related.R.Parent's ParentCategories accordingly.
+func (o *Category) SetParentCategories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Category) error {
+	query := "update \"categories\" set \"parent_id\" = null where \"parent_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ParentCategories {
+			queries.SetScanner(&rel.ParentID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Parent = nil
+		}
+		o.R.ParentCategories = nil
+	}
+
+	return o.AddParentCategories(ctx, exec, insert, related...)
+}
+
+// RemoveParentCategoriesG relationships from objects passed in.
+// Removes related items from R.ParentCategories (uses pointer comparison, removal does not keep order)
+// Sets related.R.Parent.
+// Uses the global database handle.
+func (o *Category) RemoveParentCategoriesG(ctx context.Context, related ...*Category) error {
+	return o.RemoveParentCategories(ctx, boil.GetContextDB(), related...)
+}
+
+// RemoveParentCategories relationships from objects passed in.
+// Removes related items from R.ParentCategories (uses pointer comparison, removal does not keep order)
+// Sets related.R.Parent.
+func (o *Category) RemoveParentCategories(ctx context.Context, exec boil.ContextExecutor, related ...*Category) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ParentID, nil)
+		if rel.R != nil {
+			rel.R.Parent = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("parent_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ParentCategories {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ParentCategories)
+			if ln > 1 && i < ln-1 {
+				o.R.ParentCategories[i] = o.R.ParentCategories[ln-1]
+			}
+			o.R.ParentCategories = o.R.ParentCategories[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddPostsG adds the given related objects to the existing relationships
+// of the category, optionally inserting them as new records.
+// Appends related to o.R.Posts.
+// Sets related.R.Categories appropriately.
+// Uses the global database handle.
+func (o *Category) AddPostsG(ctx context.Context, insert bool, related ...*Post) error {
+	return o.AddPosts(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddPosts adds the given related objects to the existing relationships
+// of the category, optionally inserting them as new records.
+// Appends related to o.R.Posts.
+// Sets related.R.Categories appropriately.
+func (o *Category) AddPosts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Post) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		}
+	}
+
+	for _, rel := range related {
+		query := "insert into \"post_categories\" (\"category_id\", \"post_id\") values ($1, $2)"
+		values := []interface{}{o.ID, rel.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, query)
+			fmt.Fprintln(writer, values)
+		}
+		_, err = exec.ExecContext(ctx, query, values...)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert into join table")
+		}
+	}
+	if o.R == nil {
+		o.R = &categoryR{
+			Posts: related,
+		}
+	} else {
+		o.R.Posts = append(o.R.Posts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &postR{
+				Categories: CategorySlice{o},
+			}
+		} else {
+			rel.R.Categories = append(rel.R.Categories, o)
+		}
+	}
+	return nil
+}
+
+// SetPostsG removes all previously related items of the
+// category replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Categories's Posts accordingly.
+// Replaces o.R.Posts with related.
+// Sets related.R.Categories's Posts accordingly.
+// Uses the global database handle.
+func (o *Category) SetPostsG(ctx context.Context, insert bool, related ...*Post) error {
+	return o.SetPosts(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// SetPosts removes all previously related items of the
+// category replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Categories's Posts accordingly.
+// Replaces o.R.Posts with related.
+// Sets related.R.Categories's Posts accordingly.
+func (o *Category) SetPosts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Post) error {
+	query := "delete from \"post_categories\" where \"category_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	removePostsFromCategoriesSlice(o, related)
+	if o.R != nil {
+		o.R.Posts = nil
+	}
+
+	return o.AddPosts(ctx, exec, insert, related...)
+}
+
+// RemovePostsG relationships from objects passed in.
+// Removes related items from R.Posts (uses pointer comparison, removal does not keep order)
+// Sets related.R.Categories.
+// Uses the global database handle.
+func (o *Category) RemovePostsG(ctx context.Context, related ...*Post) error {
+	return o.RemovePosts(ctx, boil.GetContextDB(), related...)
+}
+
+// RemovePosts relationships from objects passed in.
+// Removes related items from R.Posts (uses pointer comparison, removal does not keep order)
+// Sets related.R.Categories.
+func (o *Category) RemovePosts(ctx context.Context, exec boil.ContextExecutor, related ...*Post) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	query := fmt.Sprintf(
+		"delete from \"post_categories\" where \"category_id\" = $1 and \"post_id\" in (%s)",
+		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
+	)
+	values := []interface{}{o.ID}
+	for _, rel := range related {
+		values = append(values, rel.ID)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err = exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+	removePostsFromCategoriesSlice(o, related)
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Posts {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Posts)
+			if ln > 1 && i < ln-1 {
+				o.R.Posts[i] = o.R.Posts[ln-1]
+			}
+			o.R.Posts = o.R.Posts[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+func removePostsFromCategoriesSlice(o *Category, related []*Post) {
+	for _, rel := range related {
+		if rel.R == nil {
+			continue
+		}
+		for i, ri := range rel.R.Categories {
+			if o.ID != ri.ID {
+				continue
+			}
+
+			ln := len(rel.R.Categories)
+			if ln > 1 && i < ln-1 {
+				rel.R.Categories[i] = rel.R.Categories[ln-1]
+			}
+			rel.R.Categories = rel.R.Categories[:ln-1]
+			break
+		}
+	}
+}
+
+// AddPrimaryCategoryPostsG adds the given related objects to the existing relationships
+// of the category, optionally inserting them as new records.
+// Appends related to o.R.PrimaryCategoryPosts.
+// Sets related.R.PrimaryCategory appropriately.
+// Uses the global database handle.
+func (o *Category) AddPrimaryCategoryPostsG(ctx context.Context, insert bool, related ...*Post) error {
+	return o.AddPrimaryCategoryPosts(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddPrimaryCategoryPosts adds the given related objects to the existing relationships
+// of the category, optionally inserting them as new records.
+// Appends related to o.R.PrimaryCategoryPosts.
+// Sets related.R.PrimaryCategory appropriately.
+func (o *Category) AddPrimaryCategoryPosts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Post) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.PrimaryCategoryID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"posts\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"primary_category_id"}),
+				strmangle.WhereClause("\"", "\"", 2, postPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.PrimaryCategoryID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &categoryR{
+			PrimaryCategoryPosts: related,
+		}
+	} else {
+		o.R.PrimaryCategoryPosts = append(o.R.PrimaryCategoryPosts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &postR{
+				PrimaryCategory: o,
+			}
+		} else {
+			rel.R.PrimaryCategory = o
+		}
+	}
+	return nil
+}
+
+// SetPrimaryCategoryPostsG removes all previously related items of the
+// category replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.PrimaryCategory's PrimaryCategoryPosts accordingly.
+// Replaces o.R.PrimaryCategoryPosts with related.
+// Sets related.R.PrimaryCategory's PrimaryCategoryPosts accordingly.
+// Uses the global database handle.
+func (o *Category) SetPrimaryCategoryPostsG(ctx context.Context, insert bool, related ...*Post) error {
+	return o.SetPrimaryCategoryPosts(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// SetPrimaryCategoryPosts removes all previously related items of the
+// category replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.PrimaryCategory's PrimaryCategoryPosts accordingly.
+// Replaces o.R.PrimaryCategoryPosts with related.
+// Sets related.R.PrimaryCategory's PrimaryCategoryPosts accordingly.
+func (o *Category) SetPrimaryCategoryPosts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Post) error {
+	query := "update \"posts\" set \"primary_category_id\" = null where \"primary_category_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.PrimaryCategoryPosts {
+			queries.SetScanner(&rel.PrimaryCategoryID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.PrimaryCategory = nil
+		}
+		o.R.PrimaryCategoryPosts = nil
+	}
+
+	return o.AddPrimaryCategoryPosts(ctx, exec, insert, related...)
+}
+
+// RemovePrimaryCategoryPostsG relationships from objects passed in.
+// Removes related items from R.PrimaryCategoryPosts (uses pointer comparison, removal does not keep order)
+// Sets related.R.PrimaryCategory.
+// Uses the global database handle.
+func (o *Category) RemovePrimaryCategoryPostsG(ctx context.Context, related ...*Post) error {
+	return o.RemovePrimaryCategoryPosts(ctx, boil.GetContextDB(), related...)
+}
+
+// RemovePrimaryCategoryPosts relationships from objects passed in.
+// Removes related items from R.PrimaryCategoryPosts (uses pointer comparison, removal does not keep order)
+// Sets related.R.PrimaryCategory.
+func (o *Category) RemovePrimaryCategoryPosts(ctx context.Context, exec boil.ContextExecutor, related ...*Post) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.PrimaryCategoryID, nil)
+		if rel.R != nil {
+			rel.R.PrimaryCategory = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("primary_category_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.PrimaryCategoryPosts {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.PrimaryCategoryPosts)
+			if ln > 1 && i < ln-1 {
+				o.R.PrimaryCategoryPosts[i] = o.R.PrimaryCategoryPosts[ln-1]
+			}
+			o.R.PrimaryCategoryPosts = o.R.PrimaryCategoryPosts[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// Categories retrieves all the records using an executor.
+func Categories(mods ...qm.QueryMod) categoryQuery {
+	mods = append(mods, qm.From("\"categories\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"categories\".*"})
+	}
+
+	return categoryQuery{q}
+}
+
+// FindCategoryG retrieves a single record by ID.
+func FindCategoryG(ctx context.Context, iD string, selectCols ...string) (*Category, error) {
+	return FindCategory(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindCategory retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindCategory(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*Category, error) {
+	categoryObj := &Category{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"categories\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, categoryObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from categories")
+	}
+
+	if err = categoryObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return categoryObj, err
+	}
+
+	return categoryObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *Category) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Category) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no categories provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(categoryColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	categoryInsertCacheMut.RLock()
+	cache, cached := categoryInsertCache[key]
+	categoryInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			categoryAllColumns,
+			categoryColumnsWithDefault,
+			categoryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(categoryType, categoryMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(categoryType, categoryMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"categories\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"categories\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into categories")
+	}
+
+	if !cached {
+		categoryInsertCacheMut.Lock()
+		categoryInsertCache[key] = cache
+		categoryInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single Category record using the global executor.
+// See Update for more documentation.
+func (o *Category) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the Category.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Category) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	categoryUpdateCacheMut.RLock()
+	cache, cached := categoryUpdateCache[key]
+	categoryUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			categoryAllColumns,
+			categoryPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update categories, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"categories\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, categoryPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(categoryType, categoryMapping, append(wl, categoryPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update categories row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for categories")
+	}
+
+	if !cached {
+		categoryUpdateCacheMut.Lock()
+		categoryUpdateCache[key] = cache
+		categoryUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q categoryQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q categoryQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for categories")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for categories")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o CategorySlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o CategorySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), categoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"categories\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, categoryPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in category slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all category")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *Category) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Category) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no categories provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(categoryColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	categoryUpsertCacheMut.RLock()
+	cache, cached := categoryUpsertCache[key]
+	categoryUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			categoryAllColumns,
+			categoryColumnsWithDefault,
+			categoryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			categoryAllColumns,
+			categoryPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert categories, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(categoryAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(categoryPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert categories, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(categoryPrimaryKeyColumns))
+			copy(conflict, categoryPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"categories\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(categoryType, categoryMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(categoryType, categoryMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert categories")
+	}
+
+	if !cached {
+		categoryUpsertCacheMut.Lock()
+		categoryUpsertCache[key] = cache
+		categoryUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single Category record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *Category) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single Category record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Category) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Category provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), categoryPrimaryKeyMapping)
+	sql := "DELETE FROM \"categories\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from categories")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for categories")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q categoryQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q categoryQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no categoryQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from categories")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for categories")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o CategorySlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o CategorySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(categoryBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), categoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"categories\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, categoryPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from category slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for categories")
+	}
+
+	if len(categoryAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *Category) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no Category provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Category) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindCategory(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *CategorySlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty CategorySlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *CategorySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := CategorySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), categoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"categories\".* FROM \"categories\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, categoryPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in CategorySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// CategoryExistsG checks if the Category row exists.
+func CategoryExistsG(ctx context.Context, iD string) (bool, error) {
+	return CategoryExists(ctx, boil.GetContextDB(), iD)
+}
+
+// CategoryExists checks if the Category row exists.
+func CategoryExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"categories\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if categories exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Category row exists.
+func (o *Category) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return CategoryExists(ctx, exec, o.ID)
+}
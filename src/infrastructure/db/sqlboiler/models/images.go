@@ -84,37 +84,6 @@ var ImageTableColumns = struct {
 
 // Generated where
 
-type whereHelperstring struct{ field string }
-
-func (w whereHelperstring) EQ(x string) qm.QueryMod      { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperstring) NEQ(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperstring) LT(x string) qm.QueryMod      { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperstring) LTE(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperstring) GT(x string) qm.QueryMod      { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperstring) GTE(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperstring) LIKE(x string) qm.QueryMod    { return qm.Where(w.field+" LIKE ?", x) }
-func (w whereHelperstring) NLIKE(x string) qm.QueryMod   { return qm.Where(w.field+" NOT LIKE ?", x) }
-func (w whereHelperstring) ILIKE(x string) qm.QueryMod   { return qm.Where(w.field+" ILIKE ?", x) }
-func (w whereHelperstring) NILIKE(x string) qm.QueryMod  { return qm.Where(w.field+" NOT ILIKE ?", x) }
-func (w whereHelperstring) SIMILAR(x string) qm.QueryMod { return qm.Where(w.field+" SIMILAR TO ?", x) }
-func (w whereHelperstring) NSIMILAR(x string) qm.QueryMod {
-	return qm.Where(w.field+" NOT SIMILAR TO ?", x)
-}
-func (w whereHelperstring) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
 type whereHelpernull_Int struct{ field string }
 
 func (w whereHelpernull_Int) EQ(x null.Int) qm.QueryMod {
@@ -153,27 +122,6 @@ func (w whereHelpernull_Int) NIN(slice []int) qm.QueryMod {
 func (w whereHelpernull_Int) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
 func (w whereHelpernull_Int) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 
-type whereHelpertime_Time struct{ field string }
-
-func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
-}
-func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
-}
-func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
 var ImageWhere = struct {
 	ID               whereHelperstring
 	OriginalFilename whereHelperstring
@@ -198,17 +146,20 @@ var ImageWhere = struct {
 
 // ImageRels is where relationship names are stored.
 var ImageRels = struct {
-	Post string
-	User string
+	Post         string
+	User         string
+	OgImagePosts string
 }{
-	Post: "Post",
-	User: "User",
+	Post:         "Post",
+	User:         "User",
+	OgImagePosts: "OgImagePosts",
 }
 
 // imageR is where relationships are stored.
 type imageR struct {
-	Post *Post `boil:"Post" json:"Post" toml:"Post" yaml:"Post"`
-	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+	Post         *Post     `boil:"Post" json:"Post" toml:"Post" yaml:"Post"`
+	User         *User     `boil:"User" json:"User" toml:"User" yaml:"User"`
+	OgImagePosts PostSlice `boil:"OgImagePosts" json:"OgImagePosts" toml:"OgImagePosts" yaml:"OgImagePosts"`
 }
 
 // NewStruct creates a new relationship struct
@@ -230,6 +181,13 @@ func (r *imageR) GetUser() *User {
 	return r.User
 }
 
+func (r *imageR) GetOgImagePosts() PostSlice {
+	if r == nil {
+		return nil
+	}
+	return r.OgImagePosts
+}
+
 // imageL is where Load methods for each relationship are stored.
 type imageL struct{}
 
@@ -588,6 +546,20 @@ func (o *Image) User(mods ...qm.QueryMod) userQuery {
 	return Users(queryMods...)
 }
 
+// OgImagePosts retrieves all the post's Posts with an executor via og_image_id column.
+func (o *Image) OgImagePosts(mods ...qm.QueryMod) postQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"posts\".\"og_image_id\"=?", o.ID),
+	)
+
+	return Posts(queryMods...)
+}
+
 // LoadPost allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (imageL) LoadPost(ctx context.Context, e boil.ContextExecutor, singular bool, maybeImage interface{}, mods queries.Applicator) error {
@@ -828,6 +800,119 @@ func (imageL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular boo
 	return nil
 }
 
+// LoadOgImagePosts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (imageL) LoadOgImagePosts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeImage interface{}, mods queries.Applicator) error {
+	var slice []*Image
+	var object *Image
+
+	if singular {
+		var ok bool
+		object, ok = maybeImage.(*Image)
+		if !ok {
+			object = new(Image)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeImage)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeImage))
+			}
+		}
+	} else {
+		s, ok := maybeImage.(*[]*Image)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeImage)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeImage))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &imageR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &imageR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`posts`),
+		qm.WhereIn(`posts.og_image_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load posts")
+	}
+
+	var resultSlice []*Post
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice posts")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on posts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for posts")
+	}
+
+	if len(postAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.OgImagePosts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &postR{}
+			}
+			foreign.R.OgImage = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.OgImageID) {
+				local.R.OgImagePosts = append(local.R.OgImagePosts, foreign)
+				if foreign.R == nil {
+					foreign.R = &postR{}
+				}
+				foreign.R.OgImage = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // SetPostG of the image to the related item.
 // Sets o.R.Post to related.
 // Adds o to related.R.Images.
@@ -938,6 +1023,161 @@ func (o *Image) SetUser(ctx context.Context, exec boil.ContextExecutor, insert b
 	return nil
 }
 
+// AddOgImagePostsG adds the given related objects to the existing relationships
+// of the image, optionally inserting them as new records.
+// Appends related to o.R.OgImagePosts.
+// Sets related.R.OgImage appropriately.
+// Uses the global database handle.
+func (o *Image) AddOgImagePostsG(ctx context.Context, insert bool, related ...*Post) error {
+	return o.AddOgImagePosts(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddOgImagePosts adds the given related objects to the existing relationships
+// of the image, optionally inserting them as new records.
+// Appends related to o.R.OgImagePosts.
+// Sets related.R.OgImage appropriately.
+func (o *Image) AddOgImagePosts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Post) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.OgImageID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"posts\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"og_image_id"}),
+				strmangle.WhereClause("\"", "\"", 2, postPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.OgImageID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &imageR{
+			OgImagePosts: related,
+		}
+	} else {
+		o.R.OgImagePosts = append(o.R.OgImagePosts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &postR{
+				OgImage: o,
+			}
+		} else {
+			rel.R.OgImage = o
+		}
+	}
+	return nil
+}
+
+// SetOgImagePostsG removes all previously related items of the
+// image replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.OgImage's OgImagePosts accordingly.
+// Replaces o.R.OgImagePosts with related.
+// Sets related.R.OgImage's OgImagePosts accordingly.
+// Uses the global database handle.
+func (o *Image) SetOgImagePostsG(ctx context.Context, insert bool, related ...*Post) error {
+	return o.SetOgImagePosts(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// SetOgImagePosts removes all previously related items of the
+// image replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.OgImage's OgImagePosts accordingly.
+// Replaces o.R.OgImagePosts with related.
+// Sets related.R.OgImage's OgImagePosts accordingly.
+func (o *Image) SetOgImagePosts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Post) error {
+	query := "update \"posts\" set \"og_image_id\" = null where \"og_image_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.OgImagePosts {
+			queries.SetScanner(&rel.OgImageID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.OgImage = nil
+		}
+		o.R.OgImagePosts = nil
+	}
+
+	return o.AddOgImagePosts(ctx, exec, insert, related...)
+}
+
+// RemoveOgImagePostsG relationships from objects passed in.
+// Removes related items from R.OgImagePosts (uses pointer comparison, removal does not keep order)
+// Sets related.R.OgImage.
+// Uses the global database handle.
+func (o *Image) RemoveOgImagePostsG(ctx context.Context, related ...*Post) error {
+	return o.RemoveOgImagePosts(ctx, boil.GetContextDB(), related...)
+}
+
+// RemoveOgImagePosts relationships from objects passed in.
+// Removes related items from R.OgImagePosts (uses pointer comparison, removal does not keep order)
+// Sets related.R.OgImage.
+func (o *Image) RemoveOgImagePosts(ctx context.Context, exec boil.ContextExecutor, related ...*Post) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.OgImageID, nil)
+		if rel.R != nil {
+			rel.R.OgImage = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("og_image_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.OgImagePosts {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.OgImagePosts)
+			if ln > 1 && i < ln-1 {
+				o.R.OgImagePosts[i] = o.R.OgImagePosts[ln-1]
+			}
+			o.R.OgImagePosts = o.R.OgImagePosts[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // Images retrieves all the records using an executor.
 func Images(mods ...qm.QueryMod) imageQuery {
 	mods = append(mods, qm.From("\"images\""))
@@ -0,0 +1,1425 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// PostLock is an object representing the database table.
+type PostLock struct {
+	PostID     string    `boil:"post_id" json:"post_id" toml:"post_id" yaml:"post_id"`
+	UserID     string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	AcquiredAt time.Time `boil:"acquired_at" json:"acquired_at" toml:"acquired_at" yaml:"acquired_at"`
+	ExpiresAt  time.Time `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+
+	R *postLockR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L postLockL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var PostLockColumns = struct {
+	PostID     string
+	UserID     string
+	AcquiredAt string
+	ExpiresAt  string
+}{
+	PostID:     "post_id",
+	UserID:     "user_id",
+	AcquiredAt: "acquired_at",
+	ExpiresAt:  "expires_at",
+}
+
+var PostLockTableColumns = struct {
+	PostID     string
+	UserID     string
+	AcquiredAt string
+	ExpiresAt  string
+}{
+	PostID:     "post_locks.post_id",
+	UserID:     "post_locks.user_id",
+	AcquiredAt: "post_locks.acquired_at",
+	ExpiresAt:  "post_locks.expires_at",
+}
+
+// Generated where
+
+var PostLockWhere = struct {
+	PostID     whereHelperstring
+	UserID     whereHelperstring
+	AcquiredAt whereHelpertime_Time
+	ExpiresAt  whereHelpertime_Time
+}{
+	PostID:     whereHelperstring{field: "\"post_locks\".\"post_id\""},
+	UserID:     whereHelperstring{field: "\"post_locks\".\"user_id\""},
+	AcquiredAt: whereHelpertime_Time{field: "\"post_locks\".\"acquired_at\""},
+	ExpiresAt:  whereHelpertime_Time{field: "\"post_locks\".\"expires_at\""},
+}
+
+// PostLockRels is where relationship names are stored.
+var PostLockRels = struct {
+	Post string
+	User string
+}{
+	Post: "Post",
+	User: "User",
+}
+
+// postLockR is where relationships are stored.
+type postLockR struct {
+	Post *Post `boil:"Post" json:"Post" toml:"Post" yaml:"Post"`
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*postLockR) NewStruct() *postLockR {
+	return &postLockR{}
+}
+
+func (r *postLockR) GetPost() *Post {
+	if r == nil {
+		return nil
+	}
+	return r.Post
+}
+
+func (r *postLockR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+// postLockL is where Load methods for each relationship are stored.
+type postLockL struct{}
+
+var (
+	postLockAllColumns            = []string{"post_id", "user_id", "acquired_at", "expires_at"}
+	postLockColumnsWithoutDefault = []string{"post_id", "user_id", "acquired_at", "expires_at"}
+	postLockColumnsWithDefault    = []string{}
+	postLockPrimaryKeyColumns     = []string{"post_id"}
+	postLockGeneratedColumns      = []string{}
+)
+
+type (
+	// PostLockSlice is an alias for a slice of pointers to PostLock.
+	// This should almost always be used instead of []PostLock.
+	PostLockSlice []*PostLock
+	// PostLockHook is the signature for custom PostLock hook methods
+	PostLockHook func(context.Context, boil.ContextExecutor, *PostLock) error
+
+	postLockQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	postLockType                 = reflect.TypeOf(&PostLock{})
+	postLockMapping              = queries.MakeStructMapping(postLockType)
+	postLockPrimaryKeyMapping, _ = queries.BindMapping(postLockType, postLockMapping, postLockPrimaryKeyColumns)
+	postLockInsertCacheMut       sync.RWMutex
+	postLockInsertCache          = make(map[string]insertCache)
+	postLockUpdateCacheMut       sync.RWMutex
+	postLockUpdateCache          = make(map[string]updateCache)
+	postLockUpsertCacheMut       sync.RWMutex
+	postLockUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var postLockAfterSelectMu sync.Mutex
+var postLockAfterSelectHooks []PostLockHook
+
+var postLockBeforeInsertMu sync.Mutex
+var postLockBeforeInsertHooks []PostLockHook
+var postLockAfterInsertMu sync.Mutex
+var postLockAfterInsertHooks []PostLockHook
+
+var postLockBeforeUpdateMu sync.Mutex
+var postLockBeforeUpdateHooks []PostLockHook
+var postLockAfterUpdateMu sync.Mutex
+var postLockAfterUpdateHooks []PostLockHook
+
+var postLockBeforeDeleteMu sync.Mutex
+var postLockBeforeDeleteHooks []PostLockHook
+var postLockAfterDeleteMu sync.Mutex
+var postLockAfterDeleteHooks []PostLockHook
+
+var postLockBeforeUpsertMu sync.Mutex
+var postLockBeforeUpsertHooks []PostLockHook
+var postLockAfterUpsertMu sync.Mutex
+var postLockAfterUpsertHooks []PostLockHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *PostLock) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range postLockAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *PostLock) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range postLockBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *PostLock) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range postLockAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *PostLock) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range postLockBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *PostLock) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range postLockAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *PostLock) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range postLockBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *PostLock) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range postLockAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *PostLock) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range postLockBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *PostLock) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range postLockAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddPostLockHook registers your hook function for all future operations.
+func AddPostLockHook(hookPoint boil.HookPoint, postLockHook PostLockHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		postLockAfterSelectMu.Lock()
+		postLockAfterSelectHooks = append(postLockAfterSelectHooks, postLockHook)
+		postLockAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		postLockBeforeInsertMu.Lock()
+		postLockBeforeInsertHooks = append(postLockBeforeInsertHooks, postLockHook)
+		postLockBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		postLockAfterInsertMu.Lock()
+		postLockAfterInsertHooks = append(postLockAfterInsertHooks, postLockHook)
+		postLockAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		postLockBeforeUpdateMu.Lock()
+		postLockBeforeUpdateHooks = append(postLockBeforeUpdateHooks, postLockHook)
+		postLockBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		postLockAfterUpdateMu.Lock()
+		postLockAfterUpdateHooks = append(postLockAfterUpdateHooks, postLockHook)
+		postLockAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		postLockBeforeDeleteMu.Lock()
+		postLockBeforeDeleteHooks = append(postLockBeforeDeleteHooks, postLockHook)
+		postLockBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		postLockAfterDeleteMu.Lock()
+		postLockAfterDeleteHooks = append(postLockAfterDeleteHooks, postLockHook)
+		postLockAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		postLockBeforeUpsertMu.Lock()
+		postLockBeforeUpsertHooks = append(postLockBeforeUpsertHooks, postLockHook)
+		postLockBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		postLockAfterUpsertMu.Lock()
+		postLockAfterUpsertHooks = append(postLockAfterUpsertHooks, postLockHook)
+		postLockAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single postLock record from the query using the global executor.
+func (q postLockQuery) OneG(ctx context.Context) (*PostLock, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single postLock record from the query.
+func (q postLockQuery) One(ctx context.Context, exec boil.ContextExecutor) (*PostLock, error) {
+	o := &PostLock{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for post_locks")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all PostLock records from the query using the global executor.
+func (q postLockQuery) AllG(ctx context.Context) (PostLockSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all PostLock records from the query.
+func (q postLockQuery) All(ctx context.Context, exec boil.ContextExecutor) (PostLockSlice, error) {
+	var o []*PostLock
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to PostLock slice")
+	}
+
+	if len(postLockAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all PostLock records in the query using the global executor
+func (q postLockQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all PostLock records in the query.
+func (q postLockQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count post_locks rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q postLockQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q postLockQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if post_locks exists")
+	}
+
+	return count > 0, nil
+}
+
+// Post pointed to by the foreign key.
+func (o *PostLock) Post(mods ...qm.QueryMod) postQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.PostID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Posts(queryMods...)
+}
+
+// User pointed to by the foreign key.
+func (o *PostLock) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadPost allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (postLockL) LoadPost(ctx context.Context, e boil.ContextExecutor, singular bool, maybePostLock interface{}, mods queries.Applicator) error {
+	var slice []*PostLock
+	var object *PostLock
+
+	if singular {
+		var ok bool
+		object, ok = maybePostLock.(*PostLock)
+		if !ok {
+			object = new(PostLock)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePostLock)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePostLock))
+			}
+		}
+	} else {
+		s, ok := maybePostLock.(*[]*PostLock)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePostLock)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePostLock))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &postLockR{}
+		}
+		args[object.PostID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postLockR{}
+			}
+
+			args[obj.PostID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`posts`),
+		qm.WhereIn(`posts.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Post")
+	}
+
+	var resultSlice []*Post
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Post")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for posts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for posts")
+	}
+
+	if len(postAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Post = foreign
+		if foreign.R == nil {
+			foreign.R = &postR{}
+		}
+		foreign.R.PostLock = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.PostID == foreign.ID {
+				local.R.Post = foreign
+				if foreign.R == nil {
+					foreign.R = &postR{}
+				}
+				foreign.R.PostLock = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (postLockL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybePostLock interface{}, mods queries.Applicator) error {
+	var slice []*PostLock
+	var object *PostLock
+
+	if singular {
+		var ok bool
+		object, ok = maybePostLock.(*PostLock)
+		if !ok {
+			object = new(PostLock)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePostLock)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePostLock))
+			}
+		}
+	} else {
+		s, ok := maybePostLock.(*[]*PostLock)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePostLock)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePostLock))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &postLockR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postLockR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.PostLocks = append(foreign.R.PostLocks, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.PostLocks = append(foreign.R.PostLocks, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetPostG of the postLock to the related item.
+// Sets o.R.Post to related.
+// Adds o to related.R.PostLock.
+// Uses the global database handle.
+func (o *PostLock) SetPostG(ctx context.Context, insert bool, related *Post) error {
+	return o.SetPost(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetPost of the postLock to the related item.
+// Sets o.R.Post to related.
+// Adds o to related.R.PostLock.
+func (o *PostLock) SetPost(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Post) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"post_locks\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"post_id"}),
+		strmangle.WhereClause("\"", "\"", 2, postLockPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.PostID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.PostID = related.ID
+	if o.R == nil {
+		o.R = &postLockR{
+			Post: related,
+		}
+	} else {
+		o.R.Post = related
+	}
+
+	if related.R == nil {
+		related.R = &postR{
+			PostLock: o,
+		}
+	} else {
+		related.R.PostLock = o
+	}
+
+	return nil
+}
+
+// SetUserG of the postLock to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.PostLocks.
+// Uses the global database handle.
+func (o *PostLock) SetUserG(ctx context.Context, insert bool, related *User) error {
+	return o.SetUser(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetUser of the postLock to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.PostLocks.
+func (o *PostLock) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"post_locks\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, postLockPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.PostID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &postLockR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			PostLocks: PostLockSlice{o},
+		}
+	} else {
+		related.R.PostLocks = append(related.R.PostLocks, o)
+	}
+
+	return nil
+}
+
+// PostLocks retrieves all the records using an executor.
+func PostLocks(mods ...qm.QueryMod) postLockQuery {
+	mods = append(mods, qm.From("\"post_locks\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"post_locks\".*"})
+	}
+
+	return postLockQuery{q}
+}
+
+// FindPostLockG retrieves a single record by ID.
+func FindPostLockG(ctx context.Context, postID string, selectCols ...string) (*PostLock, error) {
+	return FindPostLock(ctx, boil.GetContextDB(), postID, selectCols...)
+}
+
+// FindPostLock retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindPostLock(ctx context.Context, exec boil.ContextExecutor, postID string, selectCols ...string) (*PostLock, error) {
+	postLockObj := &PostLock{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"post_locks\" where \"post_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, postID)
+
+	err := q.Bind(ctx, exec, postLockObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from post_locks")
+	}
+
+	if err = postLockObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return postLockObj, err
+	}
+
+	return postLockObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *PostLock) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *PostLock) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no post_locks provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(postLockColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	postLockInsertCacheMut.RLock()
+	cache, cached := postLockInsertCache[key]
+	postLockInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			postLockAllColumns,
+			postLockColumnsWithDefault,
+			postLockColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(postLockType, postLockMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(postLockType, postLockMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"post_locks\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"post_locks\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into post_locks")
+	}
+
+	if !cached {
+		postLockInsertCacheMut.Lock()
+		postLockInsertCache[key] = cache
+		postLockInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single PostLock record using the global executor.
+// See Update for more documentation.
+func (o *PostLock) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the PostLock.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *PostLock) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	postLockUpdateCacheMut.RLock()
+	cache, cached := postLockUpdateCache[key]
+	postLockUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			postLockAllColumns,
+			postLockPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update post_locks, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"post_locks\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, postLockPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(postLockType, postLockMapping, append(wl, postLockPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update post_locks row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for post_locks")
+	}
+
+	if !cached {
+		postLockUpdateCacheMut.Lock()
+		postLockUpdateCache[key] = cache
+		postLockUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q postLockQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q postLockQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for post_locks")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for post_locks")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o PostLockSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o PostLockSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), postLockPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"post_locks\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, postLockPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in postLock slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all postLock")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *PostLock) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *PostLock) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no post_locks provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(postLockColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	postLockUpsertCacheMut.RLock()
+	cache, cached := postLockUpsertCache[key]
+	postLockUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			postLockAllColumns,
+			postLockColumnsWithDefault,
+			postLockColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			postLockAllColumns,
+			postLockPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert post_locks, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(postLockAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(postLockPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert post_locks, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(postLockPrimaryKeyColumns))
+			copy(conflict, postLockPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"post_locks\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(postLockType, postLockMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(postLockType, postLockMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert post_locks")
+	}
+
+	if !cached {
+		postLockUpsertCacheMut.Lock()
+		postLockUpsertCache[key] = cache
+		postLockUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single PostLock record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *PostLock) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single PostLock record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *PostLock) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no PostLock provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), postLockPrimaryKeyMapping)
+	sql := "DELETE FROM \"post_locks\" WHERE \"post_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from post_locks")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for post_locks")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q postLockQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q postLockQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no postLockQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from post_locks")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for post_locks")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o PostLockSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o PostLockSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(postLockBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), postLockPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"post_locks\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, postLockPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from postLock slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for post_locks")
+	}
+
+	if len(postLockAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *PostLock) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no PostLock provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *PostLock) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindPostLock(ctx, exec, o.PostID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PostLockSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty PostLockSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PostLockSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := PostLockSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), postLockPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"post_locks\".* FROM \"post_locks\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, postLockPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in PostLockSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// PostLockExistsG checks if the PostLock row exists.
+func PostLockExistsG(ctx context.Context, postID string) (bool, error) {
+	return PostLockExists(ctx, boil.GetContextDB(), postID)
+}
+
+// PostLockExists checks if the PostLock row exists.
+func PostLockExists(ctx context.Context, exec boil.ContextExecutor, postID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"post_locks\" where \"post_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, postID)
+	}
+	row := exec.QueryRowContext(ctx, sql, postID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if post_locks exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the PostLock row exists.
+func (o *PostLock) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return PostLockExists(ctx, exec, o.PostID)
+}
@@ -0,0 +1,965 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testPostLocks(t *testing.T) {
+	t.Parallel()
+
+	query := PostLocks()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testPostLocksDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PostLock{}
+	if err = randomize.Struct(seed, o, postLockDBTypes, true, postLockColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostLock struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PostLocks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPostLocksQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PostLock{}
+	if err = randomize.Struct(seed, o, postLockDBTypes, true, postLockColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostLock struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := PostLocks().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PostLocks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPostLocksSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PostLock{}
+	if err = randomize.Struct(seed, o, postLockDBTypes, true, postLockColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostLock struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PostLockSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PostLocks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPostLocksExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PostLock{}
+	if err = randomize.Struct(seed, o, postLockDBTypes, true, postLockColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostLock struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := PostLockExists(ctx, tx, o.PostID)
+	if err != nil {
+		t.Errorf("Unable to check if PostLock exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected PostLockExists to return true, but got false.")
+	}
+}
+
+func testPostLocksFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PostLock{}
+	if err = randomize.Struct(seed, o, postLockDBTypes, true, postLockColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostLock struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	postLockFound, err := FindPostLock(ctx, tx, o.PostID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if postLockFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testPostLocksBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PostLock{}
+	if err = randomize.Struct(seed, o, postLockDBTypes, true, postLockColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostLock struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = PostLocks().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPostLocksOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PostLock{}
+	if err = randomize.Struct(seed, o, postLockDBTypes, true, postLockColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostLock struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := PostLocks().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testPostLocksAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	postLockOne := &PostLock{}
+	postLockTwo := &PostLock{}
+	if err = randomize.Struct(seed, postLockOne, postLockDBTypes, false, postLockColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostLock struct: %s", err)
+	}
+	if err = randomize.Struct(seed, postLockTwo, postLockDBTypes, false, postLockColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostLock struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = postLockOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = postLockTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PostLocks().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testPostLocksCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	postLockOne := &PostLock{}
+	postLockTwo := &PostLock{}
+	if err = randomize.Struct(seed, postLockOne, postLockDBTypes, false, postLockColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostLock struct: %s", err)
+	}
+	if err = randomize.Struct(seed, postLockTwo, postLockDBTypes, false, postLockColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostLock struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = postLockOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = postLockTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PostLocks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func postLockBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *PostLock) error {
+	*o = PostLock{}
+	return nil
+}
+
+func postLockAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *PostLock) error {
+	*o = PostLock{}
+	return nil
+}
+
+func postLockAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *PostLock) error {
+	*o = PostLock{}
+	return nil
+}
+
+func postLockBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *PostLock) error {
+	*o = PostLock{}
+	return nil
+}
+
+func postLockAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *PostLock) error {
+	*o = PostLock{}
+	return nil
+}
+
+func postLockBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *PostLock) error {
+	*o = PostLock{}
+	return nil
+}
+
+func postLockAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *PostLock) error {
+	*o = PostLock{}
+	return nil
+}
+
+func postLockBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *PostLock) error {
+	*o = PostLock{}
+	return nil
+}
+
+func postLockAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *PostLock) error {
+	*o = PostLock{}
+	return nil
+}
+
+func testPostLocksHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &PostLock{}
+	o := &PostLock{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, postLockDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize PostLock object: %s", err)
+	}
+
+	AddPostLockHook(boil.BeforeInsertHook, postLockBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	postLockBeforeInsertHooks = []PostLockHook{}
+
+	AddPostLockHook(boil.AfterInsertHook, postLockAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	postLockAfterInsertHooks = []PostLockHook{}
+
+	AddPostLockHook(boil.AfterSelectHook, postLockAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	postLockAfterSelectHooks = []PostLockHook{}
+
+	AddPostLockHook(boil.BeforeUpdateHook, postLockBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	postLockBeforeUpdateHooks = []PostLockHook{}
+
+	AddPostLockHook(boil.AfterUpdateHook, postLockAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	postLockAfterUpdateHooks = []PostLockHook{}
+
+	AddPostLockHook(boil.BeforeDeleteHook, postLockBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	postLockBeforeDeleteHooks = []PostLockHook{}
+
+	AddPostLockHook(boil.AfterDeleteHook, postLockAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	postLockAfterDeleteHooks = []PostLockHook{}
+
+	AddPostLockHook(boil.BeforeUpsertHook, postLockBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	postLockBeforeUpsertHooks = []PostLockHook{}
+
+	AddPostLockHook(boil.AfterUpsertHook, postLockAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	postLockAfterUpsertHooks = []PostLockHook{}
+}
+
+func testPostLocksInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PostLock{}
+	if err = randomize.Struct(seed, o, postLockDBTypes, true, postLockColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostLock struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PostLocks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPostLocksInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PostLock{}
+	if err = randomize.Struct(seed, o, postLockDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PostLock struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(postLockColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PostLocks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPostLockToOnePostUsingPost(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local PostLock
+	var foreign Post
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, postLockDBTypes, false, postLockColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostLock struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, postDBTypes, false, postColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Post struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.PostID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Post().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddPostHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *Post) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := PostLockSlice{&local}
+	if err = local.L.LoadPost(ctx, tx, false, (*[]*PostLock)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Post == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Post = nil
+	if err = local.L.LoadPost(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Post == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testPostLockToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local PostLock
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, postLockDBTypes, false, postLockColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostLock struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.UserID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddUserHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *User) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := PostLockSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*PostLock)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testPostLockToOneSetOpPostUsingPost(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PostLock
+	var b, c Post
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, postLockDBTypes, false, strmangle.SetComplement(postLockPrimaryKeyColumns, postLockColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, postDBTypes, false, strmangle.SetComplement(postPrimaryKeyColumns, postColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, postDBTypes, false, strmangle.SetComplement(postPrimaryKeyColumns, postColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Post{&b, &c} {
+		err = a.SetPost(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Post != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.PostLock != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.PostID != x.ID {
+			t.Error("foreign key was wrong value", a.PostID)
+		}
+
+		if exists, err := PostLockExists(ctx, tx, a.PostID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+func testPostLockToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PostLock
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, postLockDBTypes, false, strmangle.SetComplement(postLockPrimaryKeyColumns, postLockColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.PostLocks[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.UserID))
+		reflect.Indirect(reflect.ValueOf(&a.UserID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID, x.ID)
+		}
+	}
+}
+
+func testPostLocksReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PostLock{}
+	if err = randomize.Struct(seed, o, postLockDBTypes, true, postLockColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostLock struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPostLocksReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PostLock{}
+	if err = randomize.Struct(seed, o, postLockDBTypes, true, postLockColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostLock struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PostLockSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPostLocksSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PostLock{}
+	if err = randomize.Struct(seed, o, postLockDBTypes, true, postLockColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostLock struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PostLocks().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	postLockDBTypes = map[string]string{`PostID`: `uuid`, `UserID`: `uuid`, `AcquiredAt`: `timestamp with time zone`, `ExpiresAt`: `timestamp with time zone`}
+	_               = bytes.MinRead
+)
+
+func testPostLocksUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(postLockPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(postLockAllColumns) == len(postLockPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PostLock{}
+	if err = randomize.Struct(seed, o, postLockDBTypes, true, postLockColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostLock struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PostLocks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, postLockDBTypes, true, postLockPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PostLock struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testPostLocksSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(postLockAllColumns) == len(postLockPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PostLock{}
+	if err = randomize.Struct(seed, o, postLockDBTypes, true, postLockColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostLock struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PostLocks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, postLockDBTypes, true, postLockPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PostLock struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(postLockAllColumns, postLockPrimaryKeyColumns) {
+		fields = postLockAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			postLockAllColumns,
+			postLockPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := PostLockSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testPostLocksUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(postLockAllColumns) == len(postLockPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := PostLock{}
+	if err = randomize.Struct(seed, &o, postLockDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PostLock struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PostLock: %s", err)
+	}
+
+	count, err := PostLocks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, postLockDBTypes, false, postLockPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PostLock struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PostLock: %s", err)
+	}
+
+	count, err = PostLocks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1460 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// PostWorkflowHistory is an object representing the database table.
+type PostWorkflowHistory struct {
+	ID         int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	PostID     string    `boil:"post_id" json:"post_id" toml:"post_id" yaml:"post_id"`
+	FromStatus string    `boil:"from_status" json:"from_status" toml:"from_status" yaml:"from_status"`
+	ToStatus   string    `boil:"to_status" json:"to_status" toml:"to_status" yaml:"to_status"`
+	ActorID    string    `boil:"actor_id" json:"actor_id" toml:"actor_id" yaml:"actor_id"`
+	Comment    string    `boil:"comment" json:"comment" toml:"comment" yaml:"comment"`
+	CreatedAt  time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *postWorkflowHistoryR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L postWorkflowHistoryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var PostWorkflowHistoryColumns = struct {
+	ID         string
+	PostID     string
+	FromStatus string
+	ToStatus   string
+	ActorID    string
+	Comment    string
+	CreatedAt  string
+}{
+	ID:         "id",
+	PostID:     "post_id",
+	FromStatus: "from_status",
+	ToStatus:   "to_status",
+	ActorID:    "actor_id",
+	Comment:    "comment",
+	CreatedAt:  "created_at",
+}
+
+var PostWorkflowHistoryTableColumns = struct {
+	ID         string
+	PostID     string
+	FromStatus string
+	ToStatus   string
+	ActorID    string
+	Comment    string
+	CreatedAt  string
+}{
+	ID:         "post_workflow_histories.id",
+	PostID:     "post_workflow_histories.post_id",
+	FromStatus: "post_workflow_histories.from_status",
+	ToStatus:   "post_workflow_histories.to_status",
+	ActorID:    "post_workflow_histories.actor_id",
+	Comment:    "post_workflow_histories.comment",
+	CreatedAt:  "post_workflow_histories.created_at",
+}
+
+// Generated where
+
+var PostWorkflowHistoryWhere = struct {
+	ID         whereHelperint64
+	PostID     whereHelperstring
+	FromStatus whereHelperstring
+	ToStatus   whereHelperstring
+	ActorID    whereHelperstring
+	Comment    whereHelperstring
+	CreatedAt  whereHelpertime_Time
+}{
+	ID:         whereHelperint64{field: "\"post_workflow_histories\".\"id\""},
+	PostID:     whereHelperstring{field: "\"post_workflow_histories\".\"post_id\""},
+	FromStatus: whereHelperstring{field: "\"post_workflow_histories\".\"from_status\""},
+	ToStatus:   whereHelperstring{field: "\"post_workflow_histories\".\"to_status\""},
+	ActorID:    whereHelperstring{field: "\"post_workflow_histories\".\"actor_id\""},
+	Comment:    whereHelperstring{field: "\"post_workflow_histories\".\"comment\""},
+	CreatedAt:  whereHelpertime_Time{field: "\"post_workflow_histories\".\"created_at\""},
+}
+
+// PostWorkflowHistoryRels is where relationship names are stored.
+var PostWorkflowHistoryRels = struct {
+	Actor string
+	Post  string
+}{
+	Actor: "Actor",
+	Post:  "Post",
+}
+
+// postWorkflowHistoryR is where relationships are stored.
+type postWorkflowHistoryR struct {
+	Actor *User `boil:"Actor" json:"Actor" toml:"Actor" yaml:"Actor"`
+	Post  *Post `boil:"Post" json:"Post" toml:"Post" yaml:"Post"`
+}
+
+// NewStruct creates a new relationship struct
+func (*postWorkflowHistoryR) NewStruct() *postWorkflowHistoryR {
+	return &postWorkflowHistoryR{}
+}
+
+func (r *postWorkflowHistoryR) GetActor() *User {
+	if r == nil {
+		return nil
+	}
+	return r.Actor
+}
+
+func (r *postWorkflowHistoryR) GetPost() *Post {
+	if r == nil {
+		return nil
+	}
+	return r.Post
+}
+
+// postWorkflowHistoryL is where Load methods for each relationship are stored.
+type postWorkflowHistoryL struct{}
+
+var (
+	postWorkflowHistoryAllColumns            = []string{"id", "post_id", "from_status", "to_status", "actor_id", "comment", "created_at"}
+	postWorkflowHistoryColumnsWithoutDefault = []string{"post_id", "from_status", "to_status", "actor_id"}
+	postWorkflowHistoryColumnsWithDefault    = []string{"id", "comment", "created_at"}
+	postWorkflowHistoryPrimaryKeyColumns     = []string{"id"}
+	postWorkflowHistoryGeneratedColumns      = []string{}
+)
+
+type (
+	// PostWorkflowHistorySlice is an alias for a slice of pointers to PostWorkflowHistory.
+	// This should almost always be used instead of []PostWorkflowHistory.
+	PostWorkflowHistorySlice []*PostWorkflowHistory
+	// PostWorkflowHistoryHook is the signature for custom PostWorkflowHistory hook methods
+	PostWorkflowHistoryHook func(context.Context, boil.ContextExecutor, *PostWorkflowHistory) error
+
+	postWorkflowHistoryQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	postWorkflowHistoryType                 = reflect.TypeOf(&PostWorkflowHistory{})
+	postWorkflowHistoryMapping              = queries.MakeStructMapping(postWorkflowHistoryType)
+	postWorkflowHistoryPrimaryKeyMapping, _ = queries.BindMapping(postWorkflowHistoryType, postWorkflowHistoryMapping, postWorkflowHistoryPrimaryKeyColumns)
+	postWorkflowHistoryInsertCacheMut       sync.RWMutex
+	postWorkflowHistoryInsertCache          = make(map[string]insertCache)
+	postWorkflowHistoryUpdateCacheMut       sync.RWMutex
+	postWorkflowHistoryUpdateCache          = make(map[string]updateCache)
+	postWorkflowHistoryUpsertCacheMut       sync.RWMutex
+	postWorkflowHistoryUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var postWorkflowHistoryAfterSelectMu sync.Mutex
+var postWorkflowHistoryAfterSelectHooks []PostWorkflowHistoryHook
+
+var postWorkflowHistoryBeforeInsertMu sync.Mutex
+var postWorkflowHistoryBeforeInsertHooks []PostWorkflowHistoryHook
+var postWorkflowHistoryAfterInsertMu sync.Mutex
+var postWorkflowHistoryAfterInsertHooks []PostWorkflowHistoryHook
+
+var postWorkflowHistoryBeforeUpdateMu sync.Mutex
+var postWorkflowHistoryBeforeUpdateHooks []PostWorkflowHistoryHook
+var postWorkflowHistoryAfterUpdateMu sync.Mutex
+var postWorkflowHistoryAfterUpdateHooks []PostWorkflowHistoryHook
+
+var postWorkflowHistoryBeforeDeleteMu sync.Mutex
+var postWorkflowHistoryBeforeDeleteHooks []PostWorkflowHistoryHook
+var postWorkflowHistoryAfterDeleteMu sync.Mutex
+var postWorkflowHistoryAfterDeleteHooks []PostWorkflowHistoryHook
+
+var postWorkflowHistoryBeforeUpsertMu sync.Mutex
+var postWorkflowHistoryBeforeUpsertHooks []PostWorkflowHistoryHook
+var postWorkflowHistoryAfterUpsertMu sync.Mutex
+var postWorkflowHistoryAfterUpsertHooks []PostWorkflowHistoryHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *PostWorkflowHistory) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range postWorkflowHistoryAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *PostWorkflowHistory) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range postWorkflowHistoryBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *PostWorkflowHistory) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range postWorkflowHistoryAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *PostWorkflowHistory) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range postWorkflowHistoryBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *PostWorkflowHistory) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range postWorkflowHistoryAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *PostWorkflowHistory) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range postWorkflowHistoryBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *PostWorkflowHistory) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range postWorkflowHistoryAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *PostWorkflowHistory) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range postWorkflowHistoryBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *PostWorkflowHistory) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range postWorkflowHistoryAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddPostWorkflowHistoryHook registers your hook function for all future operations.
+func AddPostWorkflowHistoryHook(hookPoint boil.HookPoint, postWorkflowHistoryHook PostWorkflowHistoryHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		postWorkflowHistoryAfterSelectMu.Lock()
+		postWorkflowHistoryAfterSelectHooks = append(postWorkflowHistoryAfterSelectHooks, postWorkflowHistoryHook)
+		postWorkflowHistoryAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		postWorkflowHistoryBeforeInsertMu.Lock()
+		postWorkflowHistoryBeforeInsertHooks = append(postWorkflowHistoryBeforeInsertHooks, postWorkflowHistoryHook)
+		postWorkflowHistoryBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		postWorkflowHistoryAfterInsertMu.Lock()
+		postWorkflowHistoryAfterInsertHooks = append(postWorkflowHistoryAfterInsertHooks, postWorkflowHistoryHook)
+		postWorkflowHistoryAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		postWorkflowHistoryBeforeUpdateMu.Lock()
+		postWorkflowHistoryBeforeUpdateHooks = append(postWorkflowHistoryBeforeUpdateHooks, postWorkflowHistoryHook)
+		postWorkflowHistoryBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		postWorkflowHistoryAfterUpdateMu.Lock()
+		postWorkflowHistoryAfterUpdateHooks = append(postWorkflowHistoryAfterUpdateHooks, postWorkflowHistoryHook)
+		postWorkflowHistoryAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		postWorkflowHistoryBeforeDeleteMu.Lock()
+		postWorkflowHistoryBeforeDeleteHooks = append(postWorkflowHistoryBeforeDeleteHooks, postWorkflowHistoryHook)
+		postWorkflowHistoryBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		postWorkflowHistoryAfterDeleteMu.Lock()
+		postWorkflowHistoryAfterDeleteHooks = append(postWorkflowHistoryAfterDeleteHooks, postWorkflowHistoryHook)
+		postWorkflowHistoryAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		postWorkflowHistoryBeforeUpsertMu.Lock()
+		postWorkflowHistoryBeforeUpsertHooks = append(postWorkflowHistoryBeforeUpsertHooks, postWorkflowHistoryHook)
+		postWorkflowHistoryBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		postWorkflowHistoryAfterUpsertMu.Lock()
+		postWorkflowHistoryAfterUpsertHooks = append(postWorkflowHistoryAfterUpsertHooks, postWorkflowHistoryHook)
+		postWorkflowHistoryAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single postWorkflowHistory record from the query using the global executor.
+func (q postWorkflowHistoryQuery) OneG(ctx context.Context) (*PostWorkflowHistory, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single postWorkflowHistory record from the query.
+func (q postWorkflowHistoryQuery) One(ctx context.Context, exec boil.ContextExecutor) (*PostWorkflowHistory, error) {
+	o := &PostWorkflowHistory{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for post_workflow_histories")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all PostWorkflowHistory records from the query using the global executor.
+func (q postWorkflowHistoryQuery) AllG(ctx context.Context) (PostWorkflowHistorySlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all PostWorkflowHistory records from the query.
+func (q postWorkflowHistoryQuery) All(ctx context.Context, exec boil.ContextExecutor) (PostWorkflowHistorySlice, error) {
+	var o []*PostWorkflowHistory
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to PostWorkflowHistory slice")
+	}
+
+	if len(postWorkflowHistoryAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all PostWorkflowHistory records in the query using the global executor
+func (q postWorkflowHistoryQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all PostWorkflowHistory records in the query.
+func (q postWorkflowHistoryQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count post_workflow_histories rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q postWorkflowHistoryQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q postWorkflowHistoryQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if post_workflow_histories exists")
+	}
+
+	return count > 0, nil
+}
+
+// Actor pointed to by the foreign key.
+func (o *PostWorkflowHistory) Actor(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ActorID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Post pointed to by the foreign key.
+func (o *PostWorkflowHistory) Post(mods ...qm.QueryMod) postQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.PostID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Posts(queryMods...)
+}
+
+// LoadActor allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (postWorkflowHistoryL) LoadActor(ctx context.Context, e boil.ContextExecutor, singular bool, maybePostWorkflowHistory interface{}, mods queries.Applicator) error {
+	var slice []*PostWorkflowHistory
+	var object *PostWorkflowHistory
+
+	if singular {
+		var ok bool
+		object, ok = maybePostWorkflowHistory.(*PostWorkflowHistory)
+		if !ok {
+			object = new(PostWorkflowHistory)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePostWorkflowHistory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePostWorkflowHistory))
+			}
+		}
+	} else {
+		s, ok := maybePostWorkflowHistory.(*[]*PostWorkflowHistory)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePostWorkflowHistory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePostWorkflowHistory))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &postWorkflowHistoryR{}
+		}
+		args[object.ActorID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postWorkflowHistoryR{}
+			}
+
+			args[obj.ActorID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Actor = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ActorPostWorkflowHistories = append(foreign.R.ActorPostWorkflowHistories, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ActorID == foreign.ID {
+				local.R.Actor = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ActorPostWorkflowHistories = append(foreign.R.ActorPostWorkflowHistories, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPost allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (postWorkflowHistoryL) LoadPost(ctx context.Context, e boil.ContextExecutor, singular bool, maybePostWorkflowHistory interface{}, mods queries.Applicator) error {
+	var slice []*PostWorkflowHistory
+	var object *PostWorkflowHistory
+
+	if singular {
+		var ok bool
+		object, ok = maybePostWorkflowHistory.(*PostWorkflowHistory)
+		if !ok {
+			object = new(PostWorkflowHistory)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePostWorkflowHistory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePostWorkflowHistory))
+			}
+		}
+	} else {
+		s, ok := maybePostWorkflowHistory.(*[]*PostWorkflowHistory)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePostWorkflowHistory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePostWorkflowHistory))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &postWorkflowHistoryR{}
+		}
+		args[object.PostID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postWorkflowHistoryR{}
+			}
+
+			args[obj.PostID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`posts`),
+		qm.WhereIn(`posts.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Post")
+	}
+
+	var resultSlice []*Post
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Post")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for posts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for posts")
+	}
+
+	if len(postAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Post = foreign
+		if foreign.R == nil {
+			foreign.R = &postR{}
+		}
+		foreign.R.PostWorkflowHistories = append(foreign.R.PostWorkflowHistories, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.PostID == foreign.ID {
+				local.R.Post = foreign
+				if foreign.R == nil {
+					foreign.R = &postR{}
+				}
+				foreign.R.PostWorkflowHistories = append(foreign.R.PostWorkflowHistories, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetActorG of the postWorkflowHistory to the related item.
+// Sets o.R.Actor to related.
+// Adds o to related.R.ActorPostWorkflowHistories.
+// Uses the global database handle.
+func (o *PostWorkflowHistory) SetActorG(ctx context.Context, insert bool, related *User) error {
+	return o.SetActor(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetActor of the postWorkflowHistory to the related item.
+// Sets o.R.Actor to related.
+// Adds o to related.R.ActorPostWorkflowHistories.
+func (o *PostWorkflowHistory) SetActor(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"post_workflow_histories\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"actor_id"}),
+		strmangle.WhereClause("\"", "\"", 2, postWorkflowHistoryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ActorID = related.ID
+	if o.R == nil {
+		o.R = &postWorkflowHistoryR{
+			Actor: related,
+		}
+	} else {
+		o.R.Actor = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ActorPostWorkflowHistories: PostWorkflowHistorySlice{o},
+		}
+	} else {
+		related.R.ActorPostWorkflowHistories = append(related.R.ActorPostWorkflowHistories, o)
+	}
+
+	return nil
+}
+
+// SetPostG of the postWorkflowHistory to the related item.
+// Sets o.R.Post to related.
+// Adds o to related.R.PostWorkflowHistories.
+// Uses the global database handle.
+func (o *PostWorkflowHistory) SetPostG(ctx context.Context, insert bool, related *Post) error {
+	return o.SetPost(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetPost of the postWorkflowHistory to the related item.
+// Sets o.R.Post to related.
+// Adds o to related.R.PostWorkflowHistories.
+func (o *PostWorkflowHistory) SetPost(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Post) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"post_workflow_histories\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"post_id"}),
+		strmangle.WhereClause("\"", "\"", 2, postWorkflowHistoryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.PostID = related.ID
+	if o.R == nil {
+		o.R = &postWorkflowHistoryR{
+			Post: related,
+		}
+	} else {
+		o.R.Post = related
+	}
+
+	if related.R == nil {
+		related.R = &postR{
+			PostWorkflowHistories: PostWorkflowHistorySlice{o},
+		}
+	} else {
+		related.R.PostWorkflowHistories = append(related.R.PostWorkflowHistories, o)
+	}
+
+	return nil
+}
+
+// PostWorkflowHistories retrieves all the records using an executor.
+func PostWorkflowHistories(mods ...qm.QueryMod) postWorkflowHistoryQuery {
+	mods = append(mods, qm.From("\"post_workflow_histories\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"post_workflow_histories\".*"})
+	}
+
+	return postWorkflowHistoryQuery{q}
+}
+
+// FindPostWorkflowHistoryG retrieves a single record by ID.
+func FindPostWorkflowHistoryG(ctx context.Context, iD int64, selectCols ...string) (*PostWorkflowHistory, error) {
+	return FindPostWorkflowHistory(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindPostWorkflowHistory retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindPostWorkflowHistory(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*PostWorkflowHistory, error) {
+	postWorkflowHistoryObj := &PostWorkflowHistory{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"post_workflow_histories\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, postWorkflowHistoryObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from post_workflow_histories")
+	}
+
+	if err = postWorkflowHistoryObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return postWorkflowHistoryObj, err
+	}
+
+	return postWorkflowHistoryObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *PostWorkflowHistory) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *PostWorkflowHistory) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no post_workflow_histories provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(postWorkflowHistoryColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	postWorkflowHistoryInsertCacheMut.RLock()
+	cache, cached := postWorkflowHistoryInsertCache[key]
+	postWorkflowHistoryInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			postWorkflowHistoryAllColumns,
+			postWorkflowHistoryColumnsWithDefault,
+			postWorkflowHistoryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(postWorkflowHistoryType, postWorkflowHistoryMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(postWorkflowHistoryType, postWorkflowHistoryMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"post_workflow_histories\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"post_workflow_histories\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into post_workflow_histories")
+	}
+
+	if !cached {
+		postWorkflowHistoryInsertCacheMut.Lock()
+		postWorkflowHistoryInsertCache[key] = cache
+		postWorkflowHistoryInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single PostWorkflowHistory record using the global executor.
+// See Update for more documentation.
+func (o *PostWorkflowHistory) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the PostWorkflowHistory.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *PostWorkflowHistory) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	postWorkflowHistoryUpdateCacheMut.RLock()
+	cache, cached := postWorkflowHistoryUpdateCache[key]
+	postWorkflowHistoryUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			postWorkflowHistoryAllColumns,
+			postWorkflowHistoryPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update post_workflow_histories, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"post_workflow_histories\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, postWorkflowHistoryPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(postWorkflowHistoryType, postWorkflowHistoryMapping, append(wl, postWorkflowHistoryPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update post_workflow_histories row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for post_workflow_histories")
+	}
+
+	if !cached {
+		postWorkflowHistoryUpdateCacheMut.Lock()
+		postWorkflowHistoryUpdateCache[key] = cache
+		postWorkflowHistoryUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q postWorkflowHistoryQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q postWorkflowHistoryQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for post_workflow_histories")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for post_workflow_histories")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o PostWorkflowHistorySlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o PostWorkflowHistorySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), postWorkflowHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"post_workflow_histories\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, postWorkflowHistoryPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in postWorkflowHistory slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all postWorkflowHistory")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *PostWorkflowHistory) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *PostWorkflowHistory) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no post_workflow_histories provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(postWorkflowHistoryColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	postWorkflowHistoryUpsertCacheMut.RLock()
+	cache, cached := postWorkflowHistoryUpsertCache[key]
+	postWorkflowHistoryUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			postWorkflowHistoryAllColumns,
+			postWorkflowHistoryColumnsWithDefault,
+			postWorkflowHistoryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			postWorkflowHistoryAllColumns,
+			postWorkflowHistoryPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert post_workflow_histories, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(postWorkflowHistoryAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(postWorkflowHistoryPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert post_workflow_histories, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(postWorkflowHistoryPrimaryKeyColumns))
+			copy(conflict, postWorkflowHistoryPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"post_workflow_histories\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(postWorkflowHistoryType, postWorkflowHistoryMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(postWorkflowHistoryType, postWorkflowHistoryMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert post_workflow_histories")
+	}
+
+	if !cached {
+		postWorkflowHistoryUpsertCacheMut.Lock()
+		postWorkflowHistoryUpsertCache[key] = cache
+		postWorkflowHistoryUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single PostWorkflowHistory record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *PostWorkflowHistory) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single PostWorkflowHistory record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *PostWorkflowHistory) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no PostWorkflowHistory provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), postWorkflowHistoryPrimaryKeyMapping)
+	sql := "DELETE FROM \"post_workflow_histories\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from post_workflow_histories")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for post_workflow_histories")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q postWorkflowHistoryQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q postWorkflowHistoryQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no postWorkflowHistoryQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from post_workflow_histories")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for post_workflow_histories")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o PostWorkflowHistorySlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o PostWorkflowHistorySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(postWorkflowHistoryBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), postWorkflowHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"post_workflow_histories\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, postWorkflowHistoryPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from postWorkflowHistory slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for post_workflow_histories")
+	}
+
+	if len(postWorkflowHistoryAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *PostWorkflowHistory) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no PostWorkflowHistory provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *PostWorkflowHistory) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindPostWorkflowHistory(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PostWorkflowHistorySlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty PostWorkflowHistorySlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PostWorkflowHistorySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := PostWorkflowHistorySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), postWorkflowHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"post_workflow_histories\".* FROM \"post_workflow_histories\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, postWorkflowHistoryPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in PostWorkflowHistorySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// PostWorkflowHistoryExistsG checks if the PostWorkflowHistory row exists.
+func PostWorkflowHistoryExistsG(ctx context.Context, iD int64) (bool, error) {
+	return PostWorkflowHistoryExists(ctx, boil.GetContextDB(), iD)
+}
+
+// PostWorkflowHistoryExists checks if the PostWorkflowHistory row exists.
+func PostWorkflowHistoryExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"post_workflow_histories\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if post_workflow_histories exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the PostWorkflowHistory row exists.
+func (o *PostWorkflowHistory) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return PostWorkflowHistoryExists(ctx, exec, o.ID)
+}
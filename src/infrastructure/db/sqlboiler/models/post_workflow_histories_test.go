@@ -0,0 +1,969 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testPostWorkflowHistories(t *testing.T) {
+	t.Parallel()
+
+	query := PostWorkflowHistories()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testPostWorkflowHistoriesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PostWorkflowHistory{}
+	if err = randomize.Struct(seed, o, postWorkflowHistoryDBTypes, true, postWorkflowHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostWorkflowHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PostWorkflowHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPostWorkflowHistoriesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PostWorkflowHistory{}
+	if err = randomize.Struct(seed, o, postWorkflowHistoryDBTypes, true, postWorkflowHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostWorkflowHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := PostWorkflowHistories().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PostWorkflowHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPostWorkflowHistoriesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PostWorkflowHistory{}
+	if err = randomize.Struct(seed, o, postWorkflowHistoryDBTypes, true, postWorkflowHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostWorkflowHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PostWorkflowHistorySlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PostWorkflowHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPostWorkflowHistoriesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PostWorkflowHistory{}
+	if err = randomize.Struct(seed, o, postWorkflowHistoryDBTypes, true, postWorkflowHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostWorkflowHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := PostWorkflowHistoryExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if PostWorkflowHistory exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected PostWorkflowHistoryExists to return true, but got false.")
+	}
+}
+
+func testPostWorkflowHistoriesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PostWorkflowHistory{}
+	if err = randomize.Struct(seed, o, postWorkflowHistoryDBTypes, true, postWorkflowHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostWorkflowHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	postWorkflowHistoryFound, err := FindPostWorkflowHistory(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if postWorkflowHistoryFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testPostWorkflowHistoriesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PostWorkflowHistory{}
+	if err = randomize.Struct(seed, o, postWorkflowHistoryDBTypes, true, postWorkflowHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostWorkflowHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = PostWorkflowHistories().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPostWorkflowHistoriesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PostWorkflowHistory{}
+	if err = randomize.Struct(seed, o, postWorkflowHistoryDBTypes, true, postWorkflowHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostWorkflowHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := PostWorkflowHistories().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testPostWorkflowHistoriesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	postWorkflowHistoryOne := &PostWorkflowHistory{}
+	postWorkflowHistoryTwo := &PostWorkflowHistory{}
+	if err = randomize.Struct(seed, postWorkflowHistoryOne, postWorkflowHistoryDBTypes, false, postWorkflowHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostWorkflowHistory struct: %s", err)
+	}
+	if err = randomize.Struct(seed, postWorkflowHistoryTwo, postWorkflowHistoryDBTypes, false, postWorkflowHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostWorkflowHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = postWorkflowHistoryOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = postWorkflowHistoryTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PostWorkflowHistories().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testPostWorkflowHistoriesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	postWorkflowHistoryOne := &PostWorkflowHistory{}
+	postWorkflowHistoryTwo := &PostWorkflowHistory{}
+	if err = randomize.Struct(seed, postWorkflowHistoryOne, postWorkflowHistoryDBTypes, false, postWorkflowHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostWorkflowHistory struct: %s", err)
+	}
+	if err = randomize.Struct(seed, postWorkflowHistoryTwo, postWorkflowHistoryDBTypes, false, postWorkflowHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostWorkflowHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = postWorkflowHistoryOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = postWorkflowHistoryTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PostWorkflowHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func postWorkflowHistoryBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *PostWorkflowHistory) error {
+	*o = PostWorkflowHistory{}
+	return nil
+}
+
+func postWorkflowHistoryAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *PostWorkflowHistory) error {
+	*o = PostWorkflowHistory{}
+	return nil
+}
+
+func postWorkflowHistoryAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *PostWorkflowHistory) error {
+	*o = PostWorkflowHistory{}
+	return nil
+}
+
+func postWorkflowHistoryBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *PostWorkflowHistory) error {
+	*o = PostWorkflowHistory{}
+	return nil
+}
+
+func postWorkflowHistoryAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *PostWorkflowHistory) error {
+	*o = PostWorkflowHistory{}
+	return nil
+}
+
+func postWorkflowHistoryBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *PostWorkflowHistory) error {
+	*o = PostWorkflowHistory{}
+	return nil
+}
+
+func postWorkflowHistoryAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *PostWorkflowHistory) error {
+	*o = PostWorkflowHistory{}
+	return nil
+}
+
+func postWorkflowHistoryBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *PostWorkflowHistory) error {
+	*o = PostWorkflowHistory{}
+	return nil
+}
+
+func postWorkflowHistoryAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *PostWorkflowHistory) error {
+	*o = PostWorkflowHistory{}
+	return nil
+}
+
+func testPostWorkflowHistoriesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &PostWorkflowHistory{}
+	o := &PostWorkflowHistory{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, postWorkflowHistoryDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize PostWorkflowHistory object: %s", err)
+	}
+
+	AddPostWorkflowHistoryHook(boil.BeforeInsertHook, postWorkflowHistoryBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	postWorkflowHistoryBeforeInsertHooks = []PostWorkflowHistoryHook{}
+
+	AddPostWorkflowHistoryHook(boil.AfterInsertHook, postWorkflowHistoryAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	postWorkflowHistoryAfterInsertHooks = []PostWorkflowHistoryHook{}
+
+	AddPostWorkflowHistoryHook(boil.AfterSelectHook, postWorkflowHistoryAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	postWorkflowHistoryAfterSelectHooks = []PostWorkflowHistoryHook{}
+
+	AddPostWorkflowHistoryHook(boil.BeforeUpdateHook, postWorkflowHistoryBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	postWorkflowHistoryBeforeUpdateHooks = []PostWorkflowHistoryHook{}
+
+	AddPostWorkflowHistoryHook(boil.AfterUpdateHook, postWorkflowHistoryAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	postWorkflowHistoryAfterUpdateHooks = []PostWorkflowHistoryHook{}
+
+	AddPostWorkflowHistoryHook(boil.BeforeDeleteHook, postWorkflowHistoryBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	postWorkflowHistoryBeforeDeleteHooks = []PostWorkflowHistoryHook{}
+
+	AddPostWorkflowHistoryHook(boil.AfterDeleteHook, postWorkflowHistoryAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	postWorkflowHistoryAfterDeleteHooks = []PostWorkflowHistoryHook{}
+
+	AddPostWorkflowHistoryHook(boil.BeforeUpsertHook, postWorkflowHistoryBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	postWorkflowHistoryBeforeUpsertHooks = []PostWorkflowHistoryHook{}
+
+	AddPostWorkflowHistoryHook(boil.AfterUpsertHook, postWorkflowHistoryAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	postWorkflowHistoryAfterUpsertHooks = []PostWorkflowHistoryHook{}
+}
+
+func testPostWorkflowHistoriesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PostWorkflowHistory{}
+	if err = randomize.Struct(seed, o, postWorkflowHistoryDBTypes, true, postWorkflowHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostWorkflowHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PostWorkflowHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPostWorkflowHistoriesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PostWorkflowHistory{}
+	if err = randomize.Struct(seed, o, postWorkflowHistoryDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PostWorkflowHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(postWorkflowHistoryColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PostWorkflowHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPostWorkflowHistoryToOneUserUsingActor(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local PostWorkflowHistory
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, postWorkflowHistoryDBTypes, false, postWorkflowHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostWorkflowHistory struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.ActorID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Actor().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddUserHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *User) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := PostWorkflowHistorySlice{&local}
+	if err = local.L.LoadActor(ctx, tx, false, (*[]*PostWorkflowHistory)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Actor == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Actor = nil
+	if err = local.L.LoadActor(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Actor == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testPostWorkflowHistoryToOnePostUsingPost(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local PostWorkflowHistory
+	var foreign Post
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, postWorkflowHistoryDBTypes, false, postWorkflowHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostWorkflowHistory struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, postDBTypes, false, postColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Post struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.PostID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Post().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddPostHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *Post) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := PostWorkflowHistorySlice{&local}
+	if err = local.L.LoadPost(ctx, tx, false, (*[]*PostWorkflowHistory)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Post == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Post = nil
+	if err = local.L.LoadPost(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Post == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testPostWorkflowHistoryToOneSetOpUserUsingActor(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PostWorkflowHistory
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, postWorkflowHistoryDBTypes, false, strmangle.SetComplement(postWorkflowHistoryPrimaryKeyColumns, postWorkflowHistoryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetActor(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Actor != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ActorPostWorkflowHistories[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.ActorID != x.ID {
+			t.Error("foreign key was wrong value", a.ActorID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ActorID))
+		reflect.Indirect(reflect.ValueOf(&a.ActorID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.ActorID != x.ID {
+			t.Error("foreign key was wrong value", a.ActorID, x.ID)
+		}
+	}
+}
+func testPostWorkflowHistoryToOneSetOpPostUsingPost(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PostWorkflowHistory
+	var b, c Post
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, postWorkflowHistoryDBTypes, false, strmangle.SetComplement(postWorkflowHistoryPrimaryKeyColumns, postWorkflowHistoryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, postDBTypes, false, strmangle.SetComplement(postPrimaryKeyColumns, postColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, postDBTypes, false, strmangle.SetComplement(postPrimaryKeyColumns, postColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Post{&b, &c} {
+		err = a.SetPost(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Post != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.PostWorkflowHistories[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.PostID != x.ID {
+			t.Error("foreign key was wrong value", a.PostID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.PostID))
+		reflect.Indirect(reflect.ValueOf(&a.PostID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.PostID != x.ID {
+			t.Error("foreign key was wrong value", a.PostID, x.ID)
+		}
+	}
+}
+
+func testPostWorkflowHistoriesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PostWorkflowHistory{}
+	if err = randomize.Struct(seed, o, postWorkflowHistoryDBTypes, true, postWorkflowHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostWorkflowHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPostWorkflowHistoriesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PostWorkflowHistory{}
+	if err = randomize.Struct(seed, o, postWorkflowHistoryDBTypes, true, postWorkflowHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostWorkflowHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PostWorkflowHistorySlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPostWorkflowHistoriesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PostWorkflowHistory{}
+	if err = randomize.Struct(seed, o, postWorkflowHistoryDBTypes, true, postWorkflowHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostWorkflowHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PostWorkflowHistories().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	postWorkflowHistoryDBTypes = map[string]string{`ID`: `bigint`, `PostID`: `uuid`, `FromStatus`: `character varying`, `ToStatus`: `character varying`, `ActorID`: `uuid`, `Comment`: `text`, `CreatedAt`: `timestamp with time zone`}
+	_                          = bytes.MinRead
+)
+
+func testPostWorkflowHistoriesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(postWorkflowHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(postWorkflowHistoryAllColumns) == len(postWorkflowHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PostWorkflowHistory{}
+	if err = randomize.Struct(seed, o, postWorkflowHistoryDBTypes, true, postWorkflowHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostWorkflowHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PostWorkflowHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, postWorkflowHistoryDBTypes, true, postWorkflowHistoryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PostWorkflowHistory struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testPostWorkflowHistoriesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(postWorkflowHistoryAllColumns) == len(postWorkflowHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PostWorkflowHistory{}
+	if err = randomize.Struct(seed, o, postWorkflowHistoryDBTypes, true, postWorkflowHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostWorkflowHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PostWorkflowHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, postWorkflowHistoryDBTypes, true, postWorkflowHistoryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PostWorkflowHistory struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(postWorkflowHistoryAllColumns, postWorkflowHistoryPrimaryKeyColumns) {
+		fields = postWorkflowHistoryAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			postWorkflowHistoryAllColumns,
+			postWorkflowHistoryPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := PostWorkflowHistorySlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testPostWorkflowHistoriesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(postWorkflowHistoryAllColumns) == len(postWorkflowHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := PostWorkflowHistory{}
+	if err = randomize.Struct(seed, &o, postWorkflowHistoryDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PostWorkflowHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PostWorkflowHistory: %s", err)
+	}
+
+	count, err := PostWorkflowHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, postWorkflowHistoryDBTypes, false, postWorkflowHistoryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PostWorkflowHistory struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PostWorkflowHistory: %s", err)
+	}
+
+	count, err = PostWorkflowHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
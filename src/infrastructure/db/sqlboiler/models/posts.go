@@ -24,62 +24,97 @@ import (
 
 // Post is an object representing the database table.
 type Post struct {
-	ID               string    `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Title            string    `boil:"title" json:"title" toml:"title" yaml:"title"`
-	Content          string    `boil:"content" json:"content" toml:"content" yaml:"content"`
-	UserID           string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
-	Status           string    `boil:"status" json:"status" toml:"status" yaml:"status"`
-	FirstPublishedAt null.Time `boil:"first_published_at" json:"first_published_at,omitempty" toml:"first_published_at" yaml:"first_published_at,omitempty"`
-	ContentUpdatedAt null.Time `boil:"content_updated_at" json:"content_updated_at,omitempty" toml:"content_updated_at" yaml:"content_updated_at,omitempty"`
-	CreatedAt        time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt        time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ID                string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Title             string      `boil:"title" json:"title" toml:"title" yaml:"title"`
+	Content           string      `boil:"content" json:"content" toml:"content" yaml:"content"`
+	UserID            string      `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Status            string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	FirstPublishedAt  null.Time   `boil:"first_published_at" json:"first_published_at,omitempty" toml:"first_published_at" yaml:"first_published_at,omitempty"`
+	ContentUpdatedAt  null.Time   `boil:"content_updated_at" json:"content_updated_at,omitempty" toml:"content_updated_at" yaml:"content_updated_at,omitempty"`
+	CreatedAt         time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt         time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	PrimaryCategoryID null.String `boil:"primary_category_id" json:"primary_category_id,omitempty" toml:"primary_category_id" yaml:"primary_category_id,omitempty"`
+	Version           int         `boil:"version" json:"version" toml:"version" yaml:"version"`
+	ReviewerID        null.String `boil:"reviewer_id" json:"reviewer_id,omitempty" toml:"reviewer_id" yaml:"reviewer_id,omitempty"`
+	Excerpt           string      `boil:"excerpt" json:"excerpt" toml:"excerpt" yaml:"excerpt"`
+	MetaDescription   string      `boil:"meta_description" json:"meta_description" toml:"meta_description" yaml:"meta_description"`
+	CanonicalURL      string      `boil:"canonical_url" json:"canonical_url" toml:"canonical_url" yaml:"canonical_url"`
+	OgImageID         null.String `boil:"og_image_id" json:"og_image_id,omitempty" toml:"og_image_id" yaml:"og_image_id,omitempty"`
 
 	R *postR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L postL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var PostColumns = struct {
-	ID               string
-	Title            string
-	Content          string
-	UserID           string
-	Status           string
-	FirstPublishedAt string
-	ContentUpdatedAt string
-	CreatedAt        string
-	UpdatedAt        string
+	ID                string
+	Title             string
+	Content           string
+	UserID            string
+	Status            string
+	FirstPublishedAt  string
+	ContentUpdatedAt  string
+	CreatedAt         string
+	UpdatedAt         string
+	PrimaryCategoryID string
+	Version           string
+	ReviewerID        string
+	Excerpt           string
+	MetaDescription   string
+	CanonicalURL      string
+	OgImageID         string
 }{
-	ID:               "id",
-	Title:            "title",
-	Content:          "content",
-	UserID:           "user_id",
-	Status:           "status",
-	FirstPublishedAt: "first_published_at",
-	ContentUpdatedAt: "content_updated_at",
-	CreatedAt:        "created_at",
-	UpdatedAt:        "updated_at",
+	ID:                "id",
+	Title:             "title",
+	Content:           "content",
+	UserID:            "user_id",
+	Status:            "status",
+	FirstPublishedAt:  "first_published_at",
+	ContentUpdatedAt:  "content_updated_at",
+	CreatedAt:         "created_at",
+	UpdatedAt:         "updated_at",
+	PrimaryCategoryID: "primary_category_id",
+	Version:           "version",
+	ReviewerID:        "reviewer_id",
+	Excerpt:           "excerpt",
+	MetaDescription:   "meta_description",
+	CanonicalURL:      "canonical_url",
+	OgImageID:         "og_image_id",
 }
 
 var PostTableColumns = struct {
-	ID               string
-	Title            string
-	Content          string
-	UserID           string
-	Status           string
-	FirstPublishedAt string
-	ContentUpdatedAt string
-	CreatedAt        string
-	UpdatedAt        string
+	ID                string
+	Title             string
+	Content           string
+	UserID            string
+	Status            string
+	FirstPublishedAt  string
+	ContentUpdatedAt  string
+	CreatedAt         string
+	UpdatedAt         string
+	PrimaryCategoryID string
+	Version           string
+	ReviewerID        string
+	Excerpt           string
+	MetaDescription   string
+	CanonicalURL      string
+	OgImageID         string
 }{
-	ID:               "posts.id",
-	Title:            "posts.title",
-	Content:          "posts.content",
-	UserID:           "posts.user_id",
-	Status:           "posts.status",
-	FirstPublishedAt: "posts.first_published_at",
-	ContentUpdatedAt: "posts.content_updated_at",
-	CreatedAt:        "posts.created_at",
-	UpdatedAt:        "posts.updated_at",
+	ID:                "posts.id",
+	Title:             "posts.title",
+	Content:           "posts.content",
+	UserID:            "posts.user_id",
+	Status:            "posts.status",
+	FirstPublishedAt:  "posts.first_published_at",
+	ContentUpdatedAt:  "posts.content_updated_at",
+	CreatedAt:         "posts.created_at",
+	UpdatedAt:         "posts.updated_at",
+	PrimaryCategoryID: "posts.primary_category_id",
+	Version:           "posts.version",
+	ReviewerID:        "posts.reviewer_id",
+	Excerpt:           "posts.excerpt",
+	MetaDescription:   "posts.meta_description",
+	CanonicalURL:      "posts.canonical_url",
+	OgImageID:         "posts.og_image_id",
 }
 
 // Generated where
@@ -109,40 +144,72 @@ func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsN
 func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 
 var PostWhere = struct {
-	ID               whereHelperstring
-	Title            whereHelperstring
-	Content          whereHelperstring
-	UserID           whereHelperstring
-	Status           whereHelperstring
-	FirstPublishedAt whereHelpernull_Time
-	ContentUpdatedAt whereHelpernull_Time
-	CreatedAt        whereHelpertime_Time
-	UpdatedAt        whereHelpertime_Time
+	ID                whereHelperstring
+	Title             whereHelperstring
+	Content           whereHelperstring
+	UserID            whereHelperstring
+	Status            whereHelperstring
+	FirstPublishedAt  whereHelpernull_Time
+	ContentUpdatedAt  whereHelpernull_Time
+	CreatedAt         whereHelpertime_Time
+	UpdatedAt         whereHelpertime_Time
+	PrimaryCategoryID whereHelpernull_String
+	Version           whereHelperint
+	ReviewerID        whereHelpernull_String
+	Excerpt           whereHelperstring
+	MetaDescription   whereHelperstring
+	CanonicalURL      whereHelperstring
+	OgImageID         whereHelpernull_String
 }{
-	ID:               whereHelperstring{field: "\"posts\".\"id\""},
-	Title:            whereHelperstring{field: "\"posts\".\"title\""},
-	Content:          whereHelperstring{field: "\"posts\".\"content\""},
-	UserID:           whereHelperstring{field: "\"posts\".\"user_id\""},
-	Status:           whereHelperstring{field: "\"posts\".\"status\""},
-	FirstPublishedAt: whereHelpernull_Time{field: "\"posts\".\"first_published_at\""},
-	ContentUpdatedAt: whereHelpernull_Time{field: "\"posts\".\"content_updated_at\""},
-	CreatedAt:        whereHelpertime_Time{field: "\"posts\".\"created_at\""},
-	UpdatedAt:        whereHelpertime_Time{field: "\"posts\".\"updated_at\""},
+	ID:                whereHelperstring{field: "\"posts\".\"id\""},
+	Title:             whereHelperstring{field: "\"posts\".\"title\""},
+	Content:           whereHelperstring{field: "\"posts\".\"content\""},
+	UserID:            whereHelperstring{field: "\"posts\".\"user_id\""},
+	Status:            whereHelperstring{field: "\"posts\".\"status\""},
+	FirstPublishedAt:  whereHelpernull_Time{field: "\"posts\".\"first_published_at\""},
+	ContentUpdatedAt:  whereHelpernull_Time{field: "\"posts\".\"content_updated_at\""},
+	CreatedAt:         whereHelpertime_Time{field: "\"posts\".\"created_at\""},
+	UpdatedAt:         whereHelpertime_Time{field: "\"posts\".\"updated_at\""},
+	PrimaryCategoryID: whereHelpernull_String{field: "\"posts\".\"primary_category_id\""},
+	Version:           whereHelperint{field: "\"posts\".\"version\""},
+	ReviewerID:        whereHelpernull_String{field: "\"posts\".\"reviewer_id\""},
+	Excerpt:           whereHelperstring{field: "\"posts\".\"excerpt\""},
+	MetaDescription:   whereHelperstring{field: "\"posts\".\"meta_description\""},
+	CanonicalURL:      whereHelperstring{field: "\"posts\".\"canonical_url\""},
+	OgImageID:         whereHelpernull_String{field: "\"posts\".\"og_image_id\""},
 }
 
 // PostRels is where relationship names are stored.
 var PostRels = struct {
-	Images string
-	Tags   string
+	OgImage               string
+	PrimaryCategory       string
+	Reviewer              string
+	PostLock              string
+	Images                string
+	Categories            string
+	Tags                  string
+	PostWorkflowHistories string
 }{
-	Images: "Images",
-	Tags:   "Tags",
+	OgImage:               "OgImage",
+	PrimaryCategory:       "PrimaryCategory",
+	Reviewer:              "Reviewer",
+	PostLock:              "PostLock",
+	Images:                "Images",
+	Categories:            "Categories",
+	Tags:                  "Tags",
+	PostWorkflowHistories: "PostWorkflowHistories",
 }
 
 // postR is where relationships are stored.
 type postR struct {
-	Images ImageSlice `boil:"Images" json:"Images" toml:"Images" yaml:"Images"`
-	Tags   TagSlice   `boil:"Tags" json:"Tags" toml:"Tags" yaml:"Tags"`
+	OgImage               *Image                   `boil:"OgImage" json:"OgImage" toml:"OgImage" yaml:"OgImage"`
+	PrimaryCategory       *Category                `boil:"PrimaryCategory" json:"PrimaryCategory" toml:"PrimaryCategory" yaml:"PrimaryCategory"`
+	Reviewer              *User                    `boil:"Reviewer" json:"Reviewer" toml:"Reviewer" yaml:"Reviewer"`
+	PostLock              *PostLock                `boil:"PostLock" json:"PostLock" toml:"PostLock" yaml:"PostLock"`
+	Images                ImageSlice               `boil:"Images" json:"Images" toml:"Images" yaml:"Images"`
+	Categories            CategorySlice            `boil:"Categories" json:"Categories" toml:"Categories" yaml:"Categories"`
+	Tags                  TagSlice                 `boil:"Tags" json:"Tags" toml:"Tags" yaml:"Tags"`
+	PostWorkflowHistories PostWorkflowHistorySlice `boil:"PostWorkflowHistories" json:"PostWorkflowHistories" toml:"PostWorkflowHistories" yaml:"PostWorkflowHistories"`
 }
 
 // NewStruct creates a new relationship struct
@@ -150,6 +217,34 @@ func (*postR) NewStruct() *postR {
 	return &postR{}
 }
 
+func (r *postR) GetOgImage() *Image {
+	if r == nil {
+		return nil
+	}
+	return r.OgImage
+}
+
+func (r *postR) GetPrimaryCategory() *Category {
+	if r == nil {
+		return nil
+	}
+	return r.PrimaryCategory
+}
+
+func (r *postR) GetReviewer() *User {
+	if r == nil {
+		return nil
+	}
+	return r.Reviewer
+}
+
+func (r *postR) GetPostLock() *PostLock {
+	if r == nil {
+		return nil
+	}
+	return r.PostLock
+}
+
 func (r *postR) GetImages() ImageSlice {
 	if r == nil {
 		return nil
@@ -157,6 +252,13 @@ func (r *postR) GetImages() ImageSlice {
 	return r.Images
 }
 
+func (r *postR) GetCategories() CategorySlice {
+	if r == nil {
+		return nil
+	}
+	return r.Categories
+}
+
 func (r *postR) GetTags() TagSlice {
 	if r == nil {
 		return nil
@@ -164,13 +266,20 @@ func (r *postR) GetTags() TagSlice {
 	return r.Tags
 }
 
+func (r *postR) GetPostWorkflowHistories() PostWorkflowHistorySlice {
+	if r == nil {
+		return nil
+	}
+	return r.PostWorkflowHistories
+}
+
 // postL is where Load methods for each relationship are stored.
 type postL struct{}
 
 var (
-	postAllColumns            = []string{"id", "title", "content", "user_id", "status", "first_published_at", "content_updated_at", "created_at", "updated_at"}
+	postAllColumns            = []string{"id", "title", "content", "user_id", "status", "first_published_at", "content_updated_at", "created_at", "updated_at", "primary_category_id", "version", "reviewer_id", "excerpt", "meta_description", "canonical_url", "og_image_id"}
 	postColumnsWithoutDefault = []string{"id", "title", "content", "user_id"}
-	postColumnsWithDefault    = []string{"status", "first_published_at", "content_updated_at", "created_at", "updated_at"}
+	postColumnsWithDefault    = []string{"status", "first_published_at", "content_updated_at", "created_at", "updated_at", "primary_category_id", "version", "reviewer_id", "excerpt", "meta_description", "canonical_url", "og_image_id"}
 	postPrimaryKeyColumns     = []string{"id"}
 	postGeneratedColumns      = []string{}
 )
@@ -500,6 +609,50 @@ func (q postQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool,
 	return count > 0, nil
 }
 
+// OgImage pointed to by the foreign key.
+func (o *Post) OgImage(mods ...qm.QueryMod) imageQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.OgImageID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Images(queryMods...)
+}
+
+// PrimaryCategory pointed to by the foreign key.
+func (o *Post) PrimaryCategory(mods ...qm.QueryMod) categoryQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.PrimaryCategoryID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Categories(queryMods...)
+}
+
+// Reviewer pointed to by the foreign key.
+func (o *Post) Reviewer(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ReviewerID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// PostLock pointed to by the foreign key.
+func (o *Post) PostLock(mods ...qm.QueryMod) postLockQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"post_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return PostLocks(queryMods...)
+}
+
 // Images retrieves all the image's Images with an executor.
 func (o *Post) Images(mods ...qm.QueryMod) imageQuery {
 	var queryMods []qm.QueryMod
@@ -514,6 +667,21 @@ func (o *Post) Images(mods ...qm.QueryMod) imageQuery {
 	return Images(queryMods...)
 }
 
+// Categories retrieves all the category's Categories with an executor.
+func (o *Post) Categories(mods ...qm.QueryMod) categoryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.InnerJoin("\"post_categories\" on \"categories\".\"id\" = \"post_categories\".\"category_id\""),
+		qm.Where("\"post_categories\".\"post_id\"=?", o.ID),
+	)
+
+	return Categories(queryMods...)
+}
+
 // Tags retrieves all the tag's Tags with an executor.
 func (o *Post) Tags(mods ...qm.QueryMod) tagQuery {
 	var queryMods []qm.QueryMod
@@ -529,9 +697,23 @@ func (o *Post) Tags(mods ...qm.QueryMod) tagQuery {
 	return Tags(queryMods...)
 }
 
-// LoadImages allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (postL) LoadImages(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+// PostWorkflowHistories retrieves all the post_workflow_history's PostWorkflowHistories with an executor.
+func (o *Post) PostWorkflowHistories(mods ...qm.QueryMod) postWorkflowHistoryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"post_workflow_histories\".\"post_id\"=?", o.ID),
+	)
+
+	return PostWorkflowHistories(queryMods...)
+}
+
+// LoadOgImage allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (postL) LoadOgImage(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
 	var slice []*Post
 	var object *Post
 
@@ -562,13 +744,20 @@ func (postL) LoadImages(ctx context.Context, e boil.ContextExecutor, singular bo
 		if object.R == nil {
 			object.R = &postR{}
 		}
-		args[object.ID] = struct{}{}
+		if !queries.IsNil(object.OgImageID) {
+			args[object.OgImageID] = struct{}{}
+		}
+
 	} else {
 		for _, obj := range slice {
 			if obj.R == nil {
 				obj.R = &postR{}
 			}
-			args[obj.ID] = struct{}{}
+
+			if !queries.IsNil(obj.OgImageID) {
+				args[obj.OgImageID] = struct{}{}
+			}
+
 		}
 	}
 
@@ -585,7 +774,7 @@ func (postL) LoadImages(ctx context.Context, e boil.ContextExecutor, singular bo
 
 	query := NewQuery(
 		qm.From(`images`),
-		qm.WhereIn(`images.post_id in ?`, argsSlice...),
+		qm.WhereIn(`images.id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -593,16 +782,16 @@ func (postL) LoadImages(ctx context.Context, e boil.ContextExecutor, singular bo
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load images")
+		return errors.Wrap(err, "failed to eager load Image")
 	}
 
 	var resultSlice []*Image
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice images")
+		return errors.Wrap(err, "failed to bind eager loaded slice Image")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on images")
+		return errors.Wrap(err, "failed to close results of eager load for images")
 	}
 	if err = results.Err(); err != nil {
 		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for images")
@@ -615,25 +804,29 @@ func (postL) LoadImages(ctx context.Context, e boil.ContextExecutor, singular bo
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.Images = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &imageR{}
-			}
-			foreign.R.Post = object
+		foreign := resultSlice[0]
+		object.R.OgImage = foreign
+		if foreign.R == nil {
+			foreign.R = &imageR{}
 		}
+		foreign.R.OgImagePosts = append(foreign.R.OgImagePosts, object)
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.PostID {
-				local.R.Images = append(local.R.Images, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.OgImageID, foreign.ID) {
+				local.R.OgImage = foreign
 				if foreign.R == nil {
 					foreign.R = &imageR{}
 				}
-				foreign.R.Post = local
+				foreign.R.OgImagePosts = append(foreign.R.OgImagePosts, local)
 				break
 			}
 		}
@@ -642,9 +835,9 @@ func (postL) LoadImages(ctx context.Context, e boil.ContextExecutor, singular bo
 	return nil
 }
 
-// LoadTags allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (postL) LoadTags(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+// LoadPrimaryCategory allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (postL) LoadPrimaryCategory(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
 	var slice []*Post
 	var object *Post
 
@@ -675,13 +868,20 @@ func (postL) LoadTags(ctx context.Context, e boil.ContextExecutor, singular bool
 		if object.R == nil {
 			object.R = &postR{}
 		}
-		args[object.ID] = struct{}{}
+		if !queries.IsNil(object.PrimaryCategoryID) {
+			args[object.PrimaryCategoryID] = struct{}{}
+		}
+
 	} else {
 		for _, obj := range slice {
 			if obj.R == nil {
 				obj.R = &postR{}
 			}
-			args[obj.ID] = struct{}{}
+
+			if !queries.IsNil(obj.PrimaryCategoryID) {
+				args[obj.PrimaryCategoryID] = struct{}{}
+			}
+
 		}
 	}
 
@@ -697,10 +897,8 @@ func (postL) LoadTags(ctx context.Context, e boil.ContextExecutor, singular bool
 	}
 
 	query := NewQuery(
-		qm.Select("\"tags\".\"id\", \"tags\".\"name\", \"tags\".\"created_at\", \"tags\".\"updated_at\", \"a\".\"post_id\""),
-		qm.From("\"tags\""),
-		qm.InnerJoin("\"post_tags\" as \"a\" on \"tags\".\"id\" = \"a\".\"tag_id\""),
-		qm.WhereIn("\"a\".\"post_id\" in ?", argsSlice...),
+		qm.From(`categories`),
+		qm.WhereIn(`categories.id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -708,62 +906,51 @@ func (postL) LoadTags(ctx context.Context, e boil.ContextExecutor, singular bool
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load tags")
+		return errors.Wrap(err, "failed to eager load Category")
 	}
 
-	var resultSlice []*Tag
-
-	var localJoinCols []string
-	for results.Next() {
-		one := new(Tag)
-		var localJoinCol string
-
-		err = results.Scan(&one.ID, &one.Name, &one.CreatedAt, &one.UpdatedAt, &localJoinCol)
-		if err != nil {
-			return errors.Wrap(err, "failed to scan eager loaded results for tags")
-		}
-		if err = results.Err(); err != nil {
-			return errors.Wrap(err, "failed to plebian-bind eager loaded slice tags")
-		}
-
-		resultSlice = append(resultSlice, one)
-		localJoinCols = append(localJoinCols, localJoinCol)
+	var resultSlice []*Category
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Category")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on tags")
+		return errors.Wrap(err, "failed to close results of eager load for categories")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tags")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for categories")
 	}
 
-	if len(tagAfterSelectHooks) != 0 {
+	if len(categoryAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.Tags = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &tagR{}
-			}
-			foreign.R.Posts = append(foreign.R.Posts, object)
+		foreign := resultSlice[0]
+		object.R.PrimaryCategory = foreign
+		if foreign.R == nil {
+			foreign.R = &categoryR{}
 		}
+		foreign.R.PrimaryCategoryPosts = append(foreign.R.PrimaryCategoryPosts, object)
 		return nil
 	}
 
-	for i, foreign := range resultSlice {
-		localJoinCol := localJoinCols[i]
-		for _, local := range slice {
-			if local.ID == localJoinCol {
-				local.R.Tags = append(local.R.Tags, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.PrimaryCategoryID, foreign.ID) {
+				local.R.PrimaryCategory = foreign
 				if foreign.R == nil {
-					foreign.R = &tagR{}
+					foreign.R = &categoryR{}
 				}
-				foreign.R.Posts = append(foreign.R.Posts, local)
+				foreign.R.PrimaryCategoryPosts = append(foreign.R.PrimaryCategoryPosts, local)
 				break
 			}
 		}
@@ -772,94 +959,1340 @@ func (postL) LoadTags(ctx context.Context, e boil.ContextExecutor, singular bool
 	return nil
 }
 
-// AddImagesG adds the given related objects to the existing relationships
-// of the post, optionally inserting them as new records.
-// Appends related to o.R.Images.
-// Sets related.R.Post appropriately.
-// Uses the global database handle.
-func (o *Post) AddImagesG(ctx context.Context, insert bool, related ...*Image) error {
-	return o.AddImages(ctx, boil.GetContextDB(), insert, related...)
-}
+// LoadReviewer allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (postL) LoadReviewer(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+	var slice []*Post
+	var object *Post
 
-// AddImages adds the given related objects to the existing relationships
-// of the post, optionally inserting them as new records.
-// Appends related to o.R.Images.
-// Sets related.R.Post appropriately.
-func (o *Post) AddImages(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Image) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			rel.PostID = o.ID
-			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
+	if singular {
+		var ok bool
+		object, ok = maybePost.(*Post)
+		if !ok {
+			object = new(Post)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePost)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePost))
 			}
+		}
+	} else {
+		s, ok := maybePost.(*[]*Post)
+		if ok {
+			slice = *s
 		} else {
-			updateQuery := fmt.Sprintf(
-				"UPDATE \"images\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"post_id"}),
-				strmangle.WhereClause("\"", "\"", 2, imagePrimaryKeyColumns),
-			)
-			values := []interface{}{o.ID, rel.ID}
-
-			if boil.IsDebug(ctx) {
-				writer := boil.DebugWriterFrom(ctx)
-				fmt.Fprintln(writer, updateQuery)
-				fmt.Fprintln(writer, values)
-			}
-			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-				return errors.Wrap(err, "failed to update foreign table")
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePost)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePost))
 			}
-
-			rel.PostID = o.ID
 		}
 	}
 
-	if o.R == nil {
-		o.R = &postR{
-			Images: related,
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &postR{}
+		}
+		if !queries.IsNil(object.ReviewerID) {
+			args[object.ReviewerID] = struct{}{}
 		}
+
 	} else {
-		o.R.Images = append(o.R.Images, related...)
-	}
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postR{}
+			}
 
-	for _, rel := range related {
-		if rel.R == nil {
-			rel.R = &imageR{
-				Post: o,
+			if !queries.IsNil(obj.ReviewerID) {
+				args[obj.ReviewerID] = struct{}{}
 			}
-		} else {
-			rel.R.Post = o
+
 		}
 	}
-	return nil
-}
-
-// AddTagsG adds the given related objects to the existing relationships
-// of the post, optionally inserting them as new records.
-// Appends related to o.R.Tags.
-// Sets related.R.Posts appropriately.
-// Uses the global database handle.
-func (o *Post) AddTagsG(ctx context.Context, insert bool, related ...*Tag) error {
-	return o.AddTags(ctx, boil.GetContextDB(), insert, related...)
-}
 
-// AddTags adds the given related objects to the existing relationships
-// of the post, optionally inserting them as new records.
-// Appends related to o.R.Tags.
-// Sets related.R.Posts appropriately.
-func (o *Post) AddTags(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Tag) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
-			}
-		}
+	if len(args) == 0 {
+		return nil
 	}
 
-	for _, rel := range related {
-		query := "insert into \"post_tags\" (\"post_id\", \"tag_id\") values ($1, $2)"
-		values := []interface{}{o.ID, rel.ID}
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Reviewer = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ReviewerPosts = append(foreign.R.ReviewerPosts, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ReviewerID, foreign.ID) {
+				local.R.Reviewer = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ReviewerPosts = append(foreign.R.ReviewerPosts, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPostLock allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (postL) LoadPostLock(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+	var slice []*Post
+	var object *Post
+
+	if singular {
+		var ok bool
+		object, ok = maybePost.(*Post)
+		if !ok {
+			object = new(Post)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePost)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePost))
+			}
+		}
+	} else {
+		s, ok := maybePost.(*[]*Post)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePost)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePost))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &postR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postR{}
+			}
+
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`post_locks`),
+		qm.WhereIn(`post_locks.post_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load PostLock")
+	}
+
+	var resultSlice []*PostLock
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice PostLock")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for post_locks")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for post_locks")
+	}
+
+	if len(postLockAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.PostLock = foreign
+		if foreign.R == nil {
+			foreign.R = &postLockR{}
+		}
+		foreign.R.Post = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.PostID {
+				local.R.PostLock = foreign
+				if foreign.R == nil {
+					foreign.R = &postLockR{}
+				}
+				foreign.R.Post = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadImages allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (postL) LoadImages(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+	var slice []*Post
+	var object *Post
+
+	if singular {
+		var ok bool
+		object, ok = maybePost.(*Post)
+		if !ok {
+			object = new(Post)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePost)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePost))
+			}
+		}
+	} else {
+		s, ok := maybePost.(*[]*Post)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePost)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePost))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &postR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`images`),
+		qm.WhereIn(`images.post_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load images")
+	}
+
+	var resultSlice []*Image
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice images")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on images")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for images")
+	}
+
+	if len(imageAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Images = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &imageR{}
+			}
+			foreign.R.Post = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.PostID {
+				local.R.Images = append(local.R.Images, foreign)
+				if foreign.R == nil {
+					foreign.R = &imageR{}
+				}
+				foreign.R.Post = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCategories allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (postL) LoadCategories(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+	var slice []*Post
+	var object *Post
+
+	if singular {
+		var ok bool
+		object, ok = maybePost.(*Post)
+		if !ok {
+			object = new(Post)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePost)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePost))
+			}
+		}
+	} else {
+		s, ok := maybePost.(*[]*Post)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePost)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePost))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &postR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.Select("\"categories\".\"id\", \"categories\".\"parent_id\", \"categories\".\"name\", \"categories\".\"slug\", \"categories\".\"description\", \"categories\".\"sort_order\", \"categories\".\"created_at\", \"categories\".\"updated_at\", \"a\".\"post_id\""),
+		qm.From("\"categories\""),
+		qm.InnerJoin("\"post_categories\" as \"a\" on \"categories\".\"id\" = \"a\".\"category_id\""),
+		qm.WhereIn("\"a\".\"post_id\" in ?", argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load categories")
+	}
+
+	var resultSlice []*Category
+
+	var localJoinCols []string
+	for results.Next() {
+		one := new(Category)
+		var localJoinCol string
+
+		err = results.Scan(&one.ID, &one.ParentID, &one.Name, &one.Slug, &one.Description, &one.SortOrder, &one.CreatedAt, &one.UpdatedAt, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for categories")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice categories")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on categories")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for categories")
+	}
+
+	if len(categoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Categories = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &categoryR{}
+			}
+			foreign.R.Posts = append(foreign.R.Posts, object)
+		}
+		return nil
+	}
+
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if local.ID == localJoinCol {
+				local.R.Categories = append(local.R.Categories, foreign)
+				if foreign.R == nil {
+					foreign.R = &categoryR{}
+				}
+				foreign.R.Posts = append(foreign.R.Posts, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTags allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (postL) LoadTags(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+	var slice []*Post
+	var object *Post
+
+	if singular {
+		var ok bool
+		object, ok = maybePost.(*Post)
+		if !ok {
+			object = new(Post)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePost)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePost))
+			}
+		}
+	} else {
+		s, ok := maybePost.(*[]*Post)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePost)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePost))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &postR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.Select("\"tags\".\"id\", \"tags\".\"name\", \"tags\".\"created_at\", \"tags\".\"updated_at\", \"tags\".\"name_key\", \"a\".\"post_id\""),
+		qm.From("\"tags\""),
+		qm.InnerJoin("\"post_tags\" as \"a\" on \"tags\".\"id\" = \"a\".\"tag_id\""),
+		qm.WhereIn("\"a\".\"post_id\" in ?", argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tags")
+	}
+
+	var resultSlice []*Tag
+
+	var localJoinCols []string
+	for results.Next() {
+		one := new(Tag)
+		var localJoinCol string
+
+		err = results.Scan(&one.ID, &one.Name, &one.CreatedAt, &one.UpdatedAt, &one.NameKey, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for tags")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice tags")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tags")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tags")
+	}
+
+	if len(tagAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Tags = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tagR{}
+			}
+			foreign.R.Posts = append(foreign.R.Posts, object)
+		}
+		return nil
+	}
+
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if local.ID == localJoinCol {
+				local.R.Tags = append(local.R.Tags, foreign)
+				if foreign.R == nil {
+					foreign.R = &tagR{}
+				}
+				foreign.R.Posts = append(foreign.R.Posts, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPostWorkflowHistories allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (postL) LoadPostWorkflowHistories(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+	var slice []*Post
+	var object *Post
+
+	if singular {
+		var ok bool
+		object, ok = maybePost.(*Post)
+		if !ok {
+			object = new(Post)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePost)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePost))
+			}
+		}
+	} else {
+		s, ok := maybePost.(*[]*Post)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePost)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePost))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &postR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`post_workflow_histories`),
+		qm.WhereIn(`post_workflow_histories.post_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load post_workflow_histories")
+	}
+
+	var resultSlice []*PostWorkflowHistory
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice post_workflow_histories")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on post_workflow_histories")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for post_workflow_histories")
+	}
+
+	if len(postWorkflowHistoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PostWorkflowHistories = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &postWorkflowHistoryR{}
+			}
+			foreign.R.Post = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.PostID {
+				local.R.PostWorkflowHistories = append(local.R.PostWorkflowHistories, foreign)
+				if foreign.R == nil {
+					foreign.R = &postWorkflowHistoryR{}
+				}
+				foreign.R.Post = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetOgImageG of the post to the related item.
+// Sets o.R.OgImage to related.
+// Adds o to related.R.OgImagePosts.
+// Uses the global database handle.
+func (o *Post) SetOgImageG(ctx context.Context, insert bool, related *Image) error {
+	return o.SetOgImage(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetOgImage of the post to the related item.
+// Sets o.R.OgImage to related.
+// Adds o to related.R.OgImagePosts.
+func (o *Post) SetOgImage(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Image) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"posts\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"og_image_id"}),
+		strmangle.WhereClause("\"", "\"", 2, postPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.OgImageID, related.ID)
+	if o.R == nil {
+		o.R = &postR{
+			OgImage: related,
+		}
+	} else {
+		o.R.OgImage = related
+	}
+
+	if related.R == nil {
+		related.R = &imageR{
+			OgImagePosts: PostSlice{o},
+		}
+	} else {
+		related.R.OgImagePosts = append(related.R.OgImagePosts, o)
+	}
+
+	return nil
+}
+
+// RemoveOgImageG relationship.
+// Sets o.R.OgImage to nil.
+// Removes o from all passed in related items' relationships struct.
+// Uses the global database handle.
+func (o *Post) RemoveOgImageG(ctx context.Context, related *Image) error {
+	return o.RemoveOgImage(ctx, boil.GetContextDB(), related)
+}
+
+// RemoveOgImage relationship.
+// Sets o.R.OgImage to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Post) RemoveOgImage(ctx context.Context, exec boil.ContextExecutor, related *Image) error {
+	var err error
+
+	queries.SetScanner(&o.OgImageID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("og_image_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.OgImage = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.OgImagePosts {
+		if queries.Equal(o.OgImageID, ri.OgImageID) {
+			continue
+		}
+
+		ln := len(related.R.OgImagePosts)
+		if ln > 1 && i < ln-1 {
+			related.R.OgImagePosts[i] = related.R.OgImagePosts[ln-1]
+		}
+		related.R.OgImagePosts = related.R.OgImagePosts[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetPrimaryCategoryG of the post to the related item.
+// Sets o.R.PrimaryCategory to related.
+// Adds o to related.R.PrimaryCategoryPosts.
+// Uses the global database handle.
+func (o *Post) SetPrimaryCategoryG(ctx context.Context, insert bool, related *Category) error {
+	return o.SetPrimaryCategory(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetPrimaryCategory of the post to the related item.
+// Sets o.R.PrimaryCategory to related.
+// Adds o to related.R.PrimaryCategoryPosts.
+func (o *Post) SetPrimaryCategory(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Category) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"posts\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"primary_category_id"}),
+		strmangle.WhereClause("\"", "\"", 2, postPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.PrimaryCategoryID, related.ID)
+	if o.R == nil {
+		o.R = &postR{
+			PrimaryCategory: related,
+		}
+	} else {
+		o.R.PrimaryCategory = related
+	}
+
+	if related.R == nil {
+		related.R = &categoryR{
+			PrimaryCategoryPosts: PostSlice{o},
+		}
+	} else {
+		related.R.PrimaryCategoryPosts = append(related.R.PrimaryCategoryPosts, o)
+	}
+
+	return nil
+}
+
+// RemovePrimaryCategoryG relationship.
+// Sets o.R.PrimaryCategory to nil.
+// Removes o from all passed in related items' relationships struct.
+// Uses the global database handle.
+func (o *Post) RemovePrimaryCategoryG(ctx context.Context, related *Category) error {
+	return o.RemovePrimaryCategory(ctx, boil.GetContextDB(), related)
+}
+
+// RemovePrimaryCategory relationship.
+// Sets o.R.PrimaryCategory to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Post) RemovePrimaryCategory(ctx context.Context, exec boil.ContextExecutor, related *Category) error {
+	var err error
+
+	queries.SetScanner(&o.PrimaryCategoryID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("primary_category_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.PrimaryCategory = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.PrimaryCategoryPosts {
+		if queries.Equal(o.PrimaryCategoryID, ri.PrimaryCategoryID) {
+			continue
+		}
+
+		ln := len(related.R.PrimaryCategoryPosts)
+		if ln > 1 && i < ln-1 {
+			related.R.PrimaryCategoryPosts[i] = related.R.PrimaryCategoryPosts[ln-1]
+		}
+		related.R.PrimaryCategoryPosts = related.R.PrimaryCategoryPosts[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetReviewerG of the post to the related item.
+// Sets o.R.Reviewer to related.
+// Adds o to related.R.ReviewerPosts.
+// Uses the global database handle.
+func (o *Post) SetReviewerG(ctx context.Context, insert bool, related *User) error {
+	return o.SetReviewer(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetReviewer of the post to the related item.
+// Sets o.R.Reviewer to related.
+// Adds o to related.R.ReviewerPosts.
+func (o *Post) SetReviewer(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"posts\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"reviewer_id"}),
+		strmangle.WhereClause("\"", "\"", 2, postPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ReviewerID, related.ID)
+	if o.R == nil {
+		o.R = &postR{
+			Reviewer: related,
+		}
+	} else {
+		o.R.Reviewer = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ReviewerPosts: PostSlice{o},
+		}
+	} else {
+		related.R.ReviewerPosts = append(related.R.ReviewerPosts, o)
+	}
+
+	return nil
+}
+
+// RemoveReviewerG relationship.
+// Sets o.R.Reviewer to nil.
+// Removes o from all passed in related items' relationships struct.
+// Uses the global database handle.
+func (o *Post) RemoveReviewerG(ctx context.Context, related *User) error {
+	return o.RemoveReviewer(ctx, boil.GetContextDB(), related)
+}
+
+// RemoveReviewer relationship.
+// Sets o.R.Reviewer to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Post) RemoveReviewer(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.ReviewerID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("reviewer_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Reviewer = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ReviewerPosts {
+		if queries.Equal(o.ReviewerID, ri.ReviewerID) {
+			continue
+		}
+
+		ln := len(related.R.ReviewerPosts)
+		if ln > 1 && i < ln-1 {
+			related.R.ReviewerPosts[i] = related.R.ReviewerPosts[ln-1]
+		}
+		related.R.ReviewerPosts = related.R.ReviewerPosts[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetPostLockG of the post to the related item.
+// Sets o.R.PostLock to related.
+// Adds o to related.R.Post.
+// Uses the global database handle.
+func (o *Post) SetPostLockG(ctx context.Context, insert bool, related *PostLock) error {
+	return o.SetPostLock(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetPostLock of the post to the related item.
+// Sets o.R.PostLock to related.
+// Adds o to related.R.Post.
+func (o *Post) SetPostLock(ctx context.Context, exec boil.ContextExecutor, insert bool, related *PostLock) error {
+	var err error
+
+	if insert {
+		related.PostID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"post_locks\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"post_id"}),
+			strmangle.WhereClause("\"", "\"", 2, postLockPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.PostID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.PostID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &postR{
+			PostLock: related,
+		}
+	} else {
+		o.R.PostLock = related
+	}
+
+	if related.R == nil {
+		related.R = &postLockR{
+			Post: o,
+		}
+	} else {
+		related.R.Post = o
+	}
+	return nil
+}
+
+// AddImagesG adds the given related objects to the existing relationships
+// of the post, optionally inserting them as new records.
+// Appends related to o.R.Images.
+// Sets related.R.Post appropriately.
+// Uses the global database handle.
+func (o *Post) AddImagesG(ctx context.Context, insert bool, related ...*Image) error {
+	return o.AddImages(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddImages adds the given related objects to the existing relationships
+// of the post, optionally inserting them as new records.
+// Appends related to o.R.Images.
+// Sets related.R.Post appropriately.
+func (o *Post) AddImages(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Image) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PostID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"images\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"post_id"}),
+				strmangle.WhereClause("\"", "\"", 2, imagePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.PostID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &postR{
+			Images: related,
+		}
+	} else {
+		o.R.Images = append(o.R.Images, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &imageR{
+				Post: o,
+			}
+		} else {
+			rel.R.Post = o
+		}
+	}
+	return nil
+}
+
+// AddCategoriesG adds the given related objects to the existing relationships
+// of the post, optionally inserting them as new records.
+// Appends related to o.R.Categories.
+// Sets related.R.Posts appropriately.
+// Uses the global database handle.
+func (o *Post) AddCategoriesG(ctx context.Context, insert bool, related ...*Category) error {
+	return o.AddCategories(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddCategories adds the given related objects to the existing relationships
+// of the post, optionally inserting them as new records.
+// Appends related to o.R.Categories.
+// Sets related.R.Posts appropriately.
+func (o *Post) AddCategories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Category) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		}
+	}
+
+	for _, rel := range related {
+		query := "insert into \"post_categories\" (\"post_id\", \"category_id\") values ($1, $2)"
+		values := []interface{}{o.ID, rel.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, query)
+			fmt.Fprintln(writer, values)
+		}
+		_, err = exec.ExecContext(ctx, query, values...)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert into join table")
+		}
+	}
+	if o.R == nil {
+		o.R = &postR{
+			Categories: related,
+		}
+	} else {
+		o.R.Categories = append(o.R.Categories, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &categoryR{
+				Posts: PostSlice{o},
+			}
+		} else {
+			rel.R.Posts = append(rel.R.Posts, o)
+		}
+	}
+	return nil
+}
+
+// SetCategoriesG removes all previously related items of the
+// post replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Posts's Categories accordingly.
+// Replaces o.R.Categories with related.
+// Sets related.R.Posts's Categories accordingly.
+// Uses the global database handle.
+func (o *Post) SetCategoriesG(ctx context.Context, insert bool, related ...*Category) error {
+	return o.SetCategories(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// SetCategories removes all previously related items of the
+// post replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Posts's Categories accordingly.
+// Replaces o.R.Categories with related.
+// Sets related.R.Posts's Categories accordingly.
+func (o *Post) SetCategories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Category) error {
+	query := "delete from \"post_categories\" where \"post_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	removeCategoriesFromPostsSlice(o, related)
+	if o.R != nil {
+		o.R.Categories = nil
+	}
+
+	return o.AddCategories(ctx, exec, insert, related...)
+}
+
+// RemoveCategoriesG relationships from objects passed in.
+// Removes related items from R.Categories (uses pointer comparison, removal does not keep order)
+// Sets related.R.Posts.
+// Uses the global database handle.
+func (o *Post) RemoveCategoriesG(ctx context.Context, related ...*Category) error {
+	return o.RemoveCategories(ctx, boil.GetContextDB(), related...)
+}
+
+// RemoveCategories relationships from objects passed in.
+// Removes related items from R.Categories (uses pointer comparison, removal does not keep order)
+// Sets related.R.Posts.
+func (o *Post) RemoveCategories(ctx context.Context, exec boil.ContextExecutor, related ...*Category) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	query := fmt.Sprintf(
+		"delete from \"post_categories\" where \"post_id\" = $1 and \"category_id\" in (%s)",
+		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
+	)
+	values := []interface{}{o.ID}
+	for _, rel := range related {
+		values = append(values, rel.ID)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err = exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+	removeCategoriesFromPostsSlice(o, related)
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Categories {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Categories)
+			if ln > 1 && i < ln-1 {
+				o.R.Categories[i] = o.R.Categories[ln-1]
+			}
+			o.R.Categories = o.R.Categories[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+func removeCategoriesFromPostsSlice(o *Post, related []*Category) {
+	for _, rel := range related {
+		if rel.R == nil {
+			continue
+		}
+		for i, ri := range rel.R.Posts {
+			if o.ID != ri.ID {
+				continue
+			}
+
+			ln := len(rel.R.Posts)
+			if ln > 1 && i < ln-1 {
+				rel.R.Posts[i] = rel.R.Posts[ln-1]
+			}
+			rel.R.Posts = rel.R.Posts[:ln-1]
+			break
+		}
+	}
+}
+
+// AddTagsG adds the given related objects to the existing relationships
+// of the post, optionally inserting them as new records.
+// Appends related to o.R.Tags.
+// Sets related.R.Posts appropriately.
+// Uses the global database handle.
+func (o *Post) AddTagsG(ctx context.Context, insert bool, related ...*Tag) error {
+	return o.AddTags(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddTags adds the given related objects to the existing relationships
+// of the post, optionally inserting them as new records.
+// Appends related to o.R.Tags.
+// Sets related.R.Posts appropriately.
+func (o *Post) AddTags(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Tag) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		}
+	}
+
+	for _, rel := range related {
+		query := "insert into \"post_tags\" (\"post_id\", \"tag_id\") values ($1, $2)"
+		values := []interface{}{o.ID, rel.ID}
 
 		if boil.IsDebug(ctx) {
 			writer := boil.DebugWriterFrom(ctx)
@@ -1007,6 +2440,68 @@ func removeTagsFromPostsSlice(o *Post, related []*Tag) {
 	}
 }
 
+// AddPostWorkflowHistoriesG adds the given related objects to the existing relationships
+// of the post, optionally inserting them as new records.
+// Appends related to o.R.PostWorkflowHistories.
+// Sets related.R.Post appropriately.
+// Uses the global database handle.
+func (o *Post) AddPostWorkflowHistoriesG(ctx context.Context, insert bool, related ...*PostWorkflowHistory) error {
+	return o.AddPostWorkflowHistories(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddPostWorkflowHistories adds the given related objects to the existing relationships
+// of the post, optionally inserting them as new records.
+// Appends related to o.R.PostWorkflowHistories.
+// Sets related.R.Post appropriately.
+func (o *Post) AddPostWorkflowHistories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PostWorkflowHistory) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PostID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"post_workflow_histories\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"post_id"}),
+				strmangle.WhereClause("\"", "\"", 2, postWorkflowHistoryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.PostID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &postR{
+			PostWorkflowHistories: related,
+		}
+	} else {
+		o.R.PostWorkflowHistories = append(o.R.PostWorkflowHistories, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &postWorkflowHistoryR{
+				Post: o,
+			}
+		} else {
+			rel.R.Post = o
+		}
+	}
+	return nil
+}
+
 // Posts retrieves all the records using an executor.
 func Posts(mods ...qm.QueryMod) postQuery {
 	mods = append(mods, qm.From("\"posts\""))
@@ -494,6 +494,128 @@ func testPostsInsertWhitelist(t *testing.T) {
 	}
 }
 
+func testPostOneToOnePostLockUsingPostLock(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var foreign PostLock
+	var local Post
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &foreign, postLockDBTypes, true, postLockColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PostLock struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &local, postDBTypes, true, postColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Post struct: %s", err)
+	}
+
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreign.PostID = local.ID
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.PostLock().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.PostID != foreign.PostID {
+		t.Errorf("want: %v, got %v", foreign.PostID, check.PostID)
+	}
+
+	ranAfterSelectHook := false
+	AddPostLockHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *PostLock) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := PostSlice{&local}
+	if err = local.L.LoadPostLock(ctx, tx, false, (*[]*Post)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.PostLock == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.PostLock = nil
+	if err = local.L.LoadPostLock(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.PostLock == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testPostOneToOneSetOpPostLockUsingPostLock(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Post
+	var b, c PostLock
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, postDBTypes, false, strmangle.SetComplement(postPrimaryKeyColumns, postColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, postLockDBTypes, false, strmangle.SetComplement(postLockPrimaryKeyColumns, postLockColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, postLockDBTypes, false, strmangle.SetComplement(postLockPrimaryKeyColumns, postLockColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*PostLock{&b, &c} {
+		err = a.SetPostLock(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.PostLock != x {
+			t.Error("relationship struct not set to correct value")
+		}
+		if x.R.Post != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+
+		if a.ID != x.PostID {
+			t.Error("foreign key was wrong value", a.ID)
+		}
+
+		if exists, err := PostLockExists(ctx, tx, x.PostID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'x' to exist")
+		}
+
+		if a.ID != x.PostID {
+			t.Error("foreign key was wrong value", a.ID, x.PostID)
+		}
+
+		if _, err = x.Delete(ctx, tx); err != nil {
+			t.Fatal("failed to delete x", err)
+		}
+	}
+}
+
 func testPostToManyImages(t *testing.T) {
 	var err error
 	ctx := context.Background()
@@ -572,6 +694,90 @@ func testPostToManyImages(t *testing.T) {
 	}
 }
 
+func testPostToManyCategories(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Post
+	var b, c Category
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, postDBTypes, true, postColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Post struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, categoryDBTypes, false, categoryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, categoryDBTypes, false, categoryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = tx.Exec("insert into \"post_categories\" (\"post_id\", \"category_id\") values ($1, $2)", a.ID, b.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = tx.Exec("insert into \"post_categories\" (\"post_id\", \"category_id\") values ($1, $2)", a.ID, c.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.Categories().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.ID == b.ID {
+			bFound = true
+		}
+		if v.ID == c.ID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := PostSlice{&a}
+	if err = a.L.LoadCategories(ctx, tx, false, (*[]*Post)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Categories); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.Categories = nil
+	if err = a.L.LoadCategories(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Categories); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
 func testPostToManyTags(t *testing.T) {
 	var err error
 	ctx := context.Background()
@@ -656,6 +862,84 @@ func testPostToManyTags(t *testing.T) {
 	}
 }
 
+func testPostToManyPostWorkflowHistories(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Post
+	var b, c PostWorkflowHistory
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, postDBTypes, true, postColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Post struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, postWorkflowHistoryDBTypes, false, postWorkflowHistoryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, postWorkflowHistoryDBTypes, false, postWorkflowHistoryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.PostID = a.ID
+	c.PostID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.PostWorkflowHistories().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.PostID == b.PostID {
+			bFound = true
+		}
+		if v.PostID == c.PostID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := PostSlice{&a}
+	if err = a.L.LoadPostWorkflowHistories(ctx, tx, false, (*[]*Post)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.PostWorkflowHistories); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.PostWorkflowHistories = nil
+	if err = a.L.LoadPostWorkflowHistories(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.PostWorkflowHistories); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
 func testPostToManyAddOpImages(t *testing.T) {
 	var err error
 
@@ -731,7 +1015,7 @@ func testPostToManyAddOpImages(t *testing.T) {
 		}
 	}
 }
-func testPostToManyAddOpTags(t *testing.T) {
+func testPostToManyAddOpCategories(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -739,15 +1023,15 @@ func testPostToManyAddOpTags(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a Post
-	var b, c, d, e Tag
+	var b, c, d, e Category
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, postDBTypes, false, strmangle.SetComplement(postPrimaryKeyColumns, postColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*Tag{&b, &c, &d, &e}
+	foreigners := []*Category{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, tagDBTypes, false, strmangle.SetComplement(tagPrimaryKeyColumns, tagColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, categoryDBTypes, false, strmangle.SetComplement(categoryPrimaryKeyColumns, categoryColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -762,13 +1046,13 @@ func testPostToManyAddOpTags(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*Tag{
+	foreignersSplitByInsertion := [][]*Category{
 		{&b, &c},
 		{&d, &e},
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddTags(ctx, tx, i != 0, x...)
+		err = a.AddCategories(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -783,14 +1067,14 @@ func testPostToManyAddOpTags(t *testing.T) {
 			t.Error("relationship was not added properly to the slice")
 		}
 
-		if a.R.Tags[i*2] != first {
+		if a.R.Categories[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.Tags[i*2+1] != second {
+		if a.R.Categories[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.Tags().Count(ctx, tx)
+		count, err := a.Categories().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -800,7 +1084,7 @@ func testPostToManyAddOpTags(t *testing.T) {
 	}
 }
 
-func testPostToManySetOpTags(t *testing.T) {
+func testPostToManySetOpCategories(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -808,15 +1092,15 @@ func testPostToManySetOpTags(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a Post
-	var b, c, d, e Tag
+	var b, c, d, e Category
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, postDBTypes, false, strmangle.SetComplement(postPrimaryKeyColumns, postColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*Tag{&b, &c, &d, &e}
+	foreigners := []*Category{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, tagDBTypes, false, strmangle.SetComplement(tagPrimaryKeyColumns, tagColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, categoryDBTypes, false, strmangle.SetComplement(categoryPrimaryKeyColumns, categoryColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -831,12 +1115,12 @@ func testPostToManySetOpTags(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = a.SetTags(ctx, tx, false, &b, &c)
+	err = a.SetCategories(ctx, tx, false, &b, &c)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err := a.Tags().Count(ctx, tx)
+	count, err := a.Categories().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -844,12 +1128,12 @@ func testPostToManySetOpTags(t *testing.T) {
 		t.Error("count was wrong:", count)
 	}
 
-	err = a.SetTags(ctx, tx, true, &d, &e)
+	err = a.SetCategories(ctx, tx, true, &d, &e)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err = a.Tags().Count(ctx, tx)
+	count, err = a.Categories().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -874,15 +1158,15 @@ func testPostToManySetOpTags(t *testing.T) {
 		t.Error("relationship was not added properly to the slice")
 	}
 
-	if a.R.Tags[0] != &d {
+	if a.R.Categories[0] != &d {
 		t.Error("relationship struct slice not set to correct value")
 	}
-	if a.R.Tags[1] != &e {
+	if a.R.Categories[1] != &e {
 		t.Error("relationship struct slice not set to correct value")
 	}
 }
 
-func testPostToManyRemoveOpTags(t *testing.T) {
+func testPostToManyRemoveOpCategories(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -890,15 +1174,15 @@ func testPostToManyRemoveOpTags(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a Post
-	var b, c, d, e Tag
+	var b, c, d, e Category
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, postDBTypes, false, strmangle.SetComplement(postPrimaryKeyColumns, postColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*Tag{&b, &c, &d, &e}
+	foreigners := []*Category{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, tagDBTypes, false, strmangle.SetComplement(tagPrimaryKeyColumns, tagColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, categoryDBTypes, false, strmangle.SetComplement(categoryPrimaryKeyColumns, categoryColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -907,12 +1191,12 @@ func testPostToManyRemoveOpTags(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = a.AddTags(ctx, tx, true, foreigners...)
+	err = a.AddCategories(ctx, tx, true, foreigners...)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err := a.Tags().Count(ctx, tx)
+	count, err := a.Categories().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -920,12 +1204,12 @@ func testPostToManyRemoveOpTags(t *testing.T) {
 		t.Error("count was wrong:", count)
 	}
 
-	err = a.RemoveTags(ctx, tx, foreigners[:2]...)
+	err = a.RemoveCategories(ctx, tx, foreigners[:2]...)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err = a.Tags().Count(ctx, tx)
+	count, err = a.Categories().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -946,19 +1230,832 @@ func testPostToManyRemoveOpTags(t *testing.T) {
 		t.Error("relationship was not added properly to the foreign struct")
 	}
 
-	if len(a.R.Tags) != 2 {
+	if len(a.R.Categories) != 2 {
 		t.Error("should have preserved two relationships")
 	}
 
 	// Removal doesn't do a stable deletion for performance so we have to flip the order
-	if a.R.Tags[1] != &d {
+	if a.R.Categories[1] != &d {
 		t.Error("relationship to d should have been preserved")
 	}
-	if a.R.Tags[0] != &e {
+	if a.R.Categories[0] != &e {
 		t.Error("relationship to e should have been preserved")
 	}
 }
 
+func testPostToManyAddOpTags(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Post
+	var b, c, d, e Tag
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, postDBTypes, false, strmangle.SetComplement(postPrimaryKeyColumns, postColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Tag{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, tagDBTypes, false, strmangle.SetComplement(tagPrimaryKeyColumns, tagColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Tag{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddTags(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if first.R.Posts[0] != &a {
+			t.Error("relationship was not added properly to the slice")
+		}
+		if second.R.Posts[0] != &a {
+			t.Error("relationship was not added properly to the slice")
+		}
+
+		if a.R.Tags[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Tags[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Tags().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testPostToManySetOpTags(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Post
+	var b, c, d, e Tag
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, postDBTypes, false, strmangle.SetComplement(postPrimaryKeyColumns, postColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Tag{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, tagDBTypes, false, strmangle.SetComplement(tagPrimaryKeyColumns, tagColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetTags(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.Tags().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetTags(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.Tags().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	// The following checks cannot be implemented since we have no handle
+	// to these when we call Set(). Leaving them here as wishful thinking
+	// and to let people know there's dragons.
+	//
+	// if len(b.R.Posts) != 0 {
+	// 	t.Error("relationship was not removed properly from the slice")
+	// }
+	// if len(c.R.Posts) != 0 {
+	// 	t.Error("relationship was not removed properly from the slice")
+	// }
+	if d.R.Posts[0] != &a {
+		t.Error("relationship was not added properly to the slice")
+	}
+	if e.R.Posts[0] != &a {
+		t.Error("relationship was not added properly to the slice")
+	}
+
+	if a.R.Tags[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.Tags[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testPostToManyRemoveOpTags(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Post
+	var b, c, d, e Tag
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, postDBTypes, false, strmangle.SetComplement(postPrimaryKeyColumns, postColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Tag{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, tagDBTypes, false, strmangle.SetComplement(tagPrimaryKeyColumns, tagColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddTags(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.Tags().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveTags(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.Tags().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if len(b.R.Posts) != 0 {
+		t.Error("relationship was not removed properly from the slice")
+	}
+	if len(c.R.Posts) != 0 {
+		t.Error("relationship was not removed properly from the slice")
+	}
+	if d.R.Posts[0] != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Posts[0] != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if len(a.R.Tags) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.Tags[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.Tags[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testPostToManyAddOpPostWorkflowHistories(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Post
+	var b, c, d, e PostWorkflowHistory
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, postDBTypes, false, strmangle.SetComplement(postPrimaryKeyColumns, postColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*PostWorkflowHistory{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, postWorkflowHistoryDBTypes, false, strmangle.SetComplement(postWorkflowHistoryPrimaryKeyColumns, postWorkflowHistoryColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*PostWorkflowHistory{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddPostWorkflowHistories(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.PostID {
+			t.Error("foreign key was wrong value", a.ID, first.PostID)
+		}
+		if a.ID != second.PostID {
+			t.Error("foreign key was wrong value", a.ID, second.PostID)
+		}
+
+		if first.R.Post != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Post != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.PostWorkflowHistories[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.PostWorkflowHistories[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.PostWorkflowHistories().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testPostToOneImageUsingOgImage(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Post
+	var foreign Image
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, postDBTypes, true, postColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Post struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, imageDBTypes, false, imageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Image struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.OgImageID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.OgImage().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddImageHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *Image) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := PostSlice{&local}
+	if err = local.L.LoadOgImage(ctx, tx, false, (*[]*Post)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.OgImage == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.OgImage = nil
+	if err = local.L.LoadOgImage(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.OgImage == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testPostToOneCategoryUsingPrimaryCategory(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Post
+	var foreign Category
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, postDBTypes, true, postColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Post struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, categoryDBTypes, false, categoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Category struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.PrimaryCategoryID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.PrimaryCategory().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddCategoryHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *Category) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := PostSlice{&local}
+	if err = local.L.LoadPrimaryCategory(ctx, tx, false, (*[]*Post)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.PrimaryCategory == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.PrimaryCategory = nil
+	if err = local.L.LoadPrimaryCategory(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.PrimaryCategory == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testPostToOneUserUsingReviewer(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Post
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, postDBTypes, true, postColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Post struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.ReviewerID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Reviewer().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddUserHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *User) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := PostSlice{&local}
+	if err = local.L.LoadReviewer(ctx, tx, false, (*[]*Post)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Reviewer == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Reviewer = nil
+	if err = local.L.LoadReviewer(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Reviewer == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testPostToOneSetOpImageUsingOgImage(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Post
+	var b, c Image
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, postDBTypes, false, strmangle.SetComplement(postPrimaryKeyColumns, postColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, imageDBTypes, false, strmangle.SetComplement(imagePrimaryKeyColumns, imageColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, imageDBTypes, false, strmangle.SetComplement(imagePrimaryKeyColumns, imageColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Image{&b, &c} {
+		err = a.SetOgImage(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.OgImage != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.OgImagePosts[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.OgImageID, x.ID) {
+			t.Error("foreign key was wrong value", a.OgImageID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.OgImageID))
+		reflect.Indirect(reflect.ValueOf(&a.OgImageID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.OgImageID, x.ID) {
+			t.Error("foreign key was wrong value", a.OgImageID, x.ID)
+		}
+	}
+}
+
+func testPostToOneRemoveOpImageUsingOgImage(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Post
+	var b Image
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, postDBTypes, false, strmangle.SetComplement(postPrimaryKeyColumns, postColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, imageDBTypes, false, strmangle.SetComplement(imagePrimaryKeyColumns, imageColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetOgImage(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveOgImage(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.OgImage().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.OgImage != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.OgImageID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.OgImagePosts) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testPostToOneSetOpCategoryUsingPrimaryCategory(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Post
+	var b, c Category
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, postDBTypes, false, strmangle.SetComplement(postPrimaryKeyColumns, postColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, categoryDBTypes, false, strmangle.SetComplement(categoryPrimaryKeyColumns, categoryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, categoryDBTypes, false, strmangle.SetComplement(categoryPrimaryKeyColumns, categoryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Category{&b, &c} {
+		err = a.SetPrimaryCategory(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.PrimaryCategory != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.PrimaryCategoryPosts[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.PrimaryCategoryID, x.ID) {
+			t.Error("foreign key was wrong value", a.PrimaryCategoryID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.PrimaryCategoryID))
+		reflect.Indirect(reflect.ValueOf(&a.PrimaryCategoryID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.PrimaryCategoryID, x.ID) {
+			t.Error("foreign key was wrong value", a.PrimaryCategoryID, x.ID)
+		}
+	}
+}
+
+func testPostToOneRemoveOpCategoryUsingPrimaryCategory(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Post
+	var b Category
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, postDBTypes, false, strmangle.SetComplement(postPrimaryKeyColumns, postColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, categoryDBTypes, false, strmangle.SetComplement(categoryPrimaryKeyColumns, categoryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetPrimaryCategory(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemovePrimaryCategory(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.PrimaryCategory().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.PrimaryCategory != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.PrimaryCategoryID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.PrimaryCategoryPosts) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testPostToOneSetOpUserUsingReviewer(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Post
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, postDBTypes, false, strmangle.SetComplement(postPrimaryKeyColumns, postColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetReviewer(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Reviewer != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ReviewerPosts[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.ReviewerID, x.ID) {
+			t.Error("foreign key was wrong value", a.ReviewerID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ReviewerID))
+		reflect.Indirect(reflect.ValueOf(&a.ReviewerID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.ReviewerID, x.ID) {
+			t.Error("foreign key was wrong value", a.ReviewerID, x.ID)
+		}
+	}
+}
+
+func testPostToOneRemoveOpUserUsingReviewer(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Post
+	var b User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, postDBTypes, false, strmangle.SetComplement(postPrimaryKeyColumns, postColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetReviewer(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveReviewer(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Reviewer().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Reviewer != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.ReviewerID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.ReviewerPosts) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
 func testPostsReload(t *testing.T) {
 	t.Parallel()
 
@@ -1033,7 +2130,7 @@ func testPostsSelect(t *testing.T) {
 }
 
 var (
-	postDBTypes = map[string]string{`ID`: `uuid`, `Title`: `character varying`, `Content`: `text`, `UserID`: `uuid`, `Status`: `character varying`, `FirstPublishedAt`: `timestamp with time zone`, `ContentUpdatedAt`: `timestamp with time zone`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`}
+	postDBTypes = map[string]string{`ID`: `uuid`, `Title`: `character varying`, `Content`: `text`, `UserID`: `uuid`, `Status`: `character varying`, `FirstPublishedAt`: `timestamp with time zone`, `ContentUpdatedAt`: `timestamp with time zone`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`, `PrimaryCategoryID`: `uuid`, `Version`: `integer`, `ReviewerID`: `uuid`, `Excerpt`: `character varying`, `MetaDescription`: `character varying`, `CanonicalURL`: `character varying`, `OgImageID`: `uuid`}
 	_           = bytes.MinRead
 )
 
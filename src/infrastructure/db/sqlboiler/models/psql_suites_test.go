@@ -6,11 +6,27 @@ package models
 import "testing"
 
 func TestUpsert(t *testing.T) {
+	t.Run("AuditEvents", testAuditEventsUpsert)
+
+	t.Run("Categories", testCategoriesUpsert)
+
 	t.Run("Images", testImagesUpsert)
 
+	t.Run("PostLocks", testPostLocksUpsert)
+
+	t.Run("PostWorkflowHistories", testPostWorkflowHistoriesUpsert)
+
 	t.Run("Posts", testPostsUpsert)
 
+	t.Run("RateLimitBuckets", testRateLimitBucketsUpsert)
+
 	t.Run("Tags", testTagsUpsert)
 
 	t.Run("Users", testUsersUpsert)
+
+	t.Run("WebhookDeliveries", testWebhookDeliveriesUpsert)
+
+	t.Run("WebhookEvents", testWebhookEventsUpsert)
+
+	t.Run("WebhookSubscriptions", testWebhookSubscriptionsUpsert)
 }
@@ -0,0 +1,1079 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// RateLimitBucket is an object representing the database table.
+type RateLimitBucket struct {
+	Key       string    `boil:"key" json:"key" toml:"key" yaml:"key"`
+	Tokens    float64   `boil:"tokens" json:"tokens" toml:"tokens" yaml:"tokens"`
+	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ExpiresAt time.Time `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+
+	R *rateLimitBucketR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L rateLimitBucketL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var RateLimitBucketColumns = struct {
+	Key       string
+	Tokens    string
+	UpdatedAt string
+	ExpiresAt string
+}{
+	Key:       "key",
+	Tokens:    "tokens",
+	UpdatedAt: "updated_at",
+	ExpiresAt: "expires_at",
+}
+
+var RateLimitBucketTableColumns = struct {
+	Key       string
+	Tokens    string
+	UpdatedAt string
+	ExpiresAt string
+}{
+	Key:       "rate_limit_buckets.key",
+	Tokens:    "rate_limit_buckets.tokens",
+	UpdatedAt: "rate_limit_buckets.updated_at",
+	ExpiresAt: "rate_limit_buckets.expires_at",
+}
+
+// Generated where
+
+type whereHelperfloat64 struct{ field string }
+
+func (w whereHelperfloat64) EQ(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperfloat64) NEQ(x float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperfloat64) LT(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperfloat64) LTE(x float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperfloat64) GT(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperfloat64) GTE(x float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperfloat64) IN(slice []float64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperfloat64) NIN(slice []float64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var RateLimitBucketWhere = struct {
+	Key       whereHelperstring
+	Tokens    whereHelperfloat64
+	UpdatedAt whereHelpertime_Time
+	ExpiresAt whereHelpertime_Time
+}{
+	Key:       whereHelperstring{field: "\"rate_limit_buckets\".\"key\""},
+	Tokens:    whereHelperfloat64{field: "\"rate_limit_buckets\".\"tokens\""},
+	UpdatedAt: whereHelpertime_Time{field: "\"rate_limit_buckets\".\"updated_at\""},
+	ExpiresAt: whereHelpertime_Time{field: "\"rate_limit_buckets\".\"expires_at\""},
+}
+
+// RateLimitBucketRels is where relationship names are stored.
+var RateLimitBucketRels = struct {
+}{}
+
+// rateLimitBucketR is where relationships are stored.
+type rateLimitBucketR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*rateLimitBucketR) NewStruct() *rateLimitBucketR {
+	return &rateLimitBucketR{}
+}
+
+// rateLimitBucketL is where Load methods for each relationship are stored.
+type rateLimitBucketL struct{}
+
+var (
+	rateLimitBucketAllColumns            = []string{"key", "tokens", "updated_at", "expires_at"}
+	rateLimitBucketColumnsWithoutDefault = []string{"key", "tokens", "updated_at", "expires_at"}
+	rateLimitBucketColumnsWithDefault    = []string{}
+	rateLimitBucketPrimaryKeyColumns     = []string{"key"}
+	rateLimitBucketGeneratedColumns      = []string{}
+)
+
+type (
+	// RateLimitBucketSlice is an alias for a slice of pointers to RateLimitBucket.
+	// This should almost always be used instead of []RateLimitBucket.
+	RateLimitBucketSlice []*RateLimitBucket
+	// RateLimitBucketHook is the signature for custom RateLimitBucket hook methods
+	RateLimitBucketHook func(context.Context, boil.ContextExecutor, *RateLimitBucket) error
+
+	rateLimitBucketQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	rateLimitBucketType                 = reflect.TypeOf(&RateLimitBucket{})
+	rateLimitBucketMapping              = queries.MakeStructMapping(rateLimitBucketType)
+	rateLimitBucketPrimaryKeyMapping, _ = queries.BindMapping(rateLimitBucketType, rateLimitBucketMapping, rateLimitBucketPrimaryKeyColumns)
+	rateLimitBucketInsertCacheMut       sync.RWMutex
+	rateLimitBucketInsertCache          = make(map[string]insertCache)
+	rateLimitBucketUpdateCacheMut       sync.RWMutex
+	rateLimitBucketUpdateCache          = make(map[string]updateCache)
+	rateLimitBucketUpsertCacheMut       sync.RWMutex
+	rateLimitBucketUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var rateLimitBucketAfterSelectMu sync.Mutex
+var rateLimitBucketAfterSelectHooks []RateLimitBucketHook
+
+var rateLimitBucketBeforeInsertMu sync.Mutex
+var rateLimitBucketBeforeInsertHooks []RateLimitBucketHook
+var rateLimitBucketAfterInsertMu sync.Mutex
+var rateLimitBucketAfterInsertHooks []RateLimitBucketHook
+
+var rateLimitBucketBeforeUpdateMu sync.Mutex
+var rateLimitBucketBeforeUpdateHooks []RateLimitBucketHook
+var rateLimitBucketAfterUpdateMu sync.Mutex
+var rateLimitBucketAfterUpdateHooks []RateLimitBucketHook
+
+var rateLimitBucketBeforeDeleteMu sync.Mutex
+var rateLimitBucketBeforeDeleteHooks []RateLimitBucketHook
+var rateLimitBucketAfterDeleteMu sync.Mutex
+var rateLimitBucketAfterDeleteHooks []RateLimitBucketHook
+
+var rateLimitBucketBeforeUpsertMu sync.Mutex
+var rateLimitBucketBeforeUpsertHooks []RateLimitBucketHook
+var rateLimitBucketAfterUpsertMu sync.Mutex
+var rateLimitBucketAfterUpsertHooks []RateLimitBucketHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *RateLimitBucket) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rateLimitBucketAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *RateLimitBucket) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rateLimitBucketBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *RateLimitBucket) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rateLimitBucketAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *RateLimitBucket) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rateLimitBucketBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *RateLimitBucket) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rateLimitBucketAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *RateLimitBucket) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rateLimitBucketBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *RateLimitBucket) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rateLimitBucketAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *RateLimitBucket) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rateLimitBucketBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *RateLimitBucket) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rateLimitBucketAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddRateLimitBucketHook registers your hook function for all future operations.
+func AddRateLimitBucketHook(hookPoint boil.HookPoint, rateLimitBucketHook RateLimitBucketHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		rateLimitBucketAfterSelectMu.Lock()
+		rateLimitBucketAfterSelectHooks = append(rateLimitBucketAfterSelectHooks, rateLimitBucketHook)
+		rateLimitBucketAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		rateLimitBucketBeforeInsertMu.Lock()
+		rateLimitBucketBeforeInsertHooks = append(rateLimitBucketBeforeInsertHooks, rateLimitBucketHook)
+		rateLimitBucketBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		rateLimitBucketAfterInsertMu.Lock()
+		rateLimitBucketAfterInsertHooks = append(rateLimitBucketAfterInsertHooks, rateLimitBucketHook)
+		rateLimitBucketAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		rateLimitBucketBeforeUpdateMu.Lock()
+		rateLimitBucketBeforeUpdateHooks = append(rateLimitBucketBeforeUpdateHooks, rateLimitBucketHook)
+		rateLimitBucketBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		rateLimitBucketAfterUpdateMu.Lock()
+		rateLimitBucketAfterUpdateHooks = append(rateLimitBucketAfterUpdateHooks, rateLimitBucketHook)
+		rateLimitBucketAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		rateLimitBucketBeforeDeleteMu.Lock()
+		rateLimitBucketBeforeDeleteHooks = append(rateLimitBucketBeforeDeleteHooks, rateLimitBucketHook)
+		rateLimitBucketBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		rateLimitBucketAfterDeleteMu.Lock()
+		rateLimitBucketAfterDeleteHooks = append(rateLimitBucketAfterDeleteHooks, rateLimitBucketHook)
+		rateLimitBucketAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		rateLimitBucketBeforeUpsertMu.Lock()
+		rateLimitBucketBeforeUpsertHooks = append(rateLimitBucketBeforeUpsertHooks, rateLimitBucketHook)
+		rateLimitBucketBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		rateLimitBucketAfterUpsertMu.Lock()
+		rateLimitBucketAfterUpsertHooks = append(rateLimitBucketAfterUpsertHooks, rateLimitBucketHook)
+		rateLimitBucketAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single rateLimitBucket record from the query using the global executor.
+func (q rateLimitBucketQuery) OneG(ctx context.Context) (*RateLimitBucket, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single rateLimitBucket record from the query.
+func (q rateLimitBucketQuery) One(ctx context.Context, exec boil.ContextExecutor) (*RateLimitBucket, error) {
+	o := &RateLimitBucket{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for rate_limit_buckets")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all RateLimitBucket records from the query using the global executor.
+func (q rateLimitBucketQuery) AllG(ctx context.Context) (RateLimitBucketSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all RateLimitBucket records from the query.
+func (q rateLimitBucketQuery) All(ctx context.Context, exec boil.ContextExecutor) (RateLimitBucketSlice, error) {
+	var o []*RateLimitBucket
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to RateLimitBucket slice")
+	}
+
+	if len(rateLimitBucketAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all RateLimitBucket records in the query using the global executor
+func (q rateLimitBucketQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all RateLimitBucket records in the query.
+func (q rateLimitBucketQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count rate_limit_buckets rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q rateLimitBucketQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q rateLimitBucketQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if rate_limit_buckets exists")
+	}
+
+	return count > 0, nil
+}
+
+// RateLimitBuckets retrieves all the records using an executor.
+func RateLimitBuckets(mods ...qm.QueryMod) rateLimitBucketQuery {
+	mods = append(mods, qm.From("\"rate_limit_buckets\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"rate_limit_buckets\".*"})
+	}
+
+	return rateLimitBucketQuery{q}
+}
+
+// FindRateLimitBucketG retrieves a single record by ID.
+func FindRateLimitBucketG(ctx context.Context, key string, selectCols ...string) (*RateLimitBucket, error) {
+	return FindRateLimitBucket(ctx, boil.GetContextDB(), key, selectCols...)
+}
+
+// FindRateLimitBucket retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindRateLimitBucket(ctx context.Context, exec boil.ContextExecutor, key string, selectCols ...string) (*RateLimitBucket, error) {
+	rateLimitBucketObj := &RateLimitBucket{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"rate_limit_buckets\" where \"key\"=$1", sel,
+	)
+
+	q := queries.Raw(query, key)
+
+	err := q.Bind(ctx, exec, rateLimitBucketObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from rate_limit_buckets")
+	}
+
+	if err = rateLimitBucketObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return rateLimitBucketObj, err
+	}
+
+	return rateLimitBucketObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *RateLimitBucket) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *RateLimitBucket) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no rate_limit_buckets provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(rateLimitBucketColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	rateLimitBucketInsertCacheMut.RLock()
+	cache, cached := rateLimitBucketInsertCache[key]
+	rateLimitBucketInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			rateLimitBucketAllColumns,
+			rateLimitBucketColumnsWithDefault,
+			rateLimitBucketColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(rateLimitBucketType, rateLimitBucketMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(rateLimitBucketType, rateLimitBucketMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"rate_limit_buckets\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"rate_limit_buckets\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into rate_limit_buckets")
+	}
+
+	if !cached {
+		rateLimitBucketInsertCacheMut.Lock()
+		rateLimitBucketInsertCache[key] = cache
+		rateLimitBucketInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single RateLimitBucket record using the global executor.
+// See Update for more documentation.
+func (o *RateLimitBucket) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the RateLimitBucket.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *RateLimitBucket) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	rateLimitBucketUpdateCacheMut.RLock()
+	cache, cached := rateLimitBucketUpdateCache[key]
+	rateLimitBucketUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			rateLimitBucketAllColumns,
+			rateLimitBucketPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update rate_limit_buckets, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"rate_limit_buckets\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, rateLimitBucketPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(rateLimitBucketType, rateLimitBucketMapping, append(wl, rateLimitBucketPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update rate_limit_buckets row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for rate_limit_buckets")
+	}
+
+	if !cached {
+		rateLimitBucketUpdateCacheMut.Lock()
+		rateLimitBucketUpdateCache[key] = cache
+		rateLimitBucketUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q rateLimitBucketQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q rateLimitBucketQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for rate_limit_buckets")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for rate_limit_buckets")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o RateLimitBucketSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o RateLimitBucketSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rateLimitBucketPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"rate_limit_buckets\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, rateLimitBucketPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in rateLimitBucket slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all rateLimitBucket")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *RateLimitBucket) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *RateLimitBucket) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no rate_limit_buckets provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(rateLimitBucketColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	rateLimitBucketUpsertCacheMut.RLock()
+	cache, cached := rateLimitBucketUpsertCache[key]
+	rateLimitBucketUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			rateLimitBucketAllColumns,
+			rateLimitBucketColumnsWithDefault,
+			rateLimitBucketColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			rateLimitBucketAllColumns,
+			rateLimitBucketPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert rate_limit_buckets, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(rateLimitBucketAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(rateLimitBucketPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert rate_limit_buckets, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(rateLimitBucketPrimaryKeyColumns))
+			copy(conflict, rateLimitBucketPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"rate_limit_buckets\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(rateLimitBucketType, rateLimitBucketMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(rateLimitBucketType, rateLimitBucketMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert rate_limit_buckets")
+	}
+
+	if !cached {
+		rateLimitBucketUpsertCacheMut.Lock()
+		rateLimitBucketUpsertCache[key] = cache
+		rateLimitBucketUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single RateLimitBucket record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *RateLimitBucket) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single RateLimitBucket record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *RateLimitBucket) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no RateLimitBucket provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), rateLimitBucketPrimaryKeyMapping)
+	sql := "DELETE FROM \"rate_limit_buckets\" WHERE \"key\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from rate_limit_buckets")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for rate_limit_buckets")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q rateLimitBucketQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q rateLimitBucketQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no rateLimitBucketQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from rate_limit_buckets")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for rate_limit_buckets")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o RateLimitBucketSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o RateLimitBucketSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(rateLimitBucketBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rateLimitBucketPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"rate_limit_buckets\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, rateLimitBucketPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from rateLimitBucket slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for rate_limit_buckets")
+	}
+
+	if len(rateLimitBucketAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *RateLimitBucket) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no RateLimitBucket provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *RateLimitBucket) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindRateLimitBucket(ctx, exec, o.Key)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *RateLimitBucketSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty RateLimitBucketSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *RateLimitBucketSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := RateLimitBucketSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rateLimitBucketPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"rate_limit_buckets\".* FROM \"rate_limit_buckets\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, rateLimitBucketPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in RateLimitBucketSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// RateLimitBucketExistsG checks if the RateLimitBucket row exists.
+func RateLimitBucketExistsG(ctx context.Context, key string) (bool, error) {
+	return RateLimitBucketExists(ctx, boil.GetContextDB(), key)
+}
+
+// RateLimitBucketExists checks if the RateLimitBucket row exists.
+func RateLimitBucketExists(ctx context.Context, exec boil.ContextExecutor, key string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"rate_limit_buckets\" where \"key\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, key)
+	}
+	row := exec.QueryRowContext(ctx, sql, key)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if rate_limit_buckets exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the RateLimitBucket row exists.
+func (o *RateLimitBucket) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return RateLimitBucketExists(ctx, exec, o.Key)
+}
@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testRateLimitBuckets(t *testing.T) {
+	t.Parallel()
+
+	query := RateLimitBuckets()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testRateLimitBucketsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RateLimitBucket{}
+	if err = randomize.Struct(seed, o, rateLimitBucketDBTypes, true, rateLimitBucketColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RateLimitBucket struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := RateLimitBuckets().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRateLimitBucketsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RateLimitBucket{}
+	if err = randomize.Struct(seed, o, rateLimitBucketDBTypes, true, rateLimitBucketColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RateLimitBucket struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := RateLimitBuckets().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := RateLimitBuckets().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRateLimitBucketsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RateLimitBucket{}
+	if err = randomize.Struct(seed, o, rateLimitBucketDBTypes, true, rateLimitBucketColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RateLimitBucket struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := RateLimitBucketSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := RateLimitBuckets().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRateLimitBucketsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RateLimitBucket{}
+	if err = randomize.Struct(seed, o, rateLimitBucketDBTypes, true, rateLimitBucketColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RateLimitBucket struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := RateLimitBucketExists(ctx, tx, o.Key)
+	if err != nil {
+		t.Errorf("Unable to check if RateLimitBucket exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected RateLimitBucketExists to return true, but got false.")
+	}
+}
+
+func testRateLimitBucketsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RateLimitBucket{}
+	if err = randomize.Struct(seed, o, rateLimitBucketDBTypes, true, rateLimitBucketColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RateLimitBucket struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	rateLimitBucketFound, err := FindRateLimitBucket(ctx, tx, o.Key)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if rateLimitBucketFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testRateLimitBucketsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RateLimitBucket{}
+	if err = randomize.Struct(seed, o, rateLimitBucketDBTypes, true, rateLimitBucketColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RateLimitBucket struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = RateLimitBuckets().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testRateLimitBucketsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RateLimitBucket{}
+	if err = randomize.Struct(seed, o, rateLimitBucketDBTypes, true, rateLimitBucketColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RateLimitBucket struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := RateLimitBuckets().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testRateLimitBucketsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	rateLimitBucketOne := &RateLimitBucket{}
+	rateLimitBucketTwo := &RateLimitBucket{}
+	if err = randomize.Struct(seed, rateLimitBucketOne, rateLimitBucketDBTypes, false, rateLimitBucketColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RateLimitBucket struct: %s", err)
+	}
+	if err = randomize.Struct(seed, rateLimitBucketTwo, rateLimitBucketDBTypes, false, rateLimitBucketColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RateLimitBucket struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = rateLimitBucketOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = rateLimitBucketTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := RateLimitBuckets().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testRateLimitBucketsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	rateLimitBucketOne := &RateLimitBucket{}
+	rateLimitBucketTwo := &RateLimitBucket{}
+	if err = randomize.Struct(seed, rateLimitBucketOne, rateLimitBucketDBTypes, false, rateLimitBucketColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RateLimitBucket struct: %s", err)
+	}
+	if err = randomize.Struct(seed, rateLimitBucketTwo, rateLimitBucketDBTypes, false, rateLimitBucketColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RateLimitBucket struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = rateLimitBucketOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = rateLimitBucketTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RateLimitBuckets().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func rateLimitBucketBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *RateLimitBucket) error {
+	*o = RateLimitBucket{}
+	return nil
+}
+
+func rateLimitBucketAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *RateLimitBucket) error {
+	*o = RateLimitBucket{}
+	return nil
+}
+
+func rateLimitBucketAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *RateLimitBucket) error {
+	*o = RateLimitBucket{}
+	return nil
+}
+
+func rateLimitBucketBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *RateLimitBucket) error {
+	*o = RateLimitBucket{}
+	return nil
+}
+
+func rateLimitBucketAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *RateLimitBucket) error {
+	*o = RateLimitBucket{}
+	return nil
+}
+
+func rateLimitBucketBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *RateLimitBucket) error {
+	*o = RateLimitBucket{}
+	return nil
+}
+
+func rateLimitBucketAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *RateLimitBucket) error {
+	*o = RateLimitBucket{}
+	return nil
+}
+
+func rateLimitBucketBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *RateLimitBucket) error {
+	*o = RateLimitBucket{}
+	return nil
+}
+
+func rateLimitBucketAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *RateLimitBucket) error {
+	*o = RateLimitBucket{}
+	return nil
+}
+
+func testRateLimitBucketsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &RateLimitBucket{}
+	o := &RateLimitBucket{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, rateLimitBucketDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize RateLimitBucket object: %s", err)
+	}
+
+	AddRateLimitBucketHook(boil.BeforeInsertHook, rateLimitBucketBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	rateLimitBucketBeforeInsertHooks = []RateLimitBucketHook{}
+
+	AddRateLimitBucketHook(boil.AfterInsertHook, rateLimitBucketAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	rateLimitBucketAfterInsertHooks = []RateLimitBucketHook{}
+
+	AddRateLimitBucketHook(boil.AfterSelectHook, rateLimitBucketAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	rateLimitBucketAfterSelectHooks = []RateLimitBucketHook{}
+
+	AddRateLimitBucketHook(boil.BeforeUpdateHook, rateLimitBucketBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	rateLimitBucketBeforeUpdateHooks = []RateLimitBucketHook{}
+
+	AddRateLimitBucketHook(boil.AfterUpdateHook, rateLimitBucketAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	rateLimitBucketAfterUpdateHooks = []RateLimitBucketHook{}
+
+	AddRateLimitBucketHook(boil.BeforeDeleteHook, rateLimitBucketBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	rateLimitBucketBeforeDeleteHooks = []RateLimitBucketHook{}
+
+	AddRateLimitBucketHook(boil.AfterDeleteHook, rateLimitBucketAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	rateLimitBucketAfterDeleteHooks = []RateLimitBucketHook{}
+
+	AddRateLimitBucketHook(boil.BeforeUpsertHook, rateLimitBucketBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	rateLimitBucketBeforeUpsertHooks = []RateLimitBucketHook{}
+
+	AddRateLimitBucketHook(boil.AfterUpsertHook, rateLimitBucketAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	rateLimitBucketAfterUpsertHooks = []RateLimitBucketHook{}
+}
+
+func testRateLimitBucketsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RateLimitBucket{}
+	if err = randomize.Struct(seed, o, rateLimitBucketDBTypes, true, rateLimitBucketColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RateLimitBucket struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RateLimitBuckets().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testRateLimitBucketsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RateLimitBucket{}
+	if err = randomize.Struct(seed, o, rateLimitBucketDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize RateLimitBucket struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(rateLimitBucketColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RateLimitBuckets().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testRateLimitBucketsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RateLimitBucket{}
+	if err = randomize.Struct(seed, o, rateLimitBucketDBTypes, true, rateLimitBucketColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RateLimitBucket struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testRateLimitBucketsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RateLimitBucket{}
+	if err = randomize.Struct(seed, o, rateLimitBucketDBTypes, true, rateLimitBucketColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RateLimitBucket struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := RateLimitBucketSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testRateLimitBucketsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RateLimitBucket{}
+	if err = randomize.Struct(seed, o, rateLimitBucketDBTypes, true, rateLimitBucketColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RateLimitBucket struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := RateLimitBuckets().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	rateLimitBucketDBTypes = map[string]string{`Key`: `character varying`, `Tokens`: `double precision`, `UpdatedAt`: `timestamp with time zone`, `ExpiresAt`: `timestamp with time zone`}
+	_                      = bytes.MinRead
+)
+
+func testRateLimitBucketsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(rateLimitBucketPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(rateLimitBucketAllColumns) == len(rateLimitBucketPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RateLimitBucket{}
+	if err = randomize.Struct(seed, o, rateLimitBucketDBTypes, true, rateLimitBucketColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RateLimitBucket struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RateLimitBuckets().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, rateLimitBucketDBTypes, true, rateLimitBucketPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize RateLimitBucket struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testRateLimitBucketsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(rateLimitBucketAllColumns) == len(rateLimitBucketPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RateLimitBucket{}
+	if err = randomize.Struct(seed, o, rateLimitBucketDBTypes, true, rateLimitBucketColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RateLimitBucket struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RateLimitBuckets().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, rateLimitBucketDBTypes, true, rateLimitBucketPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize RateLimitBucket struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(rateLimitBucketAllColumns, rateLimitBucketPrimaryKeyColumns) {
+		fields = rateLimitBucketAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			rateLimitBucketAllColumns,
+			rateLimitBucketPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := RateLimitBucketSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testRateLimitBucketsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(rateLimitBucketAllColumns) == len(rateLimitBucketPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := RateLimitBucket{}
+	if err = randomize.Struct(seed, &o, rateLimitBucketDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize RateLimitBucket struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert RateLimitBucket: %s", err)
+	}
+
+	count, err := RateLimitBuckets().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, rateLimitBucketDBTypes, false, rateLimitBucketPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize RateLimitBucket struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert RateLimitBucket: %s", err)
+	}
+
+	count, err = RateLimitBuckets().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -27,6 +27,7 @@ type Tag struct {
 	Name      string    `boil:"name" json:"name" toml:"name" yaml:"name"`
 	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	NameKey   string    `boil:"name_key" json:"name_key" toml:"name_key" yaml:"name_key"`
 
 	R *tagR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L tagL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -37,11 +38,13 @@ var TagColumns = struct {
 	Name      string
 	CreatedAt string
 	UpdatedAt string
+	NameKey   string
 }{
 	ID:        "id",
 	Name:      "name",
 	CreatedAt: "created_at",
 	UpdatedAt: "updated_at",
+	NameKey:   "name_key",
 }
 
 var TagTableColumns = struct {
@@ -49,11 +52,13 @@ var TagTableColumns = struct {
 	Name      string
 	CreatedAt string
 	UpdatedAt string
+	NameKey   string
 }{
 	ID:        "tags.id",
 	Name:      "tags.name",
 	CreatedAt: "tags.created_at",
 	UpdatedAt: "tags.updated_at",
+	NameKey:   "tags.name_key",
 }
 
 // Generated where
@@ -63,11 +68,13 @@ var TagWhere = struct {
 	Name      whereHelperstring
 	CreatedAt whereHelpertime_Time
 	UpdatedAt whereHelpertime_Time
+	NameKey   whereHelperstring
 }{
 	ID:        whereHelperstring{field: "\"tags\".\"id\""},
 	Name:      whereHelperstring{field: "\"tags\".\"name\""},
 	CreatedAt: whereHelpertime_Time{field: "\"tags\".\"created_at\""},
 	UpdatedAt: whereHelpertime_Time{field: "\"tags\".\"updated_at\""},
+	NameKey:   whereHelperstring{field: "\"tags\".\"name_key\""},
 }
 
 // TagRels is where relationship names are stored.
@@ -98,8 +105,8 @@ func (r *tagR) GetPosts() PostSlice {
 type tagL struct{}
 
 var (
-	tagAllColumns            = []string{"id", "name", "created_at", "updated_at"}
-	tagColumnsWithoutDefault = []string{"id", "name"}
+	tagAllColumns            = []string{"id", "name", "created_at", "updated_at", "name_key"}
+	tagColumnsWithoutDefault = []string{"id", "name", "name_key"}
 	tagColumnsWithDefault    = []string{"created_at", "updated_at"}
 	tagPrimaryKeyColumns     = []string{"id"}
 	tagGeneratedColumns      = []string{}
@@ -500,7 +507,7 @@ func (tagL) LoadPosts(ctx context.Context, e boil.ContextExecutor, singular bool
 	}
 
 	query := NewQuery(
-		qm.Select("\"posts\".\"id\", \"posts\".\"title\", \"posts\".\"content\", \"posts\".\"user_id\", \"posts\".\"status\", \"posts\".\"first_published_at\", \"posts\".\"content_updated_at\", \"posts\".\"created_at\", \"posts\".\"updated_at\", \"a\".\"tag_id\""),
+		qm.Select("\"posts\".\"id\", \"posts\".\"title\", \"posts\".\"content\", \"posts\".\"user_id\", \"posts\".\"status\", \"posts\".\"first_published_at\", \"posts\".\"content_updated_at\", \"posts\".\"created_at\", \"posts\".\"updated_at\", \"posts\".\"primary_category_id\", \"posts\".\"version\", \"posts\".\"reviewer_id\", \"posts\".\"excerpt\", \"posts\".\"meta_description\", \"posts\".\"canonical_url\", \"posts\".\"og_image_id\", \"a\".\"tag_id\""),
 		qm.From("\"posts\""),
 		qm.InnerJoin("\"post_tags\" as \"a\" on \"posts\".\"id\" = \"a\".\"post_id\""),
 		qm.WhereIn("\"a\".\"tag_id\" in ?", argsSlice...),
@@ -521,7 +528,7 @@ func (tagL) LoadPosts(ctx context.Context, e boil.ContextExecutor, singular bool
 		one := new(Post)
 		var localJoinCol string
 
-		err = results.Scan(&one.ID, &one.Title, &one.Content, &one.UserID, &one.Status, &one.FirstPublishedAt, &one.ContentUpdatedAt, &one.CreatedAt, &one.UpdatedAt, &localJoinCol)
+		err = results.Scan(&one.ID, &one.Title, &one.Content, &one.UserID, &one.Status, &one.FirstPublishedAt, &one.ContentUpdatedAt, &one.CreatedAt, &one.UpdatedAt, &one.PrimaryCategoryID, &one.Version, &one.ReviewerID, &one.Excerpt, &one.MetaDescription, &one.CanonicalURL, &one.OgImageID, &localJoinCol)
 		if err != nil {
 			return errors.Wrap(err, "failed to scan eager loaded results for posts")
 		}
@@ -86,14 +86,23 @@ var UserWhere = struct {
 
 // UserRels is where relationship names are stored.
 var UserRels = struct {
-	Images string
+	Images                     string
+	PostLocks                  string
+	ActorPostWorkflowHistories string
+	ReviewerPosts              string
 }{
-	Images: "Images",
+	Images:                     "Images",
+	PostLocks:                  "PostLocks",
+	ActorPostWorkflowHistories: "ActorPostWorkflowHistories",
+	ReviewerPosts:              "ReviewerPosts",
 }
 
 // userR is where relationships are stored.
 type userR struct {
-	Images ImageSlice `boil:"Images" json:"Images" toml:"Images" yaml:"Images"`
+	Images                     ImageSlice               `boil:"Images" json:"Images" toml:"Images" yaml:"Images"`
+	PostLocks                  PostLockSlice            `boil:"PostLocks" json:"PostLocks" toml:"PostLocks" yaml:"PostLocks"`
+	ActorPostWorkflowHistories PostWorkflowHistorySlice `boil:"ActorPostWorkflowHistories" json:"ActorPostWorkflowHistories" toml:"ActorPostWorkflowHistories" yaml:"ActorPostWorkflowHistories"`
+	ReviewerPosts              PostSlice                `boil:"ReviewerPosts" json:"ReviewerPosts" toml:"ReviewerPosts" yaml:"ReviewerPosts"`
 }
 
 // NewStruct creates a new relationship struct
@@ -108,6 +117,27 @@ func (r *userR) GetImages() ImageSlice {
 	return r.Images
 }
 
+func (r *userR) GetPostLocks() PostLockSlice {
+	if r == nil {
+		return nil
+	}
+	return r.PostLocks
+}
+
+func (r *userR) GetActorPostWorkflowHistories() PostWorkflowHistorySlice {
+	if r == nil {
+		return nil
+	}
+	return r.ActorPostWorkflowHistories
+}
+
+func (r *userR) GetReviewerPosts() PostSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ReviewerPosts
+}
+
 // userL is where Load methods for each relationship are stored.
 type userL struct{}
 
@@ -458,6 +488,48 @@ func (o *User) Images(mods ...qm.QueryMod) imageQuery {
 	return Images(queryMods...)
 }
 
+// PostLocks retrieves all the post_lock's PostLocks with an executor.
+func (o *User) PostLocks(mods ...qm.QueryMod) postLockQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"post_locks\".\"user_id\"=?", o.ID),
+	)
+
+	return PostLocks(queryMods...)
+}
+
+// ActorPostWorkflowHistories retrieves all the post_workflow_history's PostWorkflowHistories with an executor via actor_id column.
+func (o *User) ActorPostWorkflowHistories(mods ...qm.QueryMod) postWorkflowHistoryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"post_workflow_histories\".\"actor_id\"=?", o.ID),
+	)
+
+	return PostWorkflowHistories(queryMods...)
+}
+
+// ReviewerPosts retrieves all the post's Posts with an executor via reviewer_id column.
+func (o *User) ReviewerPosts(mods ...qm.QueryMod) postQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"posts\".\"reviewer_id\"=?", o.ID),
+	)
+
+	return Posts(queryMods...)
+}
+
 // LoadImages allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (userL) LoadImages(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
@@ -571,65 +643,683 @@ func (userL) LoadImages(ctx context.Context, e boil.ContextExecutor, singular bo
 	return nil
 }
 
-// AddImagesG adds the given related objects to the existing relationships
-// of the user, optionally inserting them as new records.
-// Appends related to o.R.Images.
-// Sets related.R.User appropriately.
-// Uses the global database handle.
-func (o *User) AddImagesG(ctx context.Context, insert bool, related ...*Image) error {
-	return o.AddImages(ctx, boil.GetContextDB(), insert, related...)
-}
+// LoadPostLocks allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadPostLocks(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
 
-// AddImages adds the given related objects to the existing relationships
-// of the user, optionally inserting them as new records.
-// Appends related to o.R.Images.
-// Sets related.R.User appropriately.
-func (o *User) AddImages(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Image) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			rel.UserID = o.ID
-			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
 			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
 		} else {
-			updateQuery := fmt.Sprintf(
-				"UPDATE \"images\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, imagePrimaryKeyColumns),
-			)
-			values := []interface{}{o.ID, rel.ID}
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
 
-			if boil.IsDebug(ctx) {
-				writer := boil.DebugWriterFrom(ctx)
-				fmt.Fprintln(writer, updateQuery)
-				fmt.Fprintln(writer, values)
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
 			}
-			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-				return errors.Wrap(err, "failed to update foreign table")
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`post_locks`),
+		qm.WhereIn(`post_locks.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load post_locks")
+	}
+
+	var resultSlice []*PostLock
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice post_locks")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on post_locks")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for post_locks")
+	}
+
+	if len(postLockAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
 			}
+		}
+	}
+	if singular {
+		object.R.PostLocks = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &postLockR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
 
-			rel.UserID = o.ID
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.PostLocks = append(local.R.PostLocks, foreign)
+				if foreign.R == nil {
+					foreign.R = &postLockR{}
+				}
+				foreign.R.User = local
+				break
+			}
 		}
 	}
 
-	if o.R == nil {
-		o.R = &userR{
-			Images: related,
+	return nil
+}
+
+// LoadActorPostWorkflowHistories allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadActorPostWorkflowHistories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
 		}
 	} else {
-		o.R.Images = append(o.R.Images, related...)
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
 	}
 
-	for _, rel := range related {
-		if rel.R == nil {
-			rel.R = &imageR{
-				User: o,
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
 			}
-		} else {
-			rel.R.User = o
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`post_workflow_histories`),
+		qm.WhereIn(`post_workflow_histories.actor_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load post_workflow_histories")
+	}
+
+	var resultSlice []*PostWorkflowHistory
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice post_workflow_histories")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on post_workflow_histories")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for post_workflow_histories")
+	}
+
+	if len(postWorkflowHistoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ActorPostWorkflowHistories = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &postWorkflowHistoryR{}
+			}
+			foreign.R.Actor = object
 		}
+		return nil
 	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ActorID {
+				local.R.ActorPostWorkflowHistories = append(local.R.ActorPostWorkflowHistories, foreign)
+				if foreign.R == nil {
+					foreign.R = &postWorkflowHistoryR{}
+				}
+				foreign.R.Actor = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadReviewerPosts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadReviewerPosts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`posts`),
+		qm.WhereIn(`posts.reviewer_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load posts")
+	}
+
+	var resultSlice []*Post
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice posts")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on posts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for posts")
+	}
+
+	if len(postAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ReviewerPosts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &postR{}
+			}
+			foreign.R.Reviewer = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ReviewerID) {
+				local.R.ReviewerPosts = append(local.R.ReviewerPosts, foreign)
+				if foreign.R == nil {
+					foreign.R = &postR{}
+				}
+				foreign.R.Reviewer = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddImagesG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.Images.
+// Sets related.R.User appropriately.
+// Uses the global database handle.
+func (o *User) AddImagesG(ctx context.Context, insert bool, related ...*Image) error {
+	return o.AddImages(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddImages adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.Images.
+// Sets related.R.User appropriately.
+func (o *User) AddImages(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Image) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"images\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, imagePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			Images: related,
+		}
+	} else {
+		o.R.Images = append(o.R.Images, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &imageR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddPostLocksG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.PostLocks.
+// Sets related.R.User appropriately.
+// Uses the global database handle.
+func (o *User) AddPostLocksG(ctx context.Context, insert bool, related ...*PostLock) error {
+	return o.AddPostLocks(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddPostLocks adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.PostLocks.
+// Sets related.R.User appropriately.
+func (o *User) AddPostLocks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PostLock) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"post_locks\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, postLockPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.PostID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			PostLocks: related,
+		}
+	} else {
+		o.R.PostLocks = append(o.R.PostLocks, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &postLockR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddActorPostWorkflowHistoriesG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ActorPostWorkflowHistories.
+// Sets related.R.Actor appropriately.
+// Uses the global database handle.
+func (o *User) AddActorPostWorkflowHistoriesG(ctx context.Context, insert bool, related ...*PostWorkflowHistory) error {
+	return o.AddActorPostWorkflowHistories(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddActorPostWorkflowHistories adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ActorPostWorkflowHistories.
+// Sets related.R.Actor appropriately.
+func (o *User) AddActorPostWorkflowHistories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PostWorkflowHistory) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ActorID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"post_workflow_histories\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"actor_id"}),
+				strmangle.WhereClause("\"", "\"", 2, postWorkflowHistoryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ActorID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ActorPostWorkflowHistories: related,
+		}
+	} else {
+		o.R.ActorPostWorkflowHistories = append(o.R.ActorPostWorkflowHistories, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &postWorkflowHistoryR{
+				Actor: o,
+			}
+		} else {
+			rel.R.Actor = o
+		}
+	}
+	return nil
+}
+
+// AddReviewerPostsG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ReviewerPosts.
+// Sets related.R.Reviewer appropriately.
+// Uses the global database handle.
+func (o *User) AddReviewerPostsG(ctx context.Context, insert bool, related ...*Post) error {
+	return o.AddReviewerPosts(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddReviewerPosts adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ReviewerPosts.
+// Sets related.R.Reviewer appropriately.
+func (o *User) AddReviewerPosts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Post) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ReviewerID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"posts\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"reviewer_id"}),
+				strmangle.WhereClause("\"", "\"", 2, postPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ReviewerID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ReviewerPosts: related,
+		}
+	} else {
+		o.R.ReviewerPosts = append(o.R.ReviewerPosts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &postR{
+				Reviewer: o,
+			}
+		} else {
+			rel.R.Reviewer = o
+		}
+	}
+	return nil
+}
+
+// SetReviewerPostsG removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Reviewer's ReviewerPosts accordingly.
+// Replaces o.R.ReviewerPosts with related.
+// Sets related.R.Reviewer's ReviewerPosts accordingly.
+// Uses the global database handle.
+func (o *User) SetReviewerPostsG(ctx context.Context, insert bool, related ...*Post) error {
+	return o.SetReviewerPosts(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// SetReviewerPosts removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Reviewer's ReviewerPosts accordingly.
+// Replaces o.R.ReviewerPosts with related.
+// Sets related.R.Reviewer's ReviewerPosts accordingly.
+func (o *User) SetReviewerPosts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Post) error {
+	query := "update \"posts\" set \"reviewer_id\" = null where \"reviewer_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ReviewerPosts {
+			queries.SetScanner(&rel.ReviewerID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Reviewer = nil
+		}
+		o.R.ReviewerPosts = nil
+	}
+
+	return o.AddReviewerPosts(ctx, exec, insert, related...)
+}
+
+// RemoveReviewerPostsG relationships from objects passed in.
+// Removes related items from R.ReviewerPosts (uses pointer comparison, removal does not keep order)
+// Sets related.R.Reviewer.
+// Uses the global database handle.
+func (o *User) RemoveReviewerPostsG(ctx context.Context, related ...*Post) error {
+	return o.RemoveReviewerPosts(ctx, boil.GetContextDB(), related...)
+}
+
+// RemoveReviewerPosts relationships from objects passed in.
+// Removes related items from R.ReviewerPosts (uses pointer comparison, removal does not keep order)
+// Sets related.R.Reviewer.
+func (o *User) RemoveReviewerPosts(ctx context.Context, exec boil.ContextExecutor, related ...*Post) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ReviewerID, nil)
+		if rel.R != nil {
+			rel.R.Reviewer = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("reviewer_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ReviewerPosts {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ReviewerPosts)
+			if ln > 1 && i < ln-1 {
+				o.R.ReviewerPosts[i] = o.R.ReviewerPosts[ln-1]
+			}
+			o.R.ReviewerPosts = o.R.ReviewerPosts[:ln-1]
+			break
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,1318 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// WebhookDelivery is an object representing the database table.
+type WebhookDelivery struct {
+	ID             int64      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	SubscriptionID string     `boil:"subscription_id" json:"subscription_id" toml:"subscription_id" yaml:"subscription_id"`
+	EventType      string     `boil:"event_type" json:"event_type" toml:"event_type" yaml:"event_type"`
+	Payload        types.JSON `boil:"payload" json:"payload" toml:"payload" yaml:"payload"`
+	Status         string     `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Attempts       int        `boil:"attempts" json:"attempts" toml:"attempts" yaml:"attempts"`
+	NextAttemptAt  time.Time  `boil:"next_attempt_at" json:"next_attempt_at" toml:"next_attempt_at" yaml:"next_attempt_at"`
+	LastStatusCode null.Int   `boil:"last_status_code" json:"last_status_code,omitempty" toml:"last_status_code" yaml:"last_status_code,omitempty"`
+	LastError      string     `boil:"last_error" json:"last_error" toml:"last_error" yaml:"last_error"`
+	DeliveredAt    null.Time  `boil:"delivered_at" json:"delivered_at,omitempty" toml:"delivered_at" yaml:"delivered_at,omitempty"`
+	CreatedAt      time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt      time.Time  `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	RequestID      string     `boil:"request_id" json:"request_id" toml:"request_id" yaml:"request_id"`
+
+	R *webhookDeliveryR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L webhookDeliveryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var WebhookDeliveryColumns = struct {
+	ID             string
+	SubscriptionID string
+	EventType      string
+	Payload        string
+	Status         string
+	Attempts       string
+	NextAttemptAt  string
+	LastStatusCode string
+	LastError      string
+	DeliveredAt    string
+	CreatedAt      string
+	UpdatedAt      string
+	RequestID      string
+}{
+	ID:             "id",
+	SubscriptionID: "subscription_id",
+	EventType:      "event_type",
+	Payload:        "payload",
+	Status:         "status",
+	Attempts:       "attempts",
+	NextAttemptAt:  "next_attempt_at",
+	LastStatusCode: "last_status_code",
+	LastError:      "last_error",
+	DeliveredAt:    "delivered_at",
+	CreatedAt:      "created_at",
+	UpdatedAt:      "updated_at",
+	RequestID:      "request_id",
+}
+
+var WebhookDeliveryTableColumns = struct {
+	ID             string
+	SubscriptionID string
+	EventType      string
+	Payload        string
+	Status         string
+	Attempts       string
+	NextAttemptAt  string
+	LastStatusCode string
+	LastError      string
+	DeliveredAt    string
+	CreatedAt      string
+	UpdatedAt      string
+	RequestID      string
+}{
+	ID:             "webhook_deliveries.id",
+	SubscriptionID: "webhook_deliveries.subscription_id",
+	EventType:      "webhook_deliveries.event_type",
+	Payload:        "webhook_deliveries.payload",
+	Status:         "webhook_deliveries.status",
+	Attempts:       "webhook_deliveries.attempts",
+	NextAttemptAt:  "webhook_deliveries.next_attempt_at",
+	LastStatusCode: "webhook_deliveries.last_status_code",
+	LastError:      "webhook_deliveries.last_error",
+	DeliveredAt:    "webhook_deliveries.delivered_at",
+	CreatedAt:      "webhook_deliveries.created_at",
+	UpdatedAt:      "webhook_deliveries.updated_at",
+	RequestID:      "webhook_deliveries.request_id",
+}
+
+// Generated where
+
+var WebhookDeliveryWhere = struct {
+	ID             whereHelperint64
+	SubscriptionID whereHelperstring
+	EventType      whereHelperstring
+	Payload        whereHelpertypes_JSON
+	Status         whereHelperstring
+	Attempts       whereHelperint
+	NextAttemptAt  whereHelpertime_Time
+	LastStatusCode whereHelpernull_Int
+	LastError      whereHelperstring
+	DeliveredAt    whereHelpernull_Time
+	CreatedAt      whereHelpertime_Time
+	UpdatedAt      whereHelpertime_Time
+	RequestID      whereHelperstring
+}{
+	ID:             whereHelperint64{field: "\"webhook_deliveries\".\"id\""},
+	SubscriptionID: whereHelperstring{field: "\"webhook_deliveries\".\"subscription_id\""},
+	EventType:      whereHelperstring{field: "\"webhook_deliveries\".\"event_type\""},
+	Payload:        whereHelpertypes_JSON{field: "\"webhook_deliveries\".\"payload\""},
+	Status:         whereHelperstring{field: "\"webhook_deliveries\".\"status\""},
+	Attempts:       whereHelperint{field: "\"webhook_deliveries\".\"attempts\""},
+	NextAttemptAt:  whereHelpertime_Time{field: "\"webhook_deliveries\".\"next_attempt_at\""},
+	LastStatusCode: whereHelpernull_Int{field: "\"webhook_deliveries\".\"last_status_code\""},
+	LastError:      whereHelperstring{field: "\"webhook_deliveries\".\"last_error\""},
+	DeliveredAt:    whereHelpernull_Time{field: "\"webhook_deliveries\".\"delivered_at\""},
+	CreatedAt:      whereHelpertime_Time{field: "\"webhook_deliveries\".\"created_at\""},
+	UpdatedAt:      whereHelpertime_Time{field: "\"webhook_deliveries\".\"updated_at\""},
+	RequestID:      whereHelperstring{field: "\"webhook_deliveries\".\"request_id\""},
+}
+
+// WebhookDeliveryRels is where relationship names are stored.
+var WebhookDeliveryRels = struct {
+	Subscription string
+}{
+	Subscription: "Subscription",
+}
+
+// webhookDeliveryR is where relationships are stored.
+type webhookDeliveryR struct {
+	Subscription *WebhookSubscription `boil:"Subscription" json:"Subscription" toml:"Subscription" yaml:"Subscription"`
+}
+
+// NewStruct creates a new relationship struct
+func (*webhookDeliveryR) NewStruct() *webhookDeliveryR {
+	return &webhookDeliveryR{}
+}
+
+func (r *webhookDeliveryR) GetSubscription() *WebhookSubscription {
+	if r == nil {
+		return nil
+	}
+	return r.Subscription
+}
+
+// webhookDeliveryL is where Load methods for each relationship are stored.
+type webhookDeliveryL struct{}
+
+var (
+	webhookDeliveryAllColumns            = []string{"id", "subscription_id", "event_type", "payload", "status", "attempts", "next_attempt_at", "last_status_code", "last_error", "delivered_at", "created_at", "updated_at", "request_id"}
+	webhookDeliveryColumnsWithoutDefault = []string{"subscription_id", "event_type", "payload"}
+	webhookDeliveryColumnsWithDefault    = []string{"id", "status", "attempts", "next_attempt_at", "last_status_code", "last_error", "delivered_at", "created_at", "updated_at", "request_id"}
+	webhookDeliveryPrimaryKeyColumns     = []string{"id"}
+	webhookDeliveryGeneratedColumns      = []string{}
+)
+
+type (
+	// WebhookDeliverySlice is an alias for a slice of pointers to WebhookDelivery.
+	// This should almost always be used instead of []WebhookDelivery.
+	WebhookDeliverySlice []*WebhookDelivery
+	// WebhookDeliveryHook is the signature for custom WebhookDelivery hook methods
+	WebhookDeliveryHook func(context.Context, boil.ContextExecutor, *WebhookDelivery) error
+
+	webhookDeliveryQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	webhookDeliveryType                 = reflect.TypeOf(&WebhookDelivery{})
+	webhookDeliveryMapping              = queries.MakeStructMapping(webhookDeliveryType)
+	webhookDeliveryPrimaryKeyMapping, _ = queries.BindMapping(webhookDeliveryType, webhookDeliveryMapping, webhookDeliveryPrimaryKeyColumns)
+	webhookDeliveryInsertCacheMut       sync.RWMutex
+	webhookDeliveryInsertCache          = make(map[string]insertCache)
+	webhookDeliveryUpdateCacheMut       sync.RWMutex
+	webhookDeliveryUpdateCache          = make(map[string]updateCache)
+	webhookDeliveryUpsertCacheMut       sync.RWMutex
+	webhookDeliveryUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var webhookDeliveryAfterSelectMu sync.Mutex
+var webhookDeliveryAfterSelectHooks []WebhookDeliveryHook
+
+var webhookDeliveryBeforeInsertMu sync.Mutex
+var webhookDeliveryBeforeInsertHooks []WebhookDeliveryHook
+var webhookDeliveryAfterInsertMu sync.Mutex
+var webhookDeliveryAfterInsertHooks []WebhookDeliveryHook
+
+var webhookDeliveryBeforeUpdateMu sync.Mutex
+var webhookDeliveryBeforeUpdateHooks []WebhookDeliveryHook
+var webhookDeliveryAfterUpdateMu sync.Mutex
+var webhookDeliveryAfterUpdateHooks []WebhookDeliveryHook
+
+var webhookDeliveryBeforeDeleteMu sync.Mutex
+var webhookDeliveryBeforeDeleteHooks []WebhookDeliveryHook
+var webhookDeliveryAfterDeleteMu sync.Mutex
+var webhookDeliveryAfterDeleteHooks []WebhookDeliveryHook
+
+var webhookDeliveryBeforeUpsertMu sync.Mutex
+var webhookDeliveryBeforeUpsertHooks []WebhookDeliveryHook
+var webhookDeliveryAfterUpsertMu sync.Mutex
+var webhookDeliveryAfterUpsertHooks []WebhookDeliveryHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *WebhookDelivery) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookDeliveryAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *WebhookDelivery) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookDeliveryBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *WebhookDelivery) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookDeliveryAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *WebhookDelivery) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookDeliveryBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *WebhookDelivery) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookDeliveryAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *WebhookDelivery) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookDeliveryBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *WebhookDelivery) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookDeliveryAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *WebhookDelivery) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookDeliveryBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *WebhookDelivery) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookDeliveryAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddWebhookDeliveryHook registers your hook function for all future operations.
+func AddWebhookDeliveryHook(hookPoint boil.HookPoint, webhookDeliveryHook WebhookDeliveryHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		webhookDeliveryAfterSelectMu.Lock()
+		webhookDeliveryAfterSelectHooks = append(webhookDeliveryAfterSelectHooks, webhookDeliveryHook)
+		webhookDeliveryAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		webhookDeliveryBeforeInsertMu.Lock()
+		webhookDeliveryBeforeInsertHooks = append(webhookDeliveryBeforeInsertHooks, webhookDeliveryHook)
+		webhookDeliveryBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		webhookDeliveryAfterInsertMu.Lock()
+		webhookDeliveryAfterInsertHooks = append(webhookDeliveryAfterInsertHooks, webhookDeliveryHook)
+		webhookDeliveryAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		webhookDeliveryBeforeUpdateMu.Lock()
+		webhookDeliveryBeforeUpdateHooks = append(webhookDeliveryBeforeUpdateHooks, webhookDeliveryHook)
+		webhookDeliveryBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		webhookDeliveryAfterUpdateMu.Lock()
+		webhookDeliveryAfterUpdateHooks = append(webhookDeliveryAfterUpdateHooks, webhookDeliveryHook)
+		webhookDeliveryAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		webhookDeliveryBeforeDeleteMu.Lock()
+		webhookDeliveryBeforeDeleteHooks = append(webhookDeliveryBeforeDeleteHooks, webhookDeliveryHook)
+		webhookDeliveryBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		webhookDeliveryAfterDeleteMu.Lock()
+		webhookDeliveryAfterDeleteHooks = append(webhookDeliveryAfterDeleteHooks, webhookDeliveryHook)
+		webhookDeliveryAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		webhookDeliveryBeforeUpsertMu.Lock()
+		webhookDeliveryBeforeUpsertHooks = append(webhookDeliveryBeforeUpsertHooks, webhookDeliveryHook)
+		webhookDeliveryBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		webhookDeliveryAfterUpsertMu.Lock()
+		webhookDeliveryAfterUpsertHooks = append(webhookDeliveryAfterUpsertHooks, webhookDeliveryHook)
+		webhookDeliveryAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single webhookDelivery record from the query using the global executor.
+func (q webhookDeliveryQuery) OneG(ctx context.Context) (*WebhookDelivery, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single webhookDelivery record from the query.
+func (q webhookDeliveryQuery) One(ctx context.Context, exec boil.ContextExecutor) (*WebhookDelivery, error) {
+	o := &WebhookDelivery{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for webhook_deliveries")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all WebhookDelivery records from the query using the global executor.
+func (q webhookDeliveryQuery) AllG(ctx context.Context) (WebhookDeliverySlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all WebhookDelivery records from the query.
+func (q webhookDeliveryQuery) All(ctx context.Context, exec boil.ContextExecutor) (WebhookDeliverySlice, error) {
+	var o []*WebhookDelivery
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to WebhookDelivery slice")
+	}
+
+	if len(webhookDeliveryAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all WebhookDelivery records in the query using the global executor
+func (q webhookDeliveryQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all WebhookDelivery records in the query.
+func (q webhookDeliveryQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count webhook_deliveries rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q webhookDeliveryQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q webhookDeliveryQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if webhook_deliveries exists")
+	}
+
+	return count > 0, nil
+}
+
+// Subscription pointed to by the foreign key.
+func (o *WebhookDelivery) Subscription(mods ...qm.QueryMod) webhookSubscriptionQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.SubscriptionID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return WebhookSubscriptions(queryMods...)
+}
+
+// LoadSubscription allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (webhookDeliveryL) LoadSubscription(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWebhookDelivery interface{}, mods queries.Applicator) error {
+	var slice []*WebhookDelivery
+	var object *WebhookDelivery
+
+	if singular {
+		var ok bool
+		object, ok = maybeWebhookDelivery.(*WebhookDelivery)
+		if !ok {
+			object = new(WebhookDelivery)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWebhookDelivery)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWebhookDelivery))
+			}
+		}
+	} else {
+		s, ok := maybeWebhookDelivery.(*[]*WebhookDelivery)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWebhookDelivery)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWebhookDelivery))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &webhookDeliveryR{}
+		}
+		args[object.SubscriptionID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &webhookDeliveryR{}
+			}
+
+			args[obj.SubscriptionID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`webhook_subscriptions`),
+		qm.WhereIn(`webhook_subscriptions.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load WebhookSubscription")
+	}
+
+	var resultSlice []*WebhookSubscription
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice WebhookSubscription")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for webhook_subscriptions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for webhook_subscriptions")
+	}
+
+	if len(webhookSubscriptionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Subscription = foreign
+		if foreign.R == nil {
+			foreign.R = &webhookSubscriptionR{}
+		}
+		foreign.R.SubscriptionWebhookDeliveries = append(foreign.R.SubscriptionWebhookDeliveries, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.SubscriptionID == foreign.ID {
+				local.R.Subscription = foreign
+				if foreign.R == nil {
+					foreign.R = &webhookSubscriptionR{}
+				}
+				foreign.R.SubscriptionWebhookDeliveries = append(foreign.R.SubscriptionWebhookDeliveries, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetSubscriptionG of the webhookDelivery to the related item.
+// Sets o.R.Subscription to related.
+// Adds o to related.R.SubscriptionWebhookDeliveries.
+// Uses the global database handle.
+func (o *WebhookDelivery) SetSubscriptionG(ctx context.Context, insert bool, related *WebhookSubscription) error {
+	return o.SetSubscription(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetSubscription of the webhookDelivery to the related item.
+// Sets o.R.Subscription to related.
+// Adds o to related.R.SubscriptionWebhookDeliveries.
+func (o *WebhookDelivery) SetSubscription(ctx context.Context, exec boil.ContextExecutor, insert bool, related *WebhookSubscription) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"webhook_deliveries\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"subscription_id"}),
+		strmangle.WhereClause("\"", "\"", 2, webhookDeliveryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.SubscriptionID = related.ID
+	if o.R == nil {
+		o.R = &webhookDeliveryR{
+			Subscription: related,
+		}
+	} else {
+		o.R.Subscription = related
+	}
+
+	if related.R == nil {
+		related.R = &webhookSubscriptionR{
+			SubscriptionWebhookDeliveries: WebhookDeliverySlice{o},
+		}
+	} else {
+		related.R.SubscriptionWebhookDeliveries = append(related.R.SubscriptionWebhookDeliveries, o)
+	}
+
+	return nil
+}
+
+// WebhookDeliveries retrieves all the records using an executor.
+func WebhookDeliveries(mods ...qm.QueryMod) webhookDeliveryQuery {
+	mods = append(mods, qm.From("\"webhook_deliveries\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"webhook_deliveries\".*"})
+	}
+
+	return webhookDeliveryQuery{q}
+}
+
+// FindWebhookDeliveryG retrieves a single record by ID.
+func FindWebhookDeliveryG(ctx context.Context, iD int64, selectCols ...string) (*WebhookDelivery, error) {
+	return FindWebhookDelivery(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindWebhookDelivery retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindWebhookDelivery(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*WebhookDelivery, error) {
+	webhookDeliveryObj := &WebhookDelivery{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"webhook_deliveries\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, webhookDeliveryObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from webhook_deliveries")
+	}
+
+	if err = webhookDeliveryObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return webhookDeliveryObj, err
+	}
+
+	return webhookDeliveryObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *WebhookDelivery) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *WebhookDelivery) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no webhook_deliveries provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(webhookDeliveryColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	webhookDeliveryInsertCacheMut.RLock()
+	cache, cached := webhookDeliveryInsertCache[key]
+	webhookDeliveryInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			webhookDeliveryAllColumns,
+			webhookDeliveryColumnsWithDefault,
+			webhookDeliveryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(webhookDeliveryType, webhookDeliveryMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(webhookDeliveryType, webhookDeliveryMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"webhook_deliveries\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"webhook_deliveries\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into webhook_deliveries")
+	}
+
+	if !cached {
+		webhookDeliveryInsertCacheMut.Lock()
+		webhookDeliveryInsertCache[key] = cache
+		webhookDeliveryInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single WebhookDelivery record using the global executor.
+// See Update for more documentation.
+func (o *WebhookDelivery) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the WebhookDelivery.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *WebhookDelivery) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	webhookDeliveryUpdateCacheMut.RLock()
+	cache, cached := webhookDeliveryUpdateCache[key]
+	webhookDeliveryUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			webhookDeliveryAllColumns,
+			webhookDeliveryPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update webhook_deliveries, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"webhook_deliveries\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, webhookDeliveryPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(webhookDeliveryType, webhookDeliveryMapping, append(wl, webhookDeliveryPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update webhook_deliveries row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for webhook_deliveries")
+	}
+
+	if !cached {
+		webhookDeliveryUpdateCacheMut.Lock()
+		webhookDeliveryUpdateCache[key] = cache
+		webhookDeliveryUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q webhookDeliveryQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q webhookDeliveryQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for webhook_deliveries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for webhook_deliveries")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o WebhookDeliverySlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o WebhookDeliverySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webhookDeliveryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"webhook_deliveries\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, webhookDeliveryPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in webhookDelivery slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all webhookDelivery")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *WebhookDelivery) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *WebhookDelivery) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no webhook_deliveries provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(webhookDeliveryColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	webhookDeliveryUpsertCacheMut.RLock()
+	cache, cached := webhookDeliveryUpsertCache[key]
+	webhookDeliveryUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			webhookDeliveryAllColumns,
+			webhookDeliveryColumnsWithDefault,
+			webhookDeliveryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			webhookDeliveryAllColumns,
+			webhookDeliveryPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert webhook_deliveries, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(webhookDeliveryAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(webhookDeliveryPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert webhook_deliveries, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(webhookDeliveryPrimaryKeyColumns))
+			copy(conflict, webhookDeliveryPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"webhook_deliveries\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(webhookDeliveryType, webhookDeliveryMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(webhookDeliveryType, webhookDeliveryMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert webhook_deliveries")
+	}
+
+	if !cached {
+		webhookDeliveryUpsertCacheMut.Lock()
+		webhookDeliveryUpsertCache[key] = cache
+		webhookDeliveryUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single WebhookDelivery record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *WebhookDelivery) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single WebhookDelivery record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *WebhookDelivery) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no WebhookDelivery provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), webhookDeliveryPrimaryKeyMapping)
+	sql := "DELETE FROM \"webhook_deliveries\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from webhook_deliveries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for webhook_deliveries")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q webhookDeliveryQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q webhookDeliveryQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no webhookDeliveryQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from webhook_deliveries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for webhook_deliveries")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o WebhookDeliverySlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o WebhookDeliverySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(webhookDeliveryBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webhookDeliveryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"webhook_deliveries\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, webhookDeliveryPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from webhookDelivery slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for webhook_deliveries")
+	}
+
+	if len(webhookDeliveryAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *WebhookDelivery) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no WebhookDelivery provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *WebhookDelivery) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindWebhookDelivery(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WebhookDeliverySlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty WebhookDeliverySlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WebhookDeliverySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := WebhookDeliverySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webhookDeliveryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"webhook_deliveries\".* FROM \"webhook_deliveries\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, webhookDeliveryPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in WebhookDeliverySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// WebhookDeliveryExistsG checks if the WebhookDelivery row exists.
+func WebhookDeliveryExistsG(ctx context.Context, iD int64) (bool, error) {
+	return WebhookDeliveryExists(ctx, boil.GetContextDB(), iD)
+}
+
+// WebhookDeliveryExists checks if the WebhookDelivery row exists.
+func WebhookDeliveryExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"webhook_deliveries\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if webhook_deliveries exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the WebhookDelivery row exists.
+func (o *WebhookDelivery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return WebhookDeliveryExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1308 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// WebhookSubscription is an object representing the database table.
+type WebhookSubscription struct {
+	ID         string            `boil:"id" json:"id" toml:"id" yaml:"id"`
+	URL        string            `boil:"url" json:"url" toml:"url" yaml:"url"`
+	Secret     string            `boil:"secret" json:"secret" toml:"secret" yaml:"secret"`
+	EventTypes types.StringArray `boil:"event_types" json:"event_types" toml:"event_types" yaml:"event_types"`
+	Active     bool              `boil:"active" json:"active" toml:"active" yaml:"active"`
+	CreatedAt  time.Time         `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt  time.Time         `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *webhookSubscriptionR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L webhookSubscriptionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var WebhookSubscriptionColumns = struct {
+	ID         string
+	URL        string
+	Secret     string
+	EventTypes string
+	Active     string
+	CreatedAt  string
+	UpdatedAt  string
+}{
+	ID:         "id",
+	URL:        "url",
+	Secret:     "secret",
+	EventTypes: "event_types",
+	Active:     "active",
+	CreatedAt:  "created_at",
+	UpdatedAt:  "updated_at",
+}
+
+var WebhookSubscriptionTableColumns = struct {
+	ID         string
+	URL        string
+	Secret     string
+	EventTypes string
+	Active     string
+	CreatedAt  string
+	UpdatedAt  string
+}{
+	ID:         "webhook_subscriptions.id",
+	URL:        "webhook_subscriptions.url",
+	Secret:     "webhook_subscriptions.secret",
+	EventTypes: "webhook_subscriptions.event_types",
+	Active:     "webhook_subscriptions.active",
+	CreatedAt:  "webhook_subscriptions.created_at",
+	UpdatedAt:  "webhook_subscriptions.updated_at",
+}
+
+// Generated where
+
+type whereHelpertypes_StringArray struct{ field string }
+
+func (w whereHelpertypes_StringArray) EQ(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertypes_StringArray) NEQ(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertypes_StringArray) LT(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_StringArray) LTE(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_StringArray) GT(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_StringArray) GTE(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+type whereHelperbool struct{ field string }
+
+func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+
+var WebhookSubscriptionWhere = struct {
+	ID         whereHelperstring
+	URL        whereHelperstring
+	Secret     whereHelperstring
+	EventTypes whereHelpertypes_StringArray
+	Active     whereHelperbool
+	CreatedAt  whereHelpertime_Time
+	UpdatedAt  whereHelpertime_Time
+}{
+	ID:         whereHelperstring{field: "\"webhook_subscriptions\".\"id\""},
+	URL:        whereHelperstring{field: "\"webhook_subscriptions\".\"url\""},
+	Secret:     whereHelperstring{field: "\"webhook_subscriptions\".\"secret\""},
+	EventTypes: whereHelpertypes_StringArray{field: "\"webhook_subscriptions\".\"event_types\""},
+	Active:     whereHelperbool{field: "\"webhook_subscriptions\".\"active\""},
+	CreatedAt:  whereHelpertime_Time{field: "\"webhook_subscriptions\".\"created_at\""},
+	UpdatedAt:  whereHelpertime_Time{field: "\"webhook_subscriptions\".\"updated_at\""},
+}
+
+// WebhookSubscriptionRels is where relationship names are stored.
+var WebhookSubscriptionRels = struct {
+	SubscriptionWebhookDeliveries string
+}{
+	SubscriptionWebhookDeliveries: "SubscriptionWebhookDeliveries",
+}
+
+// webhookSubscriptionR is where relationships are stored.
+type webhookSubscriptionR struct {
+	SubscriptionWebhookDeliveries WebhookDeliverySlice `boil:"SubscriptionWebhookDeliveries" json:"SubscriptionWebhookDeliveries" toml:"SubscriptionWebhookDeliveries" yaml:"SubscriptionWebhookDeliveries"`
+}
+
+// NewStruct creates a new relationship struct
+func (*webhookSubscriptionR) NewStruct() *webhookSubscriptionR {
+	return &webhookSubscriptionR{}
+}
+
+func (r *webhookSubscriptionR) GetSubscriptionWebhookDeliveries() WebhookDeliverySlice {
+	if r == nil {
+		return nil
+	}
+	return r.SubscriptionWebhookDeliveries
+}
+
+// webhookSubscriptionL is where Load methods for each relationship are stored.
+type webhookSubscriptionL struct{}
+
+var (
+	webhookSubscriptionAllColumns            = []string{"id", "url", "secret", "event_types", "active", "created_at", "updated_at"}
+	webhookSubscriptionColumnsWithoutDefault = []string{"id", "url", "secret", "event_types"}
+	webhookSubscriptionColumnsWithDefault    = []string{"active", "created_at", "updated_at"}
+	webhookSubscriptionPrimaryKeyColumns     = []string{"id"}
+	webhookSubscriptionGeneratedColumns      = []string{}
+)
+
+type (
+	// WebhookSubscriptionSlice is an alias for a slice of pointers to WebhookSubscription.
+	// This should almost always be used instead of []WebhookSubscription.
+	WebhookSubscriptionSlice []*WebhookSubscription
+	// WebhookSubscriptionHook is the signature for custom WebhookSubscription hook methods
+	WebhookSubscriptionHook func(context.Context, boil.ContextExecutor, *WebhookSubscription) error
+
+	webhookSubscriptionQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	webhookSubscriptionType                 = reflect.TypeOf(&WebhookSubscription{})
+	webhookSubscriptionMapping              = queries.MakeStructMapping(webhookSubscriptionType)
+	webhookSubscriptionPrimaryKeyMapping, _ = queries.BindMapping(webhookSubscriptionType, webhookSubscriptionMapping, webhookSubscriptionPrimaryKeyColumns)
+	webhookSubscriptionInsertCacheMut       sync.RWMutex
+	webhookSubscriptionInsertCache          = make(map[string]insertCache)
+	webhookSubscriptionUpdateCacheMut       sync.RWMutex
+	webhookSubscriptionUpdateCache          = make(map[string]updateCache)
+	webhookSubscriptionUpsertCacheMut       sync.RWMutex
+	webhookSubscriptionUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var webhookSubscriptionAfterSelectMu sync.Mutex
+var webhookSubscriptionAfterSelectHooks []WebhookSubscriptionHook
+
+var webhookSubscriptionBeforeInsertMu sync.Mutex
+var webhookSubscriptionBeforeInsertHooks []WebhookSubscriptionHook
+var webhookSubscriptionAfterInsertMu sync.Mutex
+var webhookSubscriptionAfterInsertHooks []WebhookSubscriptionHook
+
+var webhookSubscriptionBeforeUpdateMu sync.Mutex
+var webhookSubscriptionBeforeUpdateHooks []WebhookSubscriptionHook
+var webhookSubscriptionAfterUpdateMu sync.Mutex
+var webhookSubscriptionAfterUpdateHooks []WebhookSubscriptionHook
+
+var webhookSubscriptionBeforeDeleteMu sync.Mutex
+var webhookSubscriptionBeforeDeleteHooks []WebhookSubscriptionHook
+var webhookSubscriptionAfterDeleteMu sync.Mutex
+var webhookSubscriptionAfterDeleteHooks []WebhookSubscriptionHook
+
+var webhookSubscriptionBeforeUpsertMu sync.Mutex
+var webhookSubscriptionBeforeUpsertHooks []WebhookSubscriptionHook
+var webhookSubscriptionAfterUpsertMu sync.Mutex
+var webhookSubscriptionAfterUpsertHooks []WebhookSubscriptionHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *WebhookSubscription) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookSubscriptionAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *WebhookSubscription) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookSubscriptionBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *WebhookSubscription) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookSubscriptionAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *WebhookSubscription) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookSubscriptionBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *WebhookSubscription) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookSubscriptionAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *WebhookSubscription) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookSubscriptionBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *WebhookSubscription) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookSubscriptionAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *WebhookSubscription) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookSubscriptionBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *WebhookSubscription) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webhookSubscriptionAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddWebhookSubscriptionHook registers your hook function for all future operations.
+func AddWebhookSubscriptionHook(hookPoint boil.HookPoint, webhookSubscriptionHook WebhookSubscriptionHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		webhookSubscriptionAfterSelectMu.Lock()
+		webhookSubscriptionAfterSelectHooks = append(webhookSubscriptionAfterSelectHooks, webhookSubscriptionHook)
+		webhookSubscriptionAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		webhookSubscriptionBeforeInsertMu.Lock()
+		webhookSubscriptionBeforeInsertHooks = append(webhookSubscriptionBeforeInsertHooks, webhookSubscriptionHook)
+		webhookSubscriptionBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		webhookSubscriptionAfterInsertMu.Lock()
+		webhookSubscriptionAfterInsertHooks = append(webhookSubscriptionAfterInsertHooks, webhookSubscriptionHook)
+		webhookSubscriptionAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		webhookSubscriptionBeforeUpdateMu.Lock()
+		webhookSubscriptionBeforeUpdateHooks = append(webhookSubscriptionBeforeUpdateHooks, webhookSubscriptionHook)
+		webhookSubscriptionBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		webhookSubscriptionAfterUpdateMu.Lock()
+		webhookSubscriptionAfterUpdateHooks = append(webhookSubscriptionAfterUpdateHooks, webhookSubscriptionHook)
+		webhookSubscriptionAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		webhookSubscriptionBeforeDeleteMu.Lock()
+		webhookSubscriptionBeforeDeleteHooks = append(webhookSubscriptionBeforeDeleteHooks, webhookSubscriptionHook)
+		webhookSubscriptionBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		webhookSubscriptionAfterDeleteMu.Lock()
+		webhookSubscriptionAfterDeleteHooks = append(webhookSubscriptionAfterDeleteHooks, webhookSubscriptionHook)
+		webhookSubscriptionAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		webhookSubscriptionBeforeUpsertMu.Lock()
+		webhookSubscriptionBeforeUpsertHooks = append(webhookSubscriptionBeforeUpsertHooks, webhookSubscriptionHook)
+		webhookSubscriptionBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		webhookSubscriptionAfterUpsertMu.Lock()
+		webhookSubscriptionAfterUpsertHooks = append(webhookSubscriptionAfterUpsertHooks, webhookSubscriptionHook)
+		webhookSubscriptionAfterUpsertMu.Unlock()
+	}
+}
+
+// OneG returns a single webhookSubscription record from the query using the global executor.
+func (q webhookSubscriptionQuery) OneG(ctx context.Context) (*WebhookSubscription, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single webhookSubscription record from the query.
+func (q webhookSubscriptionQuery) One(ctx context.Context, exec boil.ContextExecutor) (*WebhookSubscription, error) {
+	o := &WebhookSubscription{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for webhook_subscriptions")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all WebhookSubscription records from the query using the global executor.
+func (q webhookSubscriptionQuery) AllG(ctx context.Context) (WebhookSubscriptionSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all WebhookSubscription records from the query.
+func (q webhookSubscriptionQuery) All(ctx context.Context, exec boil.ContextExecutor) (WebhookSubscriptionSlice, error) {
+	var o []*WebhookSubscription
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to WebhookSubscription slice")
+	}
+
+	if len(webhookSubscriptionAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all WebhookSubscription records in the query using the global executor
+func (q webhookSubscriptionQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all WebhookSubscription records in the query.
+func (q webhookSubscriptionQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count webhook_subscriptions rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q webhookSubscriptionQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q webhookSubscriptionQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if webhook_subscriptions exists")
+	}
+
+	return count > 0, nil
+}
+
+// SubscriptionWebhookDeliveries retrieves all the webhook_delivery's WebhookDeliveries with an executor via subscription_id column.
+func (o *WebhookSubscription) SubscriptionWebhookDeliveries(mods ...qm.QueryMod) webhookDeliveryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"webhook_deliveries\".\"subscription_id\"=?", o.ID),
+	)
+
+	return WebhookDeliveries(queryMods...)
+}
+
+// LoadSubscriptionWebhookDeliveries allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (webhookSubscriptionL) LoadSubscriptionWebhookDeliveries(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWebhookSubscription interface{}, mods queries.Applicator) error {
+	var slice []*WebhookSubscription
+	var object *WebhookSubscription
+
+	if singular {
+		var ok bool
+		object, ok = maybeWebhookSubscription.(*WebhookSubscription)
+		if !ok {
+			object = new(WebhookSubscription)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWebhookSubscription)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWebhookSubscription))
+			}
+		}
+	} else {
+		s, ok := maybeWebhookSubscription.(*[]*WebhookSubscription)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWebhookSubscription)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWebhookSubscription))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &webhookSubscriptionR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &webhookSubscriptionR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`webhook_deliveries`),
+		qm.WhereIn(`webhook_deliveries.subscription_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load webhook_deliveries")
+	}
+
+	var resultSlice []*WebhookDelivery
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice webhook_deliveries")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on webhook_deliveries")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for webhook_deliveries")
+	}
+
+	if len(webhookDeliveryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SubscriptionWebhookDeliveries = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &webhookDeliveryR{}
+			}
+			foreign.R.Subscription = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.SubscriptionID {
+				local.R.SubscriptionWebhookDeliveries = append(local.R.SubscriptionWebhookDeliveries, foreign)
+				if foreign.R == nil {
+					foreign.R = &webhookDeliveryR{}
+				}
+				foreign.R.Subscription = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddSubscriptionWebhookDeliveriesG adds the given related objects to the existing relationships
+// of the webhook_subscription, optionally inserting them as new records.
+// Appends related to o.R.SubscriptionWebhookDeliveries.
+// Sets related.R.Subscription appropriately.
+// Uses the global database handle.
+func (o *WebhookSubscription) AddSubscriptionWebhookDeliveriesG(ctx context.Context, insert bool, related ...*WebhookDelivery) error {
+	return o.AddSubscriptionWebhookDeliveries(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddSubscriptionWebhookDeliveries adds the given related objects to the existing relationships
+// of the webhook_subscription, optionally inserting them as new records.
+// Appends related to o.R.SubscriptionWebhookDeliveries.
+// Sets related.R.Subscription appropriately.
+func (o *WebhookSubscription) AddSubscriptionWebhookDeliveries(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WebhookDelivery) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.SubscriptionID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"webhook_deliveries\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"subscription_id"}),
+				strmangle.WhereClause("\"", "\"", 2, webhookDeliveryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.SubscriptionID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &webhookSubscriptionR{
+			SubscriptionWebhookDeliveries: related,
+		}
+	} else {
+		o.R.SubscriptionWebhookDeliveries = append(o.R.SubscriptionWebhookDeliveries, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &webhookDeliveryR{
+				Subscription: o,
+			}
+		} else {
+			rel.R.Subscription = o
+		}
+	}
+	return nil
+}
+
+// WebhookSubscriptions retrieves all the records using an executor.
+func WebhookSubscriptions(mods ...qm.QueryMod) webhookSubscriptionQuery {
+	mods = append(mods, qm.From("\"webhook_subscriptions\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"webhook_subscriptions\".*"})
+	}
+
+	return webhookSubscriptionQuery{q}
+}
+
+// FindWebhookSubscriptionG retrieves a single record by ID.
+func FindWebhookSubscriptionG(ctx context.Context, iD string, selectCols ...string) (*WebhookSubscription, error) {
+	return FindWebhookSubscription(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindWebhookSubscription retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindWebhookSubscription(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*WebhookSubscription, error) {
+	webhookSubscriptionObj := &WebhookSubscription{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"webhook_subscriptions\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, webhookSubscriptionObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from webhook_subscriptions")
+	}
+
+	if err = webhookSubscriptionObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return webhookSubscriptionObj, err
+	}
+
+	return webhookSubscriptionObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *WebhookSubscription) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *WebhookSubscription) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no webhook_subscriptions provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(webhookSubscriptionColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	webhookSubscriptionInsertCacheMut.RLock()
+	cache, cached := webhookSubscriptionInsertCache[key]
+	webhookSubscriptionInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			webhookSubscriptionAllColumns,
+			webhookSubscriptionColumnsWithDefault,
+			webhookSubscriptionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(webhookSubscriptionType, webhookSubscriptionMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(webhookSubscriptionType, webhookSubscriptionMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"webhook_subscriptions\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"webhook_subscriptions\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into webhook_subscriptions")
+	}
+
+	if !cached {
+		webhookSubscriptionInsertCacheMut.Lock()
+		webhookSubscriptionInsertCache[key] = cache
+		webhookSubscriptionInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single WebhookSubscription record using the global executor.
+// See Update for more documentation.
+func (o *WebhookSubscription) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the WebhookSubscription.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *WebhookSubscription) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	webhookSubscriptionUpdateCacheMut.RLock()
+	cache, cached := webhookSubscriptionUpdateCache[key]
+	webhookSubscriptionUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			webhookSubscriptionAllColumns,
+			webhookSubscriptionPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update webhook_subscriptions, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"webhook_subscriptions\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, webhookSubscriptionPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(webhookSubscriptionType, webhookSubscriptionMapping, append(wl, webhookSubscriptionPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update webhook_subscriptions row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for webhook_subscriptions")
+	}
+
+	if !cached {
+		webhookSubscriptionUpdateCacheMut.Lock()
+		webhookSubscriptionUpdateCache[key] = cache
+		webhookSubscriptionUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q webhookSubscriptionQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q webhookSubscriptionQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for webhook_subscriptions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for webhook_subscriptions")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o WebhookSubscriptionSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o WebhookSubscriptionSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webhookSubscriptionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"webhook_subscriptions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, webhookSubscriptionPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in webhookSubscription slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all webhookSubscription")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *WebhookSubscription) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *WebhookSubscription) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no webhook_subscriptions provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(webhookSubscriptionColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	webhookSubscriptionUpsertCacheMut.RLock()
+	cache, cached := webhookSubscriptionUpsertCache[key]
+	webhookSubscriptionUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			webhookSubscriptionAllColumns,
+			webhookSubscriptionColumnsWithDefault,
+			webhookSubscriptionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			webhookSubscriptionAllColumns,
+			webhookSubscriptionPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert webhook_subscriptions, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(webhookSubscriptionAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(webhookSubscriptionPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert webhook_subscriptions, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(webhookSubscriptionPrimaryKeyColumns))
+			copy(conflict, webhookSubscriptionPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"webhook_subscriptions\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(webhookSubscriptionType, webhookSubscriptionMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(webhookSubscriptionType, webhookSubscriptionMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert webhook_subscriptions")
+	}
+
+	if !cached {
+		webhookSubscriptionUpsertCacheMut.Lock()
+		webhookSubscriptionUpsertCache[key] = cache
+		webhookSubscriptionUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single WebhookSubscription record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *WebhookSubscription) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single WebhookSubscription record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *WebhookSubscription) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no WebhookSubscription provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), webhookSubscriptionPrimaryKeyMapping)
+	sql := "DELETE FROM \"webhook_subscriptions\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from webhook_subscriptions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for webhook_subscriptions")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q webhookSubscriptionQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q webhookSubscriptionQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no webhookSubscriptionQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from webhook_subscriptions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for webhook_subscriptions")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o WebhookSubscriptionSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o WebhookSubscriptionSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(webhookSubscriptionBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webhookSubscriptionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"webhook_subscriptions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, webhookSubscriptionPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from webhookSubscription slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for webhook_subscriptions")
+	}
+
+	if len(webhookSubscriptionAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *WebhookSubscription) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no WebhookSubscription provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *WebhookSubscription) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindWebhookSubscription(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WebhookSubscriptionSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty WebhookSubscriptionSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WebhookSubscriptionSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := WebhookSubscriptionSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webhookSubscriptionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"webhook_subscriptions\".* FROM \"webhook_subscriptions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, webhookSubscriptionPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in WebhookSubscriptionSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// WebhookSubscriptionExistsG checks if the WebhookSubscription row exists.
+func WebhookSubscriptionExistsG(ctx context.Context, iD string) (bool, error) {
+	return WebhookSubscriptionExists(ctx, boil.GetContextDB(), iD)
+}
+
+// WebhookSubscriptionExists checks if the WebhookSubscription row exists.
+func WebhookSubscriptionExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"webhook_subscriptions\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if webhook_subscriptions exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the WebhookSubscription row exists.
+func (o *WebhookSubscription) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return WebhookSubscriptionExists(ctx, exec, o.ID)
+}
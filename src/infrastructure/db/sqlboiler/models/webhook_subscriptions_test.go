@@ -0,0 +1,886 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testWebhookSubscriptions(t *testing.T) {
+	t.Parallel()
+
+	query := WebhookSubscriptions()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testWebhookSubscriptionsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookSubscription{}
+	if err = randomize.Struct(seed, o, webhookSubscriptionDBTypes, true, webhookSubscriptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookSubscription struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WebhookSubscriptions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWebhookSubscriptionsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookSubscription{}
+	if err = randomize.Struct(seed, o, webhookSubscriptionDBTypes, true, webhookSubscriptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookSubscription struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := WebhookSubscriptions().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WebhookSubscriptions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWebhookSubscriptionsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookSubscription{}
+	if err = randomize.Struct(seed, o, webhookSubscriptionDBTypes, true, webhookSubscriptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookSubscription struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := WebhookSubscriptionSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WebhookSubscriptions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWebhookSubscriptionsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookSubscription{}
+	if err = randomize.Struct(seed, o, webhookSubscriptionDBTypes, true, webhookSubscriptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookSubscription struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := WebhookSubscriptionExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if WebhookSubscription exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected WebhookSubscriptionExists to return true, but got false.")
+	}
+}
+
+func testWebhookSubscriptionsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookSubscription{}
+	if err = randomize.Struct(seed, o, webhookSubscriptionDBTypes, true, webhookSubscriptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookSubscription struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	webhookSubscriptionFound, err := FindWebhookSubscription(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if webhookSubscriptionFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testWebhookSubscriptionsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookSubscription{}
+	if err = randomize.Struct(seed, o, webhookSubscriptionDBTypes, true, webhookSubscriptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookSubscription struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = WebhookSubscriptions().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWebhookSubscriptionsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookSubscription{}
+	if err = randomize.Struct(seed, o, webhookSubscriptionDBTypes, true, webhookSubscriptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookSubscription struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := WebhookSubscriptions().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testWebhookSubscriptionsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	webhookSubscriptionOne := &WebhookSubscription{}
+	webhookSubscriptionTwo := &WebhookSubscription{}
+	if err = randomize.Struct(seed, webhookSubscriptionOne, webhookSubscriptionDBTypes, false, webhookSubscriptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookSubscription struct: %s", err)
+	}
+	if err = randomize.Struct(seed, webhookSubscriptionTwo, webhookSubscriptionDBTypes, false, webhookSubscriptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookSubscription struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = webhookSubscriptionOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = webhookSubscriptionTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := WebhookSubscriptions().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testWebhookSubscriptionsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	webhookSubscriptionOne := &WebhookSubscription{}
+	webhookSubscriptionTwo := &WebhookSubscription{}
+	if err = randomize.Struct(seed, webhookSubscriptionOne, webhookSubscriptionDBTypes, false, webhookSubscriptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookSubscription struct: %s", err)
+	}
+	if err = randomize.Struct(seed, webhookSubscriptionTwo, webhookSubscriptionDBTypes, false, webhookSubscriptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookSubscription struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = webhookSubscriptionOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = webhookSubscriptionTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WebhookSubscriptions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func webhookSubscriptionBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *WebhookSubscription) error {
+	*o = WebhookSubscription{}
+	return nil
+}
+
+func webhookSubscriptionAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *WebhookSubscription) error {
+	*o = WebhookSubscription{}
+	return nil
+}
+
+func webhookSubscriptionAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *WebhookSubscription) error {
+	*o = WebhookSubscription{}
+	return nil
+}
+
+func webhookSubscriptionBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *WebhookSubscription) error {
+	*o = WebhookSubscription{}
+	return nil
+}
+
+func webhookSubscriptionAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *WebhookSubscription) error {
+	*o = WebhookSubscription{}
+	return nil
+}
+
+func webhookSubscriptionBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *WebhookSubscription) error {
+	*o = WebhookSubscription{}
+	return nil
+}
+
+func webhookSubscriptionAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *WebhookSubscription) error {
+	*o = WebhookSubscription{}
+	return nil
+}
+
+func webhookSubscriptionBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *WebhookSubscription) error {
+	*o = WebhookSubscription{}
+	return nil
+}
+
+func webhookSubscriptionAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *WebhookSubscription) error {
+	*o = WebhookSubscription{}
+	return nil
+}
+
+func testWebhookSubscriptionsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &WebhookSubscription{}
+	o := &WebhookSubscription{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, webhookSubscriptionDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize WebhookSubscription object: %s", err)
+	}
+
+	AddWebhookSubscriptionHook(boil.BeforeInsertHook, webhookSubscriptionBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	webhookSubscriptionBeforeInsertHooks = []WebhookSubscriptionHook{}
+
+	AddWebhookSubscriptionHook(boil.AfterInsertHook, webhookSubscriptionAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	webhookSubscriptionAfterInsertHooks = []WebhookSubscriptionHook{}
+
+	AddWebhookSubscriptionHook(boil.AfterSelectHook, webhookSubscriptionAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	webhookSubscriptionAfterSelectHooks = []WebhookSubscriptionHook{}
+
+	AddWebhookSubscriptionHook(boil.BeforeUpdateHook, webhookSubscriptionBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	webhookSubscriptionBeforeUpdateHooks = []WebhookSubscriptionHook{}
+
+	AddWebhookSubscriptionHook(boil.AfterUpdateHook, webhookSubscriptionAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	webhookSubscriptionAfterUpdateHooks = []WebhookSubscriptionHook{}
+
+	AddWebhookSubscriptionHook(boil.BeforeDeleteHook, webhookSubscriptionBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	webhookSubscriptionBeforeDeleteHooks = []WebhookSubscriptionHook{}
+
+	AddWebhookSubscriptionHook(boil.AfterDeleteHook, webhookSubscriptionAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	webhookSubscriptionAfterDeleteHooks = []WebhookSubscriptionHook{}
+
+	AddWebhookSubscriptionHook(boil.BeforeUpsertHook, webhookSubscriptionBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	webhookSubscriptionBeforeUpsertHooks = []WebhookSubscriptionHook{}
+
+	AddWebhookSubscriptionHook(boil.AfterUpsertHook, webhookSubscriptionAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	webhookSubscriptionAfterUpsertHooks = []WebhookSubscriptionHook{}
+}
+
+func testWebhookSubscriptionsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookSubscription{}
+	if err = randomize.Struct(seed, o, webhookSubscriptionDBTypes, true, webhookSubscriptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookSubscription struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WebhookSubscriptions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testWebhookSubscriptionsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookSubscription{}
+	if err = randomize.Struct(seed, o, webhookSubscriptionDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize WebhookSubscription struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(webhookSubscriptionColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WebhookSubscriptions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testWebhookSubscriptionToManySubscriptionWebhookDeliveries(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a WebhookSubscription
+	var b, c WebhookDelivery
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, webhookSubscriptionDBTypes, true, webhookSubscriptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookSubscription struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, webhookDeliveryDBTypes, false, webhookDeliveryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, webhookDeliveryDBTypes, false, webhookDeliveryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.SubscriptionID = a.ID
+	c.SubscriptionID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.SubscriptionWebhookDeliveries().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.SubscriptionID == b.SubscriptionID {
+			bFound = true
+		}
+		if v.SubscriptionID == c.SubscriptionID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := WebhookSubscriptionSlice{&a}
+	if err = a.L.LoadSubscriptionWebhookDeliveries(ctx, tx, false, (*[]*WebhookSubscription)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SubscriptionWebhookDeliveries); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.SubscriptionWebhookDeliveries = nil
+	if err = a.L.LoadSubscriptionWebhookDeliveries(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SubscriptionWebhookDeliveries); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testWebhookSubscriptionToManyAddOpSubscriptionWebhookDeliveries(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a WebhookSubscription
+	var b, c, d, e WebhookDelivery
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, webhookSubscriptionDBTypes, false, strmangle.SetComplement(webhookSubscriptionPrimaryKeyColumns, webhookSubscriptionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*WebhookDelivery{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, webhookDeliveryDBTypes, false, strmangle.SetComplement(webhookDeliveryPrimaryKeyColumns, webhookDeliveryColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*WebhookDelivery{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddSubscriptionWebhookDeliveries(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.SubscriptionID {
+			t.Error("foreign key was wrong value", a.ID, first.SubscriptionID)
+		}
+		if a.ID != second.SubscriptionID {
+			t.Error("foreign key was wrong value", a.ID, second.SubscriptionID)
+		}
+
+		if first.R.Subscription != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Subscription != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.SubscriptionWebhookDeliveries[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.SubscriptionWebhookDeliveries[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.SubscriptionWebhookDeliveries().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testWebhookSubscriptionsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookSubscription{}
+	if err = randomize.Struct(seed, o, webhookSubscriptionDBTypes, true, webhookSubscriptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookSubscription struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWebhookSubscriptionsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookSubscription{}
+	if err = randomize.Struct(seed, o, webhookSubscriptionDBTypes, true, webhookSubscriptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookSubscription struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := WebhookSubscriptionSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWebhookSubscriptionsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookSubscription{}
+	if err = randomize.Struct(seed, o, webhookSubscriptionDBTypes, true, webhookSubscriptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookSubscription struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := WebhookSubscriptions().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	webhookSubscriptionDBTypes = map[string]string{`ID`: `uuid`, `URL`: `text`, `Secret`: `character varying`, `EventTypes`: `ARRAYtext`, `Active`: `boolean`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`}
+	_                          = bytes.MinRead
+)
+
+func testWebhookSubscriptionsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(webhookSubscriptionPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(webhookSubscriptionAllColumns) == len(webhookSubscriptionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookSubscription{}
+	if err = randomize.Struct(seed, o, webhookSubscriptionDBTypes, true, webhookSubscriptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookSubscription struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WebhookSubscriptions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, webhookSubscriptionDBTypes, true, webhookSubscriptionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize WebhookSubscription struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testWebhookSubscriptionsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(webhookSubscriptionAllColumns) == len(webhookSubscriptionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WebhookSubscription{}
+	if err = randomize.Struct(seed, o, webhookSubscriptionDBTypes, true, webhookSubscriptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WebhookSubscription struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WebhookSubscriptions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, webhookSubscriptionDBTypes, true, webhookSubscriptionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize WebhookSubscription struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(webhookSubscriptionAllColumns, webhookSubscriptionPrimaryKeyColumns) {
+		fields = webhookSubscriptionAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			webhookSubscriptionAllColumns,
+			webhookSubscriptionPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := WebhookSubscriptionSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testWebhookSubscriptionsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(webhookSubscriptionAllColumns) == len(webhookSubscriptionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := WebhookSubscription{}
+	if err = randomize.Struct(seed, &o, webhookSubscriptionDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize WebhookSubscription struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert WebhookSubscription: %s", err)
+	}
+
+	count, err := WebhookSubscriptions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, webhookSubscriptionDBTypes, false, webhookSubscriptionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize WebhookSubscription struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert WebhookSubscription: %s", err)
+	}
+
+	count, err = WebhookSubscriptions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
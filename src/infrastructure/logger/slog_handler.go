@@ -2,14 +2,57 @@ package logger
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"log/slog"
+	"net"
+	"strconv"
 	"sync"
 
+	"go.opentelemetry.io/otel/trace"
+
 	domaincontext "github.com/MizukiShigi/cms-go/internal/domain/context"
 )
 
+// Format はログの出力形式
+type Format string
+
+const (
+	// FormatJSON は slog 標準のJSON形式
+	FormatJSON Format = "json"
+	// FormatCloud は Cloud Logging の構造化ログ形式（severity・トレース・httpRequest）
+	FormatCloud Format = "cloud"
+	// FormatText はローカル確認用の key=value 形式
+	FormatText Format = "text"
+)
+
+// New は出力形式に応じたハンドラーを作成する
+// projectID は Cloud Logging 形式でトレースを紐付けるために使用する
+func New(w io.Writer, format Format, projectID string) (slog.Handler, error) {
+	switch format {
+	case FormatJSON, "":
+		return NewHandler(slog.NewJSONHandler(w, &slog.HandlerOptions{AddSource: true})), nil
+	case FormatCloud:
+		return Handler{
+			handler: slog.NewJSONHandler(w, &slog.HandlerOptions{
+				AddSource:   true,
+				ReplaceAttr: replaceCloudLoggingAttr,
+			}),
+			cloud:     true,
+			projectID: projectID,
+		}, nil
+	case FormatText:
+		return NewHandler(slog.NewTextHandler(w, nil)), nil
+	default:
+		return nil, fmt.Errorf("unknown log format: %s", format)
+	}
+}
+
 type Handler struct {
 	handler slog.Handler
+	// Cloud Logging 形式の場合 true
+	cloud     bool
+	projectID string
 }
 
 func NewHandler(handler slog.Handler) slog.Handler {
@@ -19,14 +62,46 @@ func NewHandler(handler slog.Handler) slog.Handler {
 }
 
 func (h Handler) Handle(ctx context.Context, record slog.Record) error {
+	values := make(map[string]any)
 	if v, ok := ctx.Value(domaincontext.Logging).(*sync.Map); ok {
 		v.Range(func(k, v any) bool {
 			if k, ok := k.(string); ok {
-				record.AddAttrs(slog.Any(k, v))
+				values[k] = v
 			}
 			return true
 		})
 	}
+
+	if h.cloud {
+		// リクエスト完了時のログは httpRequest にまとめ、Cloud Logging でリクエスト単位に表示させる
+		if httpRequest, ok := cloudHTTPRequest(values); ok {
+			record.AddAttrs(httpRequest)
+			for _, key := range httpRequestKeys {
+				delete(values, key)
+			}
+		}
+	}
+	for k, v := range values {
+		record.AddAttrs(slog.Any(k, v))
+	}
+
+	// トレースと紐付けるため、スパンの中で出力したログにはトレースID・スパンIDを付ける
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.IsValid() {
+		if h.cloud {
+			if h.projectID != "" {
+				record.AddAttrs(slog.String("logging.googleapis.com/trace", fmt.Sprintf("projects/%s/traces/%s", h.projectID, spanContext.TraceID())))
+			}
+			record.AddAttrs(
+				slog.String("logging.googleapis.com/spanId", spanContext.SpanID().String()),
+				slog.Bool("logging.googleapis.com/trace_sampled", spanContext.IsSampled()),
+			)
+		} else {
+			record.AddAttrs(
+				slog.String("trace_id", spanContext.TraceID().String()),
+				slog.String("span_id", spanContext.SpanID().String()),
+			)
+		}
+	}
 	return h.handler.Handle(ctx, record)
 }
 
@@ -36,12 +111,94 @@ func (h Handler) Enabled(ctx context.Context, level slog.Level) bool {
 
 func (h Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
 	return Handler{
-		handler: h.handler.WithAttrs(attrs),
+		handler:   h.handler.WithAttrs(attrs),
+		cloud:     h.cloud,
+		projectID: h.projectID,
 	}
 }
 
 func (h Handler) WithGroup(name string) slog.Handler {
 	return Handler{
-		handler: h.handler.WithGroup(name),
+		handler:   h.handler.WithGroup(name),
+		cloud:     h.cloud,
+		projectID: h.projectID,
+	}
+}
+
+// httpRequestKeys は LoggingMiddleware がログコンテキストに設定するリクエスト情報のキー
+var httpRequestKeys = []string{"method", "url", "status_code", "response_size", "duration_ms", "user_agent", "remote_addr"}
+
+// cloudHTTPRequest はリクエスト情報を Cloud Logging の httpRequest 形式に変換する
+// ステータスコードがない（リクエスト完了前の）場合は変換しない
+func cloudHTTPRequest(values map[string]any) (slog.Attr, bool) {
+	status, ok := values["status_code"].(int)
+	if !ok {
+		return slog.Attr{}, false
+	}
+
+	attrs := []any{slog.Int("status", status)}
+	if method, ok := values["method"].(string); ok {
+		attrs = append(attrs, slog.String("requestMethod", method))
+	}
+	if url, ok := values["url"].(string); ok {
+		attrs = append(attrs, slog.String("requestUrl", url))
+	}
+	if size, ok := values["response_size"].(int); ok {
+		attrs = append(attrs, slog.String("responseSize", strconv.Itoa(size)))
+	}
+	if userAgent, ok := values["user_agent"].(string); ok && userAgent != "" {
+		attrs = append(attrs, slog.String("userAgent", userAgent))
+	}
+	if remoteAddr, ok := values["remote_addr"].(string); ok {
+		if host, _, err := net.SplitHostPort(remoteAddr); err == nil {
+			remoteAddr = host
+		}
+		attrs = append(attrs, slog.String("remoteIp", remoteAddr))
+	}
+	if durationMs, ok := values["duration_ms"].(int64); ok {
+		attrs = append(attrs, slog.String("latency", fmt.Sprintf("%.3fs", float64(durationMs)/1000)))
+	}
+	return slog.Group("httpRequest", attrs...), true
+}
+
+// replaceCloudLoggingAttr は slog の標準キーを Cloud Logging の特殊フィールドに変換する
+func replaceCloudLoggingAttr(groups []string, a slog.Attr) slog.Attr {
+	if len(groups) > 0 {
+		return a
+	}
+
+	switch a.Key {
+	case slog.LevelKey:
+		level, _ := a.Value.Any().(slog.Level)
+		return slog.String("severity", cloudSeverity(level))
+	case slog.MessageKey:
+		a.Key = "message"
+	case slog.SourceKey:
+		source, ok := a.Value.Any().(*slog.Source)
+		if !ok {
+			return a
+		}
+		return slog.Group("logging.googleapis.com/sourceLocation",
+			slog.String("file", source.File),
+			slog.String("line", strconv.Itoa(source.Line)),
+			slog.String("function", source.Function),
+		)
+	}
+	return a
+}
+
+// cloudSeverity は slog のレベルを Cloud Logging の severity に変換する
+func cloudSeverity(level slog.Level) string {
+	switch {
+	case level < slog.LevelInfo:
+		return "DEBUG"
+	case level < slog.LevelWarn:
+		return "INFO"
+	case level < slog.LevelError:
+		return "WARNING"
+	case level == slog.LevelError:
+		return "ERROR"
+	default:
+		return "CRITICAL"
 	}
 }
@@ -0,0 +1,164 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel/trace"
+
+	domaincontext "github.com/MizukiShigi/cms-go/internal/domain/context"
+)
+
+func TestNew_CloudFormat(t *testing.T) {
+	traceID, _ := trace.TraceIDFromHex("105445aa7843bc8bf206b12000100000")
+	spanID, _ := trace.SpanIDFromHex("00000000000000ff")
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: spanID, TraceFlags: trace.FlagsSampled})
+
+	log := func(t *testing.T, ctx context.Context, level slog.Level) map[string]any {
+		var buf bytes.Buffer
+		handler, err := New(&buf, FormatCloud, "my-project")
+		if err != nil {
+			t.Fatalf("New() error = %v", err)
+		}
+		slog.New(handler).Log(ctx, level, "request completed")
+
+		var entry map[string]any
+		if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+			t.Fatalf("JSONではありません: %v", err)
+		}
+		return entry
+	}
+
+	t.Run("レベルをseverityに変換する", func(t *testing.T) {
+		tests := []struct {
+			level slog.Level
+			want  string
+		}{
+			{slog.LevelDebug, "DEBUG"},
+			{slog.LevelInfo, "INFO"},
+			{slog.LevelWarn, "WARNING"},
+			{slog.LevelError, "ERROR"},
+			{slog.LevelError + 4, "CRITICAL"},
+		}
+		for _, tt := range tests {
+			if got := cloudSeverity(tt.level); got != tt.want {
+				t.Errorf("cloudSeverity(%v) = %v, want %v", tt.level, got, tt.want)
+			}
+		}
+
+		entry := log(t, context.Background(), slog.LevelWarn)
+		if entry["severity"] != "WARNING" {
+			t.Errorf("severity = %v, want WARNING", entry["severity"])
+		}
+	})
+
+	t.Run("メッセージと呼び出し元を特殊フィールドに出力する", func(t *testing.T) {
+		entry := log(t, context.Background(), slog.LevelInfo)
+
+		if entry["message"] != "request completed" {
+			t.Errorf("message = %v", entry["message"])
+		}
+		source, ok := entry["logging.googleapis.com/sourceLocation"].(map[string]any)
+		if !ok {
+			t.Fatalf("sourceLocation がありません: %v", entry)
+		}
+		if !strings.HasSuffix(source["file"].(string), "slog_handler_test.go") {
+			t.Errorf("file = %v", source["file"])
+		}
+		if _, exists := entry["level"]; exists {
+			t.Errorf("level が残っています")
+		}
+	})
+
+	t.Run("トレースIDをプロジェクト付きで出力する", func(t *testing.T) {
+		ctx := trace.ContextWithSpanContext(context.Background(), spanContext)
+
+		entry := log(t, ctx, slog.LevelInfo)
+
+		if entry["logging.googleapis.com/trace"] != "projects/my-project/traces/105445aa7843bc8bf206b12000100000" {
+			t.Errorf("trace = %v", entry["logging.googleapis.com/trace"])
+		}
+		if entry["logging.googleapis.com/spanId"] != "00000000000000ff" {
+			t.Errorf("spanId = %v", entry["logging.googleapis.com/spanId"])
+		}
+		if entry["logging.googleapis.com/trace_sampled"] != true {
+			t.Errorf("trace_sampled = %v", entry["logging.googleapis.com/trace_sampled"])
+		}
+	})
+
+	t.Run("リクエスト完了時の情報をhttpRequestにまとめる", func(t *testing.T) {
+		ctx := domaincontext.WithValue(context.Background(), "method", "GET")
+		ctx = domaincontext.WithValue(ctx, "url", "/cms/v1/posts")
+		ctx = domaincontext.WithValue(ctx, "remote_addr", "192.0.2.1:54321")
+		ctx = domaincontext.WithValue(ctx, "user_agent", "curl/8.0")
+		ctx = domaincontext.WithValue(ctx, "status_code", 200)
+		ctx = domaincontext.WithValue(ctx, "response_size", 128)
+		ctx = domaincontext.WithValue(ctx, "duration_ms", int64(1234))
+		ctx = domaincontext.WithValue(ctx, "request_id", "req-1")
+
+		entry := log(t, ctx, slog.LevelInfo)
+
+		httpRequest, ok := entry["httpRequest"].(map[string]any)
+		if !ok {
+			t.Fatalf("httpRequest がありません: %v", entry)
+		}
+		want := map[string]any{
+			"requestMethod": "GET",
+			"requestUrl":    "/cms/v1/posts",
+			"status":        float64(200),
+			"responseSize":  "128",
+			"userAgent":     "curl/8.0",
+			"remoteIp":      "192.0.2.1",
+			"latency":       "1.234s",
+		}
+		for key, value := range want {
+			if httpRequest[key] != value {
+				t.Errorf("httpRequest.%s = %v, want %v", key, httpRequest[key], value)
+			}
+		}
+		if _, exists := entry["status_code"]; exists {
+			t.Errorf("status_code が httpRequest とは別に出力されています")
+		}
+		if entry["request_id"] != "req-1" {
+			t.Errorf("request_id = %v", entry["request_id"])
+		}
+	})
+}
+
+func TestNew_JSONFormat(t *testing.T) {
+	traceID, _ := trace.TraceIDFromHex("105445aa7843bc8bf206b12000100000")
+	spanID, _ := trace.SpanIDFromHex("00000000000000ff")
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: spanID}))
+	ctx = domaincontext.WithValue(ctx, "status_code", 200)
+
+	var buf bytes.Buffer
+	handler, err := New(&buf, FormatJSON, "")
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	slog.New(handler).InfoContext(ctx, "request completed")
+
+	var entry map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("JSONではありません: %v", err)
+	}
+	if entry["level"] != "INFO" || entry["msg"] != "request completed" {
+		t.Errorf("slog 標準のキーで出力されていません: %v", entry)
+	}
+	if entry["trace_id"] != "105445aa7843bc8bf206b12000100000" || entry["span_id"] != "00000000000000ff" {
+		t.Errorf("トレースIDが出力されていません: %v", entry)
+	}
+	if entry["status_code"] != float64(200) {
+		t.Errorf("status_code = %v", entry["status_code"])
+	}
+}
+
+func TestNew_UnknownFormat(t *testing.T) {
+	if _, err := New(&bytes.Buffer{}, Format("xml"), ""); err == nil {
+		t.Errorf("不明な形式でエラーが返されていません")
+	}
+}
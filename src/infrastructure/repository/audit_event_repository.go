@@ -0,0 +1,156 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/MizukiShigi/cms-go/infrastructure/db/sqlboiler/models"
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/repository"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type AuditEventRepository struct {
+	db *sql.DB
+}
+
+func NewAuditEventRepository(db *sql.DB) *AuditEventRepository {
+	return &AuditEventRepository{db: db}
+}
+
+// auditChangeJSON は changes カラム（JSONB）の要素
+type auditChangeJSON struct {
+	Field  string `json:"field"`
+	Before any    `json:"before"`
+	After  any    `json:"after"`
+}
+
+func (r *AuditEventRepository) Create(ctx context.Context, event *entity.AuditEvent) error {
+	ctx, span := startSpan(ctx, "AuditEventRepository.Create")
+	defer span.End()
+
+	changes := make([]auditChangeJSON, 0, len(event.Changes))
+	for _, change := range event.Changes {
+		changes = append(changes, auditChangeJSON{Field: change.Field, Before: change.Before, After: change.After})
+	}
+	changesJSON, err := json.Marshal(changes)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to marshal audit changes", "error", err)
+		return valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_create_audit_event")
+	}
+
+	dbEvent := &models.AuditEvent{
+		ActorID:    userIDToNullString(event.ActorID),
+		RequestID:  event.RequestID,
+		EntityType: event.EntityType.String(),
+		EntityID:   event.EntityID,
+		Action:     event.Action.String(),
+		Changes:    changesJSON,
+		CreatedAt:  event.CreatedAt,
+	}
+
+	if err := dbEvent.Insert(ctx, GetExecDB(ctx, r.db), boil.Infer()); err != nil {
+		slog.ErrorContext(ctx, "Failed to create audit event", "error", err)
+		return valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_create_audit_event")
+	}
+
+	event.ID = dbEvent.ID
+	return nil
+}
+
+func (r *AuditEventRepository) List(ctx context.Context, options *repository.ListAuditEventsOptions) ([]*entity.AuditEvent, int, error) {
+	ctx, span := startSpan(ctx, "AuditEventRepository.List")
+	defer span.End()
+
+	execDB := GetExecDB(ctx, r.db)
+
+	var whereMods []qm.QueryMod
+	if options.ActorID != nil {
+		whereMods = append(whereMods, models.AuditEventWhere.ActorID.EQ(null.StringFrom(options.ActorID.String())))
+	}
+	if options.EntityType != nil {
+		whereMods = append(whereMods, models.AuditEventWhere.EntityType.EQ(options.EntityType.String()))
+	}
+	if options.EntityID != "" {
+		whereMods = append(whereMods, models.AuditEventWhere.EntityID.EQ(options.EntityID))
+	}
+	if options.Action != nil {
+		whereMods = append(whereMods, models.AuditEventWhere.Action.EQ(options.Action.String()))
+	}
+	if options.RequestID != "" {
+		whereMods = append(whereMods, models.AuditEventWhere.RequestID.EQ(options.RequestID))
+	}
+	if options.From != nil {
+		whereMods = append(whereMods, models.AuditEventWhere.CreatedAt.GTE(*options.From))
+	}
+	if options.To != nil {
+		whereMods = append(whereMods, models.AuditEventWhere.CreatedAt.LT(*options.To))
+	}
+
+	totalCount, err := models.AuditEvents(whereMods...).Count(ctx, execDB)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to count audit events", "error", err)
+		return nil, 0, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_count_audit_events")
+	}
+
+	queryMods := append([]qm.QueryMod{}, whereMods...)
+	queryMods = append(queryMods,
+		qm.OrderBy("created_at DESC, id DESC"),
+		qm.Limit(options.Limit),
+		qm.Offset(options.Offset),
+	)
+
+	dbEvents, err := models.AuditEvents(queryMods...).All(ctx, execDB)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get audit events", "error", err)
+		return nil, 0, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_audit_events")
+	}
+
+	events := make([]*entity.AuditEvent, 0, len(dbEvents))
+	for _, dbEvent := range dbEvents {
+		event, err := r.convertToEntity(dbEvent)
+		if err != nil {
+			return nil, 0, err
+		}
+		events = append(events, event)
+	}
+
+	return events, int(totalCount), nil
+}
+
+func (r *AuditEventRepository) convertToEntity(dbEvent *models.AuditEvent) (*entity.AuditEvent, error) {
+	var actorID *valueobject.UserID
+	if dbEvent.ActorID.Valid {
+		id, err := valueobject.ParseUserID(dbEvent.ActorID.String)
+		if err != nil {
+			return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_parse_user_id")
+		}
+		actorID = &id
+	}
+
+	entityType, err := valueobject.NewAuditEntityType(dbEvent.EntityType)
+	if err != nil {
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_parse_audit_entity_type")
+	}
+
+	action, err := valueobject.NewAuditAction(dbEvent.Action)
+	if err != nil {
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_parse_audit_action")
+	}
+
+	var changesJSON []auditChangeJSON
+	if err := json.Unmarshal(dbEvent.Changes, &changesJSON); err != nil {
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_parse_audit_changes")
+	}
+	changes := make([]entity.AuditChange, 0, len(changesJSON))
+	for _, change := range changesJSON {
+		changes = append(changes, entity.AuditChange{Field: change.Field, Before: change.Before, After: change.After})
+	}
+
+	return entity.ParseAuditEvent(dbEvent.ID, actorID, dbEvent.RequestID, entityType, dbEvent.EntityID, action, changes, dbEvent.CreatedAt), nil
+}
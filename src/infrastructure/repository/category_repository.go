@@ -0,0 +1,249 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	"github.com/MizukiShigi/cms-go/infrastructure/db/sqlboiler/models"
+	domaincontext "github.com/MizukiShigi/cms-go/internal/domain/context"
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	"github.com/lib/pq"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// categoryTreeLockKey はカテゴリツリーの付け替えを直列化するアドバイザリーロックのキー
+const categoryTreeLockKey int64 = 5_118_402_733
+
+type CategoryRepository struct {
+	db *sql.DB
+}
+
+func NewCategoryRepository(db *sql.DB) *CategoryRepository {
+	return &CategoryRepository{db: db}
+}
+
+func (r *CategoryRepository) Create(ctx context.Context, category *entity.Category) error {
+	ctx, span := startSpan(ctx, "CategoryRepository.Create")
+	defer span.End()
+
+	dbCategory := &models.Category{
+		ID:          category.ID.String(),
+		ParentID:    categoryIDToNullString(category.ParentID),
+		Name:        category.Name.String(),
+		Slug:        category.Slug.String(),
+		Description: category.Description,
+		SortOrder:   category.SortOrder,
+		CreatedAt:   category.CreatedAt,
+		UpdatedAt:   category.UpdatedAt,
+	}
+
+	if err := dbCategory.Insert(ctx, GetExecDB(ctx, r.db), boil.Infer()); err != nil {
+		return r.handleWriteError(ctx, err, "failed_to_create_category")
+	}
+
+	return nil
+}
+
+func (r *CategoryRepository) Get(ctx context.Context, id valueobject.CategoryID) (*entity.Category, error) {
+	ctx, span := startSpan(ctx, "CategoryRepository.Get")
+	defer span.End()
+
+	dbCategory, err := models.FindCategory(ctx, GetExecDB(ctx, r.db), id.String())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, valueobject.NewMyError(valueobject.NotFoundCode, "category_not_found")
+		}
+		errMsg := "Failed to find category"
+		ctx := domaincontext.WithValue(ctx, "error", err.Error())
+		slog.ErrorContext(ctx, errMsg)
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_find_category")
+	}
+
+	return r.convertToEntity(dbCategory)
+}
+
+func (r *CategoryRepository) List(ctx context.Context) ([]*entity.Category, error) {
+	ctx, span := startSpan(ctx, "CategoryRepository.List")
+	defer span.End()
+
+	dbCategories, err := models.Categories(
+		qm.OrderBy("sort_order ASC, name ASC"),
+	).All(ctx, GetExecDB(ctx, r.db))
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get categories", "error", err)
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_categories")
+	}
+
+	categories := make([]*entity.Category, 0, len(dbCategories))
+	for _, dbCategory := range dbCategories {
+		category, err := r.convertToEntity(dbCategory)
+		if err != nil {
+			return nil, err
+		}
+		categories = append(categories, category)
+	}
+
+	return categories, nil
+}
+
+func (r *CategoryRepository) Update(ctx context.Context, category *entity.Category) error {
+	ctx, span := startSpan(ctx, "CategoryRepository.Update")
+	defer span.End()
+
+	dbCategory := &models.Category{
+		ID:          category.ID.String(),
+		ParentID:    categoryIDToNullString(category.ParentID),
+		Name:        category.Name.String(),
+		Slug:        category.Slug.String(),
+		Description: category.Description,
+		SortOrder:   category.SortOrder,
+		UpdatedAt:   category.UpdatedAt,
+	}
+
+	rowsAffected, err := dbCategory.Update(ctx, GetExecDB(ctx, r.db), boil.Whitelist(
+		models.CategoryColumns.ParentID,
+		models.CategoryColumns.Name,
+		models.CategoryColumns.Slug,
+		models.CategoryColumns.Description,
+		models.CategoryColumns.SortOrder,
+		models.CategoryColumns.UpdatedAt,
+	))
+	if err != nil {
+		return r.handleWriteError(ctx, err, "failed_to_update_category")
+	}
+	if rowsAffected == 0 {
+		return valueobject.NewMyError(valueobject.NotFoundCode, "category_not_found")
+	}
+
+	return nil
+}
+
+func (r *CategoryRepository) Delete(ctx context.Context, id valueobject.CategoryID) error {
+	ctx, span := startSpan(ctx, "CategoryRepository.Delete")
+	defer span.End()
+
+	rowsAffected, err := models.Categories(
+		models.CategoryWhere.ID.EQ(id.String()),
+	).DeleteAll(ctx, GetExecDB(ctx, r.db))
+	if err != nil {
+		// 子カテゴリが存在する場合は parent_id の外部キー制約（RESTRICT）で削除できない
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23503" {
+			return valueobject.NewMyError(valueobject.ConflictCode, "category_has_child_categories")
+		}
+		slog.ErrorContext(ctx, "Failed to delete category", "error", err)
+		return valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_delete_category")
+	}
+	if rowsAffected == 0 {
+		return valueobject.NewMyError(valueobject.NotFoundCode, "category_not_found")
+	}
+
+	return nil
+}
+
+func (r *CategoryRepository) GetAncestorIDs(ctx context.Context, id valueobject.CategoryID) ([]valueobject.CategoryID, error) {
+	ctx, span := startSpan(ctx, "CategoryRepository.GetAncestorIDs")
+	defer span.End()
+
+	var rows []struct {
+		ID    string `boil:"id"`
+		Depth int    `boil:"depth"`
+	}
+	// 循環データが混入していても停止するよう深さに上限を設ける
+	err := queries.Raw(`
+		WITH RECURSIVE ancestors AS (
+			SELECT parent_id AS id, 1 AS depth FROM categories WHERE id = $1
+			UNION ALL
+			SELECT c.parent_id, a.depth + 1 FROM categories c
+			INNER JOIN ancestors a ON c.id = a.id
+			WHERE a.depth < 100
+		)
+		SELECT id, depth FROM ancestors WHERE id IS NOT NULL ORDER BY depth ASC`,
+		id.String(),
+	).Bind(ctx, GetExecDB(ctx, r.db), &rows)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get category ancestors", "error", err)
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_category_ancestors")
+	}
+
+	ancestorIDs := make([]valueobject.CategoryID, 0, len(rows))
+	for _, row := range rows {
+		ancestorID, err := valueobject.ParseCategoryID(row.ID)
+		if err != nil {
+			return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_parse_category_id")
+		}
+		ancestorIDs = append(ancestorIDs, ancestorID)
+	}
+
+	return ancestorIDs, nil
+}
+
+func (r *CategoryRepository) LockTree(ctx context.Context) error {
+	ctx, span := startSpan(ctx, "CategoryRepository.LockTree")
+	defer span.End()
+
+	// 同時に付け替えた場合に、それぞれの循環チェックをすり抜けて循環が生じないようにする
+	_, err := queries.Raw("SELECT pg_advisory_xact_lock($1)", categoryTreeLockKey).ExecContext(ctx, GetExecDB(ctx, r.db))
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to lock category tree", "error", err)
+		return valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_lock_category_tree")
+	}
+
+	return nil
+}
+
+func (r *CategoryRepository) handleWriteError(ctx context.Context, err error, key valueobject.MessageKey) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		switch pqErr.Code {
+		case "23505":
+			return valueobject.NewMyError(valueobject.ConflictCode, "category_with_this_slug_already_exists")
+		case "23503":
+			return valueobject.NewMyError(valueobject.InvalidCode, "parent_category_does_not_exist")
+		}
+	}
+	myErr := valueobject.NewMyError(valueobject.InternalServerErrorCode, key)
+	slog.ErrorContext(ctx, myErr.Error(), "error", err)
+	return myErr
+}
+
+func (r *CategoryRepository) convertToEntity(dbCategory *models.Category) (*entity.Category, error) {
+	categoryID, err := valueobject.ParseCategoryID(dbCategory.ID)
+	if err != nil {
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_parse_category_id")
+	}
+
+	var parentID *valueobject.CategoryID
+	if dbCategory.ParentID.Valid {
+		id, err := valueobject.ParseCategoryID(dbCategory.ParentID.String)
+		if err != nil {
+			return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_parse_parent_category_id")
+		}
+		parentID = &id
+	}
+
+	name, err := valueobject.NewCategoryName(dbCategory.Name)
+	if err != nil {
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_parse_category_name")
+	}
+
+	slug, err := valueobject.NewCategorySlug(dbCategory.Slug)
+	if err != nil {
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_parse_category_slug")
+	}
+
+	return entity.ParseCategory(categoryID, parentID, name, slug, dbCategory.Description, dbCategory.SortOrder, dbCategory.CreatedAt, dbCategory.UpdatedAt), nil
+}
+
+func categoryIDToNullString(id *valueobject.CategoryID) null.String {
+	if id == nil {
+		return null.String{}
+	}
+	return null.StringFrom(id.String())
+}
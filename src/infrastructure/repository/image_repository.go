@@ -3,6 +3,8 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"log/slog"
 	"time"
 
 	"github.com/MizukiShigi/cms-go/infrastructure/db/sqlboiler/models"
@@ -21,6 +23,9 @@ func NewImageRepository(db *sql.DB) *ImageRepository {
 }
 
 func (r *ImageRepository) Create(ctx context.Context, image *entity.Image) error {
+	ctx, span := startSpan(ctx, "ImageRepository.Create")
+	defer span.End()
+
 	now := time.Now()
 	dbImage := &models.Image{
 		ID:               image.ID.String(),
@@ -35,8 +40,58 @@ func (r *ImageRepository) Create(ctx context.Context, image *entity.Image) error
 	}
 
 	if err := dbImage.Insert(ctx, GetExecDB(ctx, r.db), boil.Infer()); err != nil {
-		return valueobject.NewMyError(valueobject.InternalServerErrorCode, "Failed to create image")
+		return valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_create_image")
 	}
 
 	return nil
 }
+
+func (r *ImageRepository) Get(ctx context.Context, id valueobject.ImageID) (*entity.Image, error) {
+	ctx, span := startSpan(ctx, "ImageRepository.Get")
+	defer span.End()
+
+	dbImage, err := models.FindImage(ctx, GetExecDB(ctx, r.db), id.String())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, valueobject.NewMyError(valueobject.NotFoundCode, "image_not_found")
+		}
+		slog.ErrorContext(ctx, "Failed to find image", "error", err)
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_find_image")
+	}
+
+	return convertImageToEntity(dbImage)
+}
+
+func convertImageToEntity(dbImage *models.Image) (*entity.Image, error) {
+	imageID, err := valueobject.ParseImageID(dbImage.ID)
+	if err != nil {
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "invalid_image_id")
+	}
+
+	originalFilename, err := valueobject.NewImageFilename(dbImage.OriginalFilename)
+	if err != nil {
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "invalid_image_filename")
+	}
+
+	postID, err := valueobject.ParsePostID(dbImage.PostID)
+	if err != nil {
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "invalid_post_id")
+	}
+
+	userID, err := valueobject.ParseUserID(dbImage.UserID)
+	if err != nil {
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "invalid_user_id")
+	}
+
+	return entity.ParseImage(
+		imageID,
+		originalFilename,
+		dbImage.StoredFilename,
+		dbImage.GCSURL,
+		postID,
+		userID,
+		dbImage.SortOrder.Int,
+		dbImage.CreatedAt,
+		dbImage.UpdatedAt,
+	), nil
+}
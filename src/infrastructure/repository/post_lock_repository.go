@@ -0,0 +1,175 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/infrastructure/db/sqlboiler/models"
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	"github.com/lib/pq"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type PostLockRepository struct {
+	db *sql.DB
+}
+
+func NewPostLockRepository(db *sql.DB) *PostLockRepository {
+	return &PostLockRepository{db: db}
+}
+
+func (r *PostLockRepository) FindActive(ctx context.Context, postID valueobject.PostID, now time.Time) (*entity.PostLock, error) {
+	ctx, span := startSpan(ctx, "PostLockRepository.FindActive")
+	defer span.End()
+
+	dbLock, err := models.PostLocks(
+		models.PostLockWhere.PostID.EQ(postID.String()),
+		models.PostLockWhere.ExpiresAt.GT(now),
+	).One(ctx, GetExecDB(ctx, r.db))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		slog.ErrorContext(ctx, "Failed to get post lock", "error", err)
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_post_lock")
+	}
+
+	return r.convertToEntity(dbLock)
+}
+
+func (r *PostLockRepository) FindActiveForUpdate(ctx context.Context, postID valueobject.PostID, now time.Time) (*entity.PostLock, error) {
+	ctx, span := startSpan(ctx, "PostLockRepository.FindActiveForUpdate")
+	defer span.End()
+
+	// 期限切れの行もロックし、保存が終わるまで他のユーザーが取得し直せないようにする
+	dbLock, err := models.PostLocks(
+		models.PostLockWhere.PostID.EQ(postID.String()),
+		qm.For("UPDATE"),
+	).One(ctx, GetExecDB(ctx, r.db))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		slog.ErrorContext(ctx, "Failed to get post lock", "error", err)
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_post_lock")
+	}
+	if !dbLock.ExpiresAt.After(now) {
+		return nil, nil
+	}
+
+	return r.convertToEntity(dbLock)
+}
+
+func (r *PostLockRepository) Acquire(ctx context.Context, lock *entity.PostLock) error {
+	ctx, span := startSpan(ctx, "PostLockRepository.Acquire")
+	defer span.End()
+
+	var row struct {
+		AcquiredAt time.Time `boil:"acquired_at"`
+	}
+	// 期限切れまたは同じユーザーのロックのみ上書きする。同じユーザーによる再取得では取得日時を維持する
+	// 条件付きの ON CONFLICT DO UPDATE は生成済みモデルの Upsert で表現できないため直接実行する
+	err := queries.Raw(`
+		INSERT INTO post_locks (post_id, user_id, acquired_at, expires_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (post_id) DO UPDATE
+		SET user_id = EXCLUDED.user_id,
+			acquired_at = CASE
+				WHEN post_locks.user_id = EXCLUDED.user_id AND post_locks.expires_at > EXCLUDED.acquired_at
+				THEN post_locks.acquired_at
+				ELSE EXCLUDED.acquired_at
+			END,
+			expires_at = EXCLUDED.expires_at
+		WHERE post_locks.user_id = EXCLUDED.user_id OR post_locks.expires_at <= EXCLUDED.acquired_at
+		RETURNING acquired_at`,
+		lock.PostID.String(),
+		lock.UserID.String(),
+		lock.AcquiredAt,
+		lock.ExpiresAt,
+	).Bind(ctx, GetExecDB(ctx, r.db), &row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return valueobject.NewMyError(valueobject.ConflictCode, "post_is_locked_by_another_user")
+		}
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23503" {
+			return valueobject.NewMyError(valueobject.NotFoundCode, "post_not_found")
+		}
+		slog.ErrorContext(ctx, "Failed to acquire post lock", "error", err)
+		return valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_acquire_post_lock")
+	}
+
+	lock.AcquiredAt = row.AcquiredAt
+	return nil
+}
+
+func (r *PostLockRepository) Extend(ctx context.Context, lock *entity.PostLock) error {
+	ctx, span := startSpan(ctx, "PostLockRepository.Extend")
+	defer span.End()
+
+	rowsAffected, err := models.PostLocks(
+		models.PostLockWhere.PostID.EQ(lock.PostID.String()),
+		models.PostLockWhere.UserID.EQ(lock.UserID.String()),
+		qm.Where("expires_at > now()"),
+	).UpdateAll(ctx, GetExecDB(ctx, r.db), models.M{models.PostLockColumns.ExpiresAt: lock.ExpiresAt})
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to extend post lock", "error", err)
+		return valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_extend_post_lock")
+	}
+
+	if rowsAffected == 0 {
+		return valueobject.NewMyError(valueobject.ConflictCode, "post_lock_is_not_held_by_the_user")
+	}
+
+	return nil
+}
+
+func (r *PostLockRepository) Release(ctx context.Context, postID valueobject.PostID, userID valueobject.UserID) error {
+	ctx, span := startSpan(ctx, "PostLockRepository.Release")
+	defer span.End()
+
+	_, err := models.PostLocks(
+		models.PostLockWhere.PostID.EQ(postID.String()),
+		models.PostLockWhere.UserID.EQ(userID.String()),
+	).DeleteAll(ctx, GetExecDB(ctx, r.db))
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to release post lock", "error", err)
+		return valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_release_post_lock")
+	}
+
+	return nil
+}
+
+func (r *PostLockRepository) ForceRelease(ctx context.Context, postID valueobject.PostID) error {
+	ctx, span := startSpan(ctx, "PostLockRepository.ForceRelease")
+	defer span.End()
+
+	_, err := models.PostLocks(
+		models.PostLockWhere.PostID.EQ(postID.String()),
+	).DeleteAll(ctx, GetExecDB(ctx, r.db))
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to force release post lock", "error", err)
+		return valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_release_post_lock")
+	}
+
+	return nil
+}
+
+func (r *PostLockRepository) convertToEntity(dbLock *models.PostLock) (*entity.PostLock, error) {
+	postID, err := valueobject.ParsePostID(dbLock.PostID)
+	if err != nil {
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_parse_post_id")
+	}
+
+	userID, err := valueobject.ParseUserID(dbLock.UserID)
+	if err != nil {
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_parse_user_id")
+	}
+
+	return entity.ParsePostLock(postID, userID, dbLock.AcquiredAt, dbLock.ExpiresAt), nil
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"log/slog"
 	"time"
 
@@ -11,8 +12,10 @@ import (
 	"github.com/MizukiShigi/cms-go/internal/domain/entity"
 	"github.com/MizukiShigi/cms-go/internal/domain/repository"
 	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	"github.com/lib/pq"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
@@ -25,6 +28,9 @@ func NewPostRepository(db *sql.DB) *PostRepository {
 }
 
 func (r *PostRepository) Create(ctx context.Context, post *entity.Post) error {
+	ctx, span := startSpan(ctx, "PostRepository.Create")
+	defer span.End()
+
 	now := time.Now()
 	dbPost := &models.Post{
 		ID:        post.ID.String(),
@@ -43,59 +49,110 @@ func (r *PostRepository) Create(ctx context.Context, post *entity.Post) error {
 			func(t time.Time) bool { return t.IsZero() },
 			null.TimeFrom,
 		),
+		Version:         post.Version,
+		ReviewerID:      userIDToNullString(post.ReviewerID),
+		Excerpt:         post.Excerpt.String(),
+		MetaDescription: post.MetaDescription.String(),
+		CanonicalURL:    post.CanonicalURL.String(),
+		OgImageID:       ogImageIDToNullString(post.OGImage),
 	}
 
 	if err := dbPost.Insert(ctx, GetExecDB(ctx, r.db), boil.Infer()); err != nil {
 		slog.ErrorContext(ctx, err.Error())
-		return valueobject.NewMyError(valueobject.InternalServerErrorCode, "Failed to create post")
+		return valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_create_post")
 	}
 
 	return nil
 }
 
+func ogImageIDToNullString(image *entity.Image) null.String {
+	if image == nil {
+		return null.String{}
+	}
+	return null.StringFrom(image.ID.String())
+}
+
 func (r *PostRepository) Get(ctx context.Context, id valueobject.PostID) (*entity.Post, error) {
-	dbPost, err := models.Posts(qm.Where("id = ?", id.String()), qm.Load("Tags")).One(ctx, r.db)
+	ctx, span := startSpan(ctx, "PostRepository.Get")
+	defer span.End()
+
+	queryMods := append([]qm.QueryMod{qm.Where("id = ?", id.String())}, postLoadMods()...)
+	dbPost, err := models.Posts(queryMods...).One(ctx, GetExecDB(ctx, r.db))
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, valueobject.NewMyError(valueobject.NotFoundCode, "Post not found")
+			return nil, valueobject.NewMyError(valueobject.NotFoundCode, "post_not_found")
 		}
 		errMsg := "Failed to find post"
 		ctx := domaincontext.WithValue(ctx, "error", err.Error())
 		slog.ErrorContext(ctx, errMsg)
-		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, errMsg)
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_find_post")
 	}
 
 	return r.convertToEntity(dbPost)
 }
 
-func (r *PostRepository) Update(ctx context.Context, post *entity.Post) error {
-	dbPost := &models.Post{
-		ID:      post.ID.String(),
-		Title:   post.Title.String(),
-		Content: post.Content.String(),
-		UserID:  post.UserID.String(),
-		Status:  post.Status.String(),
-		ContentUpdatedAt: ToNullable(
-			post.ContentUpdatedAt,
-			func(t time.Time) bool { return t.IsZero() },
-			null.TimeFrom,
-		),
-		FirstPublishedAt: ToNullable(
-			post.FirstPublishedAt,
-			func(t time.Time) bool { return t.IsZero() },
-			null.TimeFrom,
-		),
+// postLoadMods は投稿の変換に必要な関連（タグ・副カテゴリ・OGP画像）を読み込む
+func postLoadMods() []qm.QueryMod {
+	return []qm.QueryMod{
+		qm.Load(models.PostRels.Tags),
+		qm.Load(models.PostRels.Categories, qm.OrderBy("categories.id")),
+		qm.Load(models.PostRels.OgImage),
 	}
+}
 
-	if _, err := dbPost.Update(ctx, GetExecDB(ctx, r.db), boil.Infer()); err != nil {
+// Update は取得時のバージョンと一致する場合のみ投稿を更新し、バージョンを加算する
+func (r *PostRepository) Update(ctx context.Context, post *entity.Post) error {
+	ctx, span := startSpan(ctx, "PostRepository.Update")
+	defer span.End()
+
+	execDB := GetExecDB(ctx, r.db)
+	now := time.Now()
+
+	// バージョンの確認と更新を1つのUPDATE文で行い、同時更新による上書きを防ぐ
+	rowsAffected, err := models.Posts(
+		models.PostWhere.ID.EQ(post.ID.String()),
+		models.PostWhere.Version.EQ(post.Version),
+	).UpdateAll(ctx, execDB, models.M{
+		models.PostColumns.Title:            post.Title.String(),
+		models.PostColumns.Content:          post.Content.String(),
+		models.PostColumns.Status:           post.Status.String(),
+		models.PostColumns.FirstPublishedAt: ToNullable(post.FirstPublishedAt, func(t time.Time) bool { return t.IsZero() }, null.TimeFrom),
+		models.PostColumns.ContentUpdatedAt: ToNullable(post.ContentUpdatedAt, func(t time.Time) bool { return t.IsZero() }, null.TimeFrom),
+		models.PostColumns.UpdatedAt:        now,
+		models.PostColumns.ReviewerID:       userIDToNullString(post.ReviewerID),
+		models.PostColumns.Excerpt:          post.Excerpt.String(),
+		models.PostColumns.MetaDescription:  post.MetaDescription.String(),
+		models.PostColumns.CanonicalURL:     post.CanonicalURL.String(),
+		models.PostColumns.OgImageID:        ogImageIDToNullString(post.OGImage),
+		models.PostColumns.Version:          post.Version + 1,
+	})
+	if err != nil {
 		slog.ErrorContext(ctx, "Failed to update post", "error", err)
-		return valueobject.NewMyError(valueobject.InternalServerErrorCode, "Failed to update post")
+		return valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_update_post")
 	}
 
+	if rowsAffected == 0 {
+		exists, err := models.PostExists(ctx, execDB, post.ID.String())
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to check post existence", "error", err)
+			return valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_update_post")
+		}
+		if !exists {
+			return valueobject.NewMyError(valueobject.NotFoundCode, "post_not_found")
+		}
+		return valueobject.NewStaleVersionError("post_modified_by_another_request")
+	}
+
+	post.Version++
+	post.UpdatedAt = now
+
 	return nil
 }
 
 func (r *PostRepository) SetTags(ctx context.Context, post *entity.Post, tags []*entity.Tag) error {
+	ctx, span := startSpan(ctx, "PostRepository.SetTags")
+	defer span.End()
+
 	if tags == nil {
 		slog.InfoContext(ctx, "No tags to set")
 		return nil
@@ -113,39 +170,103 @@ func (r *PostRepository) SetTags(ctx context.Context, post *entity.Post, tags []
 	}
 
 	if err := dbPost.SetTags(ctx, GetExecDB(ctx, r.db), false, dbTags...); err != nil {
-		return valueobject.NewMyError(valueobject.InternalServerErrorCode, "Failed to set tags")
+		return valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_set_tags")
 	}
 
 	return nil
 }
 
+// SetCategories は投稿の主カテゴリと副カテゴリを置き換える
+func (r *PostRepository) SetCategories(ctx context.Context, post *entity.Post) error {
+	ctx, span := startSpan(ctx, "PostRepository.SetCategories")
+	defer span.End()
+
+	execDB := GetExecDB(ctx, r.db)
+
+	var primaryCategoryID null.String
+	if post.PrimaryCategoryID != nil {
+		primaryCategoryID = null.StringFrom(post.PrimaryCategoryID.String())
+	}
+
+	if _, err := models.Posts(
+		models.PostWhere.ID.EQ(post.ID.String()),
+	).UpdateAll(ctx, execDB, models.M{models.PostColumns.PrimaryCategoryID: primaryCategoryID}); err != nil {
+		return r.handleCategoryWriteError(ctx, err)
+	}
+
+	dbPost := &models.Post{
+		ID: post.ID.String(),
+	}
+
+	dbCategories := make([]*models.Category, 0, len(post.CategoryIDs))
+	for _, categoryID := range post.CategoryIDs {
+		dbCategories = append(dbCategories, &models.Category{
+			ID: categoryID.String(),
+		})
+	}
+
+	if err := dbPost.SetCategories(ctx, execDB, false, dbCategories...); err != nil {
+		return r.handleCategoryWriteError(ctx, err)
+	}
+
+	return nil
+}
+
+func (r *PostRepository) handleCategoryWriteError(ctx context.Context, err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23503" {
+		return valueobject.NewMyError(valueobject.InvalidCode, "category_does_not_exist")
+	}
+	slog.ErrorContext(ctx, "Failed to set categories", "error", err)
+	return valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_set_categories")
+}
+
 func (r *PostRepository) List(ctx context.Context, options *repository.ListPostsOptions) ([]*entity.Post, int, error) {
-	// カウントクエリ
-	query := models.Posts()
+	ctx, span := startSpan(ctx, "PostRepository.List")
+	defer span.End()
+
+	var whereMods []qm.QueryMod
 
 	// ステータスフィルタ
 	if options.Status != nil {
-		query = models.Posts(
-			models.PostWhere.Status.EQ(options.Status.String()),
-		)
+		whereMods = append(whereMods, models.PostWhere.Status.EQ(options.Status.String()))
 	}
 
-	totalCount, err := query.Count(ctx, r.db)
-	if err != nil {
-		slog.ErrorContext(ctx, "Failed to count posts", "error", err)
-		return nil, 0, valueobject.NewMyError(valueobject.InternalServerErrorCode, "Failed to count posts")
+	// カテゴリフィルタ（子孫カテゴリを含む）
+	if options.CategoryID != nil {
+		categoryTree := `WITH RECURSIVE category_tree AS (
+			SELECT id FROM categories WHERE id = ?
+			UNION ALL
+			SELECT c.id FROM categories c INNER JOIN category_tree t ON c.parent_id = t.id
+		) SELECT id FROM category_tree`
+		whereMods = append(whereMods, qm.Where(
+			"(posts.primary_category_id IN ("+categoryTree+") OR EXISTS (SELECT 1 FROM post_categories pc WHERE pc.post_id = posts.id AND pc.category_id IN ("+categoryTree+")))",
+			options.CategoryID.String(), options.CategoryID.String(),
+		))
 	}
 
-	// データ取得クエリ構築
-	var queryMods []qm.QueryMod
+	// タグフィルタ（TagRepository が保持する正規化キーで比較し、全角半角・大文字小文字の違いを同一視する）
+	if options.TagName != nil {
+		whereMods = append(whereMods, qm.Where(
+			"EXISTS (SELECT 1 FROM post_tags pt INNER JOIN tags t ON t.id = pt.tag_id WHERE pt.post_id = posts.id AND t.name_key = ?)",
+			options.TagName.Key(),
+		))
+	}
 
-	// ステータスフィルタ
-	if options.Status != nil {
-		queryMods = append(queryMods, models.PostWhere.Status.EQ(options.Status.String()))
+	// トランザクション内で呼ばれた場合は同じトランザクションで読み取る
+	execDB := GetExecDB(ctx, r.db)
+
+	// カウントクエリ
+	totalCount, err := models.Posts(whereMods...).Count(ctx, execDB)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to count posts", "error", err)
+		return nil, 0, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_count_posts")
 	}
 
+	// データ取得クエリ構築
+	queryMods := append([]qm.QueryMod{}, whereMods...)
+	queryMods = append(queryMods, postLoadMods()...)
 	queryMods = append(queryMods,
-		qm.Load(models.PostRels.Tags),
 		qm.Limit(options.Limit),
 		qm.Offset(options.Offset),
 	)
@@ -158,14 +279,16 @@ func (r *PostRepository) List(ctx context.Context, options *repository.ListPosts
 		queryMods = append(queryMods, qm.OrderBy("updated_at DESC"))
 	case "updated_at_asc":
 		queryMods = append(queryMods, qm.OrderBy("updated_at ASC"))
+	case "first_published_at_desc":
+		queryMods = append(queryMods, qm.OrderBy("first_published_at DESC NULLS LAST, created_at DESC"))
 	default: // created_at_desc
 		queryMods = append(queryMods, qm.OrderBy("created_at DESC"))
 	}
 
-	dbPosts, err := models.Posts(queryMods...).All(ctx, r.db)
+	dbPosts, err := models.Posts(queryMods...).All(ctx, execDB)
 	if err != nil {
 		slog.ErrorContext(ctx, "Failed to get posts", "error", err)
-		return nil, 0, valueobject.NewMyError(valueobject.InternalServerErrorCode, "Failed to get posts")
+		return nil, 0, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_posts")
 	}
 
 	posts := make([]*entity.Post, 0, len(dbPosts))
@@ -180,30 +303,80 @@ func (r *PostRepository) List(ctx context.Context, options *repository.ListPosts
 	return posts, int(totalCount), nil
 }
 
+type sitemapPostRow struct {
+	ID           string         `boil:"id"`
+	LastModified time.Time      `boil:"last_modified"`
+	Tags         pq.StringArray `boil:"tags"`
+}
+
+func (r *PostRepository) ListSitemapEntries(ctx context.Context) ([]*repository.SitemapPostEntry, error) {
+	ctx, span := startSpan(ctx, "PostRepository.ListSitemapEntries")
+	defer span.End()
+
+	var rows []*sitemapPostRow
+	err := queries.Raw(`
+		SELECT p.id,
+			COALESCE(p.content_updated_at, p.updated_at) AS last_modified,
+			COALESCE(ARRAY_AGG(t.name ORDER BY t.name) FILTER (WHERE t.name IS NOT NULL), '{}') AS tags
+		FROM posts p
+		LEFT JOIN post_tags pt ON pt.post_id = p.id
+		LEFT JOIN tags t ON t.id = pt.tag_id
+		WHERE p.status = $1
+		GROUP BY p.id
+		ORDER BY p.first_published_at, p.id`,
+		valueobject.StatusPublished.String(),
+	).Bind(ctx, GetExecDB(ctx, r.db), &rows)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get sitemap entries", "error", err)
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_sitemap_entries")
+	}
+
+	entries := make([]*repository.SitemapPostEntry, 0, len(rows))
+	for _, row := range rows {
+		postID, err := valueobject.ParsePostID(row.ID)
+		if err != nil {
+			return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "invalid_post_id")
+		}
+
+		tags := make([]valueobject.TagName, 0, len(row.Tags))
+		for _, name := range row.Tags {
+			tags = append(tags, valueobject.TagName(name))
+		}
+
+		entries = append(entries, &repository.SitemapPostEntry{
+			ID:           postID,
+			LastModified: row.LastModified,
+			Tags:         tags,
+		})
+	}
+
+	return entries, nil
+}
+
 func (r *PostRepository) convertToEntity(dbPost *models.Post) (*entity.Post, error) {
 	voPostID, err := valueobject.ParsePostID(dbPost.ID)
 	if err != nil {
-		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "Invalid post ID")
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "invalid_post_id")
 	}
 
 	voUserID, err := valueobject.ParseUserID(dbPost.UserID)
 	if err != nil {
-		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "Invalid user ID")
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "invalid_user_id")
 	}
 
 	voTitle, err := valueobject.NewPostTitle(dbPost.Title)
 	if err != nil {
-		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "Invalid post title")
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "invalid_post_title")
 	}
 
 	voContent, err := valueobject.NewPostContent(dbPost.Content)
 	if err != nil {
-		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "Invalid post content")
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "invalid_post_content")
 	}
 
 	voStatus, err := valueobject.NewPostStatus(dbPost.Status)
 	if err != nil {
-		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "Invalid post status")
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "invalid_post_status")
 	}
 
 	// タグを変換
@@ -213,7 +386,7 @@ func (r *PostRepository) convertToEntity(dbPost *models.Post) (*entity.Post, err
 		for _, dbTag := range dbPost.R.Tags {
 			voTagName, err := valueobject.NewTagName(dbTag.Name)
 			if err != nil {
-				return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "Invalid tag name")
+				return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "invalid_tag_name")
 			}
 			tags = append(tags, voTagName)
 		}
@@ -242,5 +415,51 @@ func (r *PostRepository) convertToEntity(dbPost *models.Post) (*entity.Post, err
 		tags,
 	)
 
+	post.Version = dbPost.Version
+	// 保存済みの値は検証済みのためそのまま設定する
+	post.Excerpt = valueobject.PostExcerpt(dbPost.Excerpt)
+	post.MetaDescription = valueobject.MetaDescription(dbPost.MetaDescription)
+	post.CanonicalURL = valueobject.CanonicalURL(dbPost.CanonicalURL)
+
+	if dbPost.ReviewerID.Valid {
+		reviewerID, err := valueobject.ParseUserID(dbPost.ReviewerID.String)
+		if err != nil {
+			return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "invalid_reviewer_id")
+		}
+		post.ReviewerID = &reviewerID
+	}
+
+	if dbPost.PrimaryCategoryID.Valid {
+		categoryID, err := valueobject.ParseCategoryID(dbPost.PrimaryCategoryID.String)
+		if err != nil {
+			return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "invalid_category_id")
+		}
+		post.PrimaryCategoryID = &categoryID
+	}
+
+	if dbPost.R != nil {
+		for _, dbCategory := range dbPost.R.Categories {
+			categoryID, err := valueobject.ParseCategoryID(dbCategory.ID)
+			if err != nil {
+				return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "invalid_category_id")
+			}
+			post.CategoryIDs = append(post.CategoryIDs, categoryID)
+		}
+
+		if dbPost.R.OgImage != nil {
+			post.OGImage, err = convertImageToEntity(dbPost.R.OgImage)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	return post, nil
 }
+
+func userIDToNullString(id *valueobject.UserID) null.String {
+	if id == nil {
+		return null.String{}
+	}
+	return null.StringFrom(id.String())
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+
+	"github.com/MizukiShigi/cms-go/infrastructure/db/sqlboiler/models"
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type PostWorkflowHistoryRepository struct {
+	db *sql.DB
+}
+
+func NewPostWorkflowHistoryRepository(db *sql.DB) *PostWorkflowHistoryRepository {
+	return &PostWorkflowHistoryRepository{db: db}
+}
+
+func (r *PostWorkflowHistoryRepository) Create(ctx context.Context, history *entity.PostWorkflowHistory) error {
+	ctx, span := startSpan(ctx, "PostWorkflowHistoryRepository.Create")
+	defer span.End()
+
+	dbHistory := &models.PostWorkflowHistory{
+		PostID:     history.PostID.String(),
+		FromStatus: history.FromStatus.String(),
+		ToStatus:   history.ToStatus.String(),
+		ActorID:    history.ActorID.String(),
+		Comment:    history.Comment,
+		CreatedAt:  history.CreatedAt,
+	}
+
+	if err := dbHistory.Insert(ctx, GetExecDB(ctx, r.db), boil.Infer()); err != nil {
+		slog.ErrorContext(ctx, "Failed to create post workflow history", "error", err)
+		return valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_create_post_workflow_history")
+	}
+
+	history.ID = dbHistory.ID
+	return nil
+}
+
+func (r *PostWorkflowHistoryRepository) ListByPostID(ctx context.Context, postID valueobject.PostID) ([]*entity.PostWorkflowHistory, error) {
+	ctx, span := startSpan(ctx, "PostWorkflowHistoryRepository.ListByPostID")
+	defer span.End()
+
+	dbHistories, err := models.PostWorkflowHistories(
+		models.PostWorkflowHistoryWhere.PostID.EQ(postID.String()),
+		qm.OrderBy("created_at ASC, id ASC"),
+	).All(ctx, GetExecDB(ctx, r.db))
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get post workflow histories", "error", err)
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_post_workflow_histories")
+	}
+
+	histories := make([]*entity.PostWorkflowHistory, 0, len(dbHistories))
+	for _, dbHistory := range dbHistories {
+		history, err := r.convertToEntity(dbHistory)
+		if err != nil {
+			return nil, err
+		}
+		histories = append(histories, history)
+	}
+
+	return histories, nil
+}
+
+func (r *PostWorkflowHistoryRepository) convertToEntity(dbHistory *models.PostWorkflowHistory) (*entity.PostWorkflowHistory, error) {
+	postID, err := valueobject.ParsePostID(dbHistory.PostID)
+	if err != nil {
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_parse_post_id")
+	}
+
+	fromStatus, err := valueobject.NewPostStatus(dbHistory.FromStatus)
+	if err != nil {
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_parse_post_status")
+	}
+
+	toStatus, err := valueobject.NewPostStatus(dbHistory.ToStatus)
+	if err != nil {
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_parse_post_status")
+	}
+
+	actorID, err := valueobject.ParseUserID(dbHistory.ActorID)
+	if err != nil {
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_parse_user_id")
+	}
+
+	return entity.ParsePostWorkflowHistory(dbHistory.ID, postID, fromStatus, toStatus, actorID, dbHistory.Comment, dbHistory.CreatedAt), nil
+}
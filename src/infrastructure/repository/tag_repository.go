@@ -3,14 +3,20 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"log/slog"
+	"strings"
 
 	"github.com/MizukiShigi/cms-go/infrastructure/db/sqlboiler/models"
 	domaincontext "github.com/MizukiShigi/cms-go/internal/domain/context"
 	"github.com/MizukiShigi/cms-go/internal/domain/entity"
 	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
 
+	"github.com/MizukiShigi/cms-go/internal/domain/repository"
+	"github.com/lib/pq"
+
 	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
@@ -23,22 +29,26 @@ func NewTagRepository(db *sql.DB) *TagRepository {
 }
 
 func (tr *TagRepository) FindByPostID(ctx context.Context, postID valueobject.PostID) ([]*entity.Tag, error) {
+	ctx, span := startSpan(ctx, "TagRepository.FindByPostID")
+	defer span.End()
+
 	dbTags, err := models.Tags(
-		qm.Where("post_id = ?", postID.String()),
-	).All(ctx, tr.db)
+		qm.InnerJoin("post_tags ON post_tags.tag_id = tags.id"),
+		qm.Where("post_tags.post_id = ?", postID.String()),
+	).All(ctx, GetExecDB(ctx, tr.db))
 	if err != nil {
-		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "Failed to find tags")
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_find_tags")
 	}
 
 	voTags := make([]*entity.Tag, 0, len(dbTags))
 	for _, dbTag := range dbTags {
 		tagID, err := valueobject.ParseTagID(dbTag.ID)
 		if err != nil {
-			return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "Failed to parse tag ID")
+			return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_parse_tag_id")
 		}
 		tagName, err := valueobject.NewTagName(dbTag.Name)
 		if err != nil {
-			return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "Failed to parse tag name")
+			return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_parse_tag_name")
 		}
 		voTags = append(voTags, entity.ParseTag(tagID, tagName, dbTag.CreatedAt, dbTag.UpdatedAt))
 	}
@@ -46,79 +56,235 @@ func (tr *TagRepository) FindByPostID(ctx context.Context, postID valueobject.Po
 }
 
 func (tr *TagRepository) FindOrCreateByName(ctx context.Context, tag *entity.Tag) (*entity.Tag, error) {
-	isInsert := false
+	ctx, span := startSpan(ctx, "TagRepository.FindOrCreateByName")
+	defer span.End()
+
 	execDB := GetExecDB(ctx, tr.db)
+	nameKey := tag.Name.Key()
+
+	// 大文字小文字・全角半角の違いは正規化キーで同一タグとみなす
 	dbTag, err := models.Tags(
-		qm.Where("name = ?", tag.Name.String()),
+		qm.Where("name_key = ?", nameKey),
 	).One(ctx, execDB)
+	if err == nil {
+		// 既に存在する場合は登録不要のため、そのまま返す
+		return tr.convertToEntity(dbTag)
+	}
+	if err != sql.ErrNoRows {
+		errMsg := "Failed to find tag"
+		ctx := domaincontext.WithValue(ctx, "error", err.Error())
+		slog.ErrorContext(ctx, errMsg)
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_find_tag")
+	}
+
+	// 同時に同じキーのタグが作成された場合はそちらを採用する
+	newTag := &models.Tag{
+		ID:        valueobject.NewTagID().String(),
+		Name:      tag.Name.String(),
+		NameKey:   nameKey,
+		CreatedAt: tag.CreatedAt,
+		UpdatedAt: tag.UpdatedAt,
+	}
+	if err := newTag.Upsert(ctx, execDB, false, []string{models.TagColumns.NameKey}, boil.None(), boil.Infer()); err != nil {
+		errMsg := "Failed to create tag"
+		ctx := domaincontext.WithValue(ctx, "error", err.Error())
+		slog.ErrorContext(ctx, errMsg)
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_create_tag")
+	}
+
+	dbTag, err = models.Tags(
+		qm.Where("name_key = ?", nameKey),
+	).One(ctx, execDB)
+	if err != nil {
+		errMsg := "Failed to find tag"
+		ctx := domaincontext.WithValue(ctx, "error", err.Error())
+		slog.ErrorContext(ctx, errMsg)
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_find_tag")
+	}
+
+	return tr.convertToEntity(dbTag)
+}
+
+func (tr *TagRepository) Get(ctx context.Context, id valueobject.TagID) (*entity.Tag, error) {
+	ctx, span := startSpan(ctx, "TagRepository.Get")
+	defer span.End()
+
+	dbTag, err := models.FindTag(ctx, GetExecDB(ctx, tr.db), id.String())
 	if err != nil {
-		if err != sql.ErrNoRows {
-			errMsg := "Failed to find tag"
-			ctx := domaincontext.WithValue(ctx, "error", err.Error())
-			slog.ErrorContext(ctx, errMsg)
-			return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, errMsg)
-		} else {
-			isInsert = true
+		if err == sql.ErrNoRows {
+			return nil, valueobject.NewMyError(valueobject.NotFoundCode, "tag_not_found")
 		}
+		errMsg := "Failed to find tag"
+		ctx := domaincontext.WithValue(ctx, "error", err.Error())
+		slog.ErrorContext(ctx, errMsg)
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_find_tag")
 	}
 
-	// 既に存在する場合は登録不要のため、そのまま返す
-	if !isInsert {
-		tagID, err := valueobject.ParseTagID(dbTag.ID)
+	return tr.convertToEntity(dbTag)
+}
+
+// tagWithPostCount は投稿数付きタグ取得クエリのバインド先
+type tagWithPostCount struct {
+	models.Tag `boil:",bind"`
+	PostCount  int `boil:"post_count"`
+}
+
+func (tr *TagRepository) List(ctx context.Context, options *repository.ListTagsOptions) ([]*repository.TagWithPostCount, int, error) {
+	ctx, span := startSpan(ctx, "TagRepository.List")
+	defer span.End()
+
+	execDB := GetExecDB(ctx, tr.db)
+
+	var whereMods []qm.QueryMod
+	if options.Prefix != "" {
+		prefix := valueobject.NormalizeTagKey(options.Prefix)
+		whereMods = append(whereMods, qm.Where("tags.name_key LIKE ?", escapeLikePattern(prefix)+"%"))
+	}
+
+	totalCount, err := models.Tags(whereMods...).Count(ctx, execDB)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to count tags", "error", err)
+		return nil, 0, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_count_tags")
+	}
+
+	queryMods := []qm.QueryMod{
+		qm.Select("tags.*", "COUNT(post_tags.post_id) AS post_count"),
+		qm.From("tags"),
+		qm.LeftOuterJoin("post_tags ON post_tags.tag_id = tags.id"),
+	}
+	queryMods = append(queryMods, whereMods...)
+	queryMods = append(queryMods,
+		qm.GroupBy("tags.id"),
+		qm.Limit(options.Limit),
+		qm.Offset(options.Offset),
+	)
+
+	// ソート順設定
+	switch options.Sort {
+	case "name_desc":
+		queryMods = append(queryMods, qm.OrderBy("tags.name DESC"))
+	case "post_count_desc":
+		queryMods = append(queryMods, qm.OrderBy("post_count DESC, tags.name ASC"))
+	case "post_count_asc":
+		queryMods = append(queryMods, qm.OrderBy("post_count ASC, tags.name ASC"))
+	default: // name_asc
+		queryMods = append(queryMods, qm.OrderBy("tags.name ASC"))
+	}
+
+	var rows []*tagWithPostCount
+	if err := models.NewQuery(queryMods...).Bind(ctx, execDB, &rows); err != nil {
+		slog.ErrorContext(ctx, "Failed to get tags", "error", err)
+		return nil, 0, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_tags")
+	}
+
+	tags := make([]*repository.TagWithPostCount, 0, len(rows))
+	for _, row := range rows {
+		tag, err := tr.convertToEntity(&row.Tag)
 		if err != nil {
-			errMsg := "Failed to parse tag ID"
-			ctx := domaincontext.WithValue(ctx, "error", err.Error())
-			slog.ErrorContext(ctx, errMsg)
-			return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, errMsg)
+			return nil, 0, err
 		}
+		tags = append(tags, &repository.TagWithPostCount{
+			Tag:       tag,
+			PostCount: row.PostCount,
+		})
+	}
 
-		tagName, err := valueobject.NewTagName(dbTag.Name)
-		if err != nil {
-			errMsg := "Failed to parse tag name"
-			ctx := domaincontext.WithValue(ctx, "error", err.Error())
-			slog.ErrorContext(ctx, errMsg)
-			return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, errMsg)
+	return tags, int(totalCount), nil
+}
+
+func (tr *TagRepository) Update(ctx context.Context, tag *entity.Tag) error {
+	ctx, span := startSpan(ctx, "TagRepository.Update")
+	defer span.End()
+
+	dbTag := &models.Tag{
+		ID:        tag.ID.String(),
+		Name:      tag.Name.String(),
+		NameKey:   tag.Name.Key(),
+		UpdatedAt: tag.UpdatedAt,
+	}
+
+	// 名前と合わせて正規化キーも更新する
+	rowsAffected, err := dbTag.Update(ctx, GetExecDB(ctx, tr.db), boil.Whitelist(
+		models.TagColumns.Name,
+		models.TagColumns.NameKey,
+		models.TagColumns.UpdatedAt,
+	))
+	if err != nil {
+		// PostgreSQLの一意制約違反のエラーをチェック
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return valueobject.NewMyError(valueobject.ConflictCode, "tag_with_this_name_already_exists")
 		}
+		slog.ErrorContext(ctx, "Failed to update tag", "error", err)
+		return valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_update_tag")
+	}
+	if rowsAffected == 0 {
+		return valueobject.NewMyError(valueobject.NotFoundCode, "tag_not_found")
+	}
+
+	return nil
+}
 
-		return &entity.Tag{
-			ID:        tagID,
-			Name:      tagName,
-			CreatedAt: tag.CreatedAt,
-			UpdatedAt: tag.UpdatedAt,
-		}, nil
+// Merge は統合元タグの投稿紐付けを統合先タグへ付け替え、統合元タグを削除する
+func (tr *TagRepository) Merge(ctx context.Context, sourceID valueobject.TagID, targetID valueobject.TagID) error {
+	ctx, span := startSpan(ctx, "TagRepository.Merge")
+	defer span.End()
+
+	execDB := GetExecDB(ctx, tr.db)
+
+	// 統合先タグが既に付いている投稿は主キー重複となるため除外する
+	_, err := queries.Raw(`
+		INSERT INTO post_tags (post_id, tag_id)
+		SELECT post_id, $2 FROM post_tags WHERE tag_id = $1
+		ON CONFLICT (post_id, tag_id) DO NOTHING`,
+		sourceID.String(), targetID.String(),
+	).ExecContext(ctx, execDB)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to move post tags", "error", err)
+		return valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_merge_tags")
 	}
 
-	dbTag = &models.Tag{
-		ID:   valueobject.NewTagID().String(),
-		Name: tag.Name.String(),
+	// post_tags は ON DELETE CASCADE で削除される
+	if _, err := models.Tags(models.TagWhere.ID.EQ(sourceID.String())).DeleteAll(ctx, execDB); err != nil {
+		slog.ErrorContext(ctx, "Failed to delete merged tag", "error", err)
+		return valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_merge_tags")
 	}
-	if err := dbTag.Insert(ctx, execDB, boil.Infer()); err != nil {
-		errMsg := "Failed to create tag"
-		ctx := domaincontext.WithValue(ctx, "error", err.Error())
-		slog.ErrorContext(ctx, errMsg)
-		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, errMsg)
+
+	return nil
+}
+
+// DeleteUnused はどの投稿にも紐付いていないタグを削除し、削除件数を返す
+func (tr *TagRepository) DeleteUnused(ctx context.Context) (int, error) {
+	ctx, span := startSpan(ctx, "TagRepository.DeleteUnused")
+	defer span.End()
+
+	deleted, err := models.Tags(
+		qm.Where("NOT EXISTS (SELECT 1 FROM post_tags WHERE post_tags.tag_id = tags.id)"),
+	).DeleteAll(ctx, GetExecDB(ctx, tr.db))
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to delete unused tags", "error", err)
+		return 0, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_delete_unused_tags")
 	}
 
+	return int(deleted), nil
+}
+
+func (tr *TagRepository) convertToEntity(dbTag *models.Tag) (*entity.Tag, error) {
 	tagID, err := valueobject.ParseTagID(dbTag.ID)
 	if err != nil {
-		errMsg := "Failed to parse tag ID"
-		ctx := domaincontext.WithValue(ctx, "error", err.Error())
-		slog.ErrorContext(ctx, errMsg)
-		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, errMsg)
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_parse_tag_id")
 	}
 
 	tagName, err := valueobject.NewTagName(dbTag.Name)
 	if err != nil {
-		errMsg := "Failed to parse tag name"
-		ctx := domaincontext.WithValue(ctx, "error", err.Error())
-		slog.ErrorContext(ctx, errMsg)
-		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, errMsg)
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_parse_tag_name")
 	}
 
-	return &entity.Tag{
-		ID:        tagID,
-		Name:      tagName,
-		CreatedAt: tag.CreatedAt,
-		UpdatedAt: tag.UpdatedAt,
-	}, nil
+	return entity.ParseTag(tagID, tagName, dbTag.CreatedAt, dbTag.UpdatedAt), nil
+}
+
+// escapeLikePattern はLIKE句のワイルドカード文字をエスケープする
+func escapeLikePattern(s string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return replacer.Replace(s)
 }
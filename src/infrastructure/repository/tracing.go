@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracer はリポジトリのメソッドごとのスパンを作成する
+var tracer = otel.Tracer("github.com/MizukiShigi/cms-go/infrastructure/repository")
+
+// startSpan はDBアクセスのスパンを開始する
+// 記録されないスパン（トレース未設定・サンプリング対象外）の場合は呼び出し元のコンテキストをそのまま使う
+func startSpan(ctx context.Context, name string) (context.Context, trace.Span) {
+	spanCtx, span := tracer.Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attribute.String("db.system", "postgresql")),
+	)
+	if !span.IsRecording() {
+		return ctx, span
+	}
+	return spanCtx, span
+}
@@ -5,28 +5,39 @@ import (
 	"database/sql"
 	"log/slog"
 
+	"go.opentelemetry.io/otel/attribute"
+
 	domaincontext "github.com/MizukiShigi/cms-go/internal/domain/context"
+	"github.com/MizukiShigi/cms-go/internal/domain/service"
 	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
 )
 
 type TransactionManager struct {
-	db *sql.DB
+	db       *sql.DB
+	eventBus service.EventBus
+	metrics  service.Metrics
 }
 
-func NewTransactionManager(db *sql.DB) *TransactionManager {
+func NewTransactionManager(db *sql.DB, eventBus service.EventBus, metrics service.Metrics) *TransactionManager {
 	return &TransactionManager{
-		db: db,
+		db:       db,
+		eventBus: eventBus,
+		metrics:  metrics,
 	}
 }
 
 func (tm *TransactionManager) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	ctx, span := startSpan(ctx, "TransactionManager.Transaction")
+	defer span.End()
+
 	tx, err := tm.db.BeginTx(ctx, nil)
 	if err != nil {
-		return valueobject.NewMyError(valueobject.InternalServerErrorCode, "Failed to begin transaction")
+		return valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_begin_transaction")
 	}
 
 	defer func() {
 		if p := recover(); p != nil {
+			tm.metrics.IncTransactionRollback("panic")
 			err := tx.Rollback()
 			if err != nil {
 				slog.ErrorContext(ctx, "Failed to rollback transaction", "error", err)
@@ -35,9 +46,16 @@ func (tm *TransactionManager) Transaction(ctx context.Context, fn func(ctx conte
 		}
 	}()
 
-	ctxWithTx := context.WithValue(ctx, domaincontext.TransactionDB, tx)
+	// トランザクション中に記録されたドメインイベントはコミット後にまとめて発行する
+	// 外側でイベントを集めている場合はそちらに任せる
+	ownsCollector := domaincontext.GetEventCollector(ctx) == nil
+	ctxWithEvents, collector := domaincontext.WithEventCollector(ctx)
+
+	ctxWithTx := context.WithValue(ctxWithEvents, domaincontext.TransactionDB, tx)
 	slog.InfoContext(ctx, "Transaction is set to context")
 	if err := fn(ctxWithTx); err != nil {
+		tm.metrics.IncTransactionRollback("error")
+		span.SetAttributes(attribute.Bool("db.transaction.rolled_back", true))
 		rollbackErr := tx.Rollback()
 		if rollbackErr != nil {
 			slog.ErrorContext(ctx, "Failed to rollback transaction", "error", rollbackErr)
@@ -47,7 +65,11 @@ func (tm *TransactionManager) Transaction(ctx context.Context, fn func(ctx conte
 
 	if err := tx.Commit(); err != nil {
 		slog.ErrorContext(ctx, "Failed to commit transaction", "error", err)
-		return valueobject.NewMyError(valueobject.InternalServerErrorCode, "Failed to commit transaction")
+		return valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_commit_transaction")
+	}
+
+	if ownsCollector {
+		tm.eventBus.Publish(ctx, collector.Events()...)
 	}
 
 	return nil
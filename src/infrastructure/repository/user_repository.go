@@ -22,6 +22,9 @@ func NewUserRepository(db *sql.DB) *UserRepository {
 }
 
 func (ur *UserRepository) Create(ctx context.Context, user *entity.User) error {
+	ctx, span := startSpan(ctx, "UserRepository.Create")
+	defer span.End()
+
 	now := time.Now()
 	dbUser := &models.User{
 		ID:        user.ID.String(),
@@ -35,31 +38,34 @@ func (ur *UserRepository) Create(ctx context.Context, user *entity.User) error {
 	if err := dbUser.Insert(ctx, ur.db, boil.Infer()); err != nil {
 		// PostgreSQLの一意制約違反のエラーをチェック
 		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
-			return valueobject.NewMyError(valueobject.ConflictCode, "User with this email already exists")
+			return valueobject.NewMyError(valueobject.ConflictCode, "user_with_this_email_already_exists")
 		}
-		return valueobject.NewMyError(valueobject.InternalServerErrorCode, "Failed to save user")
+		return valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_save_user")
 	}
 
 	return nil
 }
 
 func (ur *UserRepository) FindByEmail(ctx context.Context, email valueobject.Email) (*entity.User, error) {
+	ctx, span := startSpan(ctx, "UserRepository.FindByEmail")
+	defer span.End()
+
 	dbUser, err := models.Users(qm.Where(models.UserColumns.Email+" = ?", email.String())).One(ctx, ur.db)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, valueobject.NewMyError(valueobject.NotFoundCode, "User not found")
+			return nil, valueobject.NewMyError(valueobject.NotFoundCode, "user_not_found")
 		}
-		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "Failed to find user")
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_find_user")
 	}
 
 	voUserID, err := valueobject.ParseUserID(dbUser.ID)
 	if err != nil {
-		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "Failed to parse user ID")
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_parse_user_id")
 	}
 
 	voEmail, err := valueobject.NewEmail(dbUser.Email)
 	if err != nil {
-		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "Failed to parse email")
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_parse_email")
 	}
 
 	return &entity.User{
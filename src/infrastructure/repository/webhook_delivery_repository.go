@@ -0,0 +1,209 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/infrastructure/db/sqlboiler/models"
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type WebhookDeliveryRepository struct {
+	db *sql.DB
+}
+
+func NewWebhookDeliveryRepository(db *sql.DB) *WebhookDeliveryRepository {
+	return &WebhookDeliveryRepository{db: db}
+}
+
+func (r *WebhookDeliveryRepository) Create(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	ctx, span := startSpan(ctx, "WebhookDeliveryRepository.Create")
+	defer span.End()
+
+	dbDelivery := &models.WebhookDelivery{
+		SubscriptionID: delivery.SubscriptionID.String(),
+		EventType:      delivery.EventType.String(),
+		Payload:        delivery.Payload,
+		RequestID:      delivery.RequestID,
+		Status:         delivery.Status.String(),
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		LastStatusCode: ToNullable(delivery.LastStatusCode, func(int) bool { return false }, null.IntFrom),
+		LastError:      delivery.LastError,
+		DeliveredAt:    ToNullable(delivery.DeliveredAt, func(t time.Time) bool { return t.IsZero() }, null.TimeFrom),
+		CreatedAt:      delivery.CreatedAt,
+		UpdatedAt:      delivery.UpdatedAt,
+	}
+
+	if err := dbDelivery.Insert(ctx, GetExecDB(ctx, r.db), boil.Infer()); err != nil {
+		slog.ErrorContext(ctx, "Failed to create webhook delivery", "error", err)
+		return valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_create_webhook_delivery")
+	}
+
+	delivery.ID = dbDelivery.ID
+	return nil
+}
+
+func (r *WebhookDeliveryRepository) Get(ctx context.Context, id int64) (*entity.WebhookDelivery, error) {
+	ctx, span := startSpan(ctx, "WebhookDeliveryRepository.Get")
+	defer span.End()
+
+	dbDelivery, err := models.FindWebhookDelivery(ctx, GetExecDB(ctx, r.db), id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, valueobject.NewMyError(valueobject.NotFoundCode, "webhook_delivery_not_found")
+		}
+		slog.ErrorContext(ctx, "Failed to get webhook delivery", "error", err)
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_webhook_delivery")
+	}
+
+	return r.convertToEntity(dbDelivery)
+}
+
+func (r *WebhookDeliveryRepository) Update(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	ctx, span := startSpan(ctx, "WebhookDeliveryRepository.Update")
+	defer span.End()
+
+	_, err := models.WebhookDeliveries(
+		models.WebhookDeliveryWhere.ID.EQ(delivery.ID),
+	).UpdateAll(ctx, GetExecDB(ctx, r.db), models.M{
+		models.WebhookDeliveryColumns.Status:         delivery.Status.String(),
+		models.WebhookDeliveryColumns.Attempts:       delivery.Attempts,
+		models.WebhookDeliveryColumns.NextAttemptAt:  delivery.NextAttemptAt,
+		models.WebhookDeliveryColumns.LastStatusCode: ToNullable(delivery.LastStatusCode, func(int) bool { return false }, null.IntFrom),
+		models.WebhookDeliveryColumns.LastError:      delivery.LastError,
+		models.WebhookDeliveryColumns.DeliveredAt:    ToNullable(delivery.DeliveredAt, func(t time.Time) bool { return t.IsZero() }, null.TimeFrom),
+		models.WebhookDeliveryColumns.UpdatedAt:      delivery.UpdatedAt,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to update webhook delivery", "error", err)
+		return valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_update_webhook_delivery")
+	}
+
+	return nil
+}
+
+func (r *WebhookDeliveryRepository) ClaimDue(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) ([]*entity.WebhookDelivery, error) {
+	ctx, span := startSpan(ctx, "WebhookDeliveryRepository.ClaimDue")
+	defer span.End()
+
+	var dbDeliveries models.WebhookDeliverySlice
+	// 取得と次回試行日時の更新を1文で行い、他のディスパッチャーと重複して取得しない（UPDATE ... RETURNING は生成済みモデルで表現できないため直接実行する）
+	err := queries.Raw(`
+		UPDATE webhook_deliveries
+		SET next_attempt_at = $2
+		WHERE id IN (
+			SELECT id FROM webhook_deliveries
+			WHERE status = 'pending' AND next_attempt_at <= $1
+			ORDER BY next_attempt_at, id
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		now,
+		leaseUntil,
+		limit,
+	).Bind(ctx, GetExecDB(ctx, r.db), &dbDeliveries)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to claim webhook deliveries", "error", err)
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_webhook_deliveries")
+	}
+
+	return r.convertToEntities(dbDeliveries)
+}
+
+func (r *WebhookDeliveryRepository) ListBySubscription(ctx context.Context, subscriptionID valueobject.WebhookSubscriptionID, limit int, offset int) ([]*entity.WebhookDelivery, int, error) {
+	ctx, span := startSpan(ctx, "WebhookDeliveryRepository.ListBySubscription")
+	defer span.End()
+
+	execDB := GetExecDB(ctx, r.db)
+
+	totalCount, err := models.WebhookDeliveries(
+		models.WebhookDeliveryWhere.SubscriptionID.EQ(subscriptionID.String()),
+	).Count(ctx, execDB)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to count webhook deliveries", "error", err)
+		return nil, 0, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_count_webhook_deliveries")
+	}
+
+	dbDeliveries, err := models.WebhookDeliveries(
+		models.WebhookDeliveryWhere.SubscriptionID.EQ(subscriptionID.String()),
+		qm.OrderBy("created_at DESC, id DESC"),
+		qm.Limit(limit),
+		qm.Offset(offset),
+	).All(ctx, execDB)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get webhook deliveries", "error", err)
+		return nil, 0, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_webhook_deliveries")
+	}
+
+	deliveries, err := r.convertToEntities(dbDeliveries)
+	if err != nil {
+		return nil, 0, err
+	}
+	return deliveries, int(totalCount), nil
+}
+
+func (r *WebhookDeliveryRepository) convertToEntities(dbDeliveries models.WebhookDeliverySlice) ([]*entity.WebhookDelivery, error) {
+	deliveries := make([]*entity.WebhookDelivery, 0, len(dbDeliveries))
+	for _, dbDelivery := range dbDeliveries {
+		delivery, err := r.convertToEntity(dbDelivery)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	return deliveries, nil
+}
+
+func (r *WebhookDeliveryRepository) convertToEntity(dbDelivery *models.WebhookDelivery) (*entity.WebhookDelivery, error) {
+	subscriptionID, err := valueobject.ParseWebhookSubscriptionID(dbDelivery.SubscriptionID)
+	if err != nil {
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_parse_webhook_subscription_id")
+	}
+
+	eventType, err := valueobject.NewWebhookEventType(dbDelivery.EventType)
+	if err != nil {
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_parse_webhook_event_type")
+	}
+
+	status, err := valueobject.NewWebhookDeliveryStatus(dbDelivery.Status)
+	if err != nil {
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_parse_webhook_delivery_status")
+	}
+
+	var lastStatusCode *int
+	if dbDelivery.LastStatusCode.Valid {
+		code := dbDelivery.LastStatusCode.Int
+		lastStatusCode = &code
+	}
+
+	var deliveredAt *time.Time
+	if dbDelivery.DeliveredAt.Valid {
+		deliveredAt = &dbDelivery.DeliveredAt.Time
+	}
+
+	return entity.ParseWebhookDelivery(
+		dbDelivery.ID,
+		subscriptionID,
+		eventType,
+		dbDelivery.Payload,
+		dbDelivery.RequestID,
+		status,
+		dbDelivery.Attempts,
+		dbDelivery.NextAttemptAt,
+		lastStatusCode,
+		dbDelivery.LastError,
+		deliveredAt,
+		dbDelivery.CreatedAt,
+		dbDelivery.UpdatedAt,
+	), nil
+}
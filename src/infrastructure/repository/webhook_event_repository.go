@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/infrastructure/db/sqlboiler/models"
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type WebhookEventRepository struct {
+	db *sql.DB
+}
+
+func NewWebhookEventRepository(db *sql.DB) *WebhookEventRepository {
+	return &WebhookEventRepository{db: db}
+}
+
+func (r *WebhookEventRepository) Create(ctx context.Context, event *entity.WebhookEvent) error {
+	ctx, span := startSpan(ctx, "WebhookEventRepository.Create")
+	defer span.End()
+
+	dbEvent := &models.WebhookEvent{
+		EventType: event.EventType.String(),
+		Payload:   event.Payload,
+		RequestID: event.RequestID,
+		CreatedAt: event.CreatedAt,
+	}
+
+	if err := dbEvent.Insert(ctx, GetExecDB(ctx, r.db), boil.Infer()); err != nil {
+		slog.ErrorContext(ctx, "Failed to create webhook event", "error", err)
+		return valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_create_webhook_event")
+	}
+
+	event.ID = dbEvent.ID
+	return nil
+}
+
+func (r *WebhookEventRepository) ListUndispatched(ctx context.Context, limit int) ([]*entity.WebhookEvent, error) {
+	ctx, span := startSpan(ctx, "WebhookEventRepository.ListUndispatched")
+	defer span.End()
+
+	// 複数のディスパッチャーが同じイベントを展開しないように行ロックを取得する
+	dbEvents, err := models.WebhookEvents(
+		models.WebhookEventWhere.DispatchedAt.IsNull(),
+		qm.OrderBy("id"),
+		qm.Limit(limit),
+		qm.For("UPDATE SKIP LOCKED"),
+	).All(ctx, GetExecDB(ctx, r.db))
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get webhook events", "error", err)
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_webhook_events")
+	}
+
+	events := make([]*entity.WebhookEvent, 0, len(dbEvents))
+	for _, dbEvent := range dbEvents {
+		eventType, err := valueobject.NewWebhookEventType(dbEvent.EventType)
+		if err != nil {
+			return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_parse_webhook_event_type")
+		}
+		events = append(events, entity.ParseWebhookEvent(dbEvent.ID, eventType, dbEvent.Payload, dbEvent.RequestID, dbEvent.CreatedAt))
+	}
+
+	return events, nil
+}
+
+func (r *WebhookEventRepository) MarkDispatched(ctx context.Context, id int64, dispatchedAt time.Time) error {
+	ctx, span := startSpan(ctx, "WebhookEventRepository.MarkDispatched")
+	defer span.End()
+
+	_, err := models.WebhookEvents(
+		models.WebhookEventWhere.ID.EQ(id),
+	).UpdateAll(ctx, GetExecDB(ctx, r.db), models.M{models.WebhookEventColumns.DispatchedAt: dispatchedAt})
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to mark webhook event as dispatched", "error", err)
+		return valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_update_webhook_event")
+	}
+
+	return nil
+}
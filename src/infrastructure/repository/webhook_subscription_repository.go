@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	"github.com/MizukiShigi/cms-go/infrastructure/db/sqlboiler/models"
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type WebhookSubscriptionRepository struct {
+	db *sql.DB
+}
+
+func NewWebhookSubscriptionRepository(db *sql.DB) *WebhookSubscriptionRepository {
+	return &WebhookSubscriptionRepository{db: db}
+}
+
+func (r *WebhookSubscriptionRepository) Create(ctx context.Context, subscription *entity.WebhookSubscription) error {
+	ctx, span := startSpan(ctx, "WebhookSubscriptionRepository.Create")
+	defer span.End()
+
+	eventTypes := make([]string, 0, len(subscription.EventTypes))
+	for _, eventType := range subscription.EventTypes {
+		eventTypes = append(eventTypes, eventType.String())
+	}
+
+	dbSubscription := &models.WebhookSubscription{
+		ID:         subscription.ID.String(),
+		URL:        subscription.URL,
+		Secret:     subscription.Secret,
+		EventTypes: eventTypes,
+		Active:     subscription.Active,
+		CreatedAt:  subscription.CreatedAt,
+		UpdatedAt:  subscription.UpdatedAt,
+	}
+
+	// active はデフォルト値が true のため、false を指定した場合も明示的に保存する
+	if err := dbSubscription.Insert(ctx, GetExecDB(ctx, r.db), boil.Greylist(models.WebhookSubscriptionColumns.Active)); err != nil {
+		slog.ErrorContext(ctx, "Failed to create webhook subscription", "error", err)
+		return valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_create_webhook_subscription")
+	}
+
+	return nil
+}
+
+func (r *WebhookSubscriptionRepository) Get(ctx context.Context, id valueobject.WebhookSubscriptionID) (*entity.WebhookSubscription, error) {
+	ctx, span := startSpan(ctx, "WebhookSubscriptionRepository.Get")
+	defer span.End()
+
+	dbSubscription, err := models.FindWebhookSubscription(ctx, GetExecDB(ctx, r.db), id.String())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, valueobject.NewMyError(valueobject.NotFoundCode, "webhook_subscription_not_found")
+		}
+		slog.ErrorContext(ctx, "Failed to get webhook subscription", "error", err)
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_webhook_subscription")
+	}
+
+	return r.convertToEntity(dbSubscription)
+}
+
+func (r *WebhookSubscriptionRepository) List(ctx context.Context) ([]*entity.WebhookSubscription, error) {
+	ctx, span := startSpan(ctx, "WebhookSubscriptionRepository.List")
+	defer span.End()
+
+	dbSubscriptions, err := models.WebhookSubscriptions(
+		qm.OrderBy("created_at, id"),
+	).All(ctx, GetExecDB(ctx, r.db))
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get webhook subscriptions", "error", err)
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_webhook_subscriptions")
+	}
+
+	return r.convertToEntities(dbSubscriptions)
+}
+
+func (r *WebhookSubscriptionRepository) ListByEventType(ctx context.Context, eventType valueobject.WebhookEventType) ([]*entity.WebhookSubscription, error) {
+	ctx, span := startSpan(ctx, "WebhookSubscriptionRepository.ListByEventType")
+	defer span.End()
+
+	dbSubscriptions, err := models.WebhookSubscriptions(
+		models.WebhookSubscriptionWhere.Active.EQ(true),
+		qm.Where("? = ANY(event_types)", eventType.String()),
+		qm.OrderBy("created_at, id"),
+	).All(ctx, GetExecDB(ctx, r.db))
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get webhook subscriptions", "error", err)
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_webhook_subscriptions")
+	}
+
+	return r.convertToEntities(dbSubscriptions)
+}
+
+func (r *WebhookSubscriptionRepository) Delete(ctx context.Context, id valueobject.WebhookSubscriptionID) error {
+	ctx, span := startSpan(ctx, "WebhookSubscriptionRepository.Delete")
+	defer span.End()
+
+	rowsAffected, err := models.WebhookSubscriptions(
+		models.WebhookSubscriptionWhere.ID.EQ(id.String()),
+	).DeleteAll(ctx, GetExecDB(ctx, r.db))
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to delete webhook subscription", "error", err)
+		return valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_delete_webhook_subscription")
+	}
+
+	if rowsAffected == 0 {
+		return valueobject.NewMyError(valueobject.NotFoundCode, "webhook_subscription_not_found")
+	}
+
+	return nil
+}
+
+func (r *WebhookSubscriptionRepository) convertToEntities(dbSubscriptions models.WebhookSubscriptionSlice) ([]*entity.WebhookSubscription, error) {
+	subscriptions := make([]*entity.WebhookSubscription, 0, len(dbSubscriptions))
+	for _, dbSubscription := range dbSubscriptions {
+		subscription, err := r.convertToEntity(dbSubscription)
+		if err != nil {
+			return nil, err
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+	return subscriptions, nil
+}
+
+func (r *WebhookSubscriptionRepository) convertToEntity(dbSubscription *models.WebhookSubscription) (*entity.WebhookSubscription, error) {
+	id, err := valueobject.ParseWebhookSubscriptionID(dbSubscription.ID)
+	if err != nil {
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_parse_webhook_subscription_id")
+	}
+
+	eventTypes := make([]valueobject.WebhookEventType, 0, len(dbSubscription.EventTypes))
+	for _, value := range dbSubscription.EventTypes {
+		eventType, err := valueobject.NewWebhookEventType(value)
+		if err != nil {
+			return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_parse_webhook_event_type")
+		}
+		eventTypes = append(eventTypes, eventType)
+	}
+
+	return entity.ParseWebhookSubscription(id, dbSubscription.URL, dbSubscription.Secret, eventTypes, dbSubscription.Active, dbSubscription.CreatedAt, dbSubscription.UpdatedAt), nil
+}
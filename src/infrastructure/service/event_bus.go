@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/event"
+)
+
+// EventBus はプロセス内でドメインイベントをハンドラーに配信する
+type EventBus struct {
+	mu       sync.RWMutex
+	handlers map[string][]event.Handler
+	// true の場合は Publish の呼び出し元でハンドラーを実行する（テスト用）
+	sync bool
+	wg   sync.WaitGroup
+}
+
+// NewEventBus はハンドラーを非同期に実行するイベントバスを作成する
+func NewEventBus() *EventBus {
+	return &EventBus{handlers: make(map[string][]event.Handler)}
+}
+
+// NewSyncEventBus は Publish の中でハンドラーを順に実行するイベントバスを作成する
+func NewSyncEventBus() *EventBus {
+	return &EventBus{handlers: make(map[string][]event.Handler), sync: true}
+}
+
+func (b *EventBus) Subscribe(eventName string, handler event.Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.handlers[eventName] = append(b.handlers[eventName], handler)
+}
+
+// Publish はイベントを発生順にハンドラーへ配信する。ハンドラーのエラーはログに記録して続行する
+func (b *EventBus) Publish(ctx context.Context, events ...event.Event) {
+	if len(events) == 0 {
+		return
+	}
+
+	if b.sync {
+		b.dispatch(ctx, events)
+		return
+	}
+
+	// リクエストの終了でハンドラーが中断されないようにキャンセルを引き継がない
+	ctx = context.WithoutCancel(ctx)
+	b.wg.Add(1)
+	go func() {
+		defer b.wg.Done()
+		b.dispatch(ctx, events)
+	}()
+}
+
+// Wait は実行中のハンドラーが終わるまで待つ
+func (b *EventBus) Wait() {
+	b.wg.Wait()
+}
+
+func (b *EventBus) dispatch(ctx context.Context, events []event.Event) {
+	for _, e := range events {
+		b.mu.RLock()
+		handlers := b.handlers[e.EventName()]
+		b.mu.RUnlock()
+
+		for _, handler := range handlers {
+			if err := b.handle(ctx, handler, e); err != nil {
+				slog.ErrorContext(ctx, "Failed to handle domain event", "event", e.EventName(), "error", err)
+			}
+		}
+	}
+}
+
+// handle はハンドラーのパニックをエラーに変換し、他のハンドラーの実行を妨げないようにする
+func (b *EventBus) handle(ctx context.Context, handler event.Handler, e event.Event) (err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("panic: %v", p)
+		}
+	}()
+
+	return handler(ctx, e)
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/event"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventBus_Publish(t *testing.T) {
+	postID := valueobject.NewPostID()
+	created := event.PostCreated{PostID: postID, Status: valueobject.StatusDraft, At: time.Now()}
+	published := event.PostPublished{PostID: postID, At: time.Now()}
+
+	t.Run("同期バスは登録されたハンドラーにイベント名ごとに配信する", func(t *testing.T) {
+		bus := NewSyncEventBus()
+
+		var received []string
+		bus.Subscribe(event.PostCreatedName, func(ctx context.Context, e event.Event) error {
+			received = append(received, "created:"+e.(event.PostCreated).PostID.String())
+			return nil
+		})
+		bus.Subscribe(event.PostPublishedName, func(ctx context.Context, e event.Event) error {
+			received = append(received, "published")
+			return nil
+		})
+
+		bus.Publish(context.Background(), created, published, event.ImageUploaded{At: time.Now()})
+
+		assert.Equal(t, []string{"created:" + postID.String(), "published"}, received)
+	})
+
+	t.Run("ハンドラーのエラーやパニックは後続のハンドラーの実行を妨げない", func(t *testing.T) {
+		bus := NewSyncEventBus()
+
+		calls := 0
+		bus.Subscribe(event.PostCreatedName, func(ctx context.Context, e event.Event) error {
+			calls++
+			return errors.New("handler error")
+		})
+		bus.Subscribe(event.PostCreatedName, func(ctx context.Context, e event.Event) error {
+			calls++
+			panic("handler panic")
+		})
+		bus.Subscribe(event.PostCreatedName, func(ctx context.Context, e event.Event) error {
+			calls++
+			return nil
+		})
+
+		assert.NotPanics(t, func() {
+			bus.Publish(context.Background(), created)
+		})
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("非同期バスはリクエストのキャンセル後もハンドラーを実行する", func(t *testing.T) {
+		bus := NewEventBus()
+
+		var mu sync.Mutex
+		var ctxErr error
+		received := 0
+		bus.Subscribe(event.PostPublishedName, func(ctx context.Context, e event.Event) error {
+			mu.Lock()
+			defer mu.Unlock()
+			received++
+			ctxErr = ctx.Err()
+			return nil
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		bus.Publish(ctx, published)
+		cancel()
+		bus.Wait()
+
+		assert.Equal(t, 1, received)
+		assert.NoError(t, ctxErr)
+	})
+}
@@ -10,36 +10,68 @@ import (
 	"time"
 
 	"cloud.google.com/go/storage"
+	domaincontext "github.com/MizukiShigi/cms-go/internal/domain/context"
 	domainservice "github.com/MizukiShigi/cms-go/internal/domain/service"
 	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
 	"github.com/google/uuid"
+	"github.com/googleapis/gax-go/v2/callctx"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
+// storageTracer はストレージ操作のスパンを作成する
+var storageTracer = otel.Tracer("github.com/MizukiShigi/cms-go/infrastructure/service")
+
 type storageService struct {
-	client *storage.Client
+	client  *storage.Client
+	metrics domainservice.Metrics
 }
 
-func NewStorageService(client *storage.Client) *storageService {
-	return &storageService{client: client}
+func NewStorageService(client *storage.Client, metrics domainservice.Metrics) *storageService {
+	return &storageService{client: client, metrics: metrics}
 }
 
-func (s *storageService) UploadImage(ctx context.Context, bucketName string, fileName valueobject.ImageFilename, data io.Reader) (domainservice.UploadResult, error) {
+func (s *storageService) UploadImage(ctx context.Context, bucketName string, fileName valueobject.ImageFilename, data io.Reader) (result domainservice.UploadResult, err error) {
+	ctx, span := storageTracer.Start(ctx, "StorageService.UploadImage",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attribute.String("gcs.bucket", bucketName)),
+	)
+	defer span.End()
+
+	// アップロードしたバイト数と所要時間を記録する
+	startTime := time.Now()
+	var written int64
+	defer func() {
+		s.metrics.ObserveStorageUpload(written, time.Since(startTime), err)
+		span.SetAttributes(attribute.Int64("gcs.upload.bytes", written))
+		if err != nil {
+			span.SetStatus(codes.Error, err.Error())
+		}
+	}()
+
 	bucket := s.client.Bucket(bucketName)
 	ext := strings.ToLower(filepath.Ext(fileName.String()))
 	path := generateStoredFilename(ext)
 	obj := bucket.Object(path)
 
+	// GCS へのリクエストにもリクエストIDを付け、障害調査時に突き合わせられるようにする
+	if requestID := domaincontext.GetRequestID(ctx); requestID != "" {
+		ctx = callctx.SetHeaders(ctx, RequestIDHeader, requestID)
+	}
+
 	writer := obj.NewWriter(ctx)
 	defer writer.Close()
 
 	writer.ContentType = detectImageContentType(path)
 
-	if _, err := io.Copy(writer, data); err != nil {
-		return domainservice.UploadResult{}, valueobject.NewMyError(valueobject.InternalServerErrorCode, "Failed to upload image to GCS")
+	if written, err = io.Copy(writer, data); err != nil {
+		return domainservice.UploadResult{}, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_upload_image_to_gcs")
 	}
 
 	if err := writer.Close(); err != nil {
-		return domainservice.UploadResult{}, valueobject.NewMyError(valueobject.InternalServerErrorCode, "Failed to complete image upload")
+		return domainservice.UploadResult{}, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_complete_image_upload")
 	}
 
 	publicURL := fmt.Sprintf("https://storage.googleapis.com/%s/%s", bucketName, path)
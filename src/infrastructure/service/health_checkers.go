@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"cloud.google.com/go/storage"
+)
+
+// DatabaseHealthChecker はDB接続プールから接続できるか確認する
+type DatabaseHealthChecker struct {
+	db *sql.DB
+}
+
+func NewDatabaseHealthChecker(db *sql.DB) *DatabaseHealthChecker {
+	return &DatabaseHealthChecker{db: db}
+}
+
+func (c *DatabaseHealthChecker) Name() string {
+	return "database"
+}
+
+func (c *DatabaseHealthChecker) Check(ctx context.Context) error {
+	return c.db.PingContext(ctx)
+}
+
+// StorageHealthChecker は画像保存先のバケットに到達できるか確認する
+type StorageHealthChecker struct {
+	client     *storage.Client
+	bucketName string
+}
+
+func NewStorageHealthChecker(client *storage.Client, bucketName string) *StorageHealthChecker {
+	return &StorageHealthChecker{client: client, bucketName: bucketName}
+}
+
+func (c *StorageHealthChecker) Name() string {
+	return "storage"
+}
+
+func (c *StorageHealthChecker) Check(ctx context.Context) error {
+	if c.bucketName == "" {
+		return errors.New("bucket name is not configured")
+	}
+	if _, err := c.client.Bucket(c.bucketName).Attrs(ctx); err != nil {
+		return fmt.Errorf("failed to get bucket attributes: %w", err)
+	}
+	return nil
+}
+
+// JWKSHealthChecker はトークン検証に使う公開鍵（JWKS）を取得できるか確認する
+type JWKSHealthChecker struct {
+	client  *http.Client
+	jwksURL string
+}
+
+func NewJWKSHealthChecker(jwksURL string) *JWKSHealthChecker {
+	return &JWKSHealthChecker{client: &http.Client{}, jwksURL: jwksURL}
+}
+
+func (c *JWKSHealthChecker) Name() string {
+	return "jwks"
+}
+
+func (c *JWKSHealthChecker) Check(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.jwksURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create JWKS request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to get JWKS: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected JWKS status: %d", resp.StatusCode)
+	}
+
+	var jwks struct {
+		Keys []json.RawMessage `json:"keys"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&jwks); err != nil {
+		return fmt.Errorf("failed to decode JWKS: %w", err)
+	}
+	if len(jwks.Keys) == 0 {
+		return errors.New("JWKS has no keys")
+	}
+	return nil
+}
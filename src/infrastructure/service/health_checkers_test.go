@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestJWKSHealthChecker_Check(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+		wantErr    bool
+	}{
+		{
+			name:       "正常ケース: 公開鍵を取得できる",
+			statusCode: http.StatusOK,
+			body:       `{"keys":[{"kty":"RSA","kid":"key-1"}]}`,
+			wantErr:    false,
+		},
+		{
+			name:       "異常ケース: 公開鍵が空",
+			statusCode: http.StatusOK,
+			body:       `{"keys":[]}`,
+			wantErr:    true,
+		},
+		{
+			name:       "異常ケース: 2xx以外の応答",
+			statusCode: http.StatusServiceUnavailable,
+			body:       `{}`,
+			wantErr:    true,
+		},
+		{
+			name:       "異常ケース: JSONではない応答",
+			statusCode: http.StatusOK,
+			body:       `<html></html>`,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.statusCode)
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			err := NewJWKSHealthChecker(server.URL).Check(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Check() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	t.Run("異常ケース: 到達できない", func(t *testing.T) {
+		server := httptest.NewServer(http.NotFoundHandler())
+		server.Close()
+
+		if err := NewJWKSHealthChecker(server.URL).Check(context.Background()); err == nil {
+			t.Errorf("到達できないURLでエラーが返されていません")
+		}
+	})
+}
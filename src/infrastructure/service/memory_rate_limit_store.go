@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+// rateLimitSweepInterval は満杯に戻ったバケットを破棄する間隔
+const rateLimitSweepInterval = time.Minute
+
+// MemoryRateLimitStore はバケットをプロセス内に保持する（インスタンスごとに独立して制限する）
+type MemoryRateLimitStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*memoryRateLimitEntry
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+type memoryRateLimitEntry struct {
+	bucket *entity.RateLimitBucket
+	// 満杯に戻る日時（過ぎたら破棄できる）
+	fullAt time.Time
+}
+
+func NewMemoryRateLimitStore() *MemoryRateLimitStore {
+	return &MemoryRateLimitStore{
+		buckets: make(map[string]*memoryRateLimitEntry),
+		now:     time.Now,
+	}
+}
+
+func (s *MemoryRateLimitStore) Take(ctx context.Context, key string, policy valueobject.RateLimitPolicy) (entity.RateLimitDecision, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.sweep(now)
+
+	entry, ok := s.buckets[key]
+	if !ok {
+		entry = &memoryRateLimitEntry{bucket: entity.NewRateLimitBucket(policy, now)}
+		s.buckets[key] = entry
+	}
+
+	decision := entry.bucket.Take(policy, now)
+	entry.fullAt = entry.bucket.FullAt(policy)
+	return decision, nil
+}
+
+func (s *MemoryRateLimitStore) Reset(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.buckets, key)
+	return nil
+}
+
+// sweep はメモリが増え続けないように、満杯に戻ったバケットを定期的に破棄する
+func (s *MemoryRateLimitStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < rateLimitSweepInterval {
+		return
+	}
+	s.lastSweep = now
+
+	for key, entry := range s.buckets {
+		if !now.Before(entry.fullAt) {
+			delete(s.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+func TestMemoryRateLimitStore(t *testing.T) {
+	policy, _ := valueobject.NewRateLimitPolicy("test", 2, time.Minute)
+	now := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	newStore := func() *MemoryRateLimitStore {
+		store := NewMemoryRateLimitStore()
+		store.now = func() time.Time { return now }
+		return store
+	}
+
+	t.Run("キーごとに上限を超えたリクエストを拒否する", func(t *testing.T) {
+		store := newStore()
+		ctx := context.Background()
+
+		for i := 0; i < 2; i++ {
+			if decision, _ := store.Take(ctx, "ip:192.0.2.1", policy); !decision.Allowed {
+				t.Fatalf("%d回目が拒否されました", i+1)
+			}
+		}
+		if decision, _ := store.Take(ctx, "ip:192.0.2.1", policy); decision.Allowed {
+			t.Errorf("上限を超えたリクエストが許可されました")
+		}
+		// 他のキーには影響しない
+		if decision, _ := store.Take(ctx, "ip:192.0.2.2", policy); !decision.Allowed {
+			t.Errorf("別のキーのリクエストが拒否されました")
+		}
+	})
+
+	t.Run("リセットすると満杯に戻る", func(t *testing.T) {
+		store := newStore()
+		ctx := context.Background()
+
+		store.Take(ctx, "login:user", policy)
+		store.Take(ctx, "login:user", policy)
+		if err := store.Reset(ctx, "login:user"); err != nil {
+			t.Fatalf("予期しないエラー: %v", err)
+		}
+
+		if decision, _ := store.Take(ctx, "login:user", policy); !decision.Allowed || decision.Remaining != 1 {
+			t.Errorf("decision = %+v, want allowed with 1 remaining", decision)
+		}
+	})
+
+	t.Run("満杯に戻ったバケットを破棄する", func(t *testing.T) {
+		store := newStore()
+		ctx := context.Background()
+
+		store.Take(ctx, "ip:192.0.2.1", policy)
+		store.now = func() time.Time { return now.Add(2 * time.Minute) }
+		store.Take(ctx, "ip:192.0.2.2", policy)
+
+		if _, ok := store.buckets["ip:192.0.2.1"]; ok {
+			t.Errorf("満杯に戻ったバケットが残っています")
+		}
+		if _, ok := store.buckets["ip:192.0.2.2"]; !ok {
+			t.Errorf("使用中のバケットが破棄されました")
+		}
+	})
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/infrastructure/db/sqlboiler/models"
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// PostgresRateLimitStore はバケットを rate_limit_buckets テーブルに保持する
+// 複数インスタンスで同じ上限を共有するために使う
+type PostgresRateLimitStore struct {
+	db *sql.DB
+
+	mu        sync.Mutex
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+func NewPostgresRateLimitStore(db *sql.DB) *PostgresRateLimitStore {
+	return &PostgresRateLimitStore{db: db, now: time.Now}
+}
+
+func (s *PostgresRateLimitStore) Take(ctx context.Context, key string, policy valueobject.RateLimitPolicy) (entity.RateLimitDecision, error) {
+	now := s.now()
+	s.sweep(ctx, now)
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return entity.RateLimitDecision{}, fmt.Errorf("failed to begin rate limit transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// 初回は満杯のバケットを作成し、同じキーへの同時リクエストは行ロックで直列化する
+	bucket := entity.NewRateLimitBucket(policy, now)
+	newBucket := &models.RateLimitBucket{
+		Key:       key,
+		Tokens:    bucket.Tokens,
+		UpdatedAt: bucket.UpdatedAt,
+		ExpiresAt: bucket.UpdatedAt,
+	}
+	// 更新日時は補充量の計算に使うため、sqlboiler による現在時刻での上書きを無効にする
+	if err := newBucket.Upsert(boil.SkipTimestamps(ctx), tx, false, []string{models.RateLimitBucketColumns.Key}, boil.None(), boil.Infer()); err != nil {
+		return entity.RateLimitDecision{}, fmt.Errorf("failed to create rate limit bucket: %w", err)
+	}
+	dbBucket, err := models.RateLimitBuckets(
+		models.RateLimitBucketWhere.Key.EQ(key),
+		qm.For("UPDATE"),
+	).One(ctx, tx)
+	if err != nil {
+		return entity.RateLimitDecision{}, fmt.Errorf("failed to get rate limit bucket: %w", err)
+	}
+	bucket.Tokens = dbBucket.Tokens
+	bucket.UpdatedAt = dbBucket.UpdatedAt
+
+	decision := bucket.Take(policy, now)
+	if _, err := models.RateLimitBuckets(
+		models.RateLimitBucketWhere.Key.EQ(key),
+	).UpdateAll(ctx, tx, models.M{
+		models.RateLimitBucketColumns.Tokens:    bucket.Tokens,
+		models.RateLimitBucketColumns.UpdatedAt: bucket.UpdatedAt,
+		models.RateLimitBucketColumns.ExpiresAt: bucket.FullAt(policy),
+	}); err != nil {
+		return entity.RateLimitDecision{}, fmt.Errorf("failed to update rate limit bucket: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return entity.RateLimitDecision{}, fmt.Errorf("failed to commit rate limit transaction: %w", err)
+	}
+	return decision, nil
+}
+
+func (s *PostgresRateLimitStore) Reset(ctx context.Context, key string) error {
+	if _, err := models.RateLimitBuckets(models.RateLimitBucketWhere.Key.EQ(key)).DeleteAll(ctx, s.db); err != nil {
+		return fmt.Errorf("failed to reset rate limit bucket: %w", err)
+	}
+	return nil
+}
+
+// sweep はテーブルが増え続けないように、満杯に戻ったバケットを定期的に削除する
+func (s *PostgresRateLimitStore) sweep(ctx context.Context, now time.Time) {
+	s.mu.Lock()
+	if now.Sub(s.lastSweep) < rateLimitSweepInterval {
+		s.mu.Unlock()
+		return
+	}
+	s.lastSweep = now
+	s.mu.Unlock()
+
+	if _, err := models.RateLimitBuckets(models.RateLimitBucketWhere.ExpiresAt.LT(now)).DeleteAll(ctx, s.db); err != nil {
+		// 削除できなくても制限には影響しないため、ログのみ出力する
+		slog.WarnContext(ctx, "Failed to delete expired rate limit buckets", "error", err)
+	}
+}
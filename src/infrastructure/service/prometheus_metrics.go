@@ -0,0 +1,118 @@
+package service
+
+import (
+	"database/sql"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// metricsNamespace はメトリクス名の接頭辞
+const metricsNamespace = "cms"
+
+// PrometheusMetrics はメトリクスを Prometheus 形式で公開する
+type PrometheusMetrics struct {
+	registry *prometheus.Registry
+
+	httpRequests          *prometheus.CounterVec
+	httpRequestDuration   *prometheus.HistogramVec
+	transactionRollbacks  *prometheus.CounterVec
+	storageUploads        *prometheus.CounterVec
+	storageUploadBytes    prometheus.Counter
+	storageUploadDuration prometheus.Histogram
+	domainEvents          *prometheus.CounterVec
+}
+
+// NewPrometheusMetrics はメトリクスを登録する
+// db を指定した場合は接続プールの状態（sql.DBStats）も公開する
+func NewPrometheusMetrics(db *sql.DB) *PrometheusMetrics {
+	m := &PrometheusMetrics{
+		registry: prometheus.NewRegistry(),
+		httpRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "http_requests_total",
+			Help:      "Number of HTTP requests by route template, method and status code.",
+		}, []string{"method", "route", "status"}),
+		httpRequestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "HTTP request latency by route template, method and status code.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route", "status"}),
+		transactionRollbacks: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "db_transaction_rollbacks_total",
+			Help:      "Number of rolled back database transactions by reason.",
+		}, []string{"reason"}),
+		storageUploads: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "storage_uploads_total",
+			Help:      "Number of storage uploads by result.",
+		}, []string{"result"}),
+		storageUploadBytes: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "storage_upload_bytes_total",
+			Help:      "Total bytes uploaded to storage.",
+		}),
+		storageUploadDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "storage_upload_duration_seconds",
+			Help:      "Storage upload latency.",
+			Buckets:   []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+		}),
+		domainEvents: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "domain_events_total",
+			Help:      "Number of published domain events by event name (e.g. post.published).",
+		}, []string{"event"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.httpRequests,
+		m.httpRequestDuration,
+		m.transactionRollbacks,
+		m.storageUploads,
+		m.storageUploadBytes,
+		m.storageUploadDuration,
+		m.domainEvents,
+	)
+	if db != nil {
+		m.registry.MustRegister(collectors.NewDBStatsCollector(db, metricsNamespace))
+	}
+	return m
+}
+
+// Handler は /metrics のハンドラーを返す
+func (m *PrometheusMetrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+func (m *PrometheusMetrics) ObserveHTTPRequest(method string, route string, statusCode int, duration time.Duration) {
+	status := strconv.Itoa(statusCode)
+	m.httpRequests.WithLabelValues(method, route, status).Inc()
+	m.httpRequestDuration.WithLabelValues(method, route, status).Observe(duration.Seconds())
+}
+
+func (m *PrometheusMetrics) IncTransactionRollback(reason string) {
+	m.transactionRollbacks.WithLabelValues(reason).Inc()
+}
+
+func (m *PrometheusMetrics) ObserveStorageUpload(bytes int64, duration time.Duration, err error) {
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+	m.storageUploads.WithLabelValues(result).Inc()
+	m.storageUploadBytes.Add(float64(bytes))
+	m.storageUploadDuration.Observe(duration.Seconds())
+}
+
+func (m *PrometheusMetrics) IncDomainEvent(name string) {
+	m.domainEvents.WithLabelValues(name).Inc()
+}
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPrometheusMetrics_Handler(t *testing.T) {
+	metrics := NewPrometheusMetrics(nil)
+
+	metrics.ObserveHTTPRequest("GET", "/cms/v1/posts/{id}", 200, 120*time.Millisecond)
+	metrics.ObserveHTTPRequest("GET", "/cms/v1/posts/{id}", 200, 30*time.Millisecond)
+	metrics.ObserveHTTPRequest("PATCH", "/cms/v1/posts/{id}", 409, 10*time.Millisecond)
+	metrics.IncTransactionRollback("error")
+	metrics.ObserveStorageUpload(1024, time.Second, nil)
+	metrics.ObserveStorageUpload(512, time.Second, errors.New("upload failed"))
+	metrics.IncDomainEvent("post.published")
+
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	rec := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	body, _ := io.ReadAll(rec.Body)
+
+	tests := []struct {
+		name string
+		line string
+	}{
+		{"リクエスト数をルートのテンプレート単位で集計する", `cms_http_requests_total{method="GET",route="/cms/v1/posts/{id}",status="200"} 2`},
+		{"ステータスコードごとに集計する", `cms_http_requests_total{method="PATCH",route="/cms/v1/posts/{id}",status="409"} 1`},
+		{"処理時間のヒストグラムを記録する", `cms_http_request_duration_seconds_count{method="GET",route="/cms/v1/posts/{id}",status="200"} 2`},
+		{"ロールバック数を記録する", `cms_db_transaction_rollbacks_total{reason="error"} 1`},
+		{"アップロード結果を記録する", `cms_storage_uploads_total{result="error"} 1`},
+		{"アップロードしたバイト数を記録する", `cms_storage_upload_bytes_total 1536`},
+		{"ドメインイベント数を記録する", `cms_domain_events_total{event="post.published"} 1`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !strings.Contains(string(body), tt.line+"\n") {
+				t.Errorf("メトリクスに %q が含まれていません", tt.line)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	domainservice "github.com/MizukiShigi/cms-go/internal/domain/service"
+)
+
+const (
+	// webhookTimeout は1回の送信のタイムアウト
+	webhookTimeout = 10 * time.Second
+
+	WebhookEventHeader     = "X-Webhook-Event"
+	WebhookDeliveryHeader  = "X-Webhook-Delivery"
+	WebhookTimestampHeader = "X-Webhook-Timestamp"
+	WebhookSignatureHeader = "X-Webhook-Signature"
+	// RequestIDHeader はイベントを発生させたリクエストのIDを受信側へ伝えるヘッダー
+	RequestIDHeader = "X-Request-ID"
+)
+
+// ErrWebhookLocalNetwork は送信先が内部ネットワークのアドレスに解決された場合のエラー
+var ErrWebhookLocalNetwork = errors.New("webhook destination resolves to a local network address")
+
+type WebhookSender struct {
+	client *http.Client
+	now    func() time.Time
+}
+
+// NewWebhookSender は送信元を返す
+// allowLocalNetwork が false の場合は、名前解決後のアドレスが内部ネットワーク（ループバック・プライベート・リンクローカル）の送信先へ接続しない
+func NewWebhookSender(allowLocalNetwork bool) *WebhookSender {
+	dialer := &net.Dialer{Timeout: webhookTimeout}
+	if !allowLocalNetwork {
+		// 名前解決後の接続先を検証するため、DNS の応答やリダイレクト先が内部ネットワークを指す場合も拒否される
+		dialer.Control = rejectLocalNetwork
+	}
+
+	return &WebhookSender{
+		client: &http.Client{
+			Timeout: webhookTimeout,
+			Transport: &http.Transport{
+				// プロキシを経由すると接続先の検証ができないため使わない
+				Proxy:               nil,
+				DialContext:         dialer.DialContext,
+				TLSHandshakeTimeout: webhookTimeout,
+				MaxIdleConns:        100,
+				IdleConnTimeout:     90 * time.Second,
+			},
+		},
+		now: time.Now,
+	}
+}
+
+// rejectLocalNetwork は接続直前に接続先のアドレスを検証する
+func rejectLocalNetwork(network, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("invalid webhook destination %q: %w", address, err)
+	}
+	if entity.IsLocalNetworkAddress(addrPort.Addr()) {
+		return fmt.Errorf("%w: %s", ErrWebhookLocalNetwork, addrPort.Addr())
+	}
+	return nil
+}
+
+// SignWebhookPayload は "タイムスタンプ.本文" の HMAC-SHA256 署名を返す
+// 受信側は X-Webhook-Timestamp と本文から同じ値を計算して X-Webhook-Signature と比較する
+func SignWebhookPayload(secret string, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s *WebhookSender) Send(ctx context.Context, request domainservice.WebhookRequest) (*domainservice.WebhookResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, request.URL, bytes.NewReader(request.Payload))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook request: %w", err)
+	}
+
+	timestamp := strconv.FormatInt(s.now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "cms-go-webhook")
+	req.Header.Set(WebhookEventHeader, request.EventType)
+	req.Header.Set(WebhookDeliveryHeader, strconv.FormatInt(request.DeliveryID, 10))
+	req.Header.Set(WebhookTimestampHeader, timestamp)
+	req.Header.Set(WebhookSignatureHeader, SignWebhookPayload(request.Secret, timestamp, request.Payload))
+	if request.RequestID != "" {
+		req.Header.Set(RequestIDHeader, request.RequestID)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	// コネクションを再利用するために本文を読み捨てる
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	response := &domainservice.WebhookResponse{StatusCode: resp.StatusCode}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return response, fmt.Errorf("webhook receiver returned status %d", resp.StatusCode)
+	}
+	return response, nil
+}
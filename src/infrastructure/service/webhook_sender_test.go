@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	domainservice "github.com/MizukiShigi/cms-go/internal/domain/service"
+)
+
+func TestSignWebhookPayload(t *testing.T) {
+	payload := []byte(`{"event":"post.published"}`)
+
+	// 受信側の検証例: echo -n '1700000000.{"event":"post.published"}' | openssl dgst -sha256 -hmac 'test-secret-0123'
+	signature := SignWebhookPayload("test-secret-0123", "1700000000", payload)
+	expected := "sha256=f48cd34dd7eeb2bf58b4d8fac251f12d433b2e6ebe12b373607f4d31c6da9b6c"
+	if signature != expected {
+		t.Errorf("SignWebhookPayload() = %v, want %v", signature, expected)
+	}
+
+	if SignWebhookPayload("other-secret-0123", "1700000000", payload) == signature {
+		t.Errorf("異なるシークレットで署名が一致しています")
+	}
+	if SignWebhookPayload("test-secret-0123", "1700000001", payload) == signature {
+		t.Errorf("異なるタイムスタンプで署名が一致しています")
+	}
+}
+
+func TestWebhookSender_Send(t *testing.T) {
+	secret := "test-secret-0123"
+	payload := []byte(`{"event":"post.published"}`)
+	now := time.Unix(1700000000, 0)
+
+	t.Run("署名付きで送信され2xxで成功する", func(t *testing.T) {
+		var received *http.Request
+		var receivedBody []byte
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received = r
+			receivedBody, _ = io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		sender := NewWebhookSender(true)
+		sender.now = func() time.Time { return now }
+
+		resp, err := sender.Send(context.Background(), domainservice.WebhookRequest{
+			URL:        server.URL,
+			Secret:     secret,
+			EventType:  "post.published",
+			DeliveryID: 42,
+			Payload:    payload,
+			RequestID:  "req-123",
+		})
+
+		if err != nil {
+			t.Fatalf("予期しないエラー: %v", err)
+		}
+		if resp.StatusCode != http.StatusNoContent {
+			t.Errorf("StatusCode = %d, want 204", resp.StatusCode)
+		}
+		if received.Method != http.MethodPost {
+			t.Errorf("Method = %s, want POST", received.Method)
+		}
+		if string(receivedBody) != string(payload) {
+			t.Errorf("Body = %s, want %s", receivedBody, payload)
+		}
+		if received.Header.Get(WebhookEventHeader) != "post.published" {
+			t.Errorf("%s = %s", WebhookEventHeader, received.Header.Get(WebhookEventHeader))
+		}
+		if received.Header.Get(WebhookDeliveryHeader) != "42" {
+			t.Errorf("%s = %s", WebhookDeliveryHeader, received.Header.Get(WebhookDeliveryHeader))
+		}
+		if received.Header.Get(RequestIDHeader) != "req-123" {
+			t.Errorf("%s = %s", RequestIDHeader, received.Header.Get(RequestIDHeader))
+		}
+		if received.Header.Get(WebhookTimestampHeader) != "1700000000" {
+			t.Errorf("%s = %s", WebhookTimestampHeader, received.Header.Get(WebhookTimestampHeader))
+		}
+		// 受信側と同じ方法で署名を検証できる
+		expected := SignWebhookPayload(secret, received.Header.Get(WebhookTimestampHeader), receivedBody)
+		if received.Header.Get(WebhookSignatureHeader) != expected {
+			t.Errorf("%s = %s, want %s", WebhookSignatureHeader, received.Header.Get(WebhookSignatureHeader), expected)
+		}
+	})
+
+	t.Run("2xx以外の応答はステータス付きでエラーになる", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		resp, err := NewWebhookSender(true).Send(context.Background(), domainservice.WebhookRequest{
+			URL:     server.URL,
+			Secret:  secret,
+			Payload: payload,
+		})
+
+		if err == nil {
+			t.Fatal("エラーが期待されましたが、エラーが発生しませんでした")
+		}
+		if resp == nil || resp.StatusCode != http.StatusServiceUnavailable {
+			t.Errorf("StatusCode が返されていません: %v", resp)
+		}
+	})
+
+	t.Run("接続できない場合はレスポンスなしでエラーになる", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		url := server.URL
+		server.Close()
+
+		resp, err := NewWebhookSender(true).Send(context.Background(), domainservice.WebhookRequest{
+			URL:     url,
+			Secret:  secret,
+			Payload: payload,
+		})
+
+		if err == nil {
+			t.Fatal("エラーが期待されましたが、エラーが発生しませんでした")
+		}
+		if resp != nil {
+			t.Errorf("resp = %v, want nil", resp)
+		}
+	})
+
+	t.Run("内部ネットワークへの送信を許可しない場合は接続せずにエラーになる", func(t *testing.T) {
+		called := false
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		resp, err := NewWebhookSender(false).Send(context.Background(), domainservice.WebhookRequest{
+			URL:     server.URL,
+			Secret:  secret,
+			Payload: payload,
+		})
+
+		if !errors.Is(err, ErrWebhookLocalNetwork) {
+			t.Fatalf("err = %v, want %v", err, ErrWebhookLocalNetwork)
+		}
+		if resp != nil {
+			t.Errorf("resp = %v, want nil", resp)
+		}
+		if called {
+			t.Errorf("内部ネットワークの送信先にリクエストが届いています")
+		}
+	})
+
+}
@@ -0,0 +1,86 @@
+package tracing
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// CloudTraceContextHeader は Google Cloud のロードバランサーが付与するトレースヘッダー
+// 形式: TRACE_ID/SPAN_ID;o=OPTIONS（SPAN_ID は10進数、o=1 はサンプリング対象）
+const CloudTraceContextHeader = "X-Cloud-Trace-Context"
+
+// CloudTraceContext は X-Cloud-Trace-Context ヘッダーを伝播する
+type CloudTraceContext struct{}
+
+var _ propagation.TextMapPropagator = CloudTraceContext{}
+
+func (CloudTraceContext) Inject(ctx context.Context, carrier propagation.TextMapCarrier) {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.IsValid() {
+		return
+	}
+
+	sampled := 0
+	if spanContext.IsSampled() {
+		sampled = 1
+	}
+	spanID := spanContext.SpanID()
+	carrier.Set(CloudTraceContextHeader, fmt.Sprintf("%s/%d;o=%d", spanContext.TraceID(), binary.BigEndian.Uint64(spanID[:]), sampled))
+}
+
+func (CloudTraceContext) Extract(ctx context.Context, carrier propagation.TextMapCarrier) context.Context {
+	spanContext, ok := parseCloudTraceContext(carrier.Get(CloudTraceContextHeader))
+	if !ok {
+		return ctx
+	}
+	return trace.ContextWithRemoteSpanContext(ctx, spanContext)
+}
+
+func (CloudTraceContext) Fields() []string {
+	return []string{CloudTraceContextHeader}
+}
+
+func parseCloudTraceContext(header string) (trace.SpanContext, bool) {
+	if header == "" {
+		return trace.SpanContext{}, false
+	}
+
+	value, options, _ := strings.Cut(header, ";")
+	traceIDHex, spanIDDec, ok := strings.Cut(value, "/")
+	if !ok {
+		return trace.SpanContext{}, false
+	}
+
+	if len(traceIDHex) > 32 {
+		return trace.SpanContext{}, false
+	}
+	traceID, err := trace.TraceIDFromHex(strings.Repeat("0", 32-len(traceIDHex)) + traceIDHex)
+	if err != nil {
+		return trace.SpanContext{}, false
+	}
+	spanIDValue, err := strconv.ParseUint(spanIDDec, 10, 64)
+	if err != nil {
+		return trace.SpanContext{}, false
+	}
+	var spanID trace.SpanID
+	binary.BigEndian.PutUint64(spanID[:], spanIDValue)
+
+	var flags trace.TraceFlags
+	if options == "o=1" {
+		flags = trace.FlagsSampled
+	}
+
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: flags,
+		Remote:     true,
+	})
+	return spanContext, spanContext.IsValid()
+}
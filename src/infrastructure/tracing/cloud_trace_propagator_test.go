@@ -0,0 +1,97 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestCloudTraceContext_Extract(t *testing.T) {
+	tests := []struct {
+		name        string
+		header      string
+		wantValid   bool
+		wantTraceID string
+		wantSpanID  string
+		wantSampled bool
+	}{
+		{
+			name:        "正常ケース: サンプリング対象",
+			header:      "105445aa7843bc8bf206b12000100000/1;o=1",
+			wantValid:   true,
+			wantTraceID: "105445aa7843bc8bf206b12000100000",
+			wantSpanID:  "0000000000000001",
+			wantSampled: true,
+		},
+		{
+			name:        "正常ケース: オプションなし",
+			header:      "105445aa7843bc8bf206b12000100000/18446744073709551615",
+			wantValid:   true,
+			wantTraceID: "105445aa7843bc8bf206b12000100000",
+			wantSpanID:  "ffffffffffffffff",
+			wantSampled: false,
+		},
+		{
+			name:      "異常ケース: スパンIDなし",
+			header:    "105445aa7843bc8bf206b12000100000",
+			wantValid: false,
+		},
+		{
+			name:      "異常ケース: トレースIDが16進数ではない",
+			header:    "not-a-trace-id/1;o=1",
+			wantValid: false,
+		},
+		{
+			name:      "異常ケース: ヘッダーなし",
+			header:    "",
+			wantValid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			if tt.header != "" {
+				header.Set(CloudTraceContextHeader, tt.header)
+			}
+
+			ctx := CloudTraceContext{}.Extract(context.Background(), propagation.HeaderCarrier(header))
+			spanContext := trace.SpanContextFromContext(ctx)
+
+			if spanContext.IsValid() != tt.wantValid {
+				t.Fatalf("IsValid() = %v, want %v", spanContext.IsValid(), tt.wantValid)
+			}
+			if !tt.wantValid {
+				return
+			}
+			if spanContext.TraceID().String() != tt.wantTraceID {
+				t.Errorf("TraceID = %v, want %v", spanContext.TraceID(), tt.wantTraceID)
+			}
+			if spanContext.SpanID().String() != tt.wantSpanID {
+				t.Errorf("SpanID = %v, want %v", spanContext.SpanID(), tt.wantSpanID)
+			}
+			if spanContext.IsSampled() != tt.wantSampled {
+				t.Errorf("IsSampled() = %v, want %v", spanContext.IsSampled(), tt.wantSampled)
+			}
+			if !spanContext.IsRemote() {
+				t.Errorf("リモートのスパンとして扱われていません")
+			}
+		})
+	}
+}
+
+func TestCloudTraceContext_Inject(t *testing.T) {
+	header := http.Header{}
+	header.Set(CloudTraceContextHeader, "105445aa7843bc8bf206b12000100000/255;o=1")
+	ctx := CloudTraceContext{}.Extract(context.Background(), propagation.HeaderCarrier(header))
+
+	injected := http.Header{}
+	CloudTraceContext{}.Inject(ctx, propagation.HeaderCarrier(injected))
+
+	if got := injected.Get(CloudTraceContextHeader); got != "105445aa7843bc8bf206b12000100000/255;o=1" {
+		t.Errorf("Inject() = %v, want %v", got, "105445aa7843bc8bf206b12000100000/255;o=1")
+	}
+}
@@ -0,0 +1,58 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+type Settings struct {
+	ServiceName string
+	Environment string
+	// OTLP/HTTP の送信先（例: http://localhost:4318）。空の場合は送信しない
+	Endpoint string
+}
+
+// Setup はトレーサーと W3C Trace Context（traceparent）の伝播を設定し、終了処理を返す
+// 送信先が未指定の場合もトレースIDは採番し、ログとの紐付けや下流への伝播に使用する
+func Setup(ctx context.Context, settings Settings) (func(context.Context) error, error) {
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(settings.ServiceName),
+		semconv.DeploymentEnvironment(settings.Environment),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create resource: %w", err)
+	}
+
+	options := []sdktrace.TracerProviderOption{sdktrace.WithResource(res)}
+	if settings.Endpoint != "" {
+		endpoint, err := url.Parse(settings.Endpoint)
+		if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+			return nil, fmt.Errorf("invalid OTLP endpoint: %s", settings.Endpoint)
+		}
+		exporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(settings.Endpoint))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create OTLP exporter: %w", err)
+		}
+		options = append(options, sdktrace.WithBatcher(exporter))
+	}
+
+	provider := sdktrace.NewTracerProvider(options...)
+	otel.SetTracerProvider(provider)
+	// traceparent がない場合は Google Cloud の X-Cloud-Trace-Context を引き継ぐ（後の伝播方式が優先される）
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		CloudTraceContext{},
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	return provider.Shutdown, nil
+}
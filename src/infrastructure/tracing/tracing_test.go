@@ -0,0 +1,42 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+func TestSetup(t *testing.T) {
+	t.Run("送信先が未指定でもトレースIDを採番し、traceparentを伝播する", func(t *testing.T) {
+		shutdown, err := Setup(context.Background(), Settings{ServiceName: "cms-api-test", Environment: "test"})
+		if err != nil {
+			t.Fatalf("Setup() error = %v", err)
+		}
+		defer shutdown(context.Background())
+
+		ctx, span := otel.Tracer("test").Start(context.Background(), "span")
+		defer span.End()
+
+		if !span.SpanContext().IsValid() {
+			t.Fatalf("トレースIDが採番されていません")
+		}
+
+		header := http.Header{}
+		otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(header))
+		traceparent := header.Get("traceparent")
+		if !strings.Contains(traceparent, span.SpanContext().TraceID().String()) {
+			t.Errorf("traceparent = %q, トレースID %s が含まれていません", traceparent, span.SpanContext().TraceID())
+		}
+	})
+
+	t.Run("送信先のURLが不正な場合はエラー", func(t *testing.T) {
+		_, err := Setup(context.Background(), Settings{ServiceName: "cms-api-test", Endpoint: "://invalid"})
+		if err == nil {
+			t.Errorf("不正なURLでエラーが返されていません")
+		}
+	})
+}
@@ -4,6 +4,10 @@ type ContextKey string
 
 const (
 	UserID        ContextKey = "user_id"
+	UserRoles     ContextKey = "user_roles"
+	RequestID     ContextKey = "request_id"
+	ClientIP      ContextKey = "client_ip"
 	Logging       ContextKey = "logging"
 	TransactionDB ContextKey = "transaction_db"
+	DomainEvents  ContextKey = "domain_events"
 )
@@ -0,0 +1,50 @@
+package context
+
+import (
+	"context"
+	"sync"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/event"
+)
+
+// EventCollector はトランザクション中に発生したドメインイベントを集める
+type EventCollector struct {
+	mu     sync.Mutex
+	events []event.Event
+}
+
+// Events は集めたイベントを発生順に返す
+func (c *EventCollector) Events() []event.Event {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]event.Event(nil), c.events...)
+}
+
+// WithEventCollector はイベントを集めるコンテキストを返す。既に集めている場合は同じものを使う
+func WithEventCollector(ctx context.Context) (context.Context, *EventCollector) {
+	if collector := GetEventCollector(ctx); collector != nil {
+		return ctx, collector
+	}
+
+	collector := &EventCollector{}
+	return context.WithValue(ctx, DomainEvents, collector), collector
+}
+
+// GetEventCollector はコンテキストのイベント収集先を返す。トランザクション外の場合は nil を返す
+func GetEventCollector(ctx context.Context) *EventCollector {
+	collector, _ := ctx.Value(DomainEvents).(*EventCollector)
+	return collector
+}
+
+// RecordEvents はイベントをコミット後の発行対象として記録する。トランザクション外では破棄される
+func RecordEvents(ctx context.Context, events ...event.Event) {
+	collector := GetEventCollector(ctx)
+	if collector == nil {
+		return
+	}
+
+	collector.mu.Lock()
+	defer collector.mu.Unlock()
+	collector.events = append(collector.events, events...)
+}
@@ -27,3 +27,15 @@ func copySyncMap(m *sync.Map) *sync.Map {
 	})
 	return &cp
 }
+
+// GetRequestID はリクエストIDを返す。リクエスト外（バッチなど）の場合は空文字を返す
+func GetRequestID(ctx context.Context) string {
+	requestID, _ := ctx.Value(RequestID).(string)
+	return requestID
+}
+
+// GetClientIP はリクエスト元のIPアドレスを返す。リクエスト外（バッチなど）の場合は空文字を返す
+func GetClientIP(ctx context.Context) string {
+	clientIP, _ := ctx.Value(ClientIP).(string)
+	return clientIP
+}
@@ -2,6 +2,7 @@ package context
 
 import (
 	"context"
+	"slices"
 
 	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
 )
@@ -9,8 +10,23 @@ import (
 func GetUserID(ctx context.Context) (string, error) {
 	userID, ok := ctx.Value(UserID).(string)
 	if !ok {
-		return "", valueobject.NewMyError(valueobject.UnauthorizedCode, "Not found user ID")
+		return "", valueobject.NewMyError(valueobject.UnauthorizedCode, "not_found_user_id")
 	}
 
 	return userID, nil
 }
+
+// GetUserRoles は認証済みユーザーのロール一覧を返す。ロールがない場合は nil を返す
+func GetUserRoles(ctx context.Context) []valueobject.Role {
+	roles, ok := ctx.Value(UserRoles).([]valueobject.Role)
+	if !ok {
+		return nil
+	}
+
+	return roles
+}
+
+// HasRole は認証済みユーザーが指定ロールを持つかを返す
+func HasRole(ctx context.Context, role valueobject.Role) bool {
+	return slices.Contains(GetUserRoles(ctx), role)
+}
@@ -0,0 +1,21 @@
+package entity
+
+import (
+	"slices"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+// Actor は操作を行うユーザーとそのロール（ステータス遷移などの権限確認に使用する）
+type Actor struct {
+	UserID valueobject.UserID
+	Roles  []valueobject.Role
+}
+
+func NewActor(userID valueobject.UserID, roles []valueobject.Role) Actor {
+	return Actor{UserID: userID, Roles: roles}
+}
+
+func (a Actor) HasRole(role valueobject.Role) bool {
+	return slices.Contains(a.Roles, role)
+}
@@ -0,0 +1,109 @@
+package entity
+
+import (
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+// AuditFields は監査ログで比較するエンティティの項目（項目名 -> 値）
+type AuditFields map[string]any
+
+// AuditChange は1項目の変更前後の値
+type AuditChange struct {
+	Field  string
+	Before any
+	After  any
+}
+
+// AuditEvent は変更操作の監査ログ（追記のみで更新・削除しない）
+type AuditEvent struct {
+	ID int64
+	// 操作したユーザー（認証ユーザーがいない操作の場合は nil）
+	ActorID    *valueobject.UserID
+	RequestID  string
+	EntityType valueobject.AuditEntityType
+	EntityID   string
+	Action     valueobject.AuditAction
+	Changes    []AuditChange
+	CreatedAt  time.Time
+}
+
+// 新規監査イベント作成。変更前後の項目の差分を記録する（作成時は before、削除時は after を nil にする）
+func NewAuditEvent(
+	actorID *valueobject.UserID,
+	requestID string,
+	entityType valueobject.AuditEntityType,
+	entityID string,
+	action valueobject.AuditAction,
+	before AuditFields,
+	after AuditFields,
+) *AuditEvent {
+	return &AuditEvent{
+		ActorID:    actorID,
+		RequestID:  requestID,
+		EntityType: entityType,
+		EntityID:   entityID,
+		Action:     action,
+		Changes:    DiffAuditFields(before, after),
+		CreatedAt:  time.Now(),
+	}
+}
+
+// 監査イベントデータ再構築
+func ParseAuditEvent(
+	id int64,
+	actorID *valueobject.UserID,
+	requestID string,
+	entityType valueobject.AuditEntityType,
+	entityID string,
+	action valueobject.AuditAction,
+	changes []AuditChange,
+	createdAt time.Time,
+) *AuditEvent {
+	return &AuditEvent{
+		ID:         id,
+		ActorID:    actorID,
+		RequestID:  requestID,
+		EntityType: entityType,
+		EntityID:   entityID,
+		Action:     action,
+		Changes:    changes,
+		CreatedAt:  createdAt,
+	}
+}
+
+// HasChanges は記録する差分があるかを返す
+func (e *AuditEvent) HasChanges() bool {
+	return len(e.Changes) > 0
+}
+
+// DiffAuditFields は値が異なる項目を項目名順に返す
+func DiffAuditFields(before AuditFields, after AuditFields) []AuditChange {
+	fields := map[string]struct{}{}
+	for field := range before {
+		fields[field] = struct{}{}
+	}
+	for field := range after {
+		fields[field] = struct{}{}
+	}
+
+	names := make([]string, 0, len(fields))
+	for field := range fields {
+		names = append(names, field)
+	}
+	sort.Strings(names)
+
+	changes := []AuditChange{}
+	for _, field := range names {
+		beforeValue := before[field]
+		afterValue := after[field]
+		if reflect.DeepEqual(beforeValue, afterValue) {
+			continue
+		}
+		changes = append(changes, AuditChange{Field: field, Before: beforeValue, After: afterValue})
+	}
+	return changes
+}
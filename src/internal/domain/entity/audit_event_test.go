@@ -0,0 +1,89 @@
+package entity
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+func TestDiffAuditFields(t *testing.T) {
+	tests := []struct {
+		name     string
+		before   AuditFields
+		after    AuditFields
+		expected []AuditChange
+	}{
+		{
+			name:   "変更された項目のみ項目名順に返す",
+			before: AuditFields{"title": "旧タイトル", "status": "draft", "tags": []string{"go"}},
+			after:  AuditFields{"title": "新タイトル", "status": "draft", "tags": []string{"go", "test"}},
+			expected: []AuditChange{
+				{Field: "tags", Before: []string{"go"}, After: []string{"go", "test"}},
+				{Field: "title", Before: "旧タイトル", After: "新タイトル"},
+			},
+		},
+		{
+			name:   "作成時は変更前を nil とする",
+			before: nil,
+			after:  AuditFields{"name": "go"},
+			expected: []AuditChange{
+				{Field: "name", Before: nil, After: "go"},
+			},
+		},
+		{
+			name:   "削除時は変更後を nil とする",
+			before: AuditFields{"name": "go"},
+			after:  nil,
+			expected: []AuditChange{
+				{Field: "name", Before: "go", After: nil},
+			},
+		},
+		{
+			name:     "変更がない場合は空配列を返す",
+			before:   AuditFields{"name": "go"},
+			after:    AuditFields{"name": "go"},
+			expected: []AuditChange{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes := DiffAuditFields(tt.before, tt.after)
+
+			if !reflect.DeepEqual(changes, tt.expected) {
+				t.Errorf("DiffAuditFields() = %v, want %v", changes, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNewAuditEvent(t *testing.T) {
+	actorID := valueobject.NewUserID()
+	title, _ := valueobject.NewPostTitle("テストタイトル")
+	content, _ := valueobject.NewPostContent("テストコンテンツ")
+	post, _ := NewPost(title, content, actorID, valueobject.StatusDraft)
+
+	before := post.AuditFields()
+	newTitle, _ := valueobject.NewPostTitle("変更後タイトル")
+	post.Title = newTitle
+
+	event := NewAuditEvent(&actorID, "request-1", valueobject.AuditEntityPost, post.ID.String(), valueobject.AuditActionUpdate, before, post.AuditFields())
+
+	if event.ActorID == nil || !event.ActorID.Equals(actorID) {
+		t.Errorf("ActorID = %v, want %v", event.ActorID, actorID)
+	}
+	if event.RequestID != "request-1" {
+		t.Errorf("RequestID = %v, want %v", event.RequestID, "request-1")
+	}
+	if event.CreatedAt.IsZero() {
+		t.Error("CreatedAtが設定されていません")
+	}
+	expected := []AuditChange{{Field: "title", Before: "テストタイトル", After: "変更後タイトル"}}
+	if !reflect.DeepEqual(event.Changes, expected) {
+		t.Errorf("Changes = %v, want %v", event.Changes, expected)
+	}
+	if !event.HasChanges() {
+		t.Error("HasChanges() = false, want true")
+	}
+}
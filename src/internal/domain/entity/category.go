@@ -0,0 +1,122 @@
+package entity
+
+import (
+	"slices"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+type Category struct {
+	ID          valueobject.CategoryID
+	ParentID    *valueobject.CategoryID
+	Name        valueobject.CategoryName
+	Slug        valueobject.CategorySlug
+	Description string
+	SortOrder   int
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// 新規カテゴリ作成
+func NewCategory(name valueobject.CategoryName, slug valueobject.CategorySlug, description string, sortOrder int) (*Category, error) {
+	if err := validateCategoryAttributes(description, sortOrder); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	return &Category{
+		ID:          valueobject.NewCategoryID(),
+		Name:        name,
+		Slug:        slug,
+		Description: description,
+		SortOrder:   sortOrder,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+// カテゴリデータ再構築
+func ParseCategory(
+	id valueobject.CategoryID,
+	parentID *valueobject.CategoryID,
+	name valueobject.CategoryName,
+	slug valueobject.CategorySlug,
+	description string,
+	sortOrder int,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Category {
+	return &Category{
+		ID:          id,
+		ParentID:    parentID,
+		Name:        name,
+		Slug:        slug,
+		Description: description,
+		SortOrder:   sortOrder,
+		CreatedAt:   createdAt,
+		UpdatedAt:   updatedAt,
+	}
+}
+
+func (c *Category) Update(name valueobject.CategoryName, slug valueobject.CategorySlug, description string, sortOrder int) error {
+	if err := validateCategoryAttributes(description, sortOrder); err != nil {
+		return err
+	}
+
+	c.Name = name
+	c.Slug = slug
+	c.Description = description
+	c.SortOrder = sortOrder
+	c.UpdatedAt = time.Now()
+	return nil
+}
+
+/**
+* 親カテゴリ変更
+* parentAncestorIDs には新しい親カテゴリの祖先ID（親自身は含まない）を渡す
+* 自身または自身の子孫を親にすると循環するため許可しない
+ */
+func (c *Category) MoveTo(parentID *valueobject.CategoryID, parentAncestorIDs []valueobject.CategoryID) error {
+	if parentID != nil {
+		if parentID.Equals(c.ID) {
+			return valueobject.NewMyError(valueobject.InvalidCode, "category_cannot_be_its_own_parent")
+		}
+		if slices.Contains(parentAncestorIDs, c.ID) {
+			return valueobject.NewMyError(valueobject.InvalidCode, "category_parent_is_descendant")
+		}
+	}
+
+	c.ParentID = parentID
+	c.UpdatedAt = time.Now()
+	return nil
+}
+
+func validateCategoryAttributes(description string, sortOrder int) error {
+	if len([]rune(description)) > 500 {
+		return valueobject.NewMyError(valueobject.InvalidCode, "description_is_too_long")
+	}
+
+	// ソート順の範囲チェックのみ行い、重複は許可する
+	if sortOrder < 0 || sortOrder > 999 {
+		return valueobject.NewMyError(valueobject.InvalidCode, "invalid_sort_order")
+	}
+
+	return nil
+}
+
+// AuditFields は監査ログで比較するカテゴリの項目を返す
+func (c *Category) AuditFields() AuditFields {
+	var parentID any
+	if c.ParentID != nil {
+		parentID = c.ParentID.String()
+	}
+
+	return AuditFields{
+		"parent_id":   parentID,
+		"name":        c.Name.String(),
+		"slug":        c.Slug.String(),
+		"description": c.Description,
+		"sort_order":  c.SortOrder,
+	}
+}
@@ -0,0 +1,154 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+func TestNewCategory(t *testing.T) {
+	name, _ := valueobject.NewCategoryName("技術")
+	slug, _ := valueobject.NewCategorySlug("technology")
+
+	tests := []struct {
+		name        string
+		description string
+		sortOrder   int
+		wantErr     bool
+		expectedErr string
+	}{
+		{
+			name:        "正常ケース: カテゴリ作成",
+			description: "技術に関する記事",
+			sortOrder:   0,
+			wantErr:     false,
+		},
+		{
+			name:        "正常ケース: ソート順の上限",
+			description: "",
+			sortOrder:   999,
+			wantErr:     false,
+		},
+		{
+			name:        "異常ケース: 説明が長すぎる",
+			description: strings.Repeat("あ", 501),
+			sortOrder:   0,
+			wantErr:     true,
+			expectedErr: "description_is_too_long",
+		},
+		{
+			name:        "異常ケース: ソート順が範囲外",
+			description: "",
+			sortOrder:   1000,
+			wantErr:     true,
+			expectedErr: "invalid_sort_order",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			category, err := NewCategory(name, slug, tt.description, tt.sortOrder)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("エラーが期待されましたが、エラーが発生しませんでした")
+					return
+				}
+				if err.Error() != tt.expectedErr {
+					t.Errorf("期待されたエラーメッセージ = %v, 実際のエラーメッセージ = %v", tt.expectedErr, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("予期しないエラー: %v", err)
+				return
+			}
+
+			if category.ID.String() == "" {
+				t.Error("カテゴリIDが生成されていません")
+			}
+
+			if category.ParentID != nil {
+				t.Error("新規作成時は親カテゴリなしである必要があります")
+			}
+
+			if category.CreatedAt.IsZero() || category.UpdatedAt.IsZero() {
+				t.Error("タイムスタンプが設定されていません")
+			}
+		})
+	}
+}
+
+func TestCategory_MoveTo(t *testing.T) {
+	name, _ := valueobject.NewCategoryName("技術")
+	slug, _ := valueobject.NewCategorySlug("technology")
+	category, _ := NewCategory(name, slug, "", 0)
+
+	parentID := valueobject.NewCategoryID()
+	grandParentID := valueobject.NewCategoryID()
+	selfID := category.ID
+
+	tests := []struct {
+		name              string
+		parentID          *valueobject.CategoryID
+		parentAncestorIDs []valueobject.CategoryID
+		wantErr           bool
+		expectedErr       string
+	}{
+		{
+			name:              "正常ケース: 親カテゴリを設定",
+			parentID:          &parentID,
+			parentAncestorIDs: []valueobject.CategoryID{grandParentID},
+			wantErr:           false,
+		},
+		{
+			name:     "正常ケース: ルートへ移動",
+			parentID: nil,
+			wantErr:  false,
+		},
+		{
+			name:        "異常ケース: 自身を親に設定",
+			parentID:    &selfID,
+			wantErr:     true,
+			expectedErr: "category_cannot_be_its_own_parent",
+		},
+		{
+			name:              "異常ケース: 子孫を親に設定",
+			parentID:          &parentID,
+			parentAncestorIDs: []valueobject.CategoryID{grandParentID, selfID},
+			wantErr:           true,
+			expectedErr:       "category_parent_is_descendant",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := category.MoveTo(tt.parentID, tt.parentAncestorIDs)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("エラーが期待されましたが、エラーが発生しませんでした")
+					return
+				}
+				if err.Error() != tt.expectedErr {
+					t.Errorf("期待されたエラーメッセージ = %v, 実際のエラーメッセージ = %v", tt.expectedErr, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("予期しないエラー: %v", err)
+				return
+			}
+
+			if tt.parentID == nil && category.ParentID != nil {
+				t.Error("ParentIDがnilになっていません")
+			}
+			if tt.parentID != nil && !category.ParentID.Equals(*tt.parentID) {
+				t.Errorf("ParentID = %v, want %v", *category.ParentID, *tt.parentID)
+			}
+		})
+	}
+}
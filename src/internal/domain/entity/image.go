@@ -3,6 +3,7 @@ package entity
 import (
 	"time"
 
+	"github.com/MizukiShigi/cms-go/internal/domain/event"
 	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
 )
 
@@ -16,11 +17,14 @@ type Image struct {
 	SortOrder        int
 	CreatedAt        time.Time
 	UpdatedAt        time.Time
+
+	// 保存時に発行するドメインイベント
+	event.Recorder
 }
 
 func NewImage(originalFilename valueobject.ImageFilename, storedFilename string, gcsURL string, postID valueobject.PostID, userID valueobject.UserID, sortOrder int) *Image {
 	now := time.Now()
-	return &Image{
+	image := &Image{
 		ID:               valueobject.NewImageID(),
 		OriginalFilename: originalFilename,
 		StoredFilename:   storedFilename,
@@ -31,6 +35,10 @@ func NewImage(originalFilename valueobject.ImageFilename, storedFilename string,
 		CreatedAt:        now,
 		UpdatedAt:        now,
 	}
+
+	image.Raise(event.ImageUploaded{ImageID: image.ID, PostID: postID, UserID: userID, At: now})
+
+	return image
 }
 
 func ParseImage(
@@ -56,3 +64,13 @@ func ParseImage(
 		UpdatedAt:        updatedAt,
 	}
 }
+
+// AuditFields は監査ログで比較する画像の項目を返す
+func (i *Image) AuditFields() AuditFields {
+	return AuditFields{
+		"post_id":           i.PostID.String(),
+		"original_filename": i.OriginalFilename.String(),
+		"stored_filename":   i.StoredFilename,
+		"sort_order":        i.SortOrder,
+	}
+}
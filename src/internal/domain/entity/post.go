@@ -4,20 +4,36 @@ import (
 	"slices"
 	"time"
 
+	"github.com/MizukiShigi/cms-go/internal/domain/event"
 	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
 )
 
 type Post struct {
-	ID               valueobject.PostID
-	Title            valueobject.PostTitle
-	Content          valueobject.PostContent
-	UserID           valueobject.UserID
-	Status           valueobject.PostStatus
-	CreatedAt        time.Time
-	UpdatedAt        time.Time
-	FirstPublishedAt *time.Time
-	ContentUpdatedAt *time.Time
-	Tags             []valueobject.TagName
+	ID                valueobject.PostID
+	Title             valueobject.PostTitle
+	Content           valueobject.PostContent
+	UserID            valueobject.UserID
+	Status            valueobject.PostStatus
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	FirstPublishedAt  *time.Time
+	ContentUpdatedAt  *time.Time
+	Tags              []valueobject.TagName
+	PrimaryCategoryID *valueobject.CategoryID
+	CategoryIDs       []valueobject.CategoryID
+	// 楽観的排他制御のためのバージョン（更新のたびに加算される）
+	Version int
+	// レビューを担当するユーザー（レビュー依頼時に指定する）
+	ReviewerID *valueobject.UserID
+	// SEO用の項目（空の場合は未設定）
+	Excerpt         valueobject.PostExcerpt
+	MetaDescription valueobject.MetaDescription
+	CanonicalURL    valueobject.CanonicalURL
+	// OGP画像として使用する画像（未設定の場合はnil）
+	OGImage *Image
+
+	// 保存時に発行するドメインイベント
+	event.Recorder
 }
 
 // 新規投稿作成
@@ -38,6 +54,12 @@ func NewPost(title valueobject.PostTitle, content valueobject.PostContent, userI
 		ContentUpdatedAt: &now,
 		CreatedAt:        now,
 		UpdatedAt:        now,
+		Version:          1,
+	}
+
+	post.Raise(event.PostCreated{PostID: post.ID, UserID: userID, Status: status, At: now})
+	if status == valueobject.StatusPublished {
+		post.Raise(event.PostPublished{PostID: post.ID, At: now})
 	}
 
 	return post, nil
@@ -71,89 +93,171 @@ func ParsePost(
 }
 
 func (p *Post) AddTag(tag valueobject.TagName) error {
-	// タグの重複チェック
-	if slices.Contains(p.Tags, tag) {
-		return valueobject.NewMyError(valueobject.InvalidCode, "Tag already exists")
+	// タグの重複チェック（大文字小文字・全角半角の違いは同一タグとみなす）
+	if p.HasTag(tag) {
+		return valueobject.NewMyError(valueobject.InvalidCode, "tag_already_exists")
 	}
 
 	// 最大タグ数チェック
 	if len(p.Tags) >= 10 {
-		return valueobject.NewMyError(valueobject.InvalidCode, "Maximum number of tags reached")
+		return valueobject.NewMyError(valueobject.InvalidCode, "maximum_number_of_tags_reached")
 	}
 
 	p.Tags = append(p.Tags, tag)
 	return nil
 }
 
-/**
-* ステータス遷移許容
-* 下書き->（公開、削除）
-* 公開->（非公開）
-* 非公開->（公開、削除）
- */
-func (p *Post) SetStatus(status valueobject.PostStatus) error {
-	if p.Status == status {
-		return nil
+// CheckVersion は取得時のバージョンから更新されていないかを確認する
+func (p *Post) CheckVersion(version int) error {
+	if p.Version != version {
+		return valueobject.NewStaleVersionError("post_modified_by_another_request")
 	}
+	return nil
+}
 
-	switch status {
-	case valueobject.StatusDraft:
-		return p.draft()
-	case valueobject.StatusPublished:
-		return p.publish()
-	case valueobject.StatusPrivate:
-		return p.private()
-	case valueobject.StatusDeleted:
-		return p.delete()
-	default:
-		return valueobject.NewMyError(valueobject.InvalidCode, "Invalid post status")
+// CheckContentEditable はタイトル・本文を指定の値に変更できるかを確認する
+// レビュー中・承認済みの投稿はレビュー後の書き換えを防ぐため、下書きに戻すまで変更できない
+func (p *Post) CheckContentEditable(title valueobject.PostTitle, content valueobject.PostContent) error {
+	if p.Title.Equals(title) && p.Content.Equals(content) {
+		return nil
+	}
+	if p.Status == valueobject.StatusInReview || p.Status == valueobject.StatusApproved {
+		return valueobject.NewMyError(valueobject.ConflictCode, "post_content_is_locked_during_review")
 	}
+	return nil
 }
 
-func (p *Post) draft() error {
-	if p.Status != valueobject.StatusDraft {
-		return valueobject.NewMyError(valueobject.InvalidCode, "Only draft posts can be drafted")
+// HasTag は表記揺れを考慮して投稿にタグが付いているかを判定する
+func (p *Post) HasTag(tag valueobject.TagName) bool {
+	return slices.ContainsFunc(p.Tags, tag.SameKey)
+}
+
+// RemoveTag はタグを外す（付いていない場合は何もしない）
+func (p *Post) RemoveTag(tag valueobject.TagName) {
+	p.Tags = slices.DeleteFunc(p.Tags, tag.SameKey)
+}
+
+// ReplaceTags はタグをすべて置き換える
+func (p *Post) ReplaceTags(tags []valueobject.TagName) error {
+	replaced := &Post{}
+	for _, tag := range tags {
+		if err := replaced.AddTag(tag); err != nil {
+			return err
+		}
 	}
 
-	p.Status = valueobject.StatusDraft
-	*p.ContentUpdatedAt = time.Now()
+	p.Tags = replaced.Tags
 	return nil
 }
 
-func (p *Post) publish() error {
-	if p.Status != valueobject.StatusDraft && p.Status != valueobject.StatusPrivate {
-		return valueobject.NewMyError(valueobject.InvalidCode, "Only draft and private posts can be published")
+// SetCategories は主カテゴリと副カテゴリを設定する
+func (p *Post) SetCategories(primaryCategoryID *valueobject.CategoryID, categoryIDs []valueobject.CategoryID) error {
+	if primaryCategoryID == nil && len(categoryIDs) > 0 {
+		return valueobject.NewMyError(valueobject.InvalidCode, "primary_category_required")
 	}
 
-	p.Status = valueobject.StatusPublished
+	// 最大カテゴリ数チェック
+	if len(categoryIDs) > 5 {
+		return valueobject.NewMyError(valueobject.InvalidCode, "too_many_secondary_categories")
+	}
 
-	now := time.Now()
-	if p.FirstPublishedAt == nil || p.FirstPublishedAt.IsZero() {
-		p.FirstPublishedAt = &now
+	secondary := make([]valueobject.CategoryID, 0, len(categoryIDs))
+	for _, categoryID := range categoryIDs {
+		if categoryID.Equals(*primaryCategoryID) {
+			return valueobject.NewMyError(valueobject.InvalidCode, "primary_category_duplicated")
+		}
+		if slices.Contains(secondary, categoryID) {
+			return valueobject.NewMyError(valueobject.InvalidCode, "category_already_exists")
+		}
+		secondary = append(secondary, categoryID)
 	}
 
-	p.ContentUpdatedAt = &now
+	p.PrimaryCategoryID = primaryCategoryID
+	p.CategoryIDs = secondary
 	return nil
 }
 
-func (p *Post) private() error {
-	if p.Status != valueobject.StatusPublished {
-		return valueobject.NewMyError(valueobject.InvalidCode, "Only published posts can be private")
+// AssignReviewer はレビュアーを指定する。投稿者のみ指定でき、投稿者自身はレビュアーになれない
+func (p *Post) AssignReviewer(reviewerID valueobject.UserID, actor Actor) error {
+	if p.Status != valueobject.StatusDraft && p.Status != valueobject.StatusInReview {
+		return valueobject.NewMyError(valueobject.InvalidCode, "reviewer_assignment_not_allowed")
+	}
+	if !p.isAuthor(actor) {
+		return valueobject.NewMyError(valueobject.ForbiddenCode, "only_the_author_can_assign_a_reviewer")
+	}
+	if reviewerID.Equals(p.UserID) {
+		return valueobject.NewMyError(valueobject.InvalidCode, "author_cannot_review_own_post")
 	}
 
-	p.Status = valueobject.StatusPrivate
-	now := time.Now()
-	p.ContentUpdatedAt = &now
+	p.ReviewerID = &reviewerID
 	return nil
 }
 
-func (p *Post) delete() error {
-	if p.Status != valueobject.StatusDraft && p.Status != valueobject.StatusPrivate {
-		return valueobject.NewMyError(valueobject.InvalidCode, "Only draft and private posts can be deleted")
+// CheckReviewable はレビュー中の投稿を担当レビュアーとしてレビューできるかを確認する
+func (p *Post) CheckReviewable(actor Actor) error {
+	if p.Status != valueobject.StatusInReview {
+		return valueobject.NewMyError(valueobject.InvalidCode, "only_in_review_posts_can_be_reviewed")
+	}
+	if !p.isAssignedReviewer(actor) {
+		return valueobject.NewMyError(valueobject.ForbiddenCode, "only_reviewer_can_review_post")
 	}
-
-	p.Status = valueobject.StatusDeleted
-	now := time.Now()
-	p.ContentUpdatedAt = &now
 	return nil
 }
+
+func (p *Post) isAuthor(actor Actor) bool {
+	return p.UserID.Equals(actor.UserID)
+}
+
+func (p *Post) isAssignedReviewer(actor Actor) bool {
+	return p.ReviewerID != nil && p.ReviewerID.Equals(actor.UserID) && actor.HasRole(valueobject.RoleReviewer)
+}
+
+// DisplayExcerpt は抜粋が未設定の場合に本文から生成した抜粋を返す
+func (p *Post) DisplayExcerpt() valueobject.PostExcerpt {
+	if p.Excerpt != "" {
+		return p.Excerpt
+	}
+	return valueobject.NewPostExcerptFromContent(p.Content)
+}
+
+// AuditFields は監査ログで比較する投稿の項目を返す
+func (p *Post) AuditFields() AuditFields {
+	tags := make([]string, 0, len(p.Tags))
+	for _, tag := range p.Tags {
+		tags = append(tags, tag.String())
+	}
+
+	var primaryCategoryID any
+	if p.PrimaryCategoryID != nil {
+		primaryCategoryID = p.PrimaryCategoryID.String()
+	}
+
+	categoryIDs := make([]string, 0, len(p.CategoryIDs))
+	for _, categoryID := range p.CategoryIDs {
+		categoryIDs = append(categoryIDs, categoryID.String())
+	}
+
+	var reviewerID any
+	if p.ReviewerID != nil {
+		reviewerID = p.ReviewerID.String()
+	}
+
+	var ogImageID any
+	if p.OGImage != nil {
+		ogImageID = p.OGImage.ID.String()
+	}
+
+	return AuditFields{
+		"title":               p.Title.String(),
+		"content":             p.Content.String(),
+		"status":              p.Status.String(),
+		"tags":                tags,
+		"primary_category_id": primaryCategoryID,
+		"category_ids":        categoryIDs,
+		"reviewer_id":         reviewerID,
+		"excerpt":             p.Excerpt.String(),
+		"meta_description":    p.MetaDescription.String(),
+		"canonical_url":       p.CanonicalURL.String(),
+		"og_image_id":         ogImageID,
+	}
+}
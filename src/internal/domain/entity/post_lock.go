@@ -0,0 +1,60 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+// PostLockDuration は編集ロックの有効期間。保持者はこの期間内にハートビートで延長する
+const PostLockDuration = 5 * time.Minute
+
+// PostLock は投稿の編集ロック（他の編集者に編集中であることを示す勧告的なロック）
+type PostLock struct {
+	PostID     valueobject.PostID
+	UserID     valueobject.UserID
+	AcquiredAt time.Time
+	ExpiresAt  time.Time
+}
+
+// 新規ロック作成
+func NewPostLock(postID valueobject.PostID, userID valueobject.UserID, now time.Time) *PostLock {
+	return &PostLock{
+		PostID:     postID,
+		UserID:     userID,
+		AcquiredAt: now,
+		ExpiresAt:  now.Add(PostLockDuration),
+	}
+}
+
+// ロックデータ再構築
+func ParsePostLock(postID valueobject.PostID, userID valueobject.UserID, acquiredAt time.Time, expiresAt time.Time) *PostLock {
+	return &PostLock{
+		PostID:     postID,
+		UserID:     userID,
+		AcquiredAt: acquiredAt,
+		ExpiresAt:  expiresAt,
+	}
+}
+
+func (l *PostLock) IsHeldBy(userID valueobject.UserID) bool {
+	return l.UserID.Equals(userID)
+}
+
+func (l *PostLock) IsExpired(now time.Time) bool {
+	return !now.Before(l.ExpiresAt)
+}
+
+// Extend は有効期限を現在時刻から延長する
+func (l *PostLock) Extend(now time.Time) {
+	l.ExpiresAt = now.Add(PostLockDuration)
+}
+
+// CheckEditable は指定ユーザーが投稿を保存できるかを確認する
+// 期限切れのロックは他のユーザーの保存を妨げない
+func (l *PostLock) CheckEditable(userID valueobject.UserID, now time.Time) error {
+	if l.IsExpired(now) || l.IsHeldBy(userID) {
+		return nil
+	}
+	return valueobject.NewMyError(valueobject.ConflictCode, "post_is_locked_by_another_user")
+}
@@ -0,0 +1,99 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+func TestNewPostLock(t *testing.T) {
+	postID := valueobject.NewPostID()
+	userID := valueobject.NewUserID()
+	now := time.Now()
+
+	lock := NewPostLock(postID, userID, now)
+
+	if lock.PostID != postID {
+		t.Errorf("PostID = %v, want %v", lock.PostID, postID)
+	}
+	if lock.UserID != userID {
+		t.Errorf("UserID = %v, want %v", lock.UserID, userID)
+	}
+	if !lock.AcquiredAt.Equal(now) {
+		t.Errorf("AcquiredAt = %v, want %v", lock.AcquiredAt, now)
+	}
+	if !lock.ExpiresAt.Equal(now.Add(PostLockDuration)) {
+		t.Errorf("ExpiresAt = %v, want %v", lock.ExpiresAt, now.Add(PostLockDuration))
+	}
+}
+
+func TestPostLock_Extend(t *testing.T) {
+	acquiredAt := time.Now().Add(-3 * time.Minute)
+	lock := NewPostLock(valueobject.NewPostID(), valueobject.NewUserID(), acquiredAt)
+
+	now := time.Now()
+	lock.Extend(now)
+
+	if !lock.ExpiresAt.Equal(now.Add(PostLockDuration)) {
+		t.Errorf("ExpiresAt = %v, want %v", lock.ExpiresAt, now.Add(PostLockDuration))
+	}
+	// 取得日時は変わらない
+	if !lock.AcquiredAt.Equal(acquiredAt) {
+		t.Errorf("AcquiredAt = %v, want %v", lock.AcquiredAt, acquiredAt)
+	}
+}
+
+func TestPostLock_CheckEditable(t *testing.T) {
+	holderID := valueobject.NewUserID()
+	otherID := valueobject.NewUserID()
+	now := time.Now()
+
+	tests := []struct {
+		name       string
+		acquiredAt time.Time
+		userID     valueobject.UserID
+		wantErr    bool
+	}{
+		{
+			name:       "ロック保持者は保存できる",
+			acquiredAt: now,
+			userID:     holderID,
+			wantErr:    false,
+		},
+		{
+			name:       "有効なロックがある場合は他のユーザーは保存できない",
+			acquiredAt: now,
+			userID:     otherID,
+			wantErr:    true,
+		},
+		{
+			name:       "期限切れのロックは他のユーザーの保存を妨げない",
+			acquiredAt: now.Add(-PostLockDuration),
+			userID:     otherID,
+			wantErr:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lock := NewPostLock(valueobject.NewPostID(), holderID, tt.acquiredAt)
+
+			err := lock.CheckEditable(tt.userID, now)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("エラーが期待されましたが、nilでした")
+				}
+				myErr, ok := err.(*valueobject.MyError)
+				if !ok || myErr.Code != valueobject.ConflictCode {
+					t.Errorf("err = %v, want ConflictCode", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("予期しないエラー: %v", err)
+			}
+		})
+	}
+}
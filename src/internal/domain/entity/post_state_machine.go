@@ -0,0 +1,238 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/event"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+// ガード条件（遷移を実行できるユーザー・投稿の条件）
+const (
+	// GuardAuthor は投稿者のみ遷移できる
+	GuardAuthor = "author"
+	// GuardAssignedReviewer はレビュアーロールを持つ担当レビュアーのみ遷移できる
+	GuardAssignedReviewer = "assigned_reviewer"
+	// GuardReviewerAssigned はレビュアーが指定されている場合のみ遷移できる
+	GuardReviewerAssigned = "reviewer_assigned"
+	// GuardRolePrefix に続けてロール名を指定すると、そのロールを持つユーザーのみ遷移できる（例: role:publisher）
+	GuardRolePrefix = "role:"
+)
+
+// 遷移時の副作用
+const (
+	// EffectSetFirstPublishedAt は初回公開日時が未設定の場合に設定する
+	EffectSetFirstPublishedAt = "set_first_published_at"
+	// EffectTouchContentUpdatedAt はコンテンツ更新日時を更新する
+	EffectTouchContentUpdatedAt = "touch_content_updated_at"
+)
+
+// PostTransitionRule はステータス遷移の定義
+// 同じ遷移元・遷移先のルールが複数ある場合は、いずれかのガード条件を満たせば遷移できる
+type PostTransitionRule struct {
+	// Name は遷移の名前（UIのボタン表示などに使用する）
+	Name    string
+	From    valueobject.PostStatus
+	To      valueobject.PostStatus
+	Guards  []string
+	Effects []string
+}
+
+// PostTransition は実行可能な遷移
+type PostTransition struct {
+	Name string
+	To   valueobject.PostStatus
+}
+
+// PostStateMachine は投稿ステータスの遷移ルールを保持する
+type PostStateMachine struct {
+	rules []PostTransitionRule
+}
+
+// NewPostStateMachine は遷移ルールを検証してステートマシンを作成する
+func NewPostStateMachine(rules []PostTransitionRule) (*PostStateMachine, error) {
+	if len(rules) == 0 {
+		return nil, valueobject.NewMyError(valueobject.InvalidCode, "post_transition_rules_are_required")
+	}
+
+	for _, rule := range rules {
+		if err := validatePostTransitionRule(rule); err != nil {
+			return nil, err
+		}
+	}
+
+	return &PostStateMachine{rules: rules}, nil
+}
+
+// DefaultPostStateMachine は既定の遷移ルールでステートマシンを作成する
+func DefaultPostStateMachine() *PostStateMachine {
+	machine, err := NewPostStateMachine(DefaultPostTransitionRules())
+	if err != nil {
+		panic(err)
+	}
+	return machine
+}
+
+/**
+* 既定のステータス遷移
+* 下書き->（レビュー中: 投稿者、削除）
+* レビュー中->（承認済み: 担当レビュアー、下書き: 投稿者または担当レビュアー）
+* 承認済み->（公開: 公開権限、下書き: 投稿者）
+* 公開->（非公開: 公開権限）
+* 非公開->（公開: 公開権限、削除）
+* 削除->（下書き: 復元）
+ */
+func DefaultPostTransitionRules() []PostTransitionRule {
+	publisher := GuardRolePrefix + valueobject.RolePublisher.String()
+	touch := []string{EffectTouchContentUpdatedAt}
+
+	return []PostTransitionRule{
+		{Name: "submit_for_review", From: valueobject.StatusDraft, To: valueobject.StatusInReview, Guards: []string{GuardAuthor, GuardReviewerAssigned}, Effects: touch},
+		{Name: "delete", From: valueobject.StatusDraft, To: valueobject.StatusDeleted, Guards: []string{GuardAuthor}, Effects: touch},
+		{Name: "approve", From: valueobject.StatusInReview, To: valueobject.StatusApproved, Guards: []string{GuardAssignedReviewer}, Effects: touch},
+		{Name: "withdraw", From: valueobject.StatusInReview, To: valueobject.StatusDraft, Guards: []string{GuardAuthor}, Effects: touch},
+		{Name: "request_changes", From: valueobject.StatusInReview, To: valueobject.StatusDraft, Guards: []string{GuardAssignedReviewer}, Effects: touch},
+		{Name: "publish", From: valueobject.StatusApproved, To: valueobject.StatusPublished, Guards: []string{publisher}, Effects: []string{EffectSetFirstPublishedAt, EffectTouchContentUpdatedAt}},
+		{Name: "return_to_draft", From: valueobject.StatusApproved, To: valueobject.StatusDraft, Guards: []string{GuardAuthor}, Effects: touch},
+		{Name: "unpublish", From: valueobject.StatusPublished, To: valueobject.StatusPrivate, Guards: []string{publisher}, Effects: touch},
+		{Name: "republish", From: valueobject.StatusPrivate, To: valueobject.StatusPublished, Guards: []string{publisher}, Effects: []string{EffectSetFirstPublishedAt, EffectTouchContentUpdatedAt}},
+		{Name: "delete", From: valueobject.StatusPrivate, To: valueobject.StatusDeleted, Guards: []string{GuardAuthor}, Effects: touch},
+		{Name: "restore", From: valueobject.StatusDeleted, To: valueobject.StatusDraft, Guards: []string{GuardAuthor}, Effects: touch},
+	}
+}
+
+// Transit は遷移ルールに従って投稿のステータスを変更し、副作用を適用する
+func (m *PostStateMachine) Transit(post *Post, to valueobject.PostStatus, actor Actor) error {
+	if post.Status == to {
+		return nil
+	}
+
+	var guardErr error
+	for _, rule := range m.rules {
+		if rule.From != post.Status || rule.To != to {
+			continue
+		}
+
+		if err := checkPostTransitionGuards(post, rule, actor); err != nil {
+			// 最初に該当したルールのエラーを返す
+			if guardErr == nil {
+				guardErr = err
+			}
+			continue
+		}
+
+		from := post.Status
+		now := time.Now()
+		post.Status = to
+		applyPostTransitionEffects(post, rule, now)
+		raisePostStatusEvents(post, from, to, now)
+		return nil
+	}
+
+	if guardErr != nil {
+		return guardErr
+	}
+	return valueobject.NewMyError(valueobject.InvalidCode, "cannot_change_post_status", post.Status, to)
+}
+
+// AvailableTransitions は現在のステータスからユーザーが実行できる遷移を定義順に返す
+func (m *PostStateMachine) AvailableTransitions(post *Post, actor Actor) []PostTransition {
+	transitions := []PostTransition{}
+	seen := map[valueobject.PostStatus]bool{}
+	for _, rule := range m.rules {
+		if rule.From != post.Status || seen[rule.To] {
+			continue
+		}
+		if err := checkPostTransitionGuards(post, rule, actor); err != nil {
+			continue
+		}
+
+		seen[rule.To] = true
+		transitions = append(transitions, PostTransition{Name: rule.Name, To: rule.To})
+	}
+	return transitions
+}
+
+func validatePostTransitionRule(rule PostTransitionRule) error {
+	if rule.Name == "" {
+		return valueobject.NewMyError(valueobject.InvalidCode, "post_transition_name_is_required")
+	}
+	if _, err := valueobject.NewPostStatus(rule.From.String()); err != nil {
+		return valueobject.NewMyError(valueobject.InvalidCode, "invalid_post_transition_from", rule.Name)
+	}
+	if _, err := valueobject.NewPostStatus(rule.To.String()); err != nil {
+		return valueobject.NewMyError(valueobject.InvalidCode, "invalid_post_transition_to", rule.Name)
+	}
+	if rule.From == rule.To {
+		return valueobject.NewMyError(valueobject.InvalidCode, "post_transition_must_change_status", rule.Name)
+	}
+
+	for _, guard := range rule.Guards {
+		switch {
+		case guard == GuardAuthor, guard == GuardAssignedReviewer, guard == GuardReviewerAssigned:
+		case strings.HasPrefix(guard, GuardRolePrefix) && len(guard) > len(GuardRolePrefix):
+		default:
+			return valueobject.NewMyError(valueobject.InvalidCode, "unknown_post_transition_guard", guard, rule.Name)
+		}
+	}
+
+	for _, effect := range rule.Effects {
+		switch effect {
+		case EffectSetFirstPublishedAt, EffectTouchContentUpdatedAt:
+		default:
+			return valueobject.NewMyError(valueobject.InvalidCode, "unknown_post_transition_effect", effect, rule.Name)
+		}
+	}
+
+	return nil
+}
+
+func checkPostTransitionGuards(post *Post, rule PostTransitionRule, actor Actor) error {
+	for _, guard := range rule.Guards {
+		switch {
+		case guard == GuardAuthor:
+			if !post.isAuthor(actor) {
+				return valueobject.NewMyError(valueobject.ForbiddenCode, "only_the_author_can_change_the_post_status")
+			}
+		case guard == GuardAssignedReviewer:
+			if !post.isAssignedReviewer(actor) {
+				return valueobject.NewMyError(valueobject.ForbiddenCode, "only_reviewer_can_change_post_status")
+			}
+		case guard == GuardReviewerAssigned:
+			if post.ReviewerID == nil {
+				return valueobject.NewMyError(valueobject.InvalidCode, "reviewer_must_be_assigned_before_review")
+			}
+		case strings.HasPrefix(guard, GuardRolePrefix):
+			role := valueobject.Role(strings.TrimPrefix(guard, GuardRolePrefix))
+			if !actor.HasRole(role) {
+				return valueobject.NewMyError(valueobject.ForbiddenCode, "role_required", role)
+			}
+		}
+	}
+	return nil
+}
+
+func applyPostTransitionEffects(post *Post, rule PostTransitionRule, now time.Time) {
+	for _, effect := range rule.Effects {
+		switch effect {
+		case EffectSetFirstPublishedAt:
+			if post.FirstPublishedAt == nil || post.FirstPublishedAt.IsZero() {
+				post.FirstPublishedAt = &now
+			}
+		case EffectTouchContentUpdatedAt:
+			post.ContentUpdatedAt = &now
+		}
+	}
+}
+
+// raisePostStatusEvents はステータス変更と、公開・公開取り下げのイベントを記録する
+func raisePostStatusEvents(post *Post, from valueobject.PostStatus, to valueobject.PostStatus, now time.Time) {
+	post.Raise(event.PostStatusChanged{PostID: post.ID, From: from, To: to, At: now})
+	switch {
+	case to == valueobject.StatusPublished:
+		post.Raise(event.PostPublished{PostID: post.ID, At: now})
+	case from == valueobject.StatusPublished:
+		post.Raise(event.PostUnpublished{PostID: post.ID, To: to, At: now})
+	}
+}
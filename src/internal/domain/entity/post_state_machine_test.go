@@ -0,0 +1,505 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/event"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+func TestPostStateMachine_Transit(t *testing.T) {
+	authorID := valueobject.NewUserID()
+	reviewerID := valueobject.NewUserID()
+	title, _ := valueobject.NewPostTitle("テストタイトル")
+	content, _ := valueobject.NewPostContent("テストコンテンツ")
+
+	author := NewActor(authorID, nil)
+	reviewer := NewActor(reviewerID, []valueobject.Role{valueobject.RoleReviewer})
+	reviewerWithoutRole := NewActor(reviewerID, nil)
+	publisher := NewActor(valueobject.NewUserID(), []valueobject.Role{valueobject.RolePublisher})
+
+	tests := []struct {
+		name             string
+		initialStatus    valueobject.PostStatus
+		reviewerAssigned bool
+		actor            Actor
+		targetStatus     valueobject.PostStatus
+		wantErr          bool
+		expectedErr      valueobject.MessageKey
+		expectedCode     valueobject.Code
+	}{
+		{
+			name:             "正常ケース: 投稿者が下書きをレビュー依頼",
+			initialStatus:    valueobject.StatusDraft,
+			reviewerAssigned: true,
+			actor:            author,
+			targetStatus:     valueobject.StatusInReview,
+		},
+		{
+			name:          "正常ケース: 下書きから削除",
+			initialStatus: valueobject.StatusDraft,
+			actor:         author,
+			targetStatus:  valueobject.StatusDeleted,
+		},
+		{
+			name:          "正常ケース: 削除済みの投稿を下書きに復元",
+			initialStatus: valueobject.StatusDeleted,
+			actor:         author,
+			targetStatus:  valueobject.StatusDraft,
+		},
+		{
+			name:             "正常ケース: 担当レビュアーが承認",
+			initialStatus:    valueobject.StatusInReview,
+			reviewerAssigned: true,
+			actor:            reviewer,
+			targetStatus:     valueobject.StatusApproved,
+		},
+		{
+			name:             "正常ケース: 担当レビュアーが下書きに差し戻し",
+			initialStatus:    valueobject.StatusInReview,
+			reviewerAssigned: true,
+			actor:            reviewer,
+			targetStatus:     valueobject.StatusDraft,
+		},
+		{
+			name:             "正常ケース: 投稿者がレビュー依頼を取り下げ",
+			initialStatus:    valueobject.StatusInReview,
+			reviewerAssigned: true,
+			actor:            author,
+			targetStatus:     valueobject.StatusDraft,
+		},
+		{
+			name:          "正常ケース: 公開権限を持つユーザーが承認済みを公開",
+			initialStatus: valueobject.StatusApproved,
+			actor:         publisher,
+			targetStatus:  valueobject.StatusPublished,
+		},
+		{
+			name:          "正常ケース: 公開から非公開",
+			initialStatus: valueobject.StatusPublished,
+			actor:         publisher,
+			targetStatus:  valueobject.StatusPrivate,
+		},
+		{
+			name:          "正常ケース: 非公開から公開",
+			initialStatus: valueobject.StatusPrivate,
+			actor:         publisher,
+			targetStatus:  valueobject.StatusPublished,
+		},
+		{
+			name:          "正常ケース: 非公開から削除",
+			initialStatus: valueobject.StatusPrivate,
+			actor:         author,
+			targetStatus:  valueobject.StatusDeleted,
+		},
+		{
+			name:          "異常ケース: 下書きから直接公開（レビュー未承認）",
+			initialStatus: valueobject.StatusDraft,
+			actor:         publisher,
+			targetStatus:  valueobject.StatusPublished,
+			wantErr:       true,
+			expectedErr:   "cannot_change_post_status",
+			expectedCode:  valueobject.InvalidCode,
+		},
+		{
+			name:          "異常ケース: 公開権限のないユーザーが承認済みを公開",
+			initialStatus: valueobject.StatusApproved,
+			actor:         author,
+			targetStatus:  valueobject.StatusPublished,
+			wantErr:       true,
+			expectedErr:   "role_required",
+			expectedCode:  valueobject.ForbiddenCode,
+		},
+		{
+			name:          "異常ケース: レビュアー未指定でレビュー依頼",
+			initialStatus: valueobject.StatusDraft,
+			actor:         author,
+			targetStatus:  valueobject.StatusInReview,
+			wantErr:       true,
+			expectedErr:   "reviewer_must_be_assigned_before_review",
+			expectedCode:  valueobject.InvalidCode,
+		},
+		{
+			name:             "異常ケース: 投稿者以外がレビュー依頼",
+			initialStatus:    valueobject.StatusDraft,
+			reviewerAssigned: true,
+			actor:            reviewer,
+			targetStatus:     valueobject.StatusInReview,
+			wantErr:          true,
+			expectedErr:      "only_the_author_can_change_the_post_status",
+			expectedCode:     valueobject.ForbiddenCode,
+		},
+		{
+			name:             "異常ケース: 投稿者が自分で承認",
+			initialStatus:    valueobject.StatusInReview,
+			reviewerAssigned: true,
+			actor:            author,
+			targetStatus:     valueobject.StatusApproved,
+			wantErr:          true,
+			expectedErr:      "only_reviewer_can_change_post_status",
+			expectedCode:     valueobject.ForbiddenCode,
+		},
+		{
+			name:             "異常ケース: レビュアーロールのない担当者が承認",
+			initialStatus:    valueobject.StatusInReview,
+			reviewerAssigned: true,
+			actor:            reviewerWithoutRole,
+			targetStatus:     valueobject.StatusApproved,
+			wantErr:          true,
+			expectedErr:      "only_reviewer_can_change_post_status",
+			expectedCode:     valueobject.ForbiddenCode,
+		},
+		{
+			name:          "異常ケース: 投稿者以外が下書きを削除",
+			initialStatus: valueobject.StatusDraft,
+			actor:         publisher,
+			targetStatus:  valueobject.StatusDeleted,
+			wantErr:       true,
+			expectedErr:   "only_the_author_can_change_the_post_status",
+			expectedCode:  valueobject.ForbiddenCode,
+		},
+		{
+			name:          "異常ケース: 投稿者以外が削除済みの投稿を復元",
+			initialStatus: valueobject.StatusDeleted,
+			actor:         reviewer,
+			targetStatus:  valueobject.StatusDraft,
+			wantErr:       true,
+			expectedErr:   "only_the_author_can_change_the_post_status",
+			expectedCode:  valueobject.ForbiddenCode,
+		},
+		{
+			name:          "異常ケース: 公開から削除（不正な遷移）",
+			initialStatus: valueobject.StatusPublished,
+			actor:         publisher,
+			targetStatus:  valueobject.StatusDeleted,
+			wantErr:       true,
+			expectedErr:   "cannot_change_post_status",
+			expectedCode:  valueobject.InvalidCode,
+		},
+		{
+			name:          "異常ケース: 削除から公開（不正な遷移）",
+			initialStatus: valueobject.StatusDeleted,
+			actor:         publisher,
+			targetStatus:  valueobject.StatusPublished,
+			wantErr:       true,
+			expectedErr:   "cannot_change_post_status",
+			expectedCode:  valueobject.InvalidCode,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			post, _ := NewPost(title, content, authorID, tt.initialStatus)
+			if tt.reviewerAssigned {
+				post.ReviewerID = &reviewerID
+			}
+
+			err := DefaultPostStateMachine().Transit(post, tt.targetStatus, tt.actor)
+
+			if tt.wantErr {
+				var myErr *valueobject.MyError
+				if !errors.As(err, &myErr) {
+					t.Fatalf("MyErrorが期待されましたが、%vでした", err)
+				}
+				if myErr.Key() != tt.expectedErr {
+					t.Errorf("期待されたエラーメッセージのキー = %v, 実際のキー = %v", tt.expectedErr, myErr.Key())
+				}
+				if myErr.Code != tt.expectedCode {
+					t.Errorf("Code = %v, want %v", myErr.Code, tt.expectedCode)
+				}
+				if !post.Status.Equals(tt.initialStatus) {
+					t.Errorf("エラー時にステータスが変更されています: %v", post.Status)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("予期しないエラー: %v", err)
+				return
+			}
+
+			if !post.Status.Equals(tt.targetStatus) {
+				t.Errorf("Status = %v, want %v", post.Status, tt.targetStatus)
+			}
+		})
+	}
+}
+
+func TestPostStateMachine_Transit_Effects(t *testing.T) {
+	title, _ := valueobject.NewPostTitle("テストタイトル")
+	content, _ := valueobject.NewPostContent("テストコンテンツ")
+	publisher := NewActor(valueobject.NewUserID(), []valueobject.Role{valueobject.RolePublisher})
+
+	t.Run("初回公開時に初回公開日時が設定される", func(t *testing.T) {
+		post, _ := NewPost(title, content, valueobject.NewUserID(), valueobject.StatusApproved)
+
+		if err := DefaultPostStateMachine().Transit(post, valueobject.StatusPublished, publisher); err != nil {
+			t.Fatalf("予期しないエラー: %v", err)
+		}
+		if post.FirstPublishedAt == nil || post.FirstPublishedAt.IsZero() {
+			t.Error("FirstPublishedAtが設定されていません")
+		}
+	})
+
+	t.Run("再公開時は初回公開日時を変更しない", func(t *testing.T) {
+		post, _ := NewPost(title, content, valueobject.NewUserID(), valueobject.StatusPrivate)
+		firstPublishedAt := post.CreatedAt
+		post.FirstPublishedAt = &firstPublishedAt
+
+		if err := DefaultPostStateMachine().Transit(post, valueobject.StatusPublished, publisher); err != nil {
+			t.Fatalf("予期しないエラー: %v", err)
+		}
+		if !post.FirstPublishedAt.Equal(firstPublishedAt) {
+			t.Errorf("FirstPublishedAt = %v, want %v", post.FirstPublishedAt, firstPublishedAt)
+		}
+	})
+}
+
+func TestPostStateMachine_AvailableTransitions(t *testing.T) {
+	authorID := valueobject.NewUserID()
+	reviewerID := valueobject.NewUserID()
+	title, _ := valueobject.NewPostTitle("テストタイトル")
+	content, _ := valueobject.NewPostContent("テストコンテンツ")
+
+	tests := []struct {
+		name          string
+		initialStatus valueobject.PostStatus
+		actor         Actor
+		expected      []PostTransition
+	}{
+		{
+			name:          "投稿者は下書きをレビュー依頼・削除できる",
+			initialStatus: valueobject.StatusDraft,
+			actor:         NewActor(authorID, nil),
+			expected: []PostTransition{
+				{Name: "submit_for_review", To: valueobject.StatusInReview},
+				{Name: "delete", To: valueobject.StatusDeleted},
+			},
+		},
+		{
+			name:          "担当レビュアーはレビュー中の投稿を承認・差し戻しできる",
+			initialStatus: valueobject.StatusInReview,
+			actor:         NewActor(reviewerID, []valueobject.Role{valueobject.RoleReviewer}),
+			expected: []PostTransition{
+				{Name: "approve", To: valueobject.StatusApproved},
+				{Name: "request_changes", To: valueobject.StatusDraft},
+			},
+		},
+		{
+			name:          "投稿者はレビュー中の投稿を取り下げのみできる",
+			initialStatus: valueobject.StatusInReview,
+			actor:         NewActor(authorID, nil),
+			expected: []PostTransition{
+				{Name: "withdraw", To: valueobject.StatusDraft},
+			},
+		},
+		{
+			name:          "公開権限のないユーザーは公開済みの投稿を操作できない",
+			initialStatus: valueobject.StatusPublished,
+			actor:         NewActor(authorID, nil),
+			expected:      []PostTransition{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			post, _ := NewPost(title, content, authorID, tt.initialStatus)
+			post.ReviewerID = &reviewerID
+
+			transitions := DefaultPostStateMachine().AvailableTransitions(post, tt.actor)
+
+			if len(transitions) != len(tt.expected) {
+				t.Fatalf("transitions = %v, want %v", transitions, tt.expected)
+			}
+			for i := range transitions {
+				if transitions[i] != tt.expected[i] {
+					t.Errorf("transitions[%d] = %v, want %v", i, transitions[i], tt.expected[i])
+				}
+			}
+		})
+	}
+}
+
+func TestNewPostStateMachine(t *testing.T) {
+	tests := []struct {
+		name    string
+		rules   []PostTransitionRule
+		wantErr bool
+	}{
+		{
+			name:  "正常ケース: 既定のルール",
+			rules: DefaultPostTransitionRules(),
+		},
+		{
+			name: "正常ケース: 下書きから直接公開できるルール",
+			rules: []PostTransitionRule{
+				{Name: "publish", From: valueobject.StatusDraft, To: valueobject.StatusPublished, Guards: []string{"role:admin"}, Effects: []string{EffectSetFirstPublishedAt}},
+			},
+		},
+		{
+			name:    "異常ケース: ルールなし",
+			rules:   nil,
+			wantErr: true,
+		},
+		{
+			name: "異常ケース: 存在しないステータス",
+			rules: []PostTransitionRule{
+				{Name: "archive", From: valueobject.StatusPublished, To: valueobject.PostStatus("archived")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "異常ケース: 遷移元と遷移先が同じ",
+			rules: []PostTransitionRule{
+				{Name: "noop", From: valueobject.StatusDraft, To: valueobject.StatusDraft},
+			},
+			wantErr: true,
+		},
+		{
+			name: "異常ケース: 未知のガード条件",
+			rules: []PostTransitionRule{
+				{Name: "publish", From: valueobject.StatusDraft, To: valueobject.StatusPublished, Guards: []string{"role:"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "異常ケース: 未知の副作用",
+			rules: []PostTransitionRule{
+				{Name: "publish", From: valueobject.StatusDraft, To: valueobject.StatusPublished, Effects: []string{"notify"}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			machine, err := NewPostStateMachine(tt.rules)
+
+			if tt.wantErr {
+				var myErr *valueobject.MyError
+				if !errors.As(err, &myErr) || myErr.Code != valueobject.InvalidCode {
+					t.Errorf("err = %v, want InvalidCode", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("予期しないエラー: %v", err)
+			}
+			if machine == nil {
+				t.Error("ステートマシンがnilです")
+			}
+		})
+	}
+}
+
+func TestPostStateMachine_Transit_CustomRules(t *testing.T) {
+	title, _ := valueobject.NewPostTitle("テストタイトル")
+	content, _ := valueobject.NewPostContent("テストコンテンツ")
+
+	machine, _ := NewPostStateMachine([]PostTransitionRule{
+		{Name: "publish", From: valueobject.StatusDraft, To: valueobject.StatusPublished, Guards: []string{"role:admin"}, Effects: []string{EffectSetFirstPublishedAt}},
+	})
+
+	t.Run("設定したルールで下書きから公開できる", func(t *testing.T) {
+		post, _ := NewPost(title, content, valueobject.NewUserID(), valueobject.StatusDraft)
+
+		err := machine.Transit(post, valueobject.StatusPublished, NewActor(valueobject.NewUserID(), []valueobject.Role{valueobject.RoleAdmin}))
+
+		if err != nil {
+			t.Fatalf("予期しないエラー: %v", err)
+		}
+		if !post.Status.Equals(valueobject.StatusPublished) {
+			t.Errorf("Status = %v, want %v", post.Status, valueobject.StatusPublished)
+		}
+		if post.FirstPublishedAt == nil {
+			t.Error("FirstPublishedAtが設定されていません")
+		}
+	})
+
+	t.Run("設定にない遷移はできない", func(t *testing.T) {
+		post, _ := NewPost(title, content, valueobject.NewUserID(), valueobject.StatusDraft)
+
+		err := machine.Transit(post, valueobject.StatusDeleted, NewActor(post.UserID, nil))
+
+		var myErr *valueobject.MyError
+		if !errors.As(err, &myErr) || myErr.Code != valueobject.InvalidCode {
+			t.Errorf("err = %v, want InvalidCode", err)
+		}
+	})
+}
+
+func TestPostStateMachine_Transit_Events(t *testing.T) {
+	title, _ := valueobject.NewPostTitle("テストタイトル")
+	content, _ := valueobject.NewPostContent("テストコンテンツ")
+	authorID := valueobject.NewUserID()
+	author := NewActor(authorID, nil)
+	publisher := NewActor(valueobject.NewUserID(), []valueobject.Role{valueobject.RolePublisher})
+
+	tests := []struct {
+		name           string
+		initialStatus  valueobject.PostStatus
+		actor          Actor
+		targetStatus   valueobject.PostStatus
+		wantErr        bool
+		expectedEvents []string
+	}{
+		{
+			name:           "公開時はステータス変更と公開のイベントが記録される",
+			initialStatus:  valueobject.StatusApproved,
+			actor:          publisher,
+			targetStatus:   valueobject.StatusPublished,
+			expectedEvents: []string{event.PostStatusChangedName, event.PostPublishedName},
+		},
+		{
+			name:           "公開取り下げ時はステータス変更と公開取り下げのイベントが記録される",
+			initialStatus:  valueobject.StatusPublished,
+			actor:          publisher,
+			targetStatus:   valueobject.StatusPrivate,
+			expectedEvents: []string{event.PostStatusChangedName, event.PostUnpublishedName},
+		},
+		{
+			name:           "公開に関係しない遷移はステータス変更のイベントのみ記録される",
+			initialStatus:  valueobject.StatusDraft,
+			actor:          author,
+			targetStatus:   valueobject.StatusDeleted,
+			expectedEvents: []string{event.PostStatusChangedName},
+		},
+		{
+			name:           "遷移できない場合はイベントが記録されない",
+			initialStatus:  valueobject.StatusDraft,
+			actor:          author,
+			targetStatus:   valueobject.StatusPublished,
+			wantErr:        true,
+			expectedEvents: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			post, _ := NewPost(title, content, authorID, tt.initialStatus)
+			post.PullEvents()
+
+			err := DefaultPostStateMachine().Transit(post, tt.targetStatus, tt.actor)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			events := post.PullEvents()
+			if len(events) != len(tt.expectedEvents) {
+				t.Fatalf("len(events) = %d, want %d", len(events), len(tt.expectedEvents))
+			}
+			for i, e := range events {
+				if e.EventName() != tt.expectedEvents[i] {
+					t.Errorf("events[%d] = %s, want %s", i, e.EventName(), tt.expectedEvents[i])
+				}
+			}
+			if len(events) > 0 {
+				changed := events[0].(event.PostStatusChanged)
+				if changed.From != tt.initialStatus || changed.To != tt.targetStatus {
+					t.Errorf("PostStatusChanged = %s -> %s, want %s -> %s", changed.From, changed.To, tt.initialStatus, tt.targetStatus)
+				}
+			}
+		})
+	}
+}
@@ -1,9 +1,13 @@
 package entity
 
 import (
+	"errors"
+	"net/http"
+	"slices"
 	"testing"
 	"time"
 
+	"github.com/MizukiShigi/cms-go/internal/domain/event"
 	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
 )
 
@@ -113,6 +117,7 @@ func TestPost_AddTag(t *testing.T) {
 
 	tag1, _ := valueobject.NewTagName("golang")
 	tag2, _ := valueobject.NewTagName("testing")
+	tag1Variant, _ := valueobject.NewTagName("ＧｏＬａｎｇ")
 
 	tests := []struct {
 		name        string
@@ -138,7 +143,14 @@ func TestPost_AddTag(t *testing.T) {
 			tag:         tag1,
 			setup:       func() {},
 			wantErr:     true,
-			expectedErr: "Tag already exists",
+			expectedErr: "tag_already_exists",
+		},
+		{
+			name:        "異常ケース: 大文字小文字・全角半角のみ異なるタグ追加",
+			tag:         tag1Variant,
+			setup:       func() {},
+			wantErr:     true,
+			expectedErr: "tag_already_exists",
 		},
 		{
 			name: "異常ケース: 最大タグ数超過",
@@ -152,7 +164,7 @@ func TestPost_AddTag(t *testing.T) {
 				}
 			},
 			wantErr:     true,
-			expectedErr: "Maximum number of tags reached",
+			expectedErr: "maximum_number_of_tags_reached",
 		},
 	}
 
@@ -179,102 +191,116 @@ func TestPost_AddTag(t *testing.T) {
 	}
 }
 
-func TestPost_SetStatus(t *testing.T) {
-	userID := valueobject.NewUserID()
+func TestPost_AssignReviewer(t *testing.T) {
+	authorID := valueobject.NewUserID()
 	title, _ := valueobject.NewPostTitle("テストタイトル")
 	content, _ := valueobject.NewPostContent("テストコンテンツ")
 
 	tests := []struct {
 		name          string
 		initialStatus valueobject.PostStatus
-		targetStatus  valueobject.PostStatus
-		wantErr       bool
-		expectedErr   string
+		actorID       valueobject.UserID
+		reviewerID    valueobject.UserID
+		expectedCode  valueobject.Code
 	}{
 		{
-			name:          "正常ケース: 下書きから公開",
+			name:          "正常ケース: 投稿者がレビュアーを指定",
 			initialStatus: valueobject.StatusDraft,
-			targetStatus:  valueobject.StatusPublished,
-			wantErr:       false,
+			actorID:       authorID,
+			reviewerID:    valueobject.NewUserID(),
 		},
 		{
-			name:          "正常ケース: 下書きから削除",
+			name:          "異常ケース: 投稿者以外がレビュアーを指定",
 			initialStatus: valueobject.StatusDraft,
-			targetStatus:  valueobject.StatusDeleted,
-			wantErr:       false,
-		},
-		{
-			name:          "正常ケース: 公開から非公開",
-			initialStatus: valueobject.StatusPublished,
-			targetStatus:  valueobject.StatusPrivate,
-			wantErr:       false,
+			actorID:       valueobject.NewUserID(),
+			reviewerID:    valueobject.NewUserID(),
+			expectedCode:  valueobject.ForbiddenCode,
 		},
 		{
-			name:          "正常ケース: 非公開から公開",
-			initialStatus: valueobject.StatusPrivate,
-			targetStatus:  valueobject.StatusPublished,
-			wantErr:       false,
-		},
-		{
-			name:          "正常ケース: 非公開から削除",
-			initialStatus: valueobject.StatusPrivate,
-			targetStatus:  valueobject.StatusDeleted,
-			wantErr:       false,
+			name:          "異常ケース: 投稿者自身をレビュアーに指定",
+			initialStatus: valueobject.StatusDraft,
+			actorID:       authorID,
+			reviewerID:    authorID,
+			expectedCode:  valueobject.InvalidCode,
 		},
 		{
-			name:          "異常ケース: 公開から削除（不正な遷移）",
+			name:          "異常ケース: 公開済みの投稿にレビュアーを指定",
 			initialStatus: valueobject.StatusPublished,
-			targetStatus:  valueobject.StatusDeleted,
-			wantErr:       true,
-			expectedErr:   "Only draft and private posts can be deleted",
-		},
-		{
-			name:          "異常ケース: 削除から公開（不正な遷移）",
-			initialStatus: valueobject.StatusDeleted,
-			targetStatus:  valueobject.StatusPublished,
-			wantErr:       true,
-			expectedErr:   "Only draft and private posts can be published",
+			actorID:       authorID,
+			reviewerID:    valueobject.NewUserID(),
+			expectedCode:  valueobject.InvalidCode,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			post, _ := NewPost(title, content, userID, tt.initialStatus)
-
-			// 初期状態が公開以外で公開状態に設定したい場合は一度公開状態にする
-			if tt.initialStatus == valueobject.StatusPrivate && post.Status != valueobject.StatusPrivate {
-				post.SetStatus(valueobject.StatusPublished)
-				post.SetStatus(valueobject.StatusPrivate)
-			}
-			if tt.initialStatus == valueobject.StatusDeleted && post.Status != valueobject.StatusDeleted {
-				post.SetStatus(valueobject.StatusDeleted)
-			}
+			post, _ := NewPost(title, content, authorID, tt.initialStatus)
 
-			err := post.SetStatus(tt.targetStatus)
+			err := post.AssignReviewer(tt.reviewerID, NewActor(tt.actorID, nil))
 
-			if tt.wantErr {
-				if err == nil {
-					t.Errorf("エラーが期待されましたが、エラーが発生しませんでした")
-					return
+			if tt.expectedCode != "" {
+				var myErr *valueobject.MyError
+				if !errors.As(err, &myErr) || myErr.Code != tt.expectedCode {
+					t.Errorf("err = %v, want code %v", err, tt.expectedCode)
 				}
-				if err.Error() != tt.expectedErr {
-					t.Errorf("期待されたエラーメッセージ = %v, 実際のエラーメッセージ = %v", tt.expectedErr, err.Error())
+				if post.ReviewerID != nil {
+					t.Error("エラー時にレビュアーが設定されています")
 				}
 				return
 			}
 
 			if err != nil {
-				t.Errorf("予期しないエラー: %v", err)
-				return
+				t.Fatalf("予期しないエラー: %v", err)
 			}
-
-			if !post.Status.Equals(tt.targetStatus) {
-				t.Errorf("Status = %v, want %v", post.Status, tt.targetStatus)
+			if post.ReviewerID == nil || !post.ReviewerID.Equals(tt.reviewerID) {
+				t.Errorf("ReviewerID = %v, want %v", post.ReviewerID, tt.reviewerID)
 			}
 		})
 	}
 }
 
+func TestPost_CheckReviewable(t *testing.T) {
+	authorID := valueobject.NewUserID()
+	reviewerID := valueobject.NewUserID()
+	title, _ := valueobject.NewPostTitle("テストタイトル")
+	content, _ := valueobject.NewPostContent("テストコンテンツ")
+
+	t.Run("担当レビュアーはレビューできる", func(t *testing.T) {
+		post, _ := NewPost(title, content, authorID, valueobject.StatusInReview)
+		post.ReviewerID = &reviewerID
+
+		err := post.CheckReviewable(NewActor(reviewerID, []valueobject.Role{valueobject.RoleReviewer}))
+
+		if err != nil {
+			t.Fatalf("予期しないエラー: %v", err)
+		}
+	})
+
+	t.Run("投稿者はレビューできない", func(t *testing.T) {
+		post, _ := NewPost(title, content, authorID, valueobject.StatusInReview)
+		post.ReviewerID = &reviewerID
+
+		err := post.CheckReviewable(NewActor(authorID, []valueobject.Role{valueobject.RoleReviewer}))
+
+		var myErr *valueobject.MyError
+		if !errors.As(err, &myErr) || myErr.Code != valueobject.ForbiddenCode {
+			t.Errorf("err = %v, want ForbiddenCode", err)
+		}
+	})
+
+	t.Run("レビュー中以外はレビューできない", func(t *testing.T) {
+		post, _ := NewPost(title, content, authorID, valueobject.StatusDraft)
+		post.ReviewerID = &reviewerID
+
+		err := post.CheckReviewable(NewActor(reviewerID, []valueobject.Role{valueobject.RoleReviewer}))
+
+		var myErr *valueobject.MyError
+		if !errors.As(err, &myErr) || myErr.Code != valueobject.InvalidCode {
+			t.Errorf("err = %v, want InvalidCode", err)
+		}
+	})
+}
+
 func TestParsePost(t *testing.T) {
 	id, _ := valueobject.ParsePostID("550e8400-e29b-41d4-a716-446655440000")
 	title, _ := valueobject.NewPostTitle("テストタイトル")
@@ -339,3 +365,401 @@ func TestParsePost(t *testing.T) {
 		t.Errorf("Tags length = %v, want %v", len(post.Tags), 2)
 	}
 }
+
+func TestPost_SetCategories(t *testing.T) {
+	userID := valueobject.NewUserID()
+	title, _ := valueobject.NewPostTitle("テストタイトル")
+	content, _ := valueobject.NewPostContent("テストコンテンツ")
+
+	primaryID := valueobject.NewCategoryID()
+	secondaryID1 := valueobject.NewCategoryID()
+	secondaryID2 := valueobject.NewCategoryID()
+
+	tooMany := make([]valueobject.CategoryID, 0, 6)
+	for i := 0; i < 6; i++ {
+		tooMany = append(tooMany, valueobject.NewCategoryID())
+	}
+
+	tests := []struct {
+		name        string
+		primaryID   *valueobject.CategoryID
+		categoryIDs []valueobject.CategoryID
+		wantErr     bool
+		expectedErr string
+	}{
+		{
+			name:        "正常ケース: 主カテゴリと副カテゴリを設定",
+			primaryID:   &primaryID,
+			categoryIDs: []valueobject.CategoryID{secondaryID1, secondaryID2},
+			wantErr:     false,
+		},
+		{
+			name:      "正常ケース: 主カテゴリのみ設定",
+			primaryID: &primaryID,
+			wantErr:   false,
+		},
+		{
+			name:      "正常ケース: カテゴリを解除",
+			primaryID: nil,
+			wantErr:   false,
+		},
+		{
+			name:        "異常ケース: 主カテゴリなしで副カテゴリを設定",
+			primaryID:   nil,
+			categoryIDs: []valueobject.CategoryID{secondaryID1},
+			wantErr:     true,
+			expectedErr: "primary_category_required",
+		},
+		{
+			name:        "異常ケース: 主カテゴリを副カテゴリにも指定",
+			primaryID:   &primaryID,
+			categoryIDs: []valueobject.CategoryID{primaryID},
+			wantErr:     true,
+			expectedErr: "primary_category_duplicated",
+		},
+		{
+			name:        "異常ケース: 副カテゴリの重複",
+			primaryID:   &primaryID,
+			categoryIDs: []valueobject.CategoryID{secondaryID1, secondaryID1},
+			wantErr:     true,
+			expectedErr: "category_already_exists",
+		},
+		{
+			name:        "異常ケース: 副カテゴリ数超過",
+			primaryID:   &primaryID,
+			categoryIDs: tooMany,
+			wantErr:     true,
+			expectedErr: "too_many_secondary_categories",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			post, _ := NewPost(title, content, userID, valueobject.StatusDraft)
+
+			err := post.SetCategories(tt.primaryID, tt.categoryIDs)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("エラーが期待されましたが、エラーが発生しませんでした")
+					return
+				}
+				if err.Error() != tt.expectedErr {
+					t.Errorf("期待されたエラーメッセージ = %v, 実際のエラーメッセージ = %v", tt.expectedErr, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("予期しないエラー: %v", err)
+				return
+			}
+
+			if post.PrimaryCategoryID != tt.primaryID {
+				t.Errorf("PrimaryCategoryID = %v, want %v", post.PrimaryCategoryID, tt.primaryID)
+			}
+
+			if len(post.CategoryIDs) != len(tt.categoryIDs) {
+				t.Errorf("CategoryIDs length = %v, want %v", len(post.CategoryIDs), len(tt.categoryIDs))
+			}
+		})
+	}
+}
+
+func TestPost_RemoveTag(t *testing.T) {
+	title, _ := valueobject.NewPostTitle("テストタイトル")
+	content, _ := valueobject.NewPostContent("テストコンテンツ")
+	golang, _ := valueobject.NewTagName("golang")
+	python, _ := valueobject.NewTagName("python")
+	pythonVariant, _ := valueobject.NewTagName("Ｐｙｔｈｏｎ")
+	rust, _ := valueobject.NewTagName("rust")
+
+	tests := []struct {
+		name     string
+		tag      valueobject.TagName
+		expected []valueobject.TagName
+	}{
+		{
+			name:     "正常ケース: 付いているタグを外す",
+			tag:      python,
+			expected: []valueobject.TagName{golang},
+		},
+		{
+			name:     "正常ケース: 表記揺れのあるタグ名でも外せる",
+			tag:      pythonVariant,
+			expected: []valueobject.TagName{golang},
+		},
+		{
+			name:     "正常ケース: 付いていないタグは何もしない",
+			tag:      rust,
+			expected: []valueobject.TagName{golang, python},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			post, _ := NewPost(title, content, valueobject.NewUserID(), valueobject.StatusDraft)
+			post.AddTag(golang)
+			post.AddTag(python)
+
+			post.RemoveTag(tt.tag)
+
+			if !slices.Equal(post.Tags, tt.expected) {
+				t.Errorf("Tags = %v, want %v", post.Tags, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPost_ReplaceTags(t *testing.T) {
+	title, _ := valueobject.NewPostTitle("テストタイトル")
+	content, _ := valueobject.NewPostContent("テストコンテンツ")
+	golang, _ := valueobject.NewTagName("golang")
+	golangVariant, _ := valueobject.NewTagName("GoLang")
+	rust, _ := valueobject.NewTagName("rust")
+
+	t.Run("正常ケース: タグがすべて置き換わる", func(t *testing.T) {
+		post, _ := NewPost(title, content, valueobject.NewUserID(), valueobject.StatusDraft)
+		post.AddTag(golang)
+
+		if err := post.ReplaceTags([]valueobject.TagName{rust}); err != nil {
+			t.Fatalf("予期しないエラー: %v", err)
+		}
+		if !slices.Equal(post.Tags, []valueobject.TagName{rust}) {
+			t.Errorf("Tags = %v, want %v", post.Tags, []valueobject.TagName{rust})
+		}
+	})
+
+	t.Run("正常ケース: 空配列ですべてのタグが外れる", func(t *testing.T) {
+		post, _ := NewPost(title, content, valueobject.NewUserID(), valueobject.StatusDraft)
+		post.AddTag(golang)
+
+		if err := post.ReplaceTags([]valueobject.TagName{}); err != nil {
+			t.Fatalf("予期しないエラー: %v", err)
+		}
+		if len(post.Tags) != 0 {
+			t.Errorf("Tags = %v, want empty", post.Tags)
+		}
+	})
+
+	t.Run("異常ケース: 重複があると元のタグは変更されない", func(t *testing.T) {
+		post, _ := NewPost(title, content, valueobject.NewUserID(), valueobject.StatusDraft)
+		post.AddTag(rust)
+
+		err := post.ReplaceTags([]valueobject.TagName{golang, golangVariant})
+		if err == nil || err.Error() != "tag_already_exists" {
+			t.Errorf("期待されたエラーメッセージ = Tag already exists, 実際のエラー = %v", err)
+		}
+		if !slices.Equal(post.Tags, []valueobject.TagName{rust}) {
+			t.Errorf("Tags = %v, want %v", post.Tags, []valueobject.TagName{rust})
+		}
+	})
+}
+
+func TestPost_CheckVersion(t *testing.T) {
+	title, _ := valueobject.NewPostTitle("テストタイトル")
+	content, _ := valueobject.NewPostContent("テストコンテンツ")
+	post, _ := NewPost(title, content, valueobject.NewUserID(), valueobject.StatusDraft)
+	post.Version = 3
+
+	tests := []struct {
+		name    string
+		version int
+		wantErr bool
+	}{
+		{
+			name:    "正常ケース: バージョンが一致する",
+			version: 3,
+			wantErr: false,
+		},
+		{
+			name:    "異常ケース: 古いバージョン",
+			version: 2,
+			wantErr: true,
+		},
+		{
+			name:    "異常ケース: 存在しない新しいバージョン",
+			version: 4,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := post.CheckVersion(tt.version)
+
+			if !tt.wantErr {
+				if err != nil {
+					t.Errorf("予期しないエラー: %v", err)
+				}
+				return
+			}
+
+			var myErr *valueobject.MyError
+			if !errors.As(err, &myErr) {
+				t.Fatalf("MyErrorが期待されましたが、%v が返されました", err)
+			}
+			if myErr.Code != valueobject.ConflictCode {
+				t.Errorf("Code = %v, want %v", myErr.Code, valueobject.ConflictCode)
+			}
+			if myErr.StatusCode() != http.StatusPreconditionFailed {
+				t.Errorf("StatusCode() = %v, want %v", myErr.StatusCode(), http.StatusPreconditionFailed)
+			}
+		})
+	}
+}
+
+func TestPost_CheckContentEditable(t *testing.T) {
+	title, _ := valueobject.NewPostTitle("テストタイトル")
+	content, _ := valueobject.NewPostContent("テストコンテンツ")
+	newTitle, _ := valueobject.NewPostTitle("新しいタイトル")
+	newContent, _ := valueobject.NewPostContent("新しいコンテンツ")
+
+	tests := []struct {
+		name    string
+		status  valueobject.PostStatus
+		title   valueobject.PostTitle
+		content valueobject.PostContent
+		wantErr bool
+	}{
+		{
+			name:    "正常ケース: 下書きはタイトルと本文を変更できる",
+			status:  valueobject.StatusDraft,
+			title:   newTitle,
+			content: newContent,
+			wantErr: false,
+		},
+		{
+			name:    "正常ケース: 公開中の投稿は本文を変更できる",
+			status:  valueobject.StatusPublished,
+			title:   title,
+			content: newContent,
+			wantErr: false,
+		},
+		{
+			name:    "正常ケース: レビュー中でも変更がなければエラーにならない",
+			status:  valueobject.StatusInReview,
+			title:   title,
+			content: content,
+			wantErr: false,
+		},
+		{
+			name:    "異常ケース: レビュー中の投稿のタイトルは変更できない",
+			status:  valueobject.StatusInReview,
+			title:   newTitle,
+			content: content,
+			wantErr: true,
+		},
+		{
+			name:    "異常ケース: 承認済みの投稿の本文は変更できない",
+			status:  valueobject.StatusApproved,
+			title:   title,
+			content: newContent,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			post, _ := NewPost(title, content, valueobject.NewUserID(), valueobject.StatusDraft)
+			post.Status = tt.status
+
+			err := post.CheckContentEditable(tt.title, tt.content)
+
+			if !tt.wantErr {
+				if err != nil {
+					t.Errorf("予期しないエラー: %v", err)
+				}
+				return
+			}
+
+			var myErr *valueobject.MyError
+			if !errors.As(err, &myErr) {
+				t.Fatalf("MyErrorが期待されましたが、%v が返されました", err)
+			}
+			if myErr.Code != valueobject.ConflictCode {
+				t.Errorf("Code = %v, want %v", myErr.Code, valueobject.ConflictCode)
+			}
+			if myErr.Key() != "post_content_is_locked_during_review" {
+				t.Errorf("Key() = %v, want %v", myErr.Key(), "post_content_is_locked_during_review")
+			}
+		})
+	}
+}
+
+func TestNewPost_Events(t *testing.T) {
+	title, _ := valueobject.NewPostTitle("テストタイトル")
+	content, _ := valueobject.NewPostContent("テストコンテンツ")
+
+	tests := []struct {
+		name           string
+		status         valueobject.PostStatus
+		expectedEvents []string
+	}{
+		{
+			name:           "下書き作成時は作成イベントのみ記録される",
+			status:         valueobject.StatusDraft,
+			expectedEvents: []string{event.PostCreatedName},
+		},
+		{
+			name:           "公開状態で作成した場合は公開イベントも記録される",
+			status:         valueobject.StatusPublished,
+			expectedEvents: []string{event.PostCreatedName, event.PostPublishedName},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			post, _ := NewPost(title, content, valueobject.NewUserID(), tt.status)
+
+			events := post.PullEvents()
+			if len(events) != len(tt.expectedEvents) {
+				t.Fatalf("len(events) = %d, want %d", len(events), len(tt.expectedEvents))
+			}
+			for i, e := range events {
+				if e.EventName() != tt.expectedEvents[i] {
+					t.Errorf("events[%d] = %s, want %s", i, e.EventName(), tt.expectedEvents[i])
+				}
+			}
+
+			created := events[0].(event.PostCreated)
+			if !created.PostID.Equals(post.ID) {
+				t.Errorf("PostCreated.PostID = %v, want %v", created.PostID, post.ID)
+			}
+
+			if len(post.PullEvents()) != 0 {
+				t.Error("取り出したイベントが残っています")
+			}
+		})
+	}
+}
+
+func TestPost_DisplayExcerpt(t *testing.T) {
+	tests := []struct {
+		name     string
+		excerpt  valueobject.PostExcerpt
+		content  valueobject.PostContent
+		expected valueobject.PostExcerpt
+	}{
+		{
+			name:     "抜粋が設定されている場合はその抜粋を返す",
+			excerpt:  valueobject.PostExcerpt("手動で設定した抜粋"),
+			content:  valueobject.PostContent("<p>本文</p>"),
+			expected: valueobject.PostExcerpt("手動で設定した抜粋"),
+		},
+		{
+			name:     "抜粋が未設定の場合は本文から生成する",
+			content:  valueobject.PostContent("<p>本文</p>"),
+			expected: valueobject.PostExcerpt("本文"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			post := &Post{Content: tt.content, Excerpt: tt.excerpt}
+			if got := post.DisplayExcerpt(); got != tt.expected {
+				t.Errorf("DisplayExcerpt() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+// PostWorkflowHistory は投稿のステータス遷移（レビュー依頼・承認・差し戻し・公開など）の履歴
+type PostWorkflowHistory struct {
+	ID         int64
+	PostID     valueobject.PostID
+	FromStatus valueobject.PostStatus
+	ToStatus   valueobject.PostStatus
+	ActorID    valueobject.UserID
+	Comment    string
+	CreatedAt  time.Time
+}
+
+// 新規履歴作成
+func NewPostWorkflowHistory(postID valueobject.PostID, fromStatus valueobject.PostStatus, toStatus valueobject.PostStatus, actorID valueobject.UserID, comment string) (*PostWorkflowHistory, error) {
+	if len([]rune(comment)) > 1000 {
+		return nil, valueobject.NewMyError(valueobject.InvalidCode, "comment_is_too_long")
+	}
+
+	return &PostWorkflowHistory{
+		PostID:     postID,
+		FromStatus: fromStatus,
+		ToStatus:   toStatus,
+		ActorID:    actorID,
+		Comment:    comment,
+		CreatedAt:  time.Now(),
+	}, nil
+}
+
+// 履歴データ再構築
+func ParsePostWorkflowHistory(
+	id int64,
+	postID valueobject.PostID,
+	fromStatus valueobject.PostStatus,
+	toStatus valueobject.PostStatus,
+	actorID valueobject.UserID,
+	comment string,
+	createdAt time.Time,
+) *PostWorkflowHistory {
+	return &PostWorkflowHistory{
+		ID:         id,
+		PostID:     postID,
+		FromStatus: fromStatus,
+		ToStatus:   toStatus,
+		ActorID:    actorID,
+		Comment:    comment,
+		CreatedAt:  createdAt,
+	}
+}
@@ -0,0 +1,66 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+func TestNewPostWorkflowHistory(t *testing.T) {
+	postID := valueobject.NewPostID()
+	actorID := valueobject.NewUserID()
+
+	tests := []struct {
+		name    string
+		comment string
+		wantErr bool
+	}{
+		{
+			name:    "正常ケース: コメントあり",
+			comment: "導入部分をもう少し具体的にしてください",
+			wantErr: false,
+		},
+		{
+			name:    "正常ケース: コメントなし",
+			comment: "",
+			wantErr: false,
+		},
+		{
+			name:    "正常ケース: 1000文字",
+			comment: strings.Repeat("あ", 1000),
+			wantErr: false,
+		},
+		{
+			name:    "異常ケース: 1001文字",
+			comment: strings.Repeat("あ", 1001),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			history, err := NewPostWorkflowHistory(postID, valueobject.StatusInReview, valueobject.StatusDraft, actorID, tt.comment)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Error("エラーが期待されましたが、nilでした")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("予期しないエラー: %v", err)
+			}
+			if history.FromStatus != valueobject.StatusInReview || history.ToStatus != valueobject.StatusDraft {
+				t.Errorf("遷移 = %v -> %v, want in_review -> draft", history.FromStatus, history.ToStatus)
+			}
+			if history.Comment != tt.comment {
+				t.Errorf("Comment = %v, want %v", history.Comment, tt.comment)
+			}
+			if history.CreatedAt.IsZero() {
+				t.Error("CreatedAtが設定されていません")
+			}
+		})
+	}
+}
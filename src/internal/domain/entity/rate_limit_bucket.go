@@ -0,0 +1,76 @@
+package entity
+
+import (
+	"math"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+// RateLimitBucket はレート制限のトークンバケットの状態
+// 満杯（Limit 個）から始まり、リクエストごとに1つ消費し、Period をかけて満杯まで補充される
+type RateLimitBucket struct {
+	Tokens    float64
+	UpdatedAt time.Time
+}
+
+// RateLimitDecision はトークン消費の結果
+type RateLimitDecision struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// バケットが満杯に戻るまでの時間
+	ResetAfter time.Duration
+	// 次のリクエストが許可されるまでの時間（許可された場合は0）
+	RetryAfter time.Duration
+}
+
+// 新規バケット作成（満杯の状態）
+func NewRateLimitBucket(policy valueobject.RateLimitPolicy, now time.Time) *RateLimitBucket {
+	return &RateLimitBucket{
+		Tokens:    float64(policy.Limit),
+		UpdatedAt: now,
+	}
+}
+
+// Take は経過時間分のトークンを補充した上で1つ消費する。トークンが足りない場合は消費せずに拒否する
+func (b *RateLimitBucket) Take(policy valueobject.RateLimitPolicy, now time.Time) RateLimitDecision {
+	b.refill(policy, now)
+
+	decision := RateLimitDecision{Limit: policy.Limit}
+	if b.Tokens >= 1 {
+		b.Tokens--
+		decision.Allowed = true
+	} else {
+		decision.RetryAfter = tokensDuration(1-b.Tokens, policy)
+	}
+	decision.Remaining = int(math.Floor(b.Tokens))
+	decision.ResetAfter = tokensDuration(float64(policy.Limit)-b.Tokens, policy)
+	return decision
+}
+
+// FullAt はバケットが満杯に戻る日時を返す。この日時を過ぎたバケットは破棄しても結果が変わらない
+func (b *RateLimitBucket) FullAt(policy valueobject.RateLimitPolicy) time.Time {
+	return b.UpdatedAt.Add(tokensDuration(float64(policy.Limit)-b.Tokens, policy))
+}
+
+func (b *RateLimitBucket) refill(policy valueobject.RateLimitPolicy, now time.Time) {
+	// 時刻が巻き戻った場合は補充しない
+	if elapsed := now.Sub(b.UpdatedAt); elapsed > 0 {
+		b.Tokens = math.Min(float64(policy.Limit), b.Tokens+elapsed.Seconds()*refillRate(policy))
+	}
+	b.UpdatedAt = now
+}
+
+// refillRate は1秒あたりに補充するトークン数を返す
+func refillRate(policy valueobject.RateLimitPolicy) float64 {
+	return float64(policy.Limit) / policy.Period.Seconds()
+}
+
+// tokensDuration は指定数のトークンが補充されるまでの時間を返す
+func tokensDuration(tokens float64, policy valueobject.RateLimitPolicy) time.Duration {
+	if tokens <= 0 {
+		return 0
+	}
+	return time.Duration(math.Ceil(tokens / refillRate(policy) * float64(time.Second)))
+}
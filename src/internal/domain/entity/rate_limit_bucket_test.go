@@ -0,0 +1,92 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+func TestRateLimitBucket_Take(t *testing.T) {
+	// 1分に3回（20秒ごとに1つ補充）
+	policy, _ := valueobject.NewRateLimitPolicy("test", 3, time.Minute)
+	now := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	t.Run("上限まで許可し、超えた分は拒否する", func(t *testing.T) {
+		bucket := NewRateLimitBucket(policy, now)
+
+		for i := 0; i < 3; i++ {
+			decision := bucket.Take(policy, now)
+			if !decision.Allowed {
+				t.Fatalf("%d回目が拒否されました", i+1)
+			}
+			if decision.Remaining != 2-i {
+				t.Errorf("%d回目の Remaining = %d, want %d", i+1, decision.Remaining, 2-i)
+			}
+		}
+
+		decision := bucket.Take(policy, now)
+		if decision.Allowed {
+			t.Fatalf("上限を超えたリクエストが許可されました")
+		}
+		if decision.RetryAfter != 20*time.Second {
+			t.Errorf("RetryAfter = %v, want 20s", decision.RetryAfter)
+		}
+		if decision.ResetAfter != time.Minute {
+			t.Errorf("ResetAfter = %v, want 1m", decision.ResetAfter)
+		}
+		if decision.Limit != 3 || decision.Remaining != 0 {
+			t.Errorf("Limit/Remaining = %d/%d, want 3/0", decision.Limit, decision.Remaining)
+		}
+	})
+
+	t.Run("経過時間に応じて補充される", func(t *testing.T) {
+		bucket := NewRateLimitBucket(policy, now)
+		for i := 0; i < 3; i++ {
+			bucket.Take(policy, now)
+		}
+
+		decision := bucket.Take(policy, now.Add(20*time.Second))
+		if !decision.Allowed {
+			t.Fatalf("補充後のリクエストが拒否されました")
+		}
+		if decision.Remaining != 0 {
+			t.Errorf("Remaining = %d, want 0", decision.Remaining)
+		}
+	})
+
+	t.Run("上限を超えて補充されない", func(t *testing.T) {
+		bucket := NewRateLimitBucket(policy, now)
+
+		decision := bucket.Take(policy, now.Add(time.Hour))
+		if decision.Remaining != 2 {
+			t.Errorf("Remaining = %d, want 2", decision.Remaining)
+		}
+	})
+
+	t.Run("時刻が巻き戻っても補充しない", func(t *testing.T) {
+		bucket := NewRateLimitBucket(policy, now)
+		for i := 0; i < 3; i++ {
+			bucket.Take(policy, now)
+		}
+
+		if decision := bucket.Take(policy, now.Add(-time.Minute)); decision.Allowed {
+			t.Errorf("時刻の巻き戻りでリクエストが許可されました")
+		}
+	})
+}
+
+func TestRateLimitBucket_FullAt(t *testing.T) {
+	policy, _ := valueobject.NewRateLimitPolicy("test", 3, time.Minute)
+	now := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	bucket := NewRateLimitBucket(policy, now)
+
+	if got := bucket.FullAt(policy); !got.Equal(now) {
+		t.Errorf("満杯のバケットの FullAt = %v, want %v", got, now)
+	}
+
+	bucket.Take(policy, now)
+	if got := bucket.FullAt(policy); !got.Equal(now.Add(20 * time.Second)) {
+		t.Errorf("FullAt = %v, want %v", got, now.Add(20*time.Second))
+	}
+}
@@ -35,3 +35,19 @@ func ParseTag(
 		UpdatedAt: updatedAt,
 	}
 }
+
+func (t *Tag) Rename(name valueobject.TagName) {
+	if t.Name.Equals(name) {
+		return
+	}
+
+	t.Name = name
+	t.UpdatedAt = time.Now()
+}
+
+// AuditFields は監査ログで比較するタグの項目を返す
+func (t *Tag) AuditFields() AuditFields {
+	return AuditFields{
+		"name": t.Name.String(),
+	}
+}
@@ -100,13 +100,13 @@ func TestTag_FieldValidation(t *testing.T) {
 			name:        "異常ケース: 長すぎるタグ名",
 			tagName:     "this-is-a-very-long-tag-name-that-exceeds-the-maximum-allowed-length",
 			wantErr:     true,
-			expectedErr: "TagName is too long",
+			expectedErr: "tag_name_too_long",
 		},
 		{
 			name:        "異常ケース: 特殊文字を含むタグ名",
 			tagName:     "go@lang",
 			wantErr:     true,
-			expectedErr: "TagName can only contain Japanese characters, letters, numbers, hyphens, and underscores",
+			expectedErr: "tag_name_invalid_characters",
 		},
 	}
 
@@ -138,3 +138,46 @@ func TestTag_FieldValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestTag_Rename(t *testing.T) {
+	oldName, _ := valueobject.NewTagName("golang")
+	newName, _ := valueobject.NewTagName("go")
+	updatedAt := time.Now().Add(-24 * time.Hour)
+
+	tests := []struct {
+		name          string
+		newName       valueobject.TagName
+		wantUpdatedAt bool
+	}{
+		{
+			name:          "正常ケース: 別名に変更",
+			newName:       newName,
+			wantUpdatedAt: true,
+		},
+		{
+			name:          "正常ケース: 同名の場合は変更なし",
+			newName:       oldName,
+			wantUpdatedAt: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tag := ParseTag(valueobject.NewTagID(), oldName, updatedAt, updatedAt)
+
+			tag.Rename(tt.newName)
+
+			if !tag.Name.Equals(tt.newName) {
+				t.Errorf("Name = %v, want %v", tag.Name, tt.newName)
+			}
+
+			if tt.wantUpdatedAt && tag.UpdatedAt.Equal(updatedAt) {
+				t.Error("UpdatedAtが更新されていません")
+			}
+
+			if !tt.wantUpdatedAt && !tag.UpdatedAt.Equal(updatedAt) {
+				t.Error("UpdatedAtが更新されるべきではありません")
+			}
+		})
+	}
+}
@@ -18,16 +18,16 @@ type User struct {
 
 func NewUser(name string, email valueobject.Email, password string) (*User, error) {
 	if name == "" {
-		return nil, valueobject.NewMyError(valueobject.InvalidCode, "Name cannot be empty")
+		return nil, valueobject.NewMyError(valueobject.InvalidCode, "name_cannot_be_empty")
 	}
 
 	if len(password) < 8 {
-		return nil, valueobject.NewMyError(valueobject.InvalidCode, "Password must be at least 8 characters")
+		return nil, valueobject.NewMyError(valueobject.InvalidCode, "password_too_short")
 	}
 
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	if err != nil {
-		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "Failed to hash password")
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_hash_password")
 	}
 
 	now := time.Now()
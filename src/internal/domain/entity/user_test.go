@@ -28,7 +28,7 @@ func TestNewUser(t *testing.T) {
 			email:       "test@example.com",
 			password:    "password123",
 			wantErr:     true,
-			expectedErr: "Name cannot be empty",
+			expectedErr: "name_cannot_be_empty",
 		},
 		{
 			name:        "異常ケース: 短いパスワード",
@@ -36,7 +36,7 @@ func TestNewUser(t *testing.T) {
 			email:       "test@example.com",
 			password:    "1234567",
 			wantErr:     true,
-			expectedErr: "Password must be at least 8 characters",
+			expectedErr: "password_too_short",
 		},
 		{
 			name:        "異常ケース: 不正なメールアドレス",
@@ -0,0 +1,130 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+const (
+	// WebhookMaxAttempts は配信を諦めるまでの最大試行回数
+	WebhookMaxAttempts = 8
+	// WebhookRetryBaseInterval は初回の再試行までの待ち時間。以降は試行ごとに2倍にする
+	WebhookRetryBaseInterval = 30 * time.Second
+	// WebhookRetryMaxInterval は再試行の待ち時間の上限
+	WebhookRetryMaxInterval = 1 * time.Hour
+	// webhookLastErrorMaxLength は記録するエラーメッセージの最大文字数
+	webhookLastErrorMaxLength = 1000
+)
+
+// WebhookDelivery は購読ごとのWebhook配信と、その結果の記録
+type WebhookDelivery struct {
+	ID             int64
+	SubscriptionID valueobject.WebhookSubscriptionID
+	EventType      valueobject.WebhookEventType
+	Payload        []byte
+	// イベントを発生させたリクエストのID
+	RequestID     string
+	Status        valueobject.WebhookDeliveryStatus
+	Attempts      int
+	NextAttemptAt time.Time
+	// 最後の試行のレスポンスステータス（接続エラーなどでレスポンスがない場合は nil）
+	LastStatusCode *int
+	LastError      string
+	DeliveredAt    *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// 新規配信作成（すぐに配信対象になる）
+func NewWebhookDelivery(subscriptionID valueobject.WebhookSubscriptionID, eventType valueobject.WebhookEventType, payload []byte, requestID string, now time.Time) *WebhookDelivery {
+	return &WebhookDelivery{
+		SubscriptionID: subscriptionID,
+		EventType:      eventType,
+		Payload:        payload,
+		RequestID:      requestID,
+		Status:         valueobject.WebhookDeliveryPending,
+		NextAttemptAt:  now,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+}
+
+// 配信データ再構築
+func ParseWebhookDelivery(
+	id int64,
+	subscriptionID valueobject.WebhookSubscriptionID,
+	eventType valueobject.WebhookEventType,
+	payload []byte,
+	requestID string,
+	status valueobject.WebhookDeliveryStatus,
+	attempts int,
+	nextAttemptAt time.Time,
+	lastStatusCode *int,
+	lastError string,
+	deliveredAt *time.Time,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *WebhookDelivery {
+	return &WebhookDelivery{
+		ID:             id,
+		SubscriptionID: subscriptionID,
+		EventType:      eventType,
+		Payload:        payload,
+		RequestID:      requestID,
+		Status:         status,
+		Attempts:       attempts,
+		NextAttemptAt:  nextAttemptAt,
+		LastStatusCode: lastStatusCode,
+		LastError:      lastError,
+		DeliveredAt:    deliveredAt,
+		CreatedAt:      createdAt,
+		UpdatedAt:      updatedAt,
+	}
+}
+
+// Redeliver は同じ内容を配信し直す新しい配信を作成する（元の配信の記録は残す）
+// 元のリクエストまで追跡できるように、リクエストIDは元の配信のものを引き継ぐ
+func (d *WebhookDelivery) Redeliver(now time.Time) *WebhookDelivery {
+	return NewWebhookDelivery(d.SubscriptionID, d.EventType, d.Payload, d.RequestID, now)
+}
+
+// MarkSucceeded は配信成功を記録する
+func (d *WebhookDelivery) MarkSucceeded(statusCode int, now time.Time) {
+	d.Attempts++
+	d.Status = valueobject.WebhookDeliverySucceeded
+	d.LastStatusCode = &statusCode
+	d.LastError = ""
+	d.DeliveredAt = &now
+	d.UpdatedAt = now
+}
+
+// MarkFailed は配信失敗を記録し、上限に達していなければ指数バックオフで次の試行日時を設定する
+func (d *WebhookDelivery) MarkFailed(statusCode *int, errMessage string, now time.Time) {
+	d.Attempts++
+	d.LastStatusCode = statusCode
+	if runes := []rune(errMessage); len(runes) > webhookLastErrorMaxLength {
+		errMessage = string(runes[:webhookLastErrorMaxLength])
+	}
+	d.LastError = errMessage
+	d.UpdatedAt = now
+
+	if d.Attempts >= WebhookMaxAttempts {
+		d.Status = valueobject.WebhookDeliveryFailed
+		return
+	}
+	d.Status = valueobject.WebhookDeliveryPending
+	d.NextAttemptAt = now.Add(WebhookRetryInterval(d.Attempts))
+}
+
+// WebhookRetryInterval は attempts 回失敗した後の再試行までの待ち時間を返す
+func WebhookRetryInterval(attempts int) time.Duration {
+	interval := WebhookRetryBaseInterval
+	for i := 1; i < attempts; i++ {
+		interval *= 2
+		if interval >= WebhookRetryMaxInterval {
+			return WebhookRetryMaxInterval
+		}
+	}
+	return interval
+}
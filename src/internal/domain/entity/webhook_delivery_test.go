@@ -0,0 +1,120 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+func TestWebhookRetryInterval(t *testing.T) {
+	tests := []struct {
+		attempts int
+		expected time.Duration
+	}{
+		{attempts: 1, expected: 30 * time.Second},
+		{attempts: 2, expected: 1 * time.Minute},
+		{attempts: 3, expected: 2 * time.Minute},
+		{attempts: 5, expected: 8 * time.Minute},
+		{attempts: 7, expected: 32 * time.Minute},
+		{attempts: 8, expected: WebhookRetryMaxInterval},
+		{attempts: 20, expected: WebhookRetryMaxInterval},
+	}
+
+	for _, tt := range tests {
+		if got := WebhookRetryInterval(tt.attempts); got != tt.expected {
+			t.Errorf("WebhookRetryInterval(%d) = %v, want %v", tt.attempts, got, tt.expected)
+		}
+	}
+}
+
+func TestWebhookDelivery_MarkFailed(t *testing.T) {
+	now := time.Now()
+
+	t.Run("上限未満の失敗は指数バックオフで再試行される", func(t *testing.T) {
+		delivery := NewWebhookDelivery(valueobject.NewWebhookSubscriptionID(), valueobject.WebhookEventPostPublished, []byte(`{}`), "", now)
+		statusCode := 500
+
+		delivery.MarkFailed(&statusCode, "Webhook receiver returned status 500", now)
+		if delivery.Status != valueobject.WebhookDeliveryPending {
+			t.Errorf("Status = %v, want pending", delivery.Status)
+		}
+		if !delivery.NextAttemptAt.Equal(now.Add(30 * time.Second)) {
+			t.Errorf("NextAttemptAt = %v, want %v", delivery.NextAttemptAt, now.Add(30*time.Second))
+		}
+
+		delivery.MarkFailed(nil, "connection refused", now)
+		if delivery.Attempts != 2 {
+			t.Errorf("Attempts = %d, want 2", delivery.Attempts)
+		}
+		if !delivery.NextAttemptAt.Equal(now.Add(1 * time.Minute)) {
+			t.Errorf("NextAttemptAt = %v, want %v", delivery.NextAttemptAt, now.Add(1*time.Minute))
+		}
+		if delivery.LastStatusCode != nil {
+			t.Errorf("LastStatusCode = %v, want nil", *delivery.LastStatusCode)
+		}
+	})
+
+	t.Run("上限に達すると配信失敗になる", func(t *testing.T) {
+		delivery := NewWebhookDelivery(valueobject.NewWebhookSubscriptionID(), valueobject.WebhookEventPostPublished, []byte(`{}`), "", now)
+		delivery.Attempts = WebhookMaxAttempts - 1
+
+		delivery.MarkFailed(nil, strings.Repeat("a", 2000), now)
+
+		if delivery.Status != valueobject.WebhookDeliveryFailed {
+			t.Errorf("Status = %v, want failed", delivery.Status)
+		}
+		if len(delivery.LastError) != 1000 {
+			t.Errorf("LastError の長さ = %d, want 1000", len(delivery.LastError))
+		}
+	})
+}
+
+func TestWebhookDelivery_MarkSucceeded(t *testing.T) {
+	now := time.Now()
+	delivery := NewWebhookDelivery(valueobject.NewWebhookSubscriptionID(), valueobject.WebhookEventPostPublished, []byte(`{}`), "", now)
+	delivery.MarkFailed(nil, "timeout", now)
+
+	delivery.MarkSucceeded(204, now)
+
+	if delivery.Status != valueobject.WebhookDeliverySucceeded {
+		t.Errorf("Status = %v, want succeeded", delivery.Status)
+	}
+	if delivery.Attempts != 2 {
+		t.Errorf("Attempts = %d, want 2", delivery.Attempts)
+	}
+	if delivery.LastError != "" {
+		t.Errorf("LastError = %q, want empty", delivery.LastError)
+	}
+	if delivery.DeliveredAt == nil || !delivery.DeliveredAt.Equal(now) {
+		t.Errorf("DeliveredAt = %v, want %v", delivery.DeliveredAt, now)
+	}
+}
+
+func TestWebhookDelivery_Redeliver(t *testing.T) {
+	now := time.Now()
+	delivery := NewWebhookDelivery(valueobject.NewWebhookSubscriptionID(), valueobject.WebhookEventPostUpdated, []byte(`{"event":"post.updated"}`), "req-123", now)
+	delivery.ID = 10
+	delivery.Attempts = WebhookMaxAttempts
+	delivery.Status = valueobject.WebhookDeliveryFailed
+
+	redelivery := delivery.Redeliver(now.Add(time.Hour))
+
+	if redelivery.ID != 0 || redelivery.Attempts != 0 {
+		t.Errorf("新しい配信になっていません: %+v", redelivery)
+	}
+	if redelivery.Status != valueobject.WebhookDeliveryPending {
+		t.Errorf("Status = %v, want pending", redelivery.Status)
+	}
+	if string(redelivery.Payload) != string(delivery.Payload) || redelivery.SubscriptionID != delivery.SubscriptionID {
+		t.Errorf("配信内容が引き継がれていません")
+	}
+	if redelivery.RequestID != "req-123" {
+		t.Errorf("RequestID = %q, want req-123", redelivery.RequestID)
+	}
+	// 元の配信は変更しない
+	if delivery.Status != valueobject.WebhookDeliveryFailed {
+		t.Errorf("元の配信の Status が変更されています")
+	}
+}
@@ -0,0 +1,117 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+// WebhookEvent は投稿ユースケースがアウトボックスに書き込むイベント
+// 投稿の変更と同じトランザクションで保存し、配信はバックグラウンドで行う
+type WebhookEvent struct {
+	ID        int64
+	EventType valueobject.WebhookEventType
+	// 配信する JSON 本文
+	Payload []byte
+	// イベントを発生させたリクエストのID（配信時に X-Request-ID として送信する）
+	RequestID string
+	CreatedAt time.Time
+}
+
+// webhookEventPayload はWebhookの本文
+type webhookEventPayload struct {
+	Event      string             `json:"event"`
+	OccurredAt time.Time          `json:"occurred_at"`
+	Post       webhookPostPayload `json:"post"`
+}
+
+type webhookPostPayload struct {
+	ID                string     `json:"id"`
+	Title             string     `json:"title"`
+	Status            string     `json:"status"`
+	Tags              []string   `json:"tags"`
+	PrimaryCategoryID *string    `json:"primary_category_id"`
+	CategoryIDs       []string   `json:"category_ids"`
+	FirstPublishedAt  *time.Time `json:"first_published_at"`
+	ContentUpdatedAt  *time.Time `json:"content_updated_at"`
+	Version           int        `json:"version"`
+}
+
+// NewPostWebhookEvent は投稿の状態を本文に含むイベントを作成する
+func NewPostWebhookEvent(eventType valueobject.WebhookEventType, post *Post, requestID string, occurredAt time.Time) (*WebhookEvent, error) {
+	tags := make([]string, 0, len(post.Tags))
+	for _, tag := range post.Tags {
+		tags = append(tags, tag.String())
+	}
+
+	var primaryCategoryID *string
+	if post.PrimaryCategoryID != nil {
+		id := post.PrimaryCategoryID.String()
+		primaryCategoryID = &id
+	}
+
+	categoryIDs := make([]string, 0, len(post.CategoryIDs))
+	for _, categoryID := range post.CategoryIDs {
+		categoryIDs = append(categoryIDs, categoryID.String())
+	}
+
+	payload, err := json.Marshal(webhookEventPayload{
+		Event:      eventType.String(),
+		OccurredAt: occurredAt,
+		Post: webhookPostPayload{
+			ID:                post.ID.String(),
+			Title:             post.Title.String(),
+			Status:            post.Status.String(),
+			Tags:              tags,
+			PrimaryCategoryID: primaryCategoryID,
+			CategoryIDs:       categoryIDs,
+			FirstPublishedAt:  post.FirstPublishedAt,
+			ContentUpdatedAt:  post.ContentUpdatedAt,
+			Version:           post.Version,
+		},
+	})
+	if err != nil {
+		return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_encode_webhook_payload")
+	}
+
+	return &WebhookEvent{
+		EventType: eventType,
+		Payload:   payload,
+		RequestID: requestID,
+		CreatedAt: occurredAt,
+	}, nil
+}
+
+// イベントデータ再構築
+func ParseWebhookEvent(id int64, eventType valueobject.WebhookEventType, payload []byte, requestID string, createdAt time.Time) *WebhookEvent {
+	return &WebhookEvent{
+		ID:        id,
+		EventType: eventType,
+		Payload:   payload,
+		RequestID: requestID,
+		CreatedAt: createdAt,
+	}
+}
+
+/**
+* 投稿の変更から通知するイベントを判定する
+* 公開以外->公開: post.published
+* 公開->公開以外: post.unpublished
+* 公開のまま内容を更新: post.updated
+ */
+func DetectPostWebhookEvent(fromStatus valueobject.PostStatus, toStatus valueobject.PostStatus, changed bool) (valueobject.WebhookEventType, bool) {
+	wasPublished := fromStatus == valueobject.StatusPublished
+	isPublished := toStatus == valueobject.StatusPublished
+
+	switch {
+	case !wasPublished && isPublished:
+		return valueobject.WebhookEventPostPublished, true
+	case wasPublished && !isPublished:
+		return valueobject.WebhookEventPostUnpublished, true
+	case wasPublished && isPublished && changed:
+		return valueobject.WebhookEventPostUpdated, true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,83 @@
+package entity
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+func TestDetectPostWebhookEvent(t *testing.T) {
+	tests := []struct {
+		name      string
+		from      valueobject.PostStatus
+		to        valueobject.PostStatus
+		changed   bool
+		wantEvent valueobject.WebhookEventType
+		wantOK    bool
+	}{
+		{name: "承認済みから公開", from: valueobject.StatusApproved, to: valueobject.StatusPublished, wantEvent: valueobject.WebhookEventPostPublished, wantOK: true},
+		{name: "非公開から再公開", from: valueobject.StatusPrivate, to: valueobject.StatusPublished, changed: true, wantEvent: valueobject.WebhookEventPostPublished, wantOK: true},
+		{name: "公開から非公開", from: valueobject.StatusPublished, to: valueobject.StatusPrivate, wantEvent: valueobject.WebhookEventPostUnpublished, wantOK: true},
+		{name: "公開中の内容更新", from: valueobject.StatusPublished, to: valueobject.StatusPublished, changed: true, wantEvent: valueobject.WebhookEventPostUpdated, wantOK: true},
+		{name: "公開中で変更なし", from: valueobject.StatusPublished, to: valueobject.StatusPublished, changed: false, wantOK: false},
+		{name: "下書きの更新", from: valueobject.StatusDraft, to: valueobject.StatusDraft, changed: true, wantOK: false},
+		{name: "下書きからレビュー中", from: valueobject.StatusDraft, to: valueobject.StatusInReview, changed: true, wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event, ok := DetectPostWebhookEvent(tt.from, tt.to, tt.changed)
+
+			if ok != tt.wantOK {
+				t.Fatalf("ok = %v, want %v", ok, tt.wantOK)
+			}
+			if event != tt.wantEvent {
+				t.Errorf("event = %v, want %v", event, tt.wantEvent)
+			}
+		})
+	}
+}
+
+func TestNewPostWebhookEvent(t *testing.T) {
+	title, _ := valueobject.NewPostTitle("タイトル")
+	content, _ := valueobject.NewPostContent("内容")
+	tag, _ := valueobject.NewTagName("golang")
+	post, _ := NewPost(title, content, valueobject.NewUserID(), valueobject.StatusDraft)
+	post.Tags = []valueobject.TagName{tag}
+	post.Status = valueobject.StatusPublished
+	occurredAt := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	event, err := NewPostWebhookEvent(valueobject.WebhookEventPostPublished, post, "req-123", occurredAt)
+	if err != nil {
+		t.Fatalf("予期しないエラー: %v", err)
+	}
+
+	if event.RequestID != "req-123" {
+		t.Errorf("RequestID = %q, want req-123", event.RequestID)
+	}
+
+	var payload map[string]any
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		t.Fatalf("本文が JSON ではありません: %v", err)
+	}
+
+	if payload["event"] != "post.published" {
+		t.Errorf("event = %v, want post.published", payload["event"])
+	}
+	if payload["occurred_at"] != "2025-01-10T12:00:00Z" {
+		t.Errorf("occurred_at = %v", payload["occurred_at"])
+	}
+	postPayload := payload["post"].(map[string]any)
+	if postPayload["id"] != post.ID.String() {
+		t.Errorf("post.id = %v, want %v", postPayload["id"], post.ID)
+	}
+	if postPayload["status"] != "published" {
+		t.Errorf("post.status = %v, want published", postPayload["status"])
+	}
+	// 本文は配信しない
+	if _, ok := postPayload["content"]; ok {
+		t.Errorf("post.content が含まれています")
+	}
+}
@@ -0,0 +1,119 @@
+package entity
+
+import (
+	"net/netip"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+// WebhookSecretMinLength は署名用シークレットの最小文字数
+const WebhookSecretMinLength = 16
+
+// WebhookSubscription はWebhookの購読設定（通知先URL・署名用シークレット・購読するイベント）
+type WebhookSubscription struct {
+	ID         valueobject.WebhookSubscriptionID
+	URL        string
+	Secret     string
+	EventTypes []valueobject.WebhookEventType
+	Active     bool
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// 新規購読作成
+// allowLocalNetwork が false の場合は https 以外のURLと、内部ネットワークを指すURLを受け付けない
+// （名前解決後のアドレスは送信時に WebhookSender が検証する）
+func NewWebhookSubscription(rawURL string, secret string, eventTypes []valueobject.WebhookEventType, allowLocalNetwork bool) (*WebhookSubscription, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Hostname() == "" {
+		return nil, valueobject.NewMyError(valueobject.InvalidCode, "webhook_url_invalid")
+	}
+
+	if !allowLocalNetwork {
+		if parsed.Scheme != "https" {
+			return nil, valueobject.NewMyError(valueobject.InvalidCode, "webhook_url_must_be_https")
+		}
+		if isLocalNetworkHost(parsed.Hostname()) {
+			return nil, valueobject.NewMyError(valueobject.InvalidCode, "webhook_url_private_network")
+		}
+	}
+
+	if len(secret) < WebhookSecretMinLength {
+		return nil, valueobject.NewMyError(valueobject.InvalidCode, "webhook_secret_too_short")
+	}
+
+	if len(eventTypes) == 0 {
+		return nil, valueobject.NewMyError(valueobject.InvalidCode, "webhook_event_types_are_required")
+	}
+
+	// 同じイベントの重複指定は1つにまとめる
+	uniqueEventTypes := make([]valueobject.WebhookEventType, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		if !slices.Contains(uniqueEventTypes, eventType) {
+			uniqueEventTypes = append(uniqueEventTypes, eventType)
+		}
+	}
+
+	now := time.Now()
+	return &WebhookSubscription{
+		ID:         valueobject.NewWebhookSubscriptionID(),
+		URL:        parsed.String(),
+		Secret:     secret,
+		EventTypes: uniqueEventTypes,
+		Active:     true,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}, nil
+}
+
+// IsLocalNetworkAddress はWebhookの送信先にできない内部ネットワークのアドレス
+// （ループバック・プライベート・リンクローカル・未指定）かを返す
+func IsLocalNetworkAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsLoopback() ||
+		addr.IsPrivate() ||
+		addr.IsLinkLocalUnicast() ||
+		addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() ||
+		addr.IsUnspecified()
+}
+
+// isLocalNetworkHost はURLのホストが localhost または内部ネットワークのIPアドレスかを返す
+func isLocalNetworkHost(host string) bool {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return true
+	}
+	addr, err := netip.ParseAddr(host)
+	return err == nil && IsLocalNetworkAddress(addr)
+}
+
+// 購読データ再構築
+func ParseWebhookSubscription(
+	id valueobject.WebhookSubscriptionID,
+	url string,
+	secret string,
+	eventTypes []valueobject.WebhookEventType,
+	active bool,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *WebhookSubscription {
+	return &WebhookSubscription{
+		ID:         id,
+		URL:        url,
+		Secret:     secret,
+		EventTypes: eventTypes,
+		Active:     active,
+		CreatedAt:  createdAt,
+		UpdatedAt:  updatedAt,
+	}
+}
+
+// Subscribes は有効な購読で、指定イベントを購読しているかを返す
+func (s *WebhookSubscription) Subscribes(eventType valueobject.WebhookEventType) bool {
+	return s.Active && slices.Contains(s.EventTypes, eventType)
+}
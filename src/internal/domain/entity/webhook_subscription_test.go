@@ -0,0 +1,88 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+func TestNewWebhookSubscription(t *testing.T) {
+	secret := "0123456789abcdef"
+	published := []valueobject.WebhookEventType{valueobject.WebhookEventPostPublished}
+
+	tests := []struct {
+		name       string
+		url        string
+		secret     string
+		eventTypes []valueobject.WebhookEventType
+		local      bool
+		wantErr    bool
+	}{
+		{name: "正常ケース: https", url: "https://example.com/hooks", secret: secret, eventTypes: published},
+		{name: "正常ケース: ローカル環境ではhttpとlocalhostを許可", url: "http://localhost:8080/hooks", secret: secret, eventTypes: published, local: true},
+		{name: "異常ケース: http", url: "http://example.com/hooks", secret: secret, eventTypes: published, wantErr: true},
+		{name: "異常ケース: localhost", url: "https://localhost:8080/hooks", secret: secret, eventTypes: published, wantErr: true},
+		{name: "異常ケース: ループバック", url: "https://127.0.0.1/hooks", secret: secret, eventTypes: published, wantErr: true},
+		{name: "異常ケース: メタデータサーバー", url: "https://169.254.169.254/latest/meta-data", secret: secret, eventTypes: published, wantErr: true},
+		{name: "異常ケース: プライベートアドレス", url: "https://10.0.0.1/hooks", secret: secret, eventTypes: published, wantErr: true},
+		{name: "異常ケース: IPv6ループバック", url: "https://[::1]/hooks", secret: secret, eventTypes: published, wantErr: true},
+		{name: "異常ケース: IPv4射影アドレス", url: "https://[::ffff:192.168.0.1]/hooks", secret: secret, eventTypes: published, wantErr: true},
+		{name: "異常ケース: 相対URL", url: "/hooks", secret: secret, eventTypes: published, wantErr: true},
+		{name: "異常ケース: http以外のスキーム", url: "ftp://example.com/hooks", secret: secret, eventTypes: published, wantErr: true},
+		{name: "異常ケース: シークレットが短い", url: "https://example.com/hooks", secret: "short", eventTypes: published, wantErr: true},
+		{name: "異常ケース: イベント未指定", url: "https://example.com/hooks", secret: secret, eventTypes: nil, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subscription, err := NewWebhookSubscription(tt.url, tt.secret, tt.eventTypes, tt.local)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("エラーが期待されましたが、エラーが発生しませんでした")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("予期しないエラー: %v", err)
+			}
+			if !subscription.Active {
+				t.Errorf("Active = false, want true")
+			}
+			if subscription.ID == "" {
+				t.Errorf("ID が設定されていません")
+			}
+		})
+	}
+
+	t.Run("重複したイベントは1つにまとめられる", func(t *testing.T) {
+		subscription, err := NewWebhookSubscription("https://example.com/hooks", secret, []valueobject.WebhookEventType{
+			valueobject.WebhookEventPostPublished,
+			valueobject.WebhookEventPostUpdated,
+			valueobject.WebhookEventPostPublished,
+		}, false)
+		if err != nil {
+			t.Fatalf("予期しないエラー: %v", err)
+		}
+		if len(subscription.EventTypes) != 2 {
+			t.Errorf("EventTypes = %v, want 2 events", subscription.EventTypes)
+		}
+	})
+}
+
+func TestWebhookSubscription_Subscribes(t *testing.T) {
+	subscription, _ := NewWebhookSubscription("https://example.com/hooks", "0123456789abcdef", []valueobject.WebhookEventType{valueobject.WebhookEventPostPublished}, false)
+
+	if !subscription.Subscribes(valueobject.WebhookEventPostPublished) {
+		t.Errorf("購読しているイベントが対象になっていません")
+	}
+	if subscription.Subscribes(valueobject.WebhookEventPostUnpublished) {
+		t.Errorf("購読していないイベントが対象になっています")
+	}
+
+	subscription.Active = false
+	if subscription.Subscribes(valueobject.WebhookEventPostPublished) {
+		t.Errorf("無効な購読が対象になっています")
+	}
+}
@@ -0,0 +1,74 @@
+package event
+
+import (
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+// イベント名
+const (
+	PostCreatedName       = "PostCreated"
+	PostStatusChangedName = "PostStatusChanged"
+	PostPublishedName     = "PostPublished"
+	PostUnpublishedName   = "PostUnpublished"
+	ImageUploadedName     = "ImageUploaded"
+)
+
+// Event はエンティティの操作によって発生したドメインイベント
+type Event interface {
+	EventName() string
+	OccurredAt() time.Time
+}
+
+// PostCreated は投稿が作成されたことを表す
+type PostCreated struct {
+	PostID valueobject.PostID
+	UserID valueobject.UserID
+	Status valueobject.PostStatus
+	At     time.Time
+}
+
+func (e PostCreated) EventName() string     { return PostCreatedName }
+func (e PostCreated) OccurredAt() time.Time { return e.At }
+
+// PostStatusChanged は投稿のステータスが変更されたことを表す
+type PostStatusChanged struct {
+	PostID valueobject.PostID
+	From   valueobject.PostStatus
+	To     valueobject.PostStatus
+	At     time.Time
+}
+
+func (e PostStatusChanged) EventName() string     { return PostStatusChangedName }
+func (e PostStatusChanged) OccurredAt() time.Time { return e.At }
+
+// PostPublished は投稿が公開されたことを表す（作成時の公開も含む）
+type PostPublished struct {
+	PostID valueobject.PostID
+	At     time.Time
+}
+
+func (e PostPublished) EventName() string     { return PostPublishedName }
+func (e PostPublished) OccurredAt() time.Time { return e.At }
+
+// PostUnpublished は公開中の投稿が公開以外のステータスになったことを表す
+type PostUnpublished struct {
+	PostID valueobject.PostID
+	To     valueobject.PostStatus
+	At     time.Time
+}
+
+func (e PostUnpublished) EventName() string     { return PostUnpublishedName }
+func (e PostUnpublished) OccurredAt() time.Time { return e.At }
+
+// ImageUploaded は投稿に画像がアップロードされたことを表す
+type ImageUploaded struct {
+	ImageID valueobject.ImageID
+	PostID  valueobject.PostID
+	UserID  valueobject.UserID
+	At      time.Time
+}
+
+func (e ImageUploaded) EventName() string     { return ImageUploadedName }
+func (e ImageUploaded) OccurredAt() time.Time { return e.At }
@@ -0,0 +1,6 @@
+package event
+
+import "context"
+
+// Handler はイベントを処理する。コミット後に呼ばれるため、エラーは呼び出し元に返らずログに記録される
+type Handler func(ctx context.Context, e Event) error
@@ -0,0 +1,18 @@
+package event
+
+// Recorder はエンティティに埋め込み、発生したイベントを保存まで保持する
+type Recorder struct {
+	events []Event
+}
+
+// Raise はイベントを記録する
+func (r *Recorder) Raise(e Event) {
+	r.events = append(r.events, e)
+}
+
+// PullEvents は記録されたイベントを発生順に返し、記録を空にする
+func (r *Recorder) PullEvents() []Event {
+	events := r.events
+	r.events = nil
+	return events
+}
@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+type AuditEventRepository interface {
+	// Create は監査イベントを追記する。変更操作と同じトランザクション内で呼び出す
+	Create(ctx context.Context, event *entity.AuditEvent) error
+	// List は条件に一致する監査イベントを新しい順に返す
+	List(ctx context.Context, options *ListAuditEventsOptions) ([]*entity.AuditEvent, int, error)
+}
+
+// ListAuditEventsOptions は監査イベント一覧取得のオプション（nil・空文字の条件は絞り込まない）
+type ListAuditEventsOptions struct {
+	Limit      int
+	Offset     int
+	ActorID    *valueobject.UserID
+	EntityType *valueobject.AuditEntityType
+	EntityID   string
+	Action     *valueobject.AuditAction
+	RequestID  string
+	From       *time.Time
+	To         *time.Time
+}
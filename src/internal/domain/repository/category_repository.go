@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+type CategoryRepository interface {
+	Create(ctx context.Context, category *entity.Category) error
+	Get(ctx context.Context, id valueobject.CategoryID) (*entity.Category, error)
+	List(ctx context.Context) ([]*entity.Category, error)
+	Update(ctx context.Context, category *entity.Category) error
+	Delete(ctx context.Context, id valueobject.CategoryID) error
+	// GetAncestorIDs は指定カテゴリの祖先ID（自身は含まない）を親から順に返す
+	GetAncestorIDs(ctx context.Context, id valueobject.CategoryID) ([]valueobject.CategoryID, error)
+	// LockTree はトランザクション終了までカテゴリツリーの付け替えを直列化する（トランザクション内で呼び出す）
+	LockTree(ctx context.Context) error
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 
 	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
 )
 
 type ImageRepository interface {
 	Create(ctx context.Context, image *entity.Image) error
+	Get(ctx context.Context, id valueobject.ImageID) (*entity.Image, error)
 }
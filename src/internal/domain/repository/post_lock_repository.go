@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+type PostLockRepository interface {
+	// FindActive は有効期限内のロックを返す。ロックがない場合は nil を返す
+	FindActive(ctx context.Context, postID valueobject.PostID, now time.Time) (*entity.PostLock, error)
+	// FindActiveForUpdate は FindActive と同じくロックを返し、保存が終わるまで他のリクエストがロックを取得できないよう行をロックする
+	// トランザクション内で呼び出す
+	FindActiveForUpdate(ctx context.Context, postID valueobject.PostID, now time.Time) (*entity.PostLock, error)
+	// Acquire はロックがない・期限切れ・同じユーザーが保持中の場合にロックを取得する
+	// 他のユーザーが有効なロックを保持している場合は ConflictCode を返す
+	Acquire(ctx context.Context, lock *entity.PostLock) error
+	// Extend は指定ユーザーが保持する有効なロックの有効期限を更新する
+	Extend(ctx context.Context, lock *entity.PostLock) error
+	// Release は指定ユーザーが保持するロックを削除する
+	Release(ctx context.Context, postID valueobject.PostID, userID valueobject.UserID) error
+	// ForceRelease は保持者に関わらずロックを削除する
+	ForceRelease(ctx context.Context, postID valueobject.PostID) error
+}
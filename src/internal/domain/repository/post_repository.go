@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/MizukiShigi/cms-go/internal/domain/entity"
 	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
@@ -12,7 +13,18 @@ type PostRepository interface {
 	Get(ctx context.Context, id valueobject.PostID) (*entity.Post, error)
 	Update(ctx context.Context, post *entity.Post) error
 	SetTags(ctx context.Context, post *entity.Post, tags []*entity.Tag) error
+	SetCategories(ctx context.Context, post *entity.Post) error
 	List(ctx context.Context, options *ListPostsOptions) ([]*entity.Post, int, error)
+	// ListSitemapEntries は公開中の全投稿のサイトマップ用情報を返す
+	ListSitemapEntries(ctx context.Context) ([]*SitemapPostEntry, error)
+}
+
+// SitemapPostEntry はサイトマップに載せる公開中の投稿の情報
+type SitemapPostEntry struct {
+	ID valueobject.PostID
+	// 本文の最終更新日時（未設定の場合は投稿の更新日時）
+	LastModified time.Time
+	Tags         []valueobject.TagName
 }
 
 // ListPostsOptions は投稿一覧取得のオプション
@@ -20,5 +32,9 @@ type ListPostsOptions struct {
 	Limit  int
 	Offset int
 	Status *valueobject.PostStatus
-	Sort   string
+	// 指定カテゴリとその子孫カテゴリに属する投稿に絞り込む
+	CategoryID *valueobject.CategoryID
+	// 指定タグ（大文字小文字を区別しない）が付いた投稿に絞り込む
+	TagName *valueobject.TagName
+	Sort    string
 }
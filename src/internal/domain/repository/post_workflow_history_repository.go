@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+type PostWorkflowHistoryRepository interface {
+	Create(ctx context.Context, history *entity.PostWorkflowHistory) error
+	// ListByPostID は投稿のステータス遷移履歴を古い順に返す
+	ListByPostID(ctx context.Context, postID valueobject.PostID) ([]*entity.PostWorkflowHistory, error)
+}
@@ -4,8 +4,28 @@ import (
 	"context"
 
 	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
 )
 
 type TagRepository interface {
 	FindOrCreateByName(ctx context.Context, tag *entity.Tag) (*entity.Tag, error)
+	Get(ctx context.Context, id valueobject.TagID) (*entity.Tag, error)
+	List(ctx context.Context, options *ListTagsOptions) ([]*TagWithPostCount, int, error)
+	Update(ctx context.Context, tag *entity.Tag) error
+	Merge(ctx context.Context, sourceID valueobject.TagID, targetID valueobject.TagID) error
+	DeleteUnused(ctx context.Context) (int, error)
+}
+
+// ListTagsOptions はタグ一覧取得のオプション
+type ListTagsOptions struct {
+	Limit  int
+	Offset int
+	Prefix string
+	Sort   string
+}
+
+// TagWithPostCount はタグと紐づく投稿数
+type TagWithPostCount struct {
+	Tag       *entity.Tag
+	PostCount int
 }
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+type WebhookDeliveryRepository interface {
+	Create(ctx context.Context, delivery *entity.WebhookDelivery) error
+	Get(ctx context.Context, id int64) (*entity.WebhookDelivery, error)
+	// Update は試行結果（状態・試行回数・次回試行日時・最後の結果）を保存する
+	Update(ctx context.Context, delivery *entity.WebhookDelivery) error
+	// ClaimDue は次回試行日時を過ぎた配信待ちを取得し、他のディスパッチャーが重複して
+	// 配信しないように次回試行日時を leaseUntil まで延ばす
+	ClaimDue(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) ([]*entity.WebhookDelivery, error)
+	// ListBySubscription は購読の配信記録を新しい順に返す
+	ListBySubscription(ctx context.Context, subscriptionID valueobject.WebhookSubscriptionID, limit int, offset int) ([]*entity.WebhookDelivery, int, error)
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+)
+
+// WebhookEventRepository はWebhookイベントのアウトボックス
+type WebhookEventRepository interface {
+	// Create はイベントをアウトボックスに追加する。投稿の変更と同じトランザクション内で呼び出す
+	Create(ctx context.Context, event *entity.WebhookEvent) error
+	// ListUndispatched は配信に展開していないイベントを古い順に返す
+	// トランザクション内で呼び出すと、他のディスパッチャーが処理中のイベントは除外される
+	ListUndispatched(ctx context.Context, limit int) ([]*entity.WebhookEvent, error)
+	// MarkDispatched はイベントを配信に展開済みにする
+	MarkDispatched(ctx context.Context, id int64, dispatchedAt time.Time) error
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+type WebhookSubscriptionRepository interface {
+	Create(ctx context.Context, subscription *entity.WebhookSubscription) error
+	Get(ctx context.Context, id valueobject.WebhookSubscriptionID) (*entity.WebhookSubscription, error)
+	// List は購読を作成日時の古い順に返す
+	List(ctx context.Context) ([]*entity.WebhookSubscription, error)
+	// ListByEventType は指定イベントを購読している有効な購読を返す
+	ListByEventType(ctx context.Context, eventType valueobject.WebhookEventType) ([]*entity.WebhookSubscription, error)
+	// Delete は購読を削除する。配信記録も合わせて削除される
+	Delete(ctx context.Context, id valueobject.WebhookSubscriptionID) error
+}
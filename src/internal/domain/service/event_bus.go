@@ -0,0 +1,13 @@
+package service
+
+import (
+	"context"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/event"
+)
+
+// EventBus はドメインイベントを登録されたハンドラーに配信する
+type EventBus interface {
+	Subscribe(eventName string, handler event.Handler)
+	Publish(ctx context.Context, events ...event.Event)
+}
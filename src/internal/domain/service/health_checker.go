@@ -0,0 +1,13 @@
+package service
+
+import (
+	"context"
+)
+
+// HealthChecker は外部依存（DB・ストレージ・認証基盤など）の疎通を確認する
+type HealthChecker interface {
+	// Name はレスポンスに表示する依存先の名前
+	Name() string
+	// Check は依存先に到達できない場合にエラーを返す
+	Check(ctx context.Context) error
+}
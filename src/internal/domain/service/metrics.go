@@ -0,0 +1,17 @@
+package service
+
+import (
+	"time"
+)
+
+// Metrics はアプリケーションの計測値を記録する
+type Metrics interface {
+	// ObserveHTTPRequest はリクエスト1件の処理結果を記録する（route はパスのテンプレート）
+	ObserveHTTPRequest(method string, route string, statusCode int, duration time.Duration)
+	// IncTransactionRollback はトランザクションのロールバックを記録する（reason: error / panic）
+	IncTransactionRollback(reason string)
+	// ObserveStorageUpload はストレージへのアップロード結果を記録する
+	ObserveStorageUpload(bytes int64, duration time.Duration, err error)
+	// IncDomainEvent は発行されたドメインイベントを記録する（投稿の公開数などの業務指標）
+	IncDomainEvent(name string)
+}
@@ -0,0 +1,17 @@
+package service
+
+import (
+	"context"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+// RateLimitStore はキーごとのトークンバケットを保持する
+// 1インスタンスの場合はメモリ、複数インスタンスで共有する場合はDBに保持する
+type RateLimitStore interface {
+	// Take はキーのバケットからトークンを1つ消費し、許可されたかを返す
+	Take(ctx context.Context, key string, policy valueobject.RateLimitPolicy) (entity.RateLimitDecision, error)
+	// Reset はキーのバケットを満杯に戻す（ログイン成功時に失敗回数を消すなど）
+	Reset(ctx context.Context, key string) error
+}
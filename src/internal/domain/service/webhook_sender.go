@@ -0,0 +1,27 @@
+package service
+
+import (
+	"context"
+)
+
+// WebhookRequest は送信するWebhook
+type WebhookRequest struct {
+	URL        string
+	Secret     string
+	EventType  string
+	DeliveryID int64
+	Payload    []byte
+	// イベントを発生させたリクエストのID（空の場合は送信しない）
+	RequestID string
+}
+
+// WebhookResponse は受信側の応答
+type WebhookResponse struct {
+	StatusCode int
+}
+
+type WebhookSender interface {
+	// Send は本文にシークレットで署名して送信する
+	// 2xx 以外の応答はエラーを返す。応答を受け取った場合はエラー時もレスポンスを返す
+	Send(ctx context.Context, request WebhookRequest) (*WebhookResponse, error)
+}
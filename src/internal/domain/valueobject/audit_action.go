@@ -0,0 +1,26 @@
+package valueobject
+
+// AuditAction は監査ログに記録する操作の種類
+type AuditAction string
+
+const (
+	AuditActionCreate       AuditAction = "create"
+	AuditActionUpdate       AuditAction = "update"
+	AuditActionStatusChange AuditAction = "status_change"
+	AuditActionUpload       AuditAction = "upload"
+	AuditActionMerge        AuditAction = "merge"
+	AuditActionDelete       AuditAction = "delete"
+)
+
+func NewAuditAction(action string) (AuditAction, error) {
+	switch AuditAction(action) {
+	case AuditActionCreate, AuditActionUpdate, AuditActionStatusChange, AuditActionUpload, AuditActionMerge, AuditActionDelete:
+		return AuditAction(action), nil
+	default:
+		return AuditAction(""), NewMyError(InvalidCode, "invalid_audit_action")
+	}
+}
+
+func (a AuditAction) String() string {
+	return string(a)
+}
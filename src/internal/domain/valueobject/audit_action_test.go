@@ -0,0 +1,59 @@
+package valueobject
+
+import (
+	"testing"
+)
+
+func TestNewAuditAction(t *testing.T) {
+	tests := []struct {
+		name     string
+		action   string
+		wantErr  bool
+		expected AuditAction
+	}{
+		{
+			name:     "正常ケース: status_change",
+			action:   "status_change",
+			wantErr:  false,
+			expected: AuditActionStatusChange,
+		},
+		{
+			name:     "正常ケース: delete",
+			action:   "delete",
+			wantErr:  false,
+			expected: AuditActionDelete,
+		},
+		{
+			name:    "異常ケース: 無効な値",
+			action:  "publish",
+			wantErr: true,
+		},
+		{
+			name:    "異常ケース: 空文字",
+			action:  "",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewAuditAction(tt.action)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("エラーが期待されましたが、エラーが発生しませんでした")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("予期しないエラー: %v", err)
+				return
+			}
+
+			if result != tt.expected {
+				t.Errorf("NewAuditAction() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package valueobject
+
+// AuditEntityType は監査ログの対象となるエンティティの種類
+type AuditEntityType string
+
+const (
+	AuditEntityPost     AuditEntityType = "post"
+	AuditEntityImage    AuditEntityType = "image"
+	AuditEntityCategory AuditEntityType = "category"
+	AuditEntityTag      AuditEntityType = "tag"
+)
+
+func NewAuditEntityType(entityType string) (AuditEntityType, error) {
+	switch AuditEntityType(entityType) {
+	case AuditEntityPost, AuditEntityImage, AuditEntityCategory, AuditEntityTag:
+		return AuditEntityType(entityType), nil
+	default:
+		return AuditEntityType(""), NewMyError(InvalidCode, "invalid_audit_entity_type")
+	}
+}
+
+func (t AuditEntityType) String() string {
+	return string(t)
+}
@@ -0,0 +1,59 @@
+package valueobject
+
+import (
+	"testing"
+)
+
+func TestNewAuditEntityType(t *testing.T) {
+	tests := []struct {
+		name       string
+		entityType string
+		wantErr    bool
+		expected   AuditEntityType
+	}{
+		{
+			name:       "正常ケース: post",
+			entityType: "post",
+			wantErr:    false,
+			expected:   AuditEntityPost,
+		},
+		{
+			name:       "正常ケース: category",
+			entityType: "category",
+			wantErr:    false,
+			expected:   AuditEntityCategory,
+		},
+		{
+			name:       "異常ケース: 無効な値",
+			entityType: "user",
+			wantErr:    true,
+		},
+		{
+			name:       "異常ケース: 空文字",
+			entityType: "",
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewAuditEntityType(tt.entityType)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("エラーが期待されましたが、エラーが発生しませんでした")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("予期しないエラー: %v", err)
+				return
+			}
+
+			if result != tt.expected {
+				t.Errorf("NewAuditEntityType() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
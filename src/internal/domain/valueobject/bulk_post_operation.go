@@ -0,0 +1,25 @@
+package valueobject
+
+// BulkPostOperation は投稿の一括操作の種類
+type BulkPostOperation string
+
+const (
+	BulkPostSetStatus  BulkPostOperation = "set_status"
+	BulkPostAddTags    BulkPostOperation = "add_tags"
+	BulkPostRemoveTags BulkPostOperation = "remove_tags"
+	BulkPostDelete     BulkPostOperation = "delete"
+	BulkPostRestore    BulkPostOperation = "restore"
+)
+
+func NewBulkPostOperation(operation string) (BulkPostOperation, error) {
+	switch BulkPostOperation(operation) {
+	case BulkPostSetStatus, BulkPostAddTags, BulkPostRemoveTags, BulkPostDelete, BulkPostRestore:
+		return BulkPostOperation(operation), nil
+	default:
+		return BulkPostOperation(""), NewMyError(InvalidCode, "invalid_bulk_operation")
+	}
+}
+
+func (o BulkPostOperation) String() string {
+	return string(o)
+}
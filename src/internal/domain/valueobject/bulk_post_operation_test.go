@@ -0,0 +1,72 @@
+package valueobject
+
+import (
+	"testing"
+)
+
+func TestNewBulkPostOperation(t *testing.T) {
+	tests := []struct {
+		name      string
+		operation string
+		wantErr   bool
+		expected  BulkPostOperation
+	}{
+		{
+			name:      "正常ケース: set_status",
+			operation: "set_status",
+			expected:  BulkPostSetStatus,
+		},
+		{
+			name:      "正常ケース: add_tags",
+			operation: "add_tags",
+			expected:  BulkPostAddTags,
+		},
+		{
+			name:      "正常ケース: remove_tags",
+			operation: "remove_tags",
+			expected:  BulkPostRemoveTags,
+		},
+		{
+			name:      "正常ケース: delete",
+			operation: "delete",
+			expected:  BulkPostDelete,
+		},
+		{
+			name:      "正常ケース: restore",
+			operation: "restore",
+			expected:  BulkPostRestore,
+		},
+		{
+			name:      "異常ケース: 無効な値",
+			operation: "archive",
+			wantErr:   true,
+		},
+		{
+			name:      "異常ケース: 空文字",
+			operation: "",
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewBulkPostOperation(tt.operation)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("エラーが期待されましたが、エラーが発生しませんでした")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("予期しないエラー: %v", err)
+				return
+			}
+
+			if result != tt.expected {
+				t.Errorf("NewBulkPostOperation() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
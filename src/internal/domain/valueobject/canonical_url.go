@@ -0,0 +1,37 @@
+package valueobject
+
+import (
+	"net/url"
+	"strings"
+)
+
+type CanonicalURL string
+
+const canonicalURLMaxLength = 2048
+
+// NewCanonicalURL は正規URLを生成する。空文字は未設定として扱う
+func NewCanonicalURL(rawURL string) (CanonicalURL, error) {
+	normalizedURL := strings.TrimSpace(rawURL)
+	if normalizedURL == "" {
+		return CanonicalURL(""), nil
+	}
+
+	if len(normalizedURL) > canonicalURLMaxLength {
+		return CanonicalURL(""), NewMyError(InvalidCode, "canonical_url_is_too_long")
+	}
+
+	parsedURL, err := url.Parse(normalizedURL)
+	if err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") || parsedURL.Host == "" {
+		return CanonicalURL(""), NewMyError(InvalidCode, "canonical_url_invalid")
+	}
+
+	return CanonicalURL(normalizedURL), nil
+}
+
+func (c CanonicalURL) String() string {
+	return string(c)
+}
+
+func (c CanonicalURL) Equals(other CanonicalURL) bool {
+	return c == other
+}
@@ -0,0 +1,81 @@
+package valueobject
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewCanonicalURL(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expected    string
+		wantErr     bool
+		expectedErr string
+	}{
+		{
+			name:     "正常ケース: httpsのURL",
+			input:    "https://example.com/posts/go-errors",
+			expected: "https://example.com/posts/go-errors",
+		},
+		{
+			name:     "正常ケース: httpのURL",
+			input:    "http://example.com/posts/1?ref=top",
+			expected: "http://example.com/posts/1?ref=top",
+		},
+		{
+			name:     "正常ケース: 空文字は未設定",
+			input:    "",
+			expected: "",
+		},
+		{
+			name:     "正常ケース: 前後の空白を除去",
+			input:    "  https://example.com/  ",
+			expected: "https://example.com/",
+		},
+		{
+			name:        "異常ケース: 相対URL",
+			input:       "/posts/1",
+			wantErr:     true,
+			expectedErr: "canonical_url_invalid",
+		},
+		{
+			name:        "異常ケース: http以外のスキーム",
+			input:       "ftp://example.com/posts/1",
+			wantErr:     true,
+			expectedErr: "canonical_url_invalid",
+		},
+		{
+			name:        "異常ケース: 長すぎる",
+			input:       "https://example.com/" + strings.Repeat("a", 2048),
+			wantErr:     true,
+			expectedErr: "canonical_url_is_too_long",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			canonicalURL, err := NewCanonicalURL(tt.input)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("エラーが期待されましたが、エラーが発生しませんでした")
+					return
+				}
+				if err.Error() != tt.expectedErr {
+					t.Errorf("期待されたエラーメッセージ = %v, 実際のエラーメッセージ = %v", tt.expectedErr, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("予期しないエラー: %v", err)
+				return
+			}
+
+			if canonicalURL.String() != tt.expected {
+				t.Errorf("CanonicalURL.String() = %v, want %v", canonicalURL.String(), tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package valueobject
+
+import "github.com/google/uuid"
+
+type CategoryID string
+
+func NewCategoryID() CategoryID {
+	return CategoryID(uuid.New().String())
+}
+
+func (c CategoryID) String() string {
+	return string(c)
+}
+
+func (c CategoryID) Equals(other CategoryID) bool {
+	return c == other
+}
+
+func ParseCategoryID(s string) (CategoryID, error) {
+	uuid, err := uuid.Parse(s)
+	if err != nil {
+		return CategoryID(""), NewMyError(InvalidCode, "invalid_category_id")
+	}
+
+	return CategoryID(uuid.String()), nil
+}
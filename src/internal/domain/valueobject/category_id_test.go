@@ -0,0 +1,75 @@
+package valueobject
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestNewCategoryID(t *testing.T) {
+	categoryID := NewCategoryID()
+
+	// UUID形式であることを確認
+	_, err := uuid.Parse(categoryID.String())
+	if err != nil {
+		t.Errorf("CategoryIDが有効なUUID形式ではありません: %v", err)
+	}
+
+	// 2回連続で生成した際に異なる値であることを確認
+	if categoryID.Equals(NewCategoryID()) {
+		t.Error("2回連続で生成したCategoryIDが同じ値になりました")
+	}
+}
+
+func TestParseCategoryID(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		wantErr     bool
+		expectedErr string
+	}{
+		{
+			name:    "正常ケース: 有効なUUID",
+			input:   "550e8400-e29b-41d4-a716-446655440000",
+			wantErr: false,
+		},
+		{
+			name:        "異常ケース: 無効なUUID形式",
+			input:       "invalid-uuid",
+			wantErr:     true,
+			expectedErr: "invalid_category_id",
+		},
+		{
+			name:        "異常ケース: 空文字",
+			input:       "",
+			wantErr:     true,
+			expectedErr: "invalid_category_id",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			categoryID, err := ParseCategoryID(tt.input)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("エラーが期待されましたが、エラーが発生しませんでした")
+					return
+				}
+				if err.Error() != tt.expectedErr {
+					t.Errorf("期待されたエラーメッセージ = %v, 実際のエラーメッセージ = %v", tt.expectedErr, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("予期しないエラー: %v", err)
+				return
+			}
+
+			if categoryID.String() != tt.input {
+				t.Errorf("CategoryID.String() = %v, want %v", categoryID.String(), tt.input)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package valueobject
+
+import "strings"
+
+type CategoryName string
+
+func NewCategoryName(name string) (CategoryName, error) {
+	normalizedName := strings.TrimSpace(name)
+
+	if normalizedName == "" {
+		return CategoryName(""), NewMyError(InvalidCode, "category_name_empty")
+	}
+
+	if len([]rune(normalizedName)) > 100 {
+		return CategoryName(""), NewMyError(InvalidCode, "category_name_too_long")
+	}
+
+	return CategoryName(normalizedName), nil
+}
+
+func (c CategoryName) String() string {
+	return string(c)
+}
+
+func (c CategoryName) Equals(other CategoryName) bool {
+	return c == other
+}
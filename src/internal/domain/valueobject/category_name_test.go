@@ -0,0 +1,70 @@
+package valueobject
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewCategoryName(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expected    string
+		wantErr     bool
+		expectedErr string
+	}{
+		{
+			name:     "正常ケース: 日本語のカテゴリ名",
+			input:    "プログラミング",
+			expected: "プログラミング",
+		},
+		{
+			name:     "正常ケース: 前後の空白は除去される",
+			input:    "  Go言語  ",
+			expected: "Go言語",
+		},
+		{
+			name:     "正常ケース: 最大長（100文字）",
+			input:    strings.Repeat("あ", 100),
+			expected: strings.Repeat("あ", 100),
+		},
+		{
+			name:        "異常ケース: 空文字",
+			input:       "   ",
+			wantErr:     true,
+			expectedErr: "category_name_empty",
+		},
+		{
+			name:        "異常ケース: 長すぎる（101文字）",
+			input:       strings.Repeat("あ", 101),
+			wantErr:     true,
+			expectedErr: "category_name_too_long",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			categoryName, err := NewCategoryName(tt.input)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("エラーが期待されましたが、エラーが発生しませんでした")
+					return
+				}
+				if err.Error() != tt.expectedErr {
+					t.Errorf("期待されたエラーメッセージ = %v, 実際のエラーメッセージ = %v", tt.expectedErr, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("予期しないエラー: %v", err)
+				return
+			}
+
+			if categoryName.String() != tt.expected {
+				t.Errorf("CategoryName.String() = %v, want %v", categoryName.String(), tt.expected)
+			}
+		})
+	}
+}
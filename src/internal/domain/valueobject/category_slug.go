@@ -0,0 +1,32 @@
+package valueobject
+
+import (
+	"regexp"
+	"strings"
+)
+
+type CategorySlug string
+
+var categorySlugPattern = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+
+func NewCategorySlug(slug string) (CategorySlug, error) {
+	normalizedSlug := strings.TrimSpace(slug)
+
+	if len(normalizedSlug) > 100 {
+		return CategorySlug(""), NewMyError(InvalidCode, "category_slug_too_long")
+	}
+
+	if !categorySlugPattern.MatchString(normalizedSlug) {
+		return CategorySlug(""), NewMyError(InvalidCode, "category_slug_invalid_format")
+	}
+
+	return CategorySlug(normalizedSlug), nil
+}
+
+func (c CategorySlug) String() string {
+	return string(c)
+}
+
+func (c CategorySlug) Equals(other CategorySlug) bool {
+	return c == other
+}
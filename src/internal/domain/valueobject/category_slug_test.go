@@ -0,0 +1,90 @@
+package valueobject
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewCategorySlug(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		wantErr     bool
+		expectedErr string
+	}{
+		{
+			name:  "正常ケース: 英小文字のみ",
+			input: "technology",
+		},
+		{
+			name:  "正常ケース: ハイフン区切り",
+			input: "web-development-2024",
+		},
+		{
+			name:  "正常ケース: 最大長（100文字）",
+			input: strings.Repeat("a", 100),
+		},
+		{
+			name:        "異常ケース: 大文字を含む",
+			input:       "Technology",
+			wantErr:     true,
+			expectedErr: "category_slug_invalid_format",
+		},
+		{
+			name:        "異常ケース: 連続したハイフン",
+			input:       "web--dev",
+			wantErr:     true,
+			expectedErr: "category_slug_invalid_format",
+		},
+		{
+			name:        "異常ケース: 先頭のハイフン",
+			input:       "-web",
+			wantErr:     true,
+			expectedErr: "category_slug_invalid_format",
+		},
+		{
+			name:        "異常ケース: 日本語",
+			input:       "技術",
+			wantErr:     true,
+			expectedErr: "category_slug_invalid_format",
+		},
+		{
+			name:        "異常ケース: 空文字",
+			input:       "",
+			wantErr:     true,
+			expectedErr: "category_slug_invalid_format",
+		},
+		{
+			name:        "異常ケース: 長すぎる（101文字）",
+			input:       strings.Repeat("a", 101),
+			wantErr:     true,
+			expectedErr: "category_slug_too_long",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slug, err := NewCategorySlug(tt.input)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("エラーが期待されましたが、エラーが発生しませんでした")
+					return
+				}
+				if err.Error() != tt.expectedErr {
+					t.Errorf("期待されたエラーメッセージ = %v, 実際のエラーメッセージ = %v", tt.expectedErr, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("予期しないエラー: %v", err)
+				return
+			}
+
+			if slug.String() != tt.input {
+				t.Errorf("CategorySlug.String() = %v, want %v", slug.String(), tt.input)
+			}
+		})
+	}
+}
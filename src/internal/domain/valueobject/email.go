@@ -10,7 +10,7 @@ var emailRegex = regexp.MustCompile(`^[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-
 
 func NewEmail(email string) (Email, error) {
 	if !emailRegex.MatchString(email) {
-		return Email(""), NewMyError(InvalidCode, "Invalid email format")
+		return Email(""), NewMyError(InvalidCode, "invalid_email_format")
 	}
 	return Email(email), nil
 }
@@ -40,43 +40,43 @@ func TestNewEmail(t *testing.T) {
 			name:        "異常ケース: @マークなし",
 			email:       "testexample.com",
 			wantErr:     true,
-			expectedErr: "Invalid email format",
+			expectedErr: "invalid_email_format",
 		},
 		{
 			name:        "異常ケース: ドメインなし",
 			email:       "test@",
 			wantErr:     true,
-			expectedErr: "Invalid email format",
+			expectedErr: "invalid_email_format",
 		},
 		{
 			name:        "異常ケース: ローカル部なし",
 			email:       "@example.com",
 			wantErr:     true,
-			expectedErr: "Invalid email format",
+			expectedErr: "invalid_email_format",
 		},
 		{
 			name:        "異常ケース: 拡張子なし",
 			email:       "test@example",
 			wantErr:     true,
-			expectedErr: "Invalid email format",
+			expectedErr: "invalid_email_format",
 		},
 		{
 			name:        "異常ケース: 空文字",
 			email:       "",
 			wantErr:     true,
-			expectedErr: "Invalid email format",
+			expectedErr: "invalid_email_format",
 		},
 		{
 			name:        "異常ケース: スペースを含む",
 			email:       "test @example.com",
 			wantErr:     true,
-			expectedErr: "Invalid email format",
+			expectedErr: "invalid_email_format",
 		},
 		{
 			name:        "異常ケース: 短すぎる拡張子",
 			email:       "test@example.c",
 			wantErr:     true,
-			expectedErr: "Invalid email format",
+			expectedErr: "invalid_email_format",
 		},
 	}
 
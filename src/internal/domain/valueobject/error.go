@@ -2,7 +2,9 @@ package valueobject
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
+	"time"
 )
 
 type Code string
@@ -14,33 +16,133 @@ const (
 	ForbiddenCode           Code = "FORBIDDEN"
 	NotFoundCode            Code = "NOT_FOUND"
 	ConflictCode            Code = "CONFLICT"
+	TooManyRequestsCode     Code = "TOO_MANY_REQUESTS"
 )
 
 var (
-	InvalidRequestError = NewMyError(InvalidCode, "Invalid request")
-	InternalServerError = NewMyError(InternalServerErrorCode, "Internal server error")
-	ForbiddenError      = NewMyError(ForbiddenCode, "Forbidden")
-	NotFoundError       = NewMyError(NotFoundCode, "Not found")
-	ConflictError       = NewMyError(ConflictCode, "Conflict")
+	InvalidRequestError = NewMyError(InvalidCode, "invalid_request")
+	InternalServerError = NewMyError(InternalServerErrorCode, "internal_server_error")
+	ForbiddenError      = NewMyError(ForbiddenCode, "forbidden")
+	NotFoundError       = NewMyError(NotFoundCode, "not_found")
+	ConflictError       = NewMyError(ConflictCode, "conflict")
 )
 
+// MessageKey は翻訳カタログ（i18n パッケージ）のメッセージのキー
+// 利用者に表示する文言はプレゼンテーション層でリクエストの言語に翻訳する
+type MessageKey string
+
+// FieldError は入力項目ごとの検証エラー
+type FieldError struct {
+	// リクエストボディ上の項目名（ネストした項目は "tags[0]" のように表す）
+	Field string
+	// 満たさなかった制約（"required", "max" など）
+	Constraint string
+	key        MessageKey
+	args       []any
+}
+
+// NewFieldError は入力項目の検証エラーを返す。args はメッセージの書式に埋め込む値
+func NewFieldError(field, constraint string, key MessageKey, args ...any) FieldError {
+	return FieldError{
+		Field:      field,
+		Constraint: constraint,
+		key:        key,
+		args:       args,
+	}
+}
+
+// Key はメッセージのキーを返す
+func (e FieldError) Key() MessageKey {
+	return e.key
+}
+
+// Args はメッセージの書式に埋め込む値を返す
+func (e FieldError) Args() []any {
+	return e.args
+}
+
 type MyError struct {
-	Code    Code   `json:"code"`
-	Message string `json:"message"`
+	// 機械判定用のエラーコード（言語によらず変わらない）
+	Code Code
+	// 検証に失敗した入力項目（入力検証エラーの場合のみ）
+	Errors []FieldError
+	// メッセージのキーと埋め込む値
+	key  MessageKey
+	args []any
+	// コードから決まるHTTPステータスを上書きする場合に設定する
+	statusCode int
+	// 再試行できるまでの時間（Retry-After ヘッダーで返す）
+	retryAfter time.Duration
 }
 
-func NewMyError(code Code, message string) *MyError {
+// NewMyError はメッセージのキーからエラーを作成する。args はメッセージの書式に埋め込む値
+func NewMyError(code Code, key MessageKey, args ...any) *MyError {
 	return &MyError{
-		Code:    code,
-		Message: message,
+		Code: code,
+		key:  key,
+		args: args,
 	}
 }
 
+// NewStaleVersionError は楽観的排他制御で更新対象が古くなっていた場合のエラー（412）を返す
+func NewStaleVersionError(key MessageKey) *MyError {
+	return NewMyError(ConflictCode, key).WithStatusCode(http.StatusPreconditionFailed)
+}
+
+// Error はログ出力用にメッセージのキーと埋め込む値を返す
 func (e *MyError) Error() string {
-	return e.Message
+	if len(e.args) == 0 {
+		return string(e.key)
+	}
+	return fmt.Sprintf("%s %v", e.key, e.args)
+}
+
+// Key はメッセージのキーを返す
+func (e *MyError) Key() MessageKey {
+	return e.key
+}
+
+// Args はメッセージの書式に埋め込む値を返す
+func (e *MyError) Args() []any {
+	return e.args
+}
+
+// WithStatusCode はHTTPステータスを上書きしたエラーを返す
+func (e *MyError) WithStatusCode(statusCode int) *MyError {
+	return &MyError{
+		Code:       e.Code,
+		Errors:     e.Errors,
+		key:        e.key,
+		args:       e.args,
+		statusCode: statusCode,
+		retryAfter: e.retryAfter,
+	}
+}
+
+// NewValidationError は入力検証に失敗した項目をすべて含むエラー（400）を返す
+func NewValidationError(fieldErrors []FieldError) *MyError {
+	err := NewMyError(InvalidCode, "validation_failed")
+	err.Errors = fieldErrors
+	return err
+}
+
+// NewTooManyRequestsError はレート制限を超えた場合のエラー（429）を返す
+func NewTooManyRequestsError(key MessageKey, retryAfter time.Duration) *MyError {
+	err := NewMyError(TooManyRequestsCode, key)
+	err.retryAfter = retryAfter
+	return err
+}
+
+// RetryAfter は再試行できるまでの時間を返す。指定がない場合は0を返す
+func (e *MyError) RetryAfter() time.Duration {
+	return e.retryAfter
 }
 
 func (e *MyError) StatusCode() int {
+	if e.statusCode != 0 {
+		return e.statusCode
+	}
+
 	switch e.Code {
 	case InvalidCode:
 		return http.StatusBadRequest
@@ -54,6 +156,8 @@ func (e *MyError) StatusCode() int {
 		return http.StatusNotFound
 	case ConflictCode:
 		return http.StatusConflict
+	case TooManyRequestsCode:
+		return http.StatusTooManyRequests
 	default:
 		return http.StatusInternalServerError
 	}
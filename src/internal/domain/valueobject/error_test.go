@@ -3,7 +3,9 @@ package valueobject
 import (
 	"errors"
 	"net/http"
+	"reflect"
 	"testing"
+	"time"
 )
 
 func TestNewMyError(t *testing.T) {
@@ -46,24 +48,46 @@ func TestNewMyError(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := NewMyError(tt.code, tt.message)
+			err := NewMyError(tt.code, MessageKey(tt.message))
 
 			if err.Code != tt.code {
 				t.Errorf("Code = %v, want %v", err.Code, tt.code)
 			}
-			if err.Message != tt.message {
-				t.Errorf("Message = %v, want %v", err.Message, tt.message)
+			if err.Key() != MessageKey(tt.message) {
+				t.Errorf("Key() = %v, want %v", err.Key(), tt.message)
 			}
 		})
 	}
 }
 
 func TestMyError_Error(t *testing.T) {
-	message := "テストエラーメッセージ"
-	err := NewMyError(InvalidCode, message)
+	// ログ出力用にキーと埋め込む値を返す（利用者向けの文言はプレゼンテーション層で翻訳する）
+	err := NewMyError(NotFoundCode, "post_not_found")
+	if err.Error() != "post_not_found" {
+		t.Errorf("Error() = %v, want %v", err.Error(), "post_not_found")
+	}
 
-	if err.Error() != message {
-		t.Errorf("Error() = %v, want %v", err.Error(), message)
+	err = NewMyError(InvalidCode, "cannot_change_post_status", "published", "draft")
+	if err.Error() != "cannot_change_post_status [published draft]" {
+		t.Errorf("Error() = %v, want %v", err.Error(), "cannot_change_post_status [published draft]")
+	}
+}
+
+func TestMyError_KeyAndArgs(t *testing.T) {
+	base := NewMyError(InvalidCode, "cannot_change_post_status", "published", "draft")
+	overridden := base.WithStatusCode(http.StatusConflict)
+
+	// ステータスを上書きしてもキーと埋め込む値は変わらない
+	if overridden.Key() != "cannot_change_post_status" {
+		t.Errorf("Key() = %v", overridden.Key())
+	}
+	if !reflect.DeepEqual(overridden.Args(), []any{"published", "draft"}) {
+		t.Errorf("Args() = %v", overridden.Args())
+	}
+
+	fieldError := NewFieldError("title", "required", "field_required", "title")
+	if fieldError.Key() != "field_required" || !reflect.DeepEqual(fieldError.Args(), []any{"title"}) {
+		t.Errorf("FieldError = %+v", fieldError)
 	}
 }
 
@@ -103,6 +127,11 @@ func TestMyError_StatusCode(t *testing.T) {
 			code:           ConflictCode,
 			expectedStatus: http.StatusConflict,
 		},
+		{
+			name:           "TooManyRequestsCode",
+			code:           TooManyRequestsCode,
+			expectedStatus: http.StatusTooManyRequests,
+		},
 		{
 			name:           "未知のコード",
 			code:           Code("UNKNOWN"),
@@ -122,6 +151,71 @@ func TestMyError_StatusCode(t *testing.T) {
 	}
 }
 
+func TestMyError_WithStatusCode(t *testing.T) {
+	base := NewMyError(ConflictCode, "競合が発生しました")
+	overridden := base.WithStatusCode(http.StatusPreconditionFailed)
+
+	if overridden.StatusCode() != http.StatusPreconditionFailed {
+		t.Errorf("StatusCode() = %v, want %v", overridden.StatusCode(), http.StatusPreconditionFailed)
+	}
+	if overridden.Code != ConflictCode || overridden.Key() != base.Key() {
+		t.Errorf("Code/Key が変化しました: %v %v", overridden.Code, overridden.Key())
+	}
+	// 元のエラーは変更されない
+	if base.StatusCode() != http.StatusConflict {
+		t.Errorf("元のエラーのStatusCode() = %v, want %v", base.StatusCode(), http.StatusConflict)
+	}
+}
+
+func TestNewValidationError(t *testing.T) {
+	fieldErrors := []FieldError{
+		NewFieldError("title", "required", "field_required", "title"),
+		NewFieldError("tags[0]", "max", "field_max_length", "tags[0]", "50"),
+	}
+	err := NewValidationError(fieldErrors)
+
+	if err.Code != InvalidCode {
+		t.Errorf("Code = %v, want %v", err.Code, InvalidCode)
+	}
+	if err.StatusCode() != http.StatusBadRequest {
+		t.Errorf("StatusCode() = %v, want %v", err.StatusCode(), http.StatusBadRequest)
+	}
+	if len(err.Errors) != 2 || err.Errors[1].Key() != "field_max_length" || !reflect.DeepEqual(err.Errors[1].Args(), []any{"tags[0]", "50"}) {
+		t.Errorf("Errors = %+v, want %+v", err.Errors, fieldErrors)
+	}
+
+	// ステータスを上書きしても項目ごとのエラーを保持する
+	if got := err.WithStatusCode(http.StatusUnprocessableEntity).Errors; len(got) != 2 {
+		t.Errorf("WithStatusCode().Errors = %+v, want %+v", got, fieldErrors)
+	}
+}
+
+func TestNewTooManyRequestsError(t *testing.T) {
+	err := NewTooManyRequestsError("リクエストが多すぎます", 30*time.Second)
+
+	if err.StatusCode() != http.StatusTooManyRequests {
+		t.Errorf("StatusCode() = %v, want %v", err.StatusCode(), http.StatusTooManyRequests)
+	}
+	// ステータスを上書きしても再試行までの時間は引き継ぐ
+	if got := err.WithStatusCode(http.StatusServiceUnavailable).RetryAfter(); got != 30*time.Second {
+		t.Errorf("RetryAfter() = %v, want 30s", got)
+	}
+	if got := NewMyError(ConflictCode, "競合").RetryAfter(); got != 0 {
+		t.Errorf("RetryAfter() = %v, want 0", got)
+	}
+}
+
+func TestNewStaleVersionError(t *testing.T) {
+	err := NewStaleVersionError("古いバージョンです")
+
+	if err.Code != ConflictCode {
+		t.Errorf("Code = %v, want %v", err.Code, ConflictCode)
+	}
+	if err.StatusCode() != http.StatusPreconditionFailed {
+		t.Errorf("StatusCode() = %v, want %v", err.StatusCode(), http.StatusPreconditionFailed)
+	}
+}
+
 func TestIsMyError(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -193,8 +287,8 @@ func TestReturnMyError(t *testing.T) {
 			if result.Code != tt.expected.Code {
 				t.Errorf("ReturnMyError().Code = %v, want %v", result.Code, tt.expected.Code)
 			}
-			if result.Message != tt.expected.Message {
-				t.Errorf("ReturnMyError().Message = %v, want %v", result.Message, tt.expected.Message)
+			if result.Key() != tt.expected.Key() {
+				t.Errorf("ReturnMyError().Key() = %v, want %v", result.Key(), tt.expected.Key())
 			}
 		})
 	}
@@ -205,42 +299,42 @@ func TestPredefinedErrors(t *testing.T) {
 		name         string
 		err          *MyError
 		expectedCode Code
-		expectedMsg  string
+		expectedKey  string
 		expectedHTTP int
 	}{
 		{
 			name:         "InvalidRequestError",
 			err:          InvalidRequestError,
 			expectedCode: InvalidCode,
-			expectedMsg:  "Invalid request",
+			expectedKey:  "invalid_request",
 			expectedHTTP: http.StatusBadRequest,
 		},
 		{
 			name:         "InternalServerError",
 			err:          InternalServerError,
 			expectedCode: InternalServerErrorCode,
-			expectedMsg:  "Internal server error",
+			expectedKey:  "internal_server_error",
 			expectedHTTP: http.StatusInternalServerError,
 		},
 		{
 			name:         "ForbiddenError",
 			err:          ForbiddenError,
 			expectedCode: ForbiddenCode,
-			expectedMsg:  "Forbidden",
+			expectedKey:  "forbidden",
 			expectedHTTP: http.StatusForbidden,
 		},
 		{
 			name:         "NotFoundError",
 			err:          NotFoundError,
 			expectedCode: NotFoundCode,
-			expectedMsg:  "Not found",
+			expectedKey:  "not_found",
 			expectedHTTP: http.StatusNotFound,
 		},
 		{
 			name:         "ConflictError",
 			err:          ConflictError,
 			expectedCode: ConflictCode,
-			expectedMsg:  "Conflict",
+			expectedKey:  "conflict",
 			expectedHTTP: http.StatusConflict,
 		},
 	}
@@ -250,8 +344,8 @@ func TestPredefinedErrors(t *testing.T) {
 			if tt.err.Code != tt.expectedCode {
 				t.Errorf("Code = %v, want %v", tt.err.Code, tt.expectedCode)
 			}
-			if tt.err.Message != tt.expectedMsg {
-				t.Errorf("Message = %v, want %v", tt.err.Message, tt.expectedMsg)
+			if tt.err.Key() != MessageKey(tt.expectedKey) {
+				t.Errorf("Key() = %v, want %v", tt.err.Key(), tt.expectedKey)
 			}
 			if tt.err.StatusCode() != tt.expectedHTTP {
 				t.Errorf("StatusCode() = %v, want %v", tt.err.StatusCode(), tt.expectedHTTP)
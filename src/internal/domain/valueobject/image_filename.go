@@ -18,14 +18,14 @@ func NewImageFilename(filename string) (ImageFilename, error) {
 
 func validateFilename(name string) error {
 	if len(name) == 0 || len(name) > 255 {
-		return NewMyError(InvalidCode, "filename length invalid")
+		return NewMyError(InvalidCode, "filename_length_invalid")
 	}
 
 	// 禁止文字チェック
 	invalidChars := []string{"/", "\\", ":", "*", "?", "\"", "<", ">", "|"}
 	for _, char := range invalidChars {
 		if strings.Contains(name, char) {
-			return NewMyError(InvalidCode, "filename contains invalid characters")
+			return NewMyError(InvalidCode, "filename_contains_invalid_characters")
 		}
 	}
 
@@ -36,7 +36,7 @@ func validateFilename(name string) error {
 		return nil
 	}
 
-	return NewMyError(InvalidCode, "unsupported file extension")
+	return NewMyError(InvalidCode, "unsupported_file_extension")
 }
 
 func (f ImageFilename) String() string {
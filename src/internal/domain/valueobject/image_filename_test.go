@@ -61,79 +61,79 @@ func TestNewImageFilename(t *testing.T) {
 			name:        "異常ケース: 空文字",
 			filename:    "",
 			wantErr:     true,
-			expectedErr: "filename length invalid",
+			expectedErr: "filename_length_invalid",
 		},
 		{
 			name:        "異常ケース: 長すぎるファイル名（256文字）",
 			filename:    strings.Repeat("a", 252) + ".jpg",
 			wantErr:     true,
-			expectedErr: "filename length invalid",
+			expectedErr: "filename_length_invalid",
 		},
 		{
 			name:        "異常ケース: 禁止文字を含む（スラッシュ）",
 			filename:    "path/to/image.jpg",
 			wantErr:     true,
-			expectedErr: "filename contains invalid characters",
+			expectedErr: "filename_contains_invalid_characters",
 		},
 		{
 			name:        "異常ケース: 禁止文字を含む（バックスラッシュ）",
 			filename:    "path\\to\\image.jpg",
 			wantErr:     true,
-			expectedErr: "filename contains invalid characters",
+			expectedErr: "filename_contains_invalid_characters",
 		},
 		{
 			name:        "異常ケース: 禁止文字を含む（コロン）",
 			filename:    "C:image.jpg",
 			wantErr:     true,
-			expectedErr: "filename contains invalid characters",
+			expectedErr: "filename_contains_invalid_characters",
 		},
 		{
 			name:        "異常ケース: 禁止文字を含む（アスタリスク）",
 			filename:    "image*.jpg",
 			wantErr:     true,
-			expectedErr: "filename contains invalid characters",
+			expectedErr: "filename_contains_invalid_characters",
 		},
 		{
 			name:        "異常ケース: 禁止文字を含む（クエスチョン）",
 			filename:    "image?.jpg",
 			wantErr:     true,
-			expectedErr: "filename contains invalid characters",
+			expectedErr: "filename_contains_invalid_characters",
 		},
 		{
 			name:        "異常ケース: 禁止文字を含む（ダブルクォート）",
 			filename:    "\"image\".jpg",
 			wantErr:     true,
-			expectedErr: "filename contains invalid characters",
+			expectedErr: "filename_contains_invalid_characters",
 		},
 		{
 			name:        "異常ケース: 禁止文字を含む（不等号）",
 			filename:    "<image>.jpg",
 			wantErr:     true,
-			expectedErr: "filename contains invalid characters",
+			expectedErr: "filename_contains_invalid_characters",
 		},
 		{
 			name:        "異常ケース: 禁止文字を含む（パイプ）",
 			filename:    "image|copy.jpg",
 			wantErr:     true,
-			expectedErr: "filename contains invalid characters",
+			expectedErr: "filename_contains_invalid_characters",
 		},
 		{
 			name:        "異常ケース: サポートされていない拡張子（txt）",
 			filename:    "document.txt",
 			wantErr:     true,
-			expectedErr: "unsupported file extension",
+			expectedErr: "unsupported_file_extension",
 		},
 		{
 			name:        "異常ケース: サポートされていない拡張子（pdf）",
 			filename:    "file.pdf",
 			wantErr:     true,
-			expectedErr: "unsupported file extension",
+			expectedErr: "unsupported_file_extension",
 		},
 		{
 			name:        "異常ケース: 拡張子なし",
 			filename:    "filename",
 			wantErr:     true,
-			expectedErr: "unsupported file extension",
+			expectedErr: "unsupported_file_extension",
 		},
 	}
 
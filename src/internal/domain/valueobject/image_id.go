@@ -21,7 +21,7 @@ func (p ImageID) Equals(other ImageID) bool {
 func ParseImageID(s string) (ImageID, error) {
 	uuid, err := uuid.Parse(s)
 	if err != nil {
-		return ImageID(""), NewMyError(InvalidCode, "Invalid image ID")
+		return ImageID(""), NewMyError(InvalidCode, "invalid_image_id")
 	}
 
 	return ImageID(uuid.String()), nil
@@ -53,31 +53,31 @@ func TestParseImageID(t *testing.T) {
 			name:        "異常ケース: 無効なUUID形式",
 			input:       "invalid-uuid",
 			wantErr:     true,
-			expectedErr: "Invalid image ID",
+			expectedErr: "invalid_image_id",
 		},
 		{
 			name:        "異常ケース: 空文字",
 			input:       "",
 			wantErr:     true,
-			expectedErr: "Invalid image ID",
+			expectedErr: "invalid_image_id",
 		},
 		{
 			name:        "異常ケース: 短いUUID",
 			input:       "550e8400-e29b-41d4-a716",
 			wantErr:     true,
-			expectedErr: "Invalid image ID",
+			expectedErr: "invalid_image_id",
 		},
 		{
 			name:        "異常ケース: 長いUUID",
 			input:       "550e8400-e29b-41d4-a716-446655440000-extra",
 			wantErr:     true,
-			expectedErr: "Invalid image ID",
+			expectedErr: "invalid_image_id",
 		},
 		{
 			name:        "異常ケース: 大文字小文字混在（無効な文字）",
 			input:       "550e8400-e29b-41d4-a716-44665544000G",
 			wantErr:     true,
-			expectedErr: "Invalid image ID",
+			expectedErr: "invalid_image_id",
 		},
 	}
 
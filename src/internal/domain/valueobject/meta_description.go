@@ -0,0 +1,32 @@
+package valueobject
+
+import (
+	"strings"
+)
+
+type MetaDescription string
+
+// 検索結果に表示される長さを超えないよう制限する
+const metaDescriptionMaxLength = 160
+
+func NewMetaDescription(description string) (MetaDescription, error) {
+	normalizedDescription := strings.TrimSpace(description)
+
+	if len([]rune(normalizedDescription)) > metaDescriptionMaxLength {
+		return MetaDescription(""), NewMyError(InvalidCode, "meta_description_is_too_long")
+	}
+
+	if strings.ContainsAny(normalizedDescription, "\n\r\t") {
+		return MetaDescription(""), NewMyError(InvalidCode, "meta_description_invalid_characters")
+	}
+
+	return MetaDescription(normalizedDescription), nil
+}
+
+func (m MetaDescription) String() string {
+	return string(m)
+}
+
+func (m MetaDescription) Equals(other MetaDescription) bool {
+	return m == other
+}
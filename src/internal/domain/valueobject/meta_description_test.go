@@ -0,0 +1,75 @@
+package valueobject
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewMetaDescription(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expected    string
+		wantErr     bool
+		expectedErr string
+	}{
+		{
+			name:     "正常ケース: 通常の説明文",
+			input:    "Goのエラー処理のベストプラクティスを紹介します。",
+			expected: "Goのエラー処理のベストプラクティスを紹介します。",
+		},
+		{
+			name:     "正常ケース: 空の説明文",
+			input:    "",
+			expected: "",
+		},
+		{
+			name:     "正常ケース: 前後の空白を除去",
+			input:    "  説明文  ",
+			expected: "説明文",
+		},
+		{
+			name:     "正常ケース: 最大長（160文字）",
+			input:    strings.Repeat("あ", 160),
+			expected: strings.Repeat("あ", 160),
+		},
+		{
+			name:        "異常ケース: 長すぎる（161文字）",
+			input:       strings.Repeat("あ", 161),
+			wantErr:     true,
+			expectedErr: "meta_description_is_too_long",
+		},
+		{
+			name:        "異常ケース: 改行を含む",
+			input:       "説明文\n改行",
+			wantErr:     true,
+			expectedErr: "meta_description_invalid_characters",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			description, err := NewMetaDescription(tt.input)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("エラーが期待されましたが、エラーが発生しませんでした")
+					return
+				}
+				if err.Error() != tt.expectedErr {
+					t.Errorf("期待されたエラーメッセージ = %v, 実際のエラーメッセージ = %v", tt.expectedErr, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("予期しないエラー: %v", err)
+				return
+			}
+
+			if description.String() != tt.expected {
+				t.Errorf("MetaDescription.String() = %v, want %v", description.String(), tt.expected)
+			}
+		})
+	}
+}
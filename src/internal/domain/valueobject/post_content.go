@@ -4,7 +4,7 @@ type PostContent string
 
 func NewPostContent(content string) (PostContent, error) {
 	if len(content) > 10000 {
-		return PostContent(""), NewMyError(InvalidCode, "Content is too long")
+		return PostContent(""), NewMyError(InvalidCode, "content_is_too_long")
 	}
 	return PostContent(content), nil
 }
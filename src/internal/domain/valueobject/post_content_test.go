@@ -51,13 +51,13 @@ func TestNewPostContent(t *testing.T) {
 			name:        "異常ケース: 長すぎるコンテンツ（10001文字）",
 			content:     strings.Repeat("a", 10001),
 			wantErr:     true,
-			expectedErr: "Content is too long",
+			expectedErr: "content_is_too_long",
 		},
 		{
 			name:        "異常ケース: 非常に長いコンテンツ",
 			content:     strings.Repeat("テスト", 5000),
 			wantErr:     true,
-			expectedErr: "Content is too long",
+			expectedErr: "content_is_too_long",
 		},
 	}
 
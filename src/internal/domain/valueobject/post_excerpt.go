@@ -0,0 +1,50 @@
+package valueobject
+
+import (
+	"regexp"
+	"strings"
+)
+
+type PostExcerpt string
+
+const (
+	postExcerptMaxLength = 300
+	// 本文から自動生成する抜粋の長さ
+	generatedExcerptLength = 120
+)
+
+var (
+	htmlTagPattern    = regexp.MustCompile(`<[^>]*>`)
+	whitespacePattern = regexp.MustCompile(`\s+`)
+)
+
+func NewPostExcerpt(excerpt string) (PostExcerpt, error) {
+	normalizedExcerpt := strings.TrimSpace(excerpt)
+
+	if len([]rune(normalizedExcerpt)) > postExcerptMaxLength {
+		return PostExcerpt(""), NewMyError(InvalidCode, "excerpt_is_too_long")
+	}
+
+	return PostExcerpt(normalizedExcerpt), nil
+}
+
+// NewPostExcerptFromContent は本文からHTMLタグと連続する空白を除き、先頭を切り出した抜粋を生成する
+func NewPostExcerptFromContent(content PostContent) PostExcerpt {
+	text := htmlTagPattern.ReplaceAllString(content.String(), " ")
+	text = strings.TrimSpace(whitespacePattern.ReplaceAllString(text, " "))
+
+	runes := []rune(text)
+	if len(runes) <= generatedExcerptLength {
+		return PostExcerpt(text)
+	}
+
+	return PostExcerpt(strings.TrimSpace(string(runes[:generatedExcerptLength])) + "…")
+}
+
+func (p PostExcerpt) String() string {
+	return string(p)
+}
+
+func (p PostExcerpt) Equals(other PostExcerpt) bool {
+	return p == other
+}
@@ -0,0 +1,107 @@
+package valueobject
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewPostExcerpt(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expected    string
+		wantErr     bool
+		expectedErr string
+	}{
+		{
+			name:     "正常ケース: 通常の抜粋",
+			input:    "この記事ではGoのエラー処理を解説します。",
+			expected: "この記事ではGoのエラー処理を解説します。",
+		},
+		{
+			name:     "正常ケース: 空の抜粋",
+			input:    "",
+			expected: "",
+		},
+		{
+			name:     "正常ケース: 前後の空白を除去",
+			input:    "  抜粋  ",
+			expected: "抜粋",
+		},
+		{
+			name:     "正常ケース: 最大長（300文字）",
+			input:    strings.Repeat("あ", 300),
+			expected: strings.Repeat("あ", 300),
+		},
+		{
+			name:        "異常ケース: 長すぎる（301文字）",
+			input:       strings.Repeat("あ", 301),
+			wantErr:     true,
+			expectedErr: "excerpt_is_too_long",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			excerpt, err := NewPostExcerpt(tt.input)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("エラーが期待されましたが、エラーが発生しませんでした")
+					return
+				}
+				if err.Error() != tt.expectedErr {
+					t.Errorf("期待されたエラーメッセージ = %v, 実際のエラーメッセージ = %v", tt.expectedErr, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("予期しないエラー: %v", err)
+				return
+			}
+
+			if excerpt.String() != tt.expected {
+				t.Errorf("PostExcerpt.String() = %v, want %v", excerpt.String(), tt.expected)
+			}
+		})
+	}
+}
+
+func TestNewPostExcerptFromContent(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  PostContent
+		expected string
+	}{
+		{
+			name:     "短い本文はそのまま抜粋になる",
+			content:  PostContent("短い本文です。"),
+			expected: "短い本文です。",
+		},
+		{
+			name:     "HTMLタグと連続する空白を除く",
+			content:  PostContent("<p>はじめに</p>\n\n<p>本文   です</p>"),
+			expected: "はじめに 本文 です",
+		},
+		{
+			name:     "長い本文は120文字で切り詰めて省略記号を付ける",
+			content:  PostContent(strings.Repeat("あ", 200)),
+			expected: strings.Repeat("あ", 120) + "…",
+		},
+		{
+			name:     "空の本文は空の抜粋になる",
+			content:  PostContent(""),
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			excerpt := NewPostExcerptFromContent(tt.content)
+			if excerpt.String() != tt.expected {
+				t.Errorf("NewPostExcerptFromContent() = %v, want %v", excerpt.String(), tt.expected)
+			}
+		})
+	}
+}
@@ -21,7 +21,7 @@ func (p PostID) Equals(other PostID) bool {
 func ParsePostID(s string) (PostID, error) {
 	uuid, err := uuid.Parse(s)
 	if err != nil {
-		return PostID(""), NewMyError(InvalidCode, "Invalid post ID")
+		return PostID(""), NewMyError(InvalidCode, "invalid_post_id")
 	}
 
 	return PostID(uuid.String()), nil
@@ -4,6 +4,8 @@ type PostStatus string
 
 const (
 	StatusDraft     PostStatus = "draft"
+	StatusInReview  PostStatus = "in_review"
+	StatusApproved  PostStatus = "approved"
 	StatusPublished PostStatus = "published"
 	StatusPrivate   PostStatus = "private"
 	StatusDeleted   PostStatus = "deleted"
@@ -11,10 +13,10 @@ const (
 
 func NewPostStatus(status string) (PostStatus, error) {
 	switch PostStatus(status) {
-	case StatusDraft, StatusPublished, StatusPrivate, StatusDeleted:
+	case StatusDraft, StatusInReview, StatusApproved, StatusPublished, StatusPrivate, StatusDeleted:
 		return PostStatus(status), nil
 	default:
-		return PostStatus(""), NewMyError(InvalidCode, "Invalid post status")
+		return PostStatus(""), NewMyError(InvalidCode, "invalid_post_status")
 	}
 }
 
@@ -18,6 +18,18 @@ func TestNewPostStatus(t *testing.T) {
 			wantErr:  false,
 			expected: StatusDraft,
 		},
+		{
+			name:     "正常ケース: in_review",
+			status:   "in_review",
+			wantErr:  false,
+			expected: StatusInReview,
+		},
+		{
+			name:     "正常ケース: approved",
+			status:   "approved",
+			wantErr:  false,
+			expected: StatusApproved,
+		},
 		{
 			name:     "正常ケース: published",
 			status:   "published",
@@ -40,25 +52,25 @@ func TestNewPostStatus(t *testing.T) {
 			name:        "異常ケース: 無効なステータス",
 			status:      "invalid",
 			wantErr:     true,
-			expectedErr: "Invalid post status",
+			expectedErr: "invalid_post_status",
 		},
 		{
 			name:        "異常ケース: 空文字",
 			status:      "",
 			wantErr:     true,
-			expectedErr: "Invalid post status",
+			expectedErr: "invalid_post_status",
 		},
 		{
 			name:        "異常ケース: 大文字",
 			status:      "DRAFT",
 			wantErr:     true,
-			expectedErr: "Invalid post status",
+			expectedErr: "invalid_post_status",
 		},
 		{
 			name:        "異常ケース: 混合文字",
 			status:      "Draft",
 			wantErr:     true,
-			expectedErr: "Invalid post status",
+			expectedErr: "invalid_post_status",
 		},
 	}
 
@@ -1,7 +1,6 @@
 package valueobject
 
 import (
-	"fmt"
 	"html"
 	"strings"
 )
@@ -13,19 +12,19 @@ func NewPostTitle(title string) (PostTitle, error) {
 	normalizedTitleRune := []rune(normalizedTitle)
 
 	if len(normalizedTitleRune) > 200 {
-		return PostTitle(""), NewMyError(InvalidCode, "Title is too long")
+		return PostTitle(""), NewMyError(InvalidCode, "title_is_too_long")
 	}
 
 	sanitizedTitle := html.EscapeString(normalizedTitle)
 	sanitizedTitleRune := []rune(sanitizedTitle)
 	if len(sanitizedTitleRune) > 255 {
-		return PostTitle(""), NewMyError(InvalidCode, "Title contains too many special characters that expand when escaped")
+		return PostTitle(""), NewMyError(InvalidCode, "title_too_many_escaped_characters")
 	}
 
 	forbiddenChars := []rune{'<', '>', '"', '\'', '\\', '/', '\n', '\r', '\t'}
 	for _, char := range forbiddenChars {
 		if strings.ContainsRune(sanitizedTitle, char) {
-			return PostTitle(""), NewMyError(InvalidCode, fmt.Sprintf("Title contains forbidden character: %q", char))
+			return PostTitle(""), NewMyError(InvalidCode, "title_contains_forbidden_character", char)
 		}
 	}
 
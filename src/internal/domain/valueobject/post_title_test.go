@@ -51,37 +51,37 @@ func TestNewPostTitle(t *testing.T) {
 			name:        "異常ケース: 長すぎるタイトル（201文字）",
 			title:       strings.Repeat("あ", 201),
 			wantErr:     true,
-			expectedErr: "Title is too long",
+			expectedErr: "title_is_too_long",
 		},
 		{
 			name:        "異常ケース: 改行文字を含む",
 			title:       "タイトル\n改行",
 			wantErr:     true,
-			expectedErr: "Title contains forbidden character: '\\n'",
+			expectedErr: "title_contains_forbidden_character [10]",
 		},
 		{
 			name:        "異常ケース: タブ文字を含む",
 			title:       "タイトル\tタブ",
 			wantErr:     true,
-			expectedErr: "Title contains forbidden character: '\\t'",
+			expectedErr: "title_contains_forbidden_character [9]",
 		},
 		{
 			name:        "異常ケース: キャリッジリターンを含む",
 			title:       "タイトル\r復帰",
 			wantErr:     true,
-			expectedErr: "Title contains forbidden character: '\\r'",
+			expectedErr: "title_contains_forbidden_character [13]",
 		},
 		{
 			name:        "異常ケース: バックスラッシュを含む",
 			title:       "タイトル\\バックスラッシュ",
 			wantErr:     true,
-			expectedErr: "Title contains forbidden character: '\\\\'",
+			expectedErr: "title_contains_forbidden_character [92]",
 		},
 		{
 			name:        "異常ケース: スラッシュを含む",
 			title:       "タイトル/スラッシュ",
 			wantErr:     true,
-			expectedErr: "Title contains forbidden character: '/'",
+			expectedErr: "title_contains_forbidden_character [47]",
 		},
 	}
 
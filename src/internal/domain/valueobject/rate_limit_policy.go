@@ -0,0 +1,59 @@
+package valueobject
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RateLimitPolicy はトークンバケットによるレート制限の設定
+// Period ごとに Limit 回まで許可し、トークンは Period をかけて一定の速度で補充する
+type RateLimitPolicy struct {
+	// Name はポリシー名（バケットのキーの接頭辞に使う）
+	Name   string
+	Limit  int
+	Period time.Duration
+}
+
+func NewRateLimitPolicy(name string, limit int, period time.Duration) (RateLimitPolicy, error) {
+	if strings.TrimSpace(name) == "" {
+		return RateLimitPolicy{}, NewMyError(InvalidCode, "rate_limit_policy_name_is_required")
+	}
+	if limit <= 0 {
+		return RateLimitPolicy{}, NewMyError(InvalidCode, "rate_limit_must_be_positive")
+	}
+	if period < time.Second {
+		return RateLimitPolicy{}, NewMyError(InvalidCode, "rate_limit_period_must_be_at_least_1s")
+	}
+
+	return RateLimitPolicy{
+		Name:   name,
+		Limit:  limit,
+		Period: period,
+	}, nil
+}
+
+// ParseRateLimitPolicy は "回数/期間"（例: "300/1m"）形式の設定からポリシーを作成する
+func ParseRateLimitPolicy(name string, value string) (RateLimitPolicy, error) {
+	limitValue, periodValue, ok := strings.Cut(strings.TrimSpace(value), "/")
+	if !ok {
+		return RateLimitPolicy{}, NewMyError(InvalidCode, "rate_limit_policy_invalid_format")
+	}
+
+	limit, err := strconv.Atoi(limitValue)
+	if err != nil {
+		return RateLimitPolicy{}, NewMyError(InvalidCode, "invalid_rate_limit")
+	}
+	period, err := time.ParseDuration(periodValue)
+	if err != nil {
+		return RateLimitPolicy{}, NewMyError(InvalidCode, "invalid_rate_limit_period")
+	}
+
+	return NewRateLimitPolicy(name, limit, period)
+}
+
+// HeaderValue は RateLimit-Policy ヘッダーの値（例: "300;w=60"）を返す
+func (p RateLimitPolicy) HeaderValue() string {
+	return fmt.Sprintf("%d;w=%d", p.Limit, int(p.Period.Seconds()))
+}
@@ -0,0 +1,97 @@
+package valueobject
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseRateLimitPolicy(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		wantLimit   int
+		wantPeriod  time.Duration
+		wantErr     bool
+		expectedErr string
+	}{
+		{
+			name:       "正常ケース: 1分あたりの回数",
+			input:      "300/1m",
+			wantLimit:  300,
+			wantPeriod: time.Minute,
+		},
+		{
+			name:       "正常ケース: 前後の空白を除去",
+			input:      " 5/15m ",
+			wantLimit:  5,
+			wantPeriod: 15 * time.Minute,
+		},
+		{
+			name:        "異常ケース: 区切りがない",
+			input:       "300",
+			wantErr:     true,
+			expectedErr: "rate_limit_policy_invalid_format",
+		},
+		{
+			name:        "異常ケース: 回数が数値でない",
+			input:       "many/1m",
+			wantErr:     true,
+			expectedErr: "invalid_rate_limit",
+		},
+		{
+			name:        "異常ケース: 期間が不正",
+			input:       "300/minute",
+			wantErr:     true,
+			expectedErr: "invalid_rate_limit_period",
+		},
+		{
+			name:        "異常ケース: 回数が0",
+			input:       "0/1m",
+			wantErr:     true,
+			expectedErr: "rate_limit_must_be_positive",
+		},
+		{
+			name:        "異常ケース: 期間が1秒未満",
+			input:       "10/500ms",
+			wantErr:     true,
+			expectedErr: "rate_limit_period_must_be_at_least_1s",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := ParseRateLimitPolicy("ip", tt.input)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("エラーが返されませんでした")
+				}
+				if err.Error() != tt.expectedErr {
+					t.Errorf("error = %v, want %v", err.Error(), tt.expectedErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("予期しないエラー: %v", err)
+			}
+			if policy.Name != "ip" || policy.Limit != tt.wantLimit || policy.Period != tt.wantPeriod {
+				t.Errorf("policy = %+v, want ip %d %v", policy, tt.wantLimit, tt.wantPeriod)
+			}
+		})
+	}
+}
+
+func TestNewRateLimitPolicy_名前が空の場合はエラー(t *testing.T) {
+	if _, err := NewRateLimitPolicy(" ", 10, time.Minute); err == nil {
+		t.Errorf("エラーが返されませんでした")
+	}
+}
+
+func TestRateLimitPolicy_HeaderValue(t *testing.T) {
+	policy, _ := NewRateLimitPolicy("user", 600, time.Minute)
+
+	if got := policy.HeaderValue(); got != "600;w=60" {
+		t.Errorf("HeaderValue() = %v, want 600;w=60", got)
+	}
+}
@@ -0,0 +1,22 @@
+package valueobject
+
+// ReviewDecision はレビュアーによるレビュー結果
+type ReviewDecision string
+
+const (
+	ReviewApprove        ReviewDecision = "approve"
+	ReviewRequestChanges ReviewDecision = "request_changes"
+)
+
+func NewReviewDecision(decision string) (ReviewDecision, error) {
+	switch ReviewDecision(decision) {
+	case ReviewApprove, ReviewRequestChanges:
+		return ReviewDecision(decision), nil
+	default:
+		return ReviewDecision(""), NewMyError(InvalidCode, "invalid_review_decision")
+	}
+}
+
+func (d ReviewDecision) String() string {
+	return string(d)
+}
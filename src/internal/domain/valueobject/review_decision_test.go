@@ -0,0 +1,59 @@
+package valueobject
+
+import (
+	"testing"
+)
+
+func TestNewReviewDecision(t *testing.T) {
+	tests := []struct {
+		name     string
+		decision string
+		wantErr  bool
+		expected ReviewDecision
+	}{
+		{
+			name:     "正常ケース: approve",
+			decision: "approve",
+			wantErr:  false,
+			expected: ReviewApprove,
+		},
+		{
+			name:     "正常ケース: request_changes",
+			decision: "request_changes",
+			wantErr:  false,
+			expected: ReviewRequestChanges,
+		},
+		{
+			name:     "異常ケース: 無効な値",
+			decision: "reject",
+			wantErr:  true,
+		},
+		{
+			name:     "異常ケース: 空文字",
+			decision: "",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewReviewDecision(tt.decision)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("エラーが期待されましたが、エラーが発生しませんでした")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("予期しないエラー: %v", err)
+				return
+			}
+
+			if result != tt.expected {
+				t.Errorf("NewReviewDecision() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package valueobject
+
+// Role は認証基盤（auth0のapp_rolesクレーム）で付与されるユーザーのロール
+type Role string
+
+const (
+	RoleAdmin     Role = "admin"
+	RoleReviewer  Role = "reviewer"
+	RolePublisher Role = "publisher"
+)
+
+func (r Role) String() string {
+	return string(r)
+}
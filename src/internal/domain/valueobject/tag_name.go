@@ -3,29 +3,52 @@ package valueobject
 import (
 	"regexp"
 	"strings"
+
+	"golang.org/x/text/unicode/norm"
 )
 
 type TagName string
 
 func NewTagName(tag string) (TagName, error) {
-	normalizedTag := strings.TrimSpace(tag)
+	normalizedTag := NormalizeTagName(tag)
 
 	if len(normalizedTag) > 50 {
-		return TagName(""), NewMyError(InvalidCode, "TagName is too long")
+		return TagName(""), NewMyError(InvalidCode, "tag_name_too_long")
 	}
 
 	validPattern := regexp.MustCompile(`^[\p{Hiragana}\p{Katakana}\p{Han}a-zA-Z0-9\-_]+$`)
 	if !validPattern.MatchString(normalizedTag) {
-		return TagName(""), NewMyError(InvalidCode, "TagName can only contain Japanese characters, letters, numbers, hyphens, and underscores")
+		return TagName(""), NewMyError(InvalidCode, "tag_name_invalid_characters")
 	}
 
 	return TagName(normalizedTag), nil
 }
 
+// NormalizeTagName は表示に用いるタグ名を返す
+// 全角英数字などの表記揺れを吸収するためNFKC正規化する（大文字小文字は維持）
+func NormalizeTagName(s string) string {
+	return strings.TrimSpace(norm.NFKC.String(s))
+}
+
+// NormalizeTagKey はタグの同一性判定に用いる正規化キーを返す（NFKC正規化 + 小文字化）
+func NormalizeTagKey(s string) string {
+	return strings.ToLower(NormalizeTagName(s))
+}
+
 func (t TagName) String() string {
 	return string(t)
 }
 
+// Key は大文字小文字・全角半角を区別しない正規化キーを返す
+func (t TagName) Key() string {
+	return NormalizeTagKey(string(t))
+}
+
 func (t TagName) Equals(other TagName) bool {
 	return t == other
 }
+
+// SameKey は正規化キーが一致するか（同一タグとみなせるか）を判定する
+func (t TagName) SameKey(other TagName) bool {
+	return t.Key() == other.Key()
+}
@@ -61,49 +61,49 @@ func TestNewTagName(t *testing.T) {
 			name:        "異常ケース: 長すぎるタグ（51文字）",
 			tag:         strings.Repeat("a", 51),
 			wantErr:     true,
-			expectedErr: "TagName is too long",
+			expectedErr: "tag_name_too_long",
 		},
 		{
 			name:        "異常ケース: 非常に長いタグ",
 			tag:         strings.Repeat("tag", 25),
 			wantErr:     true,
-			expectedErr: "TagName is too long",
+			expectedErr: "tag_name_too_long",
 		},
 		{
 			name:        "異常ケース: スペースを含む",
 			tag:         "web development",
 			wantErr:     true,
-			expectedErr: "TagName can only contain Japanese characters, letters, numbers, hyphens, and underscores",
+			expectedErr: "tag_name_invalid_characters",
 		},
 		{
 			name:        "異常ケース: 特殊文字を含む",
 			tag:         "tech!",
 			wantErr:     true,
-			expectedErr: "TagName can only contain Japanese characters, letters, numbers, hyphens, and underscores",
+			expectedErr: "tag_name_invalid_characters",
 		},
 		{
 			name:        "異常ケース: ドットを含む",
 			tag:         "node.js",
 			wantErr:     true,
-			expectedErr: "TagName can only contain Japanese characters, letters, numbers, hyphens, and underscores",
+			expectedErr: "tag_name_invalid_characters",
 		},
 		{
 			name:        "異常ケース: カンマを含む",
 			tag:         "web,dev",
 			wantErr:     true,
-			expectedErr: "TagName can only contain Japanese characters, letters, numbers, hyphens, and underscores",
+			expectedErr: "tag_name_invalid_characters",
 		},
 		{
 			name:        "異常ケース: アットマークを含む",
 			tag:         "@tag",
 			wantErr:     true,
-			expectedErr: "TagName can only contain Japanese characters, letters, numbers, hyphens, and underscores",
+			expectedErr: "tag_name_invalid_characters",
 		},
 		{
 			name:        "異常ケース: スラッシュを含む",
 			tag:         "web/dev",
 			wantErr:     true,
-			expectedErr: "TagName can only contain Japanese characters, letters, numbers, hyphens, and underscores",
+			expectedErr: "tag_name_invalid_characters",
 		},
 	}
 
@@ -185,6 +185,21 @@ func TestTagName_Normalization(t *testing.T) {
 			input:    "python",
 			expected: "python",
 		},
+		{
+			name:     "全角英数字が半角に変換される",
+			input:    "Ｇｏ１２３",
+			expected: "Go123",
+		},
+		{
+			name:     "半角カタカナが全角に変換される",
+			input:    "ｺﾞﾗﾝｸﾞ",
+			expected: "ゴラング",
+		},
+		{
+			name:     "大文字小文字は表示名として維持される",
+			input:    "GoLang",
+			expected: "GoLang",
+		},
 	}
 
 	for _, tt := range tests {
@@ -202,6 +217,60 @@ func TestTagName_Normalization(t *testing.T) {
 	}
 }
 
+func TestTagName_Key(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "小文字化される",
+			input:    "GoLang",
+			expected: "golang",
+		},
+		{
+			name:     "全角英字が半角小文字になる",
+			input:    "Ｇｏ",
+			expected: "go",
+		},
+		{
+			name:     "日本語はそのまま",
+			input:    "プログラミング",
+			expected: "プログラミング",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tagName, err := NewTagName(tt.input)
+			if err != nil {
+				t.Fatalf("予期しないエラー: %v", err)
+			}
+
+			if tagName.Key() != tt.expected {
+				t.Errorf("Key() = %v, want %v", tagName.Key(), tt.expected)
+			}
+		})
+	}
+}
+
+func TestTagName_SameKey(t *testing.T) {
+	upper, _ := NewTagName("Go")
+	lower, _ := NewTagName("go")
+	fullWidth, _ := NewTagName("Ｇｏ")
+	other, _ := NewTagName("golang")
+
+	if !upper.SameKey(lower) {
+		t.Error("大文字小文字のみ異なるタグの比較がfalseになりました")
+	}
+	if !lower.SameKey(fullWidth) {
+		t.Error("全角半角のみ異なるタグの比較がfalseになりました")
+	}
+	if upper.SameKey(other) {
+		t.Error("異なるタグ同士の比較がtrueになりました")
+	}
+}
+
 func TestTagName_Boundary(t *testing.T) {
 	// 境界値テスト：50文字ちょうど
 	tag50 := strings.Repeat("a", 50)
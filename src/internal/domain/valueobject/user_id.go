@@ -13,7 +13,7 @@ func NewUserID() UserID {
 func ParseUserID(s string) (UserID, error) {
 	uuid, err := uuid.Parse(s)
 	if err != nil {
-		return UserID(""), NewMyError(InvalidCode, "Invalid user ID")
+		return UserID(""), NewMyError(InvalidCode, "invalid_user_id")
 	}
 	return UserID(uuid.String()), nil
 }
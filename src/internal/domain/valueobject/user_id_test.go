@@ -48,25 +48,25 @@ func TestParseUserID(t *testing.T) {
 			name:        "異常ケース: 無効なUUID形式",
 			input:       "invalid-uuid",
 			wantErr:     true,
-			expectedErr: "Invalid user ID",
+			expectedErr: "invalid_user_id",
 		},
 		{
 			name:        "異常ケース: 空文字",
 			input:       "",
 			wantErr:     true,
-			expectedErr: "Invalid user ID",
+			expectedErr: "invalid_user_id",
 		},
 		{
 			name:        "異常ケース: 短いUUID",
 			input:       "550e8400-e29b-41d4-a716",
 			wantErr:     true,
-			expectedErr: "Invalid user ID",
+			expectedErr: "invalid_user_id",
 		},
 		{
 			name:        "異常ケース: 長いUUID",
 			input:       "550e8400-e29b-41d4-a716-446655440000-extra",
 			wantErr:     true,
-			expectedErr: "Invalid user ID",
+			expectedErr: "invalid_user_id",
 		},
 	}
 
@@ -0,0 +1,26 @@
+package valueobject
+
+// WebhookDeliveryStatus はWebhook配信の状態
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryPending は配信待ち（再試行待ちを含む）
+	WebhookDeliveryPending WebhookDeliveryStatus = "pending"
+	// WebhookDeliverySucceeded は配信成功
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "succeeded"
+	// WebhookDeliveryFailed は再試行の上限に達して配信を諦めた
+	WebhookDeliveryFailed WebhookDeliveryStatus = "failed"
+)
+
+func NewWebhookDeliveryStatus(status string) (WebhookDeliveryStatus, error) {
+	switch WebhookDeliveryStatus(status) {
+	case WebhookDeliveryPending, WebhookDeliverySucceeded, WebhookDeliveryFailed:
+		return WebhookDeliveryStatus(status), nil
+	default:
+		return WebhookDeliveryStatus(""), NewMyError(InvalidCode, "invalid_webhook_delivery_status")
+	}
+}
+
+func (s WebhookDeliveryStatus) String() string {
+	return string(s)
+}
@@ -0,0 +1,23 @@
+package valueobject
+
+// WebhookEventType はWebhookで通知するイベントの種類
+type WebhookEventType string
+
+const (
+	WebhookEventPostPublished   WebhookEventType = "post.published"
+	WebhookEventPostUpdated     WebhookEventType = "post.updated"
+	WebhookEventPostUnpublished WebhookEventType = "post.unpublished"
+)
+
+func NewWebhookEventType(eventType string) (WebhookEventType, error) {
+	switch WebhookEventType(eventType) {
+	case WebhookEventPostPublished, WebhookEventPostUpdated, WebhookEventPostUnpublished:
+		return WebhookEventType(eventType), nil
+	default:
+		return WebhookEventType(""), NewMyError(InvalidCode, "invalid_webhook_event_type")
+	}
+}
+
+func (e WebhookEventType) String() string {
+	return string(e)
+}
@@ -0,0 +1,59 @@
+package valueobject
+
+import (
+	"testing"
+)
+
+func TestNewWebhookEventType(t *testing.T) {
+	tests := []struct {
+		name      string
+		eventType string
+		wantErr   bool
+		expected  WebhookEventType
+	}{
+		{
+			name:      "正常ケース: post.published",
+			eventType: "post.published",
+			wantErr:   false,
+			expected:  WebhookEventPostPublished,
+		},
+		{
+			name:      "正常ケース: post.unpublished",
+			eventType: "post.unpublished",
+			wantErr:   false,
+			expected:  WebhookEventPostUnpublished,
+		},
+		{
+			name:      "異常ケース: 無効な値",
+			eventType: "post.deleted",
+			wantErr:   true,
+		},
+		{
+			name:      "異常ケース: 空文字",
+			eventType: "",
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewWebhookEventType(tt.eventType)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("エラーが期待されましたが、エラーが発生しませんでした")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("予期しないエラー: %v", err)
+				return
+			}
+
+			if result != tt.expected {
+				t.Errorf("NewWebhookEventType() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package valueobject
+
+import "github.com/google/uuid"
+
+type WebhookSubscriptionID string
+
+func NewWebhookSubscriptionID() WebhookSubscriptionID {
+	return WebhookSubscriptionID(uuid.New().String())
+}
+
+func (w WebhookSubscriptionID) String() string {
+	return string(w)
+}
+
+func (w WebhookSubscriptionID) Equals(other WebhookSubscriptionID) bool {
+	return w == other
+}
+
+func ParseWebhookSubscriptionID(s string) (WebhookSubscriptionID, error) {
+	uuid, err := uuid.Parse(s)
+	if err != nil {
+		return WebhookSubscriptionID(""), NewMyError(InvalidCode, "invalid_webhook_subscription_id")
+	}
+
+	return WebhookSubscriptionID(uuid.String()), nil
+}
@@ -0,0 +1,39 @@
+package i18n
+
+import "fmt"
+
+// Language はメッセージの言語（BCP 47 の言語タグ）
+type Language string
+
+const (
+	English  Language = "en"
+	Japanese Language = "ja"
+)
+
+// DefaultLanguage は利用者の言語が判定できない場合に使う言語
+const DefaultLanguage = English
+
+// SupportedLanguages はメッセージを用意している言語（先頭が既定の言語）
+var SupportedLanguages = []Language{English, Japanese}
+
+var catalog = map[Language]map[string]string{
+	English:  messagesEn,
+	Japanese: messagesJa,
+}
+
+// Translate はキーに対応するメッセージを指定した言語で返す
+// 翻訳がない場合は英語のメッセージを、キー自体が登録されていない場合はキーをそのまま返す
+func Translate(lang Language, key string, args ...any) string {
+	format, ok := catalog[lang][key]
+	if !ok {
+		format, ok = catalog[DefaultLanguage][key]
+	}
+	if !ok {
+		format = key
+	}
+
+	if len(args) == 0 {
+		return format
+	}
+	return fmt.Sprintf(format, args...)
+}
@@ -0,0 +1,86 @@
+package i18n
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestCatalog(t *testing.T) {
+	// 書式指定子（%s, %[1]d など）。引数の順序を入れ替えた場合も数が一致すればよい
+	verb := regexp.MustCompile(`%(\[\d+\])?[a-z]`)
+
+	for lang, messages := range catalog {
+		for key, message := range messages {
+			en, ok := messagesEn[key]
+			if !ok {
+				t.Errorf("%s: キー %q の英語のメッセージがありません", lang, key)
+				continue
+			}
+			if got, want := len(verb.FindAllString(message, -1)), len(verb.FindAllString(en, -1)); got != want {
+				t.Errorf("%s: キー %q の書式指定子の数 = %d, want %d", lang, key, got, want)
+			}
+		}
+	}
+	for key := range messagesEn {
+		if _, ok := messagesJa[key]; !ok {
+			t.Errorf("ja: キー %q の日本語のメッセージがありません", key)
+		}
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	tests := []struct {
+		name     string
+		lang     Language
+		key      string
+		args     []any
+		expected string
+	}{
+		{
+			name:     "正常ケース: 英語",
+			lang:     English,
+			key:      "post_not_found",
+			expected: "Post not found",
+		},
+		{
+			name:     "正常ケース: 日本語",
+			lang:     Japanese,
+			key:      "post_not_found",
+			expected: "投稿が見つかりません",
+		},
+		{
+			name:     "正常ケース: 引数を埋め込む",
+			lang:     Japanese,
+			key:      "cannot_change_post_status",
+			args:     []any{"published", "draft"},
+			expected: "投稿のステータスを published から draft に変更することはできません",
+		},
+		{
+			name:     "正常ケース: 引数の順序を入れ替える",
+			lang:     Japanese,
+			key:      "unknown_post_transition_guard",
+			args:     []any{"has_reviewer", "submit"},
+			expected: "投稿のステータス遷移 submit に不明なガード has_reviewer が指定されています",
+		},
+		{
+			name:     "フォールバック: 未対応の言語は英語で返す",
+			lang:     Language("fr"),
+			key:      "post_not_found",
+			expected: "Post not found",
+		},
+		{
+			name:     "フォールバック: 未登録のキーはキーをそのまま返す",
+			lang:     Japanese,
+			key:      "unknown_key",
+			expected: "unknown_key",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Translate(tt.lang, tt.key, tt.args...); got != tt.expected {
+				t.Errorf("Translate() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
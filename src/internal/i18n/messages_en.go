@@ -0,0 +1,243 @@
+package i18n
+
+// messagesEn は英語のメッセージ（キーが見つからない場合の既定の文言にも使う）
+var messagesEn = map[string]string{
+	"app_user_id_is_not_a_string":                "app_user_id is not a string",
+	"author_cannot_review_own_post":              "Author cannot review own post",
+	"authorization_header_invalid_format":        "invalid authorization header format. 'Bearer <token>' format is required",
+	"authorization_header_missing":               "authorization header is missing",
+	"cannot_change_post_status":                  "Cannot change post status from %s to %s",
+	"cannot_merge_a_tag_into_itself":             "Cannot merge a tag into itself",
+	"canonical_url_invalid":                      "Canonical URL must be an absolute http or https URL",
+	"canonical_url_is_too_long":                  "Canonical URL is too long",
+	"category_already_exists":                    "Category already exists",
+	"category_cannot_be_its_own_parent":          "Category cannot be its own parent",
+	"category_does_not_exist":                    "Category does not exist",
+	"category_has_child_categories":              "Category has child categories",
+	"category_name_empty":                        "CategoryName cannot be empty",
+	"category_name_too_long":                     "CategoryName is too long",
+	"category_not_found":                         "Category not found",
+	"category_parent_is_descendant":              "Category cannot be moved under its own descendant",
+	"category_slug_invalid_format":               "CategorySlug can only contain lowercase letters, numbers, and single hyphens",
+	"category_slug_too_long":                     "CategorySlug is too long",
+	"category_with_this_slug_already_exists":     "Category with this slug already exists",
+	"comment_is_too_long":                        "Comment is too long",
+	"comment_required_for_request_changes":       "Comment is required when requesting changes",
+	"conflict":                                   "Conflict",
+	"content_is_too_long":                        "Content is too long",
+	"description_is_too_long":                    "Description is too long",
+	"duplicate_post_id":                          "Duplicate post ID",
+	"excerpt_is_too_long":                        "Excerpt is too long",
+	"failed_to_acquire_post_lock":                "Failed to acquire post lock",
+	"failed_to_begin_transaction":                "Failed to begin transaction",
+	"failed_to_claim_webhook_deliveries":         "Failed to claim webhook deliveries",
+	"failed_to_commit_transaction":               "Failed to commit transaction",
+	"failed_to_complete_image_upload":            "Failed to complete image upload",
+	"failed_to_count_audit_events":               "Failed to count audit events",
+	"failed_to_count_posts":                      "Failed to count posts",
+	"failed_to_count_tags":                       "Failed to count tags",
+	"failed_to_count_webhook_deliveries":         "Failed to count webhook deliveries",
+	"failed_to_create_audit_event":               "Failed to create audit event",
+	"failed_to_create_category":                  "Failed to create category",
+	"failed_to_create_image":                     "Failed to create image",
+	"failed_to_create_post":                      "Failed to create post",
+	"failed_to_create_post_workflow_history":     "Failed to create post workflow history",
+	"failed_to_create_tag":                       "Failed to create tag",
+	"failed_to_create_webhook_delivery":          "Failed to create webhook delivery",
+	"failed_to_create_webhook_event":             "Failed to create webhook event",
+	"failed_to_create_webhook_subscription":      "Failed to create webhook subscription",
+	"failed_to_delete_category":                  "Failed to delete category",
+	"failed_to_delete_unused_tags":               "Failed to delete unused tags",
+	"failed_to_delete_webhook_subscription":      "Failed to delete webhook subscription",
+	"failed_to_encode_webhook_payload":           "Failed to encode webhook payload",
+	"failed_to_extend_post_lock":                 "Failed to extend post lock",
+	"failed_to_find_category":                    "Failed to find category",
+	"failed_to_find_image":                       "Failed to find image",
+	"failed_to_find_or_create_tag":               "Failed to find or create tag",
+	"failed_to_find_post":                        "Failed to find post",
+	"failed_to_find_tag":                         "Failed to find tag",
+	"failed_to_find_tags":                        "Failed to find tags",
+	"failed_to_find_user":                        "Failed to find user",
+	"failed_to_get_audit_events":                 "Failed to get audit events",
+	"failed_to_get_categories":                   "Failed to get categories",
+	"failed_to_get_category":                     "Failed to get category",
+	"failed_to_get_category_ancestors":           "Failed to get category ancestors",
+	"failed_to_lock_category_tree":               "Failed to lock category tree",
+	"failed_to_get_image":                        "Failed to get image",
+	"failed_to_get_parent_category":              "Failed to get parent category",
+	"failed_to_get_post":                         "Failed to get post",
+	"failed_to_get_post_lock":                    "Failed to get post lock",
+	"failed_to_get_post_workflow_histories":      "Failed to get post workflow histories",
+	"failed_to_get_posts":                        "Failed to get posts",
+	"failed_to_get_sitemap_entries":              "Failed to get sitemap entries",
+	"failed_to_get_tag":                          "Failed to get tag",
+	"failed_to_get_tags":                         "Failed to get tags",
+	"failed_to_get_webhook_deliveries":           "Failed to get webhook deliveries",
+	"failed_to_get_webhook_delivery":             "Failed to get webhook delivery",
+	"failed_to_get_webhook_events":               "Failed to get webhook events",
+	"failed_to_get_webhook_subscription":         "Failed to get webhook subscription",
+	"failed_to_get_webhook_subscriptions":        "Failed to get webhook subscriptions",
+	"failed_to_hash_password":                    "Failed to hash password",
+	"failed_to_list_audit_events":                "Failed to list audit events",
+	"failed_to_merge_tags":                       "Failed to merge tags",
+	"failed_to_open_file":                        "Failed to open file",
+	"failed_to_parse_audit_action":               "Failed to parse audit action",
+	"failed_to_parse_audit_changes":              "Failed to parse audit changes",
+	"failed_to_parse_audit_entity_type":          "Failed to parse audit entity type",
+	"failed_to_parse_category_id":                "Failed to parse category ID",
+	"failed_to_parse_category_name":              "Failed to parse category name",
+	"failed_to_parse_category_slug":              "Failed to parse category slug",
+	"failed_to_parse_email":                      "Failed to parse email",
+	"failed_to_parse_parent_category_id":         "Failed to parse parent category ID",
+	"failed_to_parse_post_id":                    "Failed to parse post ID",
+	"failed_to_parse_post_status":                "Failed to parse post status",
+	"failed_to_parse_tag_id":                     "Failed to parse tag ID",
+	"failed_to_parse_tag_name":                   "Failed to parse tag name",
+	"failed_to_parse_user_id":                    "Failed to parse user ID",
+	"failed_to_parse_webhook_delivery_status":    "Failed to parse webhook delivery status",
+	"failed_to_parse_webhook_event_type":         "Failed to parse webhook event type",
+	"failed_to_parse_webhook_subscription_id":    "Failed to parse webhook subscription ID",
+	"failed_to_release_post_lock":                "Failed to release post lock",
+	"failed_to_save_user":                        "Failed to save user",
+	"failed_to_set_categories":                   "Failed to set categories",
+	"failed_to_set_tags":                         "Failed to set tags",
+	"failed_to_update_category":                  "Failed to update category",
+	"failed_to_update_post":                      "Failed to update post",
+	"failed_to_update_tag":                       "Failed to update tag",
+	"failed_to_update_webhook_deliveries":        "Failed to update %d webhook deliveries",
+	"failed_to_update_webhook_delivery":          "Failed to update webhook delivery",
+	"failed_to_update_webhook_event":             "Failed to update webhook event",
+	"failed_to_upload_image_to_gcs":              "Failed to upload image to GCS",
+	"field_email":                                "%s must be a valid email address",
+	"field_invalid":                              "%s is invalid",
+	"field_max":                                  "%s must be at most %s",
+	"field_max_items":                            "%s must be at most %s items",
+	"field_max_length":                           "%s must be at most %s characters",
+	"field_min":                                  "%s must be at least %s",
+	"field_min_items":                            "%s must be at least %s items",
+	"field_min_length":                           "%s must be at least %s characters",
+	"field_oneof":                                "%s must be one of [%s]",
+	"field_required":                             "%s is required",
+	"field_uuid":                                 "%s must be a valid UUID",
+	"file_too_large":                             "File too large",
+	"filename_contains_invalid_characters":       "filename contains invalid characters",
+	"filename_length_invalid":                    "filename length invalid",
+	"forbidden":                                  "Forbidden",
+	"from_must_be_before_to":                     "from must be before to",
+	"if_match_header_is_required":                "If-Match header is required",
+	"image_not_found":                            "Image not found",
+	"insufficient_role":                          "Insufficient role",
+	"internal_server_error":                      "Internal server error",
+	"invalid_actor_id":                           "Invalid actor_id",
+	"invalid_audit_action":                       "Invalid audit action",
+	"invalid_audit_entity_type":                  "Invalid audit entity type",
+	"invalid_bulk_operation":                     "Invalid bulk operation",
+	"invalid_category_id":                        "Invalid category ID",
+	"invalid_content":                            "Invalid content",
+	"invalid_email":                              "Invalid email",
+	"invalid_email_format":                       "Invalid email format",
+	"invalid_filename":                           "Invalid filename",
+	"invalid_from":                               "Invalid from",
+	"invalid_if_match_header":                    "Invalid If-Match header",
+	"invalid_image_filename":                     "Invalid image filename",
+	"invalid_image_id":                           "Invalid image ID",
+	"invalid_password":                           "invalid password",
+	"invalid_post_content":                       "Invalid post content",
+	"invalid_post_id":                            "Invalid post ID",
+	"invalid_post_status":                        "Invalid post status",
+	"invalid_post_title":                         "Invalid post title",
+	"invalid_post_transition_from":               "Invalid from status in post transition %s",
+	"invalid_post_transition_to":                 "Invalid to status in post transition %s",
+	"invalid_rate_limit":                         "Invalid rate limit",
+	"invalid_rate_limit_period":                  "Invalid rate limit period",
+	"invalid_request":                            "Invalid request",
+	"invalid_request_payload":                    "Invalid request payload",
+	"invalid_review_decision":                    "Invalid review decision",
+	"invalid_reviewer_id":                        "Invalid reviewer ID",
+	"invalid_sort_order":                         "Invalid sort order",
+	"invalid_status":                             "Invalid status",
+	"invalid_tag":                                "Invalid tag",
+	"invalid_tag_id":                             "Invalid tag ID",
+	"invalid_tag_name":                           "Invalid tag name",
+	"invalid_target_tag_id":                      "Invalid target tag ID",
+	"invalid_title":                              "Invalid title",
+	"invalid_to":                                 "Invalid to",
+	"invalid_token":                              "Invalid token",
+	"invalid_user_id":                            "Invalid user ID",
+	"invalid_webhook_delivery_id":                "Invalid webhook delivery ID",
+	"invalid_webhook_delivery_status":            "Invalid webhook delivery status",
+	"invalid_webhook_event_type":                 "Invalid webhook event type",
+	"invalid_webhook_subscription_id":            "Invalid webhook subscription ID",
+	"maximum_number_of_tags_reached":             "Maximum number of tags reached",
+	"meta_description_invalid_characters":        "Meta description cannot contain line breaks or tabs",
+	"meta_description_is_too_long":               "Meta description is too long",
+	"method_not_allowed":                         "Method not allowed",
+	"name_cannot_be_empty":                       "Name cannot be empty",
+	"new_posts_must_be_created_as_draft":         "New posts must be created as draft",
+	"no_update_fields":                           "No update fields",
+	"not_found":                                  "Not found",
+	"not_found_user_id":                          "Not found user ID",
+	"og_image_does_not_exist":                    "OG image does not exist",
+	"only_deleted_posts_can_be_restored":         "Only deleted posts can be restored",
+	"only_in_review_posts_can_be_reviewed":       "Only in-review posts can be reviewed",
+	"only_reviewer_can_change_post_status":       "Only the assigned reviewer can change the post status",
+	"only_reviewer_can_review_post":              "Only the assigned reviewer can review the post",
+	"only_the_author_can_assign_a_reviewer":      "Only the author can assign a reviewer",
+	"only_the_author_can_change_the_post_status": "Only the author can change the post status",
+	"parent_category_does_not_exist":             "Parent category does not exist",
+	"password_too_short":                         "Password must be at least 8 characters",
+	"post_content_is_locked_during_review":       "The title and content cannot be changed while the post is in review or approved. Return it to draft first",
+	"post_id_is_required":                        "Post ID is required",
+	"post_ids_are_required":                      "Post IDs are required",
+	"post_is_locked_by_another_user":             "Post is locked by another user",
+	"post_lock_is_not_held_by_the_user":          "Post lock is not held by the user",
+	"post_modified_by_another_request":           "Post has been modified by another request",
+	"post_not_found":                             "Post not found",
+	"post_transition_must_change_status":         "Post transition %s must change the status",
+	"post_transition_name_is_required":           "Post transition name is required",
+	"post_transition_rules_are_required":         "Post transition rules are required",
+	"primary_category_duplicated":                "Primary category cannot also be a secondary category",
+	"primary_category_required":                  "Primary category is required when secondary categories are set",
+	"rate_limit_must_be_positive":                "Rate limit must be positive",
+	"rate_limit_period_must_be_at_least_1s":      "Rate limit period must be at least 1s",
+	"rate_limit_policy_invalid_format":           "Rate limit policy must be in LIMIT/PERIOD format",
+	"rate_limit_policy_name_is_required":         "Rate limit policy name is required",
+	"required_category_id":                       "Required category ID",
+	"required_post_id":                           "Required post ID",
+	"required_tag_id":                            "Required tag ID",
+	"required_webhook_subscription_id":           "Required webhook subscription ID",
+	"reviewer_assignment_not_allowed":            "Reviewer can only be assigned to draft or in-review posts",
+	"reviewer_must_be_assigned_before_review":    "Reviewer must be assigned before review",
+	"role_required":                              "Role %s is required",
+	"sitemap_not_found":                          "Sitemap not found",
+	"status_is_required_for_set_status":          "Status is required for set_status",
+	"tag_already_exists":                         "Tag already exists",
+	"tag_cannot_be_both_added_and_removed":       "Tag cannot be both added and removed",
+	"tag_name_invalid_characters":                "TagName can only contain Japanese characters, letters, numbers, hyphens, and underscores",
+	"tag_name_too_long":                          "TagName is too long",
+	"tag_not_found":                              "Tag not found",
+	"tag_with_this_name_already_exists":          "Tag with this name already exists",
+	"tags_and_tag_changes_combined":              "Cannot combine tags with add_tags or remove_tags",
+	"tags_required_for_operation":                "Tags are required for %s",
+	"title_contains_forbidden_character":         "Title contains forbidden character: %q",
+	"title_is_too_long":                          "Title is too long",
+	"title_too_many_escaped_characters":          "Title contains too many special characters that expand when escaped",
+	"too_many_login_attempts":                    "Too many failed login attempts",
+	"too_many_posts":                             "Too many posts (max %d)",
+	"too_many_requests":                          "Too many requests",
+	"too_many_secondary_categories":              "Maximum number of secondary categories exceeded",
+	"unknown_post_transition_effect":             "Unknown effect %s in post transition %s",
+	"unknown_post_transition_guard":              "Unknown guard %s in post transition %s",
+	"unsupported_file_extension":                 "unsupported file extension",
+	"user_already_exists":                        "User already exists",
+	"user_not_found":                             "User not found",
+	"user_with_this_email_already_exists":        "User with this email already exists",
+	"validation_failed":                          "Validation failed",
+	"webhook_delivery_not_found":                 "Webhook delivery not found",
+	"webhook_event_types_are_required":           "Webhook event types are required",
+	"webhook_secret_too_short":                   "Webhook secret must be at least 16 characters",
+	"webhook_subscription_not_found":             "Webhook subscription not found",
+	"webhook_url_invalid":                        "Webhook URL must be an absolute http or https URL",
+	"webhook_url_must_be_https":                  "Webhook URL must use https",
+	"webhook_url_private_network":                "Webhook URL must not point to a private, loopback or link-local address",
+}
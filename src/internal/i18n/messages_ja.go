@@ -0,0 +1,243 @@
+package i18n
+
+// messagesJa は日本語のメッセージ
+var messagesJa = map[string]string{
+	"app_user_id_is_not_a_string":                "トークンの app_user_id が不正です",
+	"author_cannot_review_own_post":              "投稿者は自分の投稿をレビューできません",
+	"authorization_header_invalid_format":        "Authorization ヘッダーは「Bearer <トークン>」の形式で指定してください",
+	"authorization_header_missing":               "Authorization ヘッダーを指定してください",
+	"cannot_change_post_status":                  "投稿のステータスを %s から %s に変更することはできません",
+	"cannot_merge_a_tag_into_itself":             "タグを自身に統合することはできません",
+	"canonical_url_invalid":                      "正規URLは http または https の絶対URLで指定してください",
+	"canonical_url_is_too_long":                  "正規URLが長すぎます",
+	"category_already_exists":                    "カテゴリは既に存在します",
+	"category_cannot_be_its_own_parent":          "カテゴリを自身の親にすることはできません",
+	"category_does_not_exist":                    "カテゴリが存在しません",
+	"category_has_child_categories":              "子カテゴリが存在します",
+	"category_name_empty":                        "カテゴリ名を入力してください",
+	"category_name_too_long":                     "カテゴリ名が長すぎます",
+	"category_not_found":                         "カテゴリが見つかりません",
+	"category_parent_is_descendant":              "カテゴリを自身の子孫の下に移動することはできません",
+	"category_slug_invalid_format":               "スラッグには英小文字・数字・単独のハイフンのみ使用できます",
+	"category_slug_too_long":                     "スラッグが長すぎます",
+	"category_with_this_slug_already_exists":     "同じスラッグのカテゴリが既に存在します",
+	"comment_is_too_long":                        "コメントが長すぎます",
+	"comment_required_for_request_changes":       "修正を依頼する場合はコメントを入力してください",
+	"conflict":                                   "競合が発生しました",
+	"content_is_too_long":                        "本文が長すぎます",
+	"description_is_too_long":                    "説明が長すぎます",
+	"duplicate_post_id":                          "投稿IDが重複しています",
+	"excerpt_is_too_long":                        "抜粋が長すぎます",
+	"failed_to_acquire_post_lock":                "投稿の編集ロックの取得に失敗しました",
+	"failed_to_begin_transaction":                "トランザクションの開始に失敗しました",
+	"failed_to_claim_webhook_deliveries":         "Webhookの配信の確保に失敗しました",
+	"failed_to_commit_transaction":               "トランザクションのコミットに失敗しました",
+	"failed_to_complete_image_upload":            "画像のアップロードの完了に失敗しました",
+	"failed_to_count_audit_events":               "監査ログの件数の取得に失敗しました",
+	"failed_to_count_posts":                      "投稿の件数の取得に失敗しました",
+	"failed_to_count_tags":                       "タグの件数の取得に失敗しました",
+	"failed_to_count_webhook_deliveries":         "Webhookの配信の件数の取得に失敗しました",
+	"failed_to_create_audit_event":               "監査ログの作成に失敗しました",
+	"failed_to_create_category":                  "カテゴリの作成に失敗しました",
+	"failed_to_create_image":                     "画像の作成に失敗しました",
+	"failed_to_create_post":                      "投稿の作成に失敗しました",
+	"failed_to_create_post_workflow_history":     "投稿のワークフロー履歴の作成に失敗しました",
+	"failed_to_create_tag":                       "タグの作成に失敗しました",
+	"failed_to_create_webhook_delivery":          "Webhookの配信の作成に失敗しました",
+	"failed_to_create_webhook_event":             "Webhookイベントの作成に失敗しました",
+	"failed_to_create_webhook_subscription":      "Webhookの購読の作成に失敗しました",
+	"failed_to_delete_category":                  "カテゴリの削除に失敗しました",
+	"failed_to_delete_unused_tags":               "未使用のタグの削除に失敗しました",
+	"failed_to_delete_webhook_subscription":      "Webhookの購読の削除に失敗しました",
+	"failed_to_encode_webhook_payload":           "Webhookのペイロードの生成に失敗しました",
+	"failed_to_extend_post_lock":                 "投稿の編集ロックの延長に失敗しました",
+	"failed_to_find_category":                    "カテゴリの検索に失敗しました",
+	"failed_to_find_image":                       "画像の検索に失敗しました",
+	"failed_to_find_or_create_tag":               "タグの検索または作成に失敗しました",
+	"failed_to_find_post":                        "投稿の検索に失敗しました",
+	"failed_to_find_tag":                         "タグの検索に失敗しました",
+	"failed_to_find_tags":                        "タグの検索に失敗しました",
+	"failed_to_find_user":                        "ユーザーの検索に失敗しました",
+	"failed_to_get_audit_events":                 "監査ログの取得に失敗しました",
+	"failed_to_get_categories":                   "カテゴリ一覧の取得に失敗しました",
+	"failed_to_get_category":                     "カテゴリの取得に失敗しました",
+	"failed_to_get_category_ancestors":           "親カテゴリの階層の取得に失敗しました",
+	"failed_to_lock_category_tree":               "カテゴリツリーのロックに失敗しました",
+	"failed_to_get_image":                        "画像の取得に失敗しました",
+	"failed_to_get_parent_category":              "親カテゴリの取得に失敗しました",
+	"failed_to_get_post":                         "投稿の取得に失敗しました",
+	"failed_to_get_post_lock":                    "投稿の編集ロックの取得に失敗しました",
+	"failed_to_get_post_workflow_histories":      "投稿のワークフロー履歴の取得に失敗しました",
+	"failed_to_get_posts":                        "投稿一覧の取得に失敗しました",
+	"failed_to_get_sitemap_entries":              "サイトマップの取得に失敗しました",
+	"failed_to_get_tag":                          "タグの取得に失敗しました",
+	"failed_to_get_tags":                         "タグ一覧の取得に失敗しました",
+	"failed_to_get_webhook_deliveries":           "Webhookの配信一覧の取得に失敗しました",
+	"failed_to_get_webhook_delivery":             "Webhookの配信の取得に失敗しました",
+	"failed_to_get_webhook_events":               "Webhookイベントの取得に失敗しました",
+	"failed_to_get_webhook_subscription":         "Webhookの購読の取得に失敗しました",
+	"failed_to_get_webhook_subscriptions":        "Webhookの購読一覧の取得に失敗しました",
+	"failed_to_hash_password":                    "パスワードのハッシュ化に失敗しました",
+	"failed_to_list_audit_events":                "監査ログ一覧の取得に失敗しました",
+	"failed_to_merge_tags":                       "タグの統合に失敗しました",
+	"failed_to_open_file":                        "ファイルを開けませんでした",
+	"failed_to_parse_audit_action":               "監査ログの操作種別の変換に失敗しました",
+	"failed_to_parse_audit_changes":              "監査ログの変更内容の変換に失敗しました",
+	"failed_to_parse_audit_entity_type":          "監査ログの対象種別の変換に失敗しました",
+	"failed_to_parse_category_id":                "カテゴリIDの変換に失敗しました",
+	"failed_to_parse_category_name":              "カテゴリ名の変換に失敗しました",
+	"failed_to_parse_category_slug":              "カテゴリのスラッグの変換に失敗しました",
+	"failed_to_parse_email":                      "メールアドレスの変換に失敗しました",
+	"failed_to_parse_parent_category_id":         "親カテゴリIDの変換に失敗しました",
+	"failed_to_parse_post_id":                    "投稿IDの変換に失敗しました",
+	"failed_to_parse_post_status":                "投稿ステータスの変換に失敗しました",
+	"failed_to_parse_tag_id":                     "タグIDの変換に失敗しました",
+	"failed_to_parse_tag_name":                   "タグ名の変換に失敗しました",
+	"failed_to_parse_user_id":                    "ユーザーIDの変換に失敗しました",
+	"failed_to_parse_webhook_delivery_status":    "Webhookの配信ステータスの変換に失敗しました",
+	"failed_to_parse_webhook_event_type":         "Webhookのイベント種別の変換に失敗しました",
+	"failed_to_parse_webhook_subscription_id":    "Webhookの購読IDの変換に失敗しました",
+	"failed_to_release_post_lock":                "投稿の編集ロックの解除に失敗しました",
+	"failed_to_save_user":                        "ユーザーの保存に失敗しました",
+	"failed_to_set_categories":                   "カテゴリの設定に失敗しました",
+	"failed_to_set_tags":                         "タグの設定に失敗しました",
+	"failed_to_update_category":                  "カテゴリの更新に失敗しました",
+	"failed_to_update_post":                      "投稿の更新に失敗しました",
+	"failed_to_update_tag":                       "タグの更新に失敗しました",
+	"failed_to_update_webhook_deliveries":        "%d件のWebhookの配信の更新に失敗しました",
+	"failed_to_update_webhook_delivery":          "Webhookの配信の更新に失敗しました",
+	"failed_to_update_webhook_event":             "Webhookイベントの更新に失敗しました",
+	"failed_to_upload_image_to_gcs":              "画像のアップロードに失敗しました",
+	"field_email":                                "%sにはメールアドレスを指定してください",
+	"field_invalid":                              "%sが不正です",
+	"field_max":                                  "%sは%s以下で指定してください",
+	"field_max_items":                            "%sは%s件以内で指定してください",
+	"field_max_length":                           "%sは%s文字以内で入力してください",
+	"field_min":                                  "%sは%s以上で指定してください",
+	"field_min_items":                            "%sは%s件以上指定してください",
+	"field_min_length":                           "%sは%s文字以上で入力してください",
+	"field_oneof":                                "%sには[%s]のいずれかを指定してください",
+	"field_required":                             "%sを入力してください",
+	"field_uuid":                                 "%sにはUUIDを指定してください",
+	"file_too_large":                             "ファイルサイズが大きすぎます",
+	"filename_contains_invalid_characters":       "ファイル名に使用できない文字が含まれています",
+	"filename_length_invalid":                    "ファイル名の長さが不正です",
+	"forbidden":                                  "操作する権限がありません",
+	"from_must_be_before_to":                     "from は to より前の日時を指定してください",
+	"if_match_header_is_required":                "If-Match ヘッダーを指定してください",
+	"image_not_found":                            "画像が見つかりません",
+	"insufficient_role":                          "ロールの権限が不足しています",
+	"internal_server_error":                      "サーバー内部でエラーが発生しました",
+	"invalid_actor_id":                           "actor_id が不正です",
+	"invalid_audit_action":                       "監査ログの操作種別が不正です",
+	"invalid_audit_entity_type":                  "監査ログの対象種別が不正です",
+	"invalid_bulk_operation":                     "一括操作の種類が不正です",
+	"invalid_category_id":                        "カテゴリIDが不正です",
+	"invalid_content":                            "本文が不正です",
+	"invalid_email":                              "メールアドレスが不正です",
+	"invalid_email_format":                       "メールアドレスの形式が不正です",
+	"invalid_filename":                           "ファイル名が不正です",
+	"invalid_from":                               "from が不正です",
+	"invalid_if_match_header":                    "If-Match ヘッダーが不正です",
+	"invalid_image_filename":                     "画像のファイル名が不正です",
+	"invalid_image_id":                           "画像IDが不正です",
+	"invalid_password":                           "パスワードが正しくありません",
+	"invalid_post_content":                       "投稿の本文が不正です",
+	"invalid_post_id":                            "投稿IDが不正です",
+	"invalid_post_status":                        "投稿ステータスが不正です",
+	"invalid_post_title":                         "投稿のタイトルが不正です",
+	"invalid_post_transition_from":               "投稿のステータス遷移 %s の遷移元ステータスが不正です",
+	"invalid_post_transition_to":                 "投稿のステータス遷移 %s の遷移先ステータスが不正です",
+	"invalid_rate_limit":                         "レート制限の設定が不正です",
+	"invalid_rate_limit_period":                  "レート制限の期間が不正です",
+	"invalid_request":                            "リクエストが不正です",
+	"invalid_request_payload":                    "リクエストボディが不正です",
+	"invalid_review_decision":                    "レビュー結果が不正です",
+	"invalid_reviewer_id":                        "レビュアーIDが不正です",
+	"invalid_sort_order":                         "並び順が不正です",
+	"invalid_status":                             "ステータスが不正です",
+	"invalid_tag":                                "タグが不正です",
+	"invalid_tag_id":                             "タグIDが不正です",
+	"invalid_tag_name":                           "タグ名が不正です",
+	"invalid_target_tag_id":                      "統合先のタグIDが不正です",
+	"invalid_title":                              "タイトルが不正です",
+	"invalid_to":                                 "to が不正です",
+	"invalid_token":                              "トークンが不正です",
+	"invalid_user_id":                            "ユーザーIDが不正です",
+	"invalid_webhook_delivery_id":                "Webhookの配信IDが不正です",
+	"invalid_webhook_delivery_status":            "Webhookの配信ステータスが不正です",
+	"invalid_webhook_event_type":                 "Webhookのイベント種別が不正です",
+	"invalid_webhook_subscription_id":            "Webhookの購読IDが不正です",
+	"maximum_number_of_tags_reached":             "タグの上限に達しています",
+	"meta_description_invalid_characters":        "メタディスクリプションに改行やタブは使用できません",
+	"meta_description_is_too_long":               "メタディスクリプションが長すぎます",
+	"method_not_allowed":                         "許可されていないメソッドです",
+	"name_cannot_be_empty":                       "名前を入力してください",
+	"new_posts_must_be_created_as_draft":         "新しい投稿は下書きとして作成してください",
+	"no_update_fields":                           "更新する項目がありません",
+	"not_found":                                  "見つかりません",
+	"not_found_user_id":                          "ユーザーIDが見つかりません",
+	"og_image_does_not_exist":                    "OGP画像が存在しません",
+	"only_deleted_posts_can_be_restored":         "復元できるのは削除済みの投稿のみです",
+	"only_in_review_posts_can_be_reviewed":       "レビューできるのはレビュー中の投稿のみです",
+	"only_reviewer_can_change_post_status":       "投稿のステータスを変更できるのは担当レビュアーのみです",
+	"only_reviewer_can_review_post":              "投稿をレビューできるのは担当レビュアーのみです",
+	"only_the_author_can_assign_a_reviewer":      "レビュアーを指定できるのは投稿者のみです",
+	"only_the_author_can_change_the_post_status": "投稿のステータスを変更できるのは投稿者のみです",
+	"parent_category_does_not_exist":             "親カテゴリが存在しません",
+	"password_too_short":                         "パスワードは8文字以上で入力してください",
+	"post_content_is_locked_during_review":       "レビュー中または承認済みの投稿はタイトルと本文を変更できません。下書きに戻してから編集してください",
+	"post_id_is_required":                        "投稿IDを指定してください",
+	"post_ids_are_required":                      "投稿IDを指定してください",
+	"post_is_locked_by_another_user":             "他のユーザーが投稿を編集中です",
+	"post_lock_is_not_held_by_the_user":          "投稿の編集ロックを保持していません",
+	"post_modified_by_another_request":           "投稿は他のリクエストで更新されています",
+	"post_not_found":                             "投稿が見つかりません",
+	"post_transition_must_change_status":         "投稿のステータス遷移 %s はステータスを変更する必要があります",
+	"post_transition_name_is_required":           "投稿のステータス遷移の名前を指定してください",
+	"post_transition_rules_are_required":         "投稿のステータス遷移のルールを指定してください",
+	"primary_category_duplicated":                "メインカテゴリを追加カテゴリにも指定することはできません",
+	"primary_category_required":                  "追加カテゴリを指定する場合はメインカテゴリを指定してください",
+	"rate_limit_must_be_positive":                "レート制限の回数は1以上で指定してください",
+	"rate_limit_period_must_be_at_least_1s":      "レート制限の期間は1秒以上で指定してください",
+	"rate_limit_policy_invalid_format":           "レート制限は「回数/期間」の形式で指定してください",
+	"rate_limit_policy_name_is_required":         "レート制限の名前を指定してください",
+	"required_category_id":                       "カテゴリIDを指定してください",
+	"required_post_id":                           "投稿IDを指定してください",
+	"required_tag_id":                            "タグIDを指定してください",
+	"required_webhook_subscription_id":           "Webhookの購読IDを指定してください",
+	"reviewer_assignment_not_allowed":            "レビュアーを指定できるのは下書きまたはレビュー中の投稿のみです",
+	"reviewer_must_be_assigned_before_review":    "レビューの前にレビュアーを指定してください",
+	"role_required":                              "%s ロールが必要です",
+	"sitemap_not_found":                          "サイトマップが見つかりません",
+	"status_is_required_for_set_status":          "set_status にはステータスを指定してください",
+	"tag_already_exists":                         "タグは既に存在します",
+	"tag_cannot_be_both_added_and_removed":       "同じタグを追加と削除の両方に指定することはできません",
+	"tag_name_invalid_characters":                "タグ名には日本語・英字・数字・ハイフン・アンダースコアのみ使用できます",
+	"tag_name_too_long":                          "タグ名が長すぎます",
+	"tag_not_found":                              "タグが見つかりません",
+	"tag_with_this_name_already_exists":          "同じ名前のタグが既に存在します",
+	"tags_and_tag_changes_combined":              "tags と add_tags / remove_tags は同時に指定できません",
+	"tags_required_for_operation":                "%s にはタグを指定してください",
+	"title_contains_forbidden_character":         "タイトルに使用できない文字が含まれています: %q",
+	"title_is_too_long":                          "タイトルが長すぎます",
+	"title_too_many_escaped_characters":          "タイトルにエスケープ時に長くなる特殊文字が多すぎます",
+	"too_many_login_attempts":                    "ログインの失敗回数が上限を超えました。しばらくしてから再度お試しください",
+	"too_many_posts":                             "一度に操作できる投稿は%d件までです",
+	"too_many_requests":                          "リクエスト数が上限を超えました。しばらくしてから再度お試しください",
+	"too_many_secondary_categories":              "追加カテゴリの上限を超えています",
+	"unknown_post_transition_effect":             "投稿のステータス遷移 %[2]s に不明な処理 %[1]s が指定されています",
+	"unknown_post_transition_guard":              "投稿のステータス遷移 %[2]s に不明なガード %[1]s が指定されています",
+	"unsupported_file_extension":                 "対応していないファイル形式です",
+	"user_already_exists":                        "ユーザーは既に存在します",
+	"user_not_found":                             "ユーザーが見つかりません",
+	"user_with_this_email_already_exists":        "このメールアドレスのユーザーは既に存在します",
+	"validation_failed":                          "入力内容に誤りがあります",
+	"webhook_delivery_not_found":                 "Webhookの配信が見つかりません",
+	"webhook_event_types_are_required":           "Webhookのイベント種別を指定してください",
+	"webhook_secret_too_short":                   "Webhookのシークレットは16文字以上で指定してください",
+	"webhook_subscription_not_found":             "Webhookの購読が見つかりません",
+	"webhook_url_invalid":                        "WebhookのURLは http または https の絶対URLで指定してください",
+	"webhook_url_must_be_https":                  "WebhookのURLは https で指定してください",
+	"webhook_url_private_network":                "WebhookのURLにプライベート・ループバック・リンクローカルのアドレスは指定できません",
+}
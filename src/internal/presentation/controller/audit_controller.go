@@ -0,0 +1,42 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/MizukiShigi/cms-go/internal/presentation/helper"
+	"github.com/MizukiShigi/cms-go/internal/usecase"
+)
+
+type AuditController struct {
+	listAuditEventsUsecase *usecase.ListAuditEventsUsecase
+}
+
+func NewAuditController(listAuditEventsUsecase *usecase.ListAuditEventsUsecase) *AuditController {
+	return &AuditController{
+		listAuditEventsUsecase: listAuditEventsUsecase,
+	}
+}
+
+func (ac *AuditController) ListAuditEvents(w http.ResponseWriter, r *http.Request) {
+	// クエリパラメータを取得
+	query := r.URL.Query()
+	req := &usecase.ListAuditEventsRequest{
+		Limit:      query.Get("limit"),
+		Offset:     query.Get("offset"),
+		ActorID:    query.Get("actor_id"),
+		EntityType: query.Get("entity_type"),
+		EntityID:   query.Get("entity_id"),
+		Action:     query.Get("action"),
+		RequestID:  query.Get("request_id"),
+		From:       query.Get("from"),
+		To:         query.Get("to"),
+	}
+
+	response, err := ac.listAuditEventsUsecase.Execute(r.Context(), req)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	helper.RespondWithJSON(w, http.StatusOK, response)
+}
@@ -7,8 +7,6 @@ import (
 	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
 	"github.com/MizukiShigi/cms-go/internal/presentation/helper"
 	"github.com/MizukiShigi/cms-go/internal/usecase"
-
-	"github.com/go-playground/validator/v10"
 )
 
 type AuthController struct {
@@ -48,19 +46,15 @@ type UserResponse struct {
 func (ac *AuthController) Register(w http.ResponseWriter, r *http.Request) {
 	var req RegisterRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		myError := valueobject.NewMyError(valueobject.InvalidCode, "Invalid request payload")
-		helper.RespondWithError(w, myError)
+		myError := valueobject.NewMyError(valueobject.InvalidCode, "invalid_request_payload")
+		helper.RespondWithError(w, r, myError)
 		return
 	}
 
-	validate := validator.New()
-	err := validate.Struct(req)
+	err := helper.ValidateStruct(req)
 	if err != nil {
-		for _, err := range err.(validator.ValidationErrors) {
-			myError := valueobject.NewMyError(valueobject.InvalidCode, err.Error())
-			helper.RespondWithError(w, myError)
-			return
-		}
+		helper.RespondWithError(w, r, err)
+		return
 	}
 
 	input := &usecase.RegisterUserInput{
@@ -71,7 +65,7 @@ func (ac *AuthController) Register(w http.ResponseWriter, r *http.Request) {
 
 	output, err := ac.registerUserUsecase.Execute(r.Context(), input)
 	if err != nil {
-		helper.RespondWithError(w, err)
+		helper.RespondWithError(w, r, err)
 		return
 	}
 
@@ -87,19 +81,15 @@ func (ac *AuthController) Register(w http.ResponseWriter, r *http.Request) {
 func (ac *AuthController) Login(w http.ResponseWriter, r *http.Request) {
 	var req LoginRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		MyError := valueobject.NewMyError(valueobject.InvalidCode, "Invalid request payload")
-		helper.RespondWithError(w, MyError)
+		MyError := valueobject.NewMyError(valueobject.InvalidCode, "invalid_request_payload")
+		helper.RespondWithError(w, r, MyError)
 		return
 	}
 
-	validate := validator.New()
-	err := validate.Struct(req)
+	err := helper.ValidateStruct(req)
 	if err != nil {
-		for _, err := range err.(validator.ValidationErrors) {
-			myError := valueobject.NewMyError(valueobject.InvalidCode, err.Error())
-			helper.RespondWithError(w, myError)
-			return
-		}
+		helper.RespondWithError(w, r, err)
+		return
 	}
 
 	input := &usecase.LoginUserInput{
@@ -109,7 +99,7 @@ func (ac *AuthController) Login(w http.ResponseWriter, r *http.Request) {
 
 	output, err := ac.loginUserUsecase.Execute(r.Context(), input)
 	if err != nil {
-		helper.RespondWithError(w, err)
+		helper.RespondWithError(w, r, err)
 		return
 	}
 
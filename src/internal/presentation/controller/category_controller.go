@@ -0,0 +1,270 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	"github.com/MizukiShigi/cms-go/internal/presentation/helper"
+	"github.com/MizukiShigi/cms-go/internal/usecase"
+
+	"github.com/gorilla/mux"
+)
+
+type CategoryController struct {
+	listCategoriesUsecase *usecase.ListCategoriesUsecase
+	createCategoryUsecase *usecase.CreateCategoryUsecase
+	getCategoryUsecase    *usecase.GetCategoryUsecase
+	updateCategoryUsecase *usecase.UpdateCategoryUsecase
+	deleteCategoryUsecase *usecase.DeleteCategoryUsecase
+}
+
+func NewCategoryController(listCategoriesUsecase *usecase.ListCategoriesUsecase, createCategoryUsecase *usecase.CreateCategoryUsecase, getCategoryUsecase *usecase.GetCategoryUsecase, updateCategoryUsecase *usecase.UpdateCategoryUsecase, deleteCategoryUsecase *usecase.DeleteCategoryUsecase) *CategoryController {
+	return &CategoryController{
+		listCategoriesUsecase: listCategoriesUsecase,
+		createCategoryUsecase: createCategoryUsecase,
+		getCategoryUsecase:    getCategoryUsecase,
+		updateCategoryUsecase: updateCategoryUsecase,
+		deleteCategoryUsecase: deleteCategoryUsecase,
+	}
+}
+
+type CategoryRequest struct {
+	ParentID    *string `json:"parent_id" validate:"omitempty,uuid"`
+	Name        string  `json:"name" validate:"required"`
+	Slug        string  `json:"slug" validate:"required"`
+	Description string  `json:"description"`
+	SortOrder   int     `json:"sort_order" validate:"min=0,max=999"`
+}
+
+type CategoryResponse struct {
+	ID          string    `json:"id"`
+	ParentID    *string   `json:"parent_id"`
+	Name        string    `json:"name"`
+	Slug        string    `json:"slug"`
+	Description string    `json:"description"`
+	SortOrder   int       `json:"sort_order"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type GetCategoryResponse struct {
+	CategoryResponse
+	AncestorIDs []string `json:"ancestor_ids"`
+}
+
+func (cc *CategoryController) ListCategories(w http.ResponseWriter, r *http.Request) {
+	response, err := cc.listCategoriesUsecase.Execute(r.Context())
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	helper.RespondWithJSON(w, http.StatusOK, response)
+}
+
+func (cc *CategoryController) CreateCategory(w http.ResponseWriter, r *http.Request) {
+	var req CategoryRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.InvalidCode, "invalid_request_payload"))
+		return
+	}
+
+	parentID, name, slug, err := parseCategoryRequest(&req)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	output, err := cc.createCategoryUsecase.Execute(r.Context(), &usecase.CreateCategoryInput{
+		ParentID:    parentID,
+		Name:        name,
+		Slug:        slug,
+		Description: req.Description,
+		SortOrder:   req.SortOrder,
+	})
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	helper.RespondWithJSON(w, http.StatusCreated, toCategoryResponse(output.Category))
+}
+
+func (cc *CategoryController) GetCategory(w http.ResponseWriter, r *http.Request) {
+	categoryID, err := parseCategoryIDFromPath(r)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	output, err := cc.getCategoryUsecase.Execute(r.Context(), &usecase.GetCategoryInput{ID: categoryID})
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	ancestorIDs := make([]string, 0, len(output.AncestorIDs))
+	for _, ancestorID := range output.AncestorIDs {
+		ancestorIDs = append(ancestorIDs, ancestorID.String())
+	}
+
+	helper.RespondWithJSON(w, http.StatusOK, GetCategoryResponse{
+		CategoryResponse: toCategoryResponse(output.Category),
+		AncestorIDs:      ancestorIDs,
+	})
+}
+
+func (cc *CategoryController) UpdateCategory(w http.ResponseWriter, r *http.Request) {
+	categoryID, err := parseCategoryIDFromPath(r)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	var req CategoryRequest
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.InvalidCode, "invalid_request_payload"))
+		return
+	}
+
+	parentID, name, slug, err := parseCategoryRequest(&req)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	output, err := cc.updateCategoryUsecase.Execute(r.Context(), &usecase.UpdateCategoryInput{
+		ID:          categoryID,
+		ParentID:    parentID,
+		Name:        name,
+		Slug:        slug,
+		Description: req.Description,
+		SortOrder:   req.SortOrder,
+	})
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	helper.RespondWithJSON(w, http.StatusOK, toCategoryResponse(output.Category))
+}
+
+func (cc *CategoryController) DeleteCategory(w http.ResponseWriter, r *http.Request) {
+	categoryID, err := parseCategoryIDFromPath(r)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	err = cc.deleteCategoryUsecase.Execute(r.Context(), &usecase.DeleteCategoryInput{ID: categoryID})
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func parseCategoryRequest(req *CategoryRequest) (*valueobject.CategoryID, valueobject.CategoryName, valueobject.CategorySlug, error) {
+	if err := helper.ValidateStruct(req); err != nil {
+		return nil, "", "", err
+	}
+
+	var parentID *valueobject.CategoryID
+	if req.ParentID != nil {
+		id, err := valueobject.ParseCategoryID(*req.ParentID)
+		if err != nil {
+			return nil, "", "", err
+		}
+		parentID = &id
+	}
+
+	name, err := valueobject.NewCategoryName(req.Name)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	slug, err := valueobject.NewCategorySlug(req.Slug)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	return parentID, name, slug, nil
+}
+
+// parseCategoryIDs は投稿リクエストのカテゴリ指定を値オブジェクトに変換する
+func parseCategoryIDs(primaryCategoryID *string, categoryIDs []string) (*valueobject.CategoryID, []valueobject.CategoryID, error) {
+	var primary *valueobject.CategoryID
+	if primaryCategoryID != nil {
+		id, err := valueobject.ParseCategoryID(*primaryCategoryID)
+		if err != nil {
+			return nil, nil, err
+		}
+		primary = &id
+	}
+
+	var ids []valueobject.CategoryID
+	if categoryIDs != nil {
+		ids = make([]valueobject.CategoryID, 0, len(categoryIDs))
+		for _, categoryID := range categoryIDs {
+			id, err := valueobject.ParseCategoryID(categoryID)
+			if err != nil {
+				return nil, nil, err
+			}
+			ids = append(ids, id)
+		}
+	}
+
+	return primary, ids, nil
+}
+
+// formatCategoryIDs はレスポンス用にカテゴリIDを文字列へ変換する
+func formatCategoryIDs(primaryCategoryID *valueobject.CategoryID, categoryIDs []valueobject.CategoryID) (*string, []string) {
+	var primary *string
+	if primaryCategoryID != nil {
+		id := primaryCategoryID.String()
+		primary = &id
+	}
+
+	// 配列で返したいので、nilの場合は空配列を返す
+	ids := make([]string, 0, len(categoryIDs))
+	for _, categoryID := range categoryIDs {
+		ids = append(ids, categoryID.String())
+	}
+
+	return primary, ids
+}
+
+func toCategoryResponse(category *entity.Category) CategoryResponse {
+	var parentID *string
+	if category.ParentID != nil {
+		id := category.ParentID.String()
+		parentID = &id
+	}
+
+	return CategoryResponse{
+		ID:          category.ID.String(),
+		ParentID:    parentID,
+		Name:        category.Name.String(),
+		Slug:        category.Slug.String(),
+		Description: category.Description,
+		SortOrder:   category.SortOrder,
+		CreatedAt:   category.CreatedAt,
+		UpdatedAt:   category.UpdatedAt,
+	}
+}
+
+func parseCategoryIDFromPath(r *http.Request) (valueobject.CategoryID, error) {
+	vars := mux.Vars(r)
+	id, exists := vars["id"]
+	if !exists {
+		return "", valueobject.NewMyError(valueobject.InvalidCode, "required_category_id")
+	}
+
+	return valueobject.ParseCategoryID(id)
+}
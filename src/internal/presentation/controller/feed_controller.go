@@ -0,0 +1,79 @@
+package controller
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+
+	"github.com/MizukiShigi/cms-go/internal/presentation/helper"
+	"github.com/MizukiShigi/cms-go/internal/usecase"
+
+	"github.com/gorilla/mux"
+)
+
+type FeedController struct {
+	getFeedUsecase *usecase.GetFeedUsecase
+}
+
+func NewFeedController(getFeedUsecase *usecase.GetFeedUsecase) *FeedController {
+	return &FeedController{
+		getFeedUsecase: getFeedUsecase,
+	}
+}
+
+// feedEncoder はフィードを各形式のバイト列に変換する
+type feedEncoder func(feed *usecase.Feed, selfURL string) ([]byte, error)
+
+func (fc *FeedController) GetRSS(w http.ResponseWriter, r *http.Request) {
+	fc.respondFeed(w, r, helper.RSSContentType, helper.EncodeRSS)
+}
+
+func (fc *FeedController) GetAtom(w http.ResponseWriter, r *http.Request) {
+	fc.respondFeed(w, r, helper.AtomContentType, helper.EncodeAtom)
+}
+
+func (fc *FeedController) GetJSONFeed(w http.ResponseWriter, r *http.Request) {
+	fc.respondFeed(w, r, helper.JSONFeedContentType, helper.EncodeJSONFeed)
+}
+
+func (fc *FeedController) respondFeed(w http.ResponseWriter, r *http.Request, contentType string, encode feedEncoder) {
+	feed, err := fc.getFeedUsecase.Execute(r.Context(), &usecase.GetFeedInput{
+		Tag:   mux.Vars(r)["tag"],
+		Limit: r.URL.Query().Get("limit"),
+	})
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	body, err := encode(feed, requestURL(r))
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	// 内容が変わらない限り同じETagになるよう本文のハッシュを使う
+	sum := sha256.Sum256(body)
+	etag := `W/"` + hex.EncodeToString(sum[:16]) + `"`
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	if helper.RespondNotModifiedIfFresh(w, r, etag, feed.Updated) {
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
+// requestURL はフィード自身のURLをリクエストから組み立てる
+func requestURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+		scheme = proto
+	}
+
+	return scheme + "://" + r.Host + r.URL.RequestURI()
+}
@@ -0,0 +1,72 @@
+package controller
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/presentation/helper"
+	"github.com/MizukiShigi/cms-go/internal/usecase"
+)
+
+type HealthController struct {
+	checkReadinessUsecase *usecase.CheckReadinessUsecase
+}
+
+func NewHealthController(checkReadinessUsecase *usecase.CheckReadinessUsecase) *HealthController {
+	return &HealthController{
+		checkReadinessUsecase: checkReadinessUsecase,
+	}
+}
+
+type LivenessResponse struct {
+	Status string `json:"status"`
+}
+
+type ReadinessResponse struct {
+	// ok / fail / shutting_down
+	Status string                `json:"status"`
+	Checks []HealthCheckResponse `json:"checks"`
+}
+
+type HealthCheckResponse struct {
+	Name       string    `json:"name"`
+	Status     string    `json:"status"`
+	Error      string    `json:"error,omitempty"`
+	DurationMs int64     `json:"duration_ms"`
+	CheckedAt  time.Time `json:"checked_at"`
+}
+
+// Liveness はプロセスが応答できることのみを返す（依存先の障害で再起動させないため確認しない）
+func (hc *HealthController) Liveness(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Cache-Control", "no-store")
+	helper.RespondWithJSON(w, http.StatusOK, LivenessResponse{Status: "ok"})
+}
+
+// Readiness は依存先の確認結果を返し、準備未完了の場合は 503 を返す
+func (hc *HealthController) Readiness(w http.ResponseWriter, r *http.Request) {
+	output := hc.checkReadinessUsecase.Execute(r.Context())
+
+	checks := make([]HealthCheckResponse, 0, len(output.Checks))
+	for _, check := range output.Checks {
+		checks = append(checks, HealthCheckResponse{
+			Name:       check.Name,
+			Status:     string(check.Status),
+			Error:      check.Error,
+			DurationMs: check.Duration.Milliseconds(),
+			CheckedAt:  check.CheckedAt,
+		})
+	}
+
+	response := ReadinessResponse{Status: string(usecase.HealthCheckOK), Checks: checks}
+	statusCode := http.StatusOK
+	if !output.Ready {
+		response.Status = string(usecase.HealthCheckFail)
+		statusCode = http.StatusServiceUnavailable
+	}
+	if output.ShuttingDown {
+		response.Status = "shutting_down"
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	helper.RespondWithJSON(w, statusCode, response)
+}
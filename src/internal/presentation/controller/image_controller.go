@@ -31,25 +31,25 @@ type CreateImageResponse struct {
 func (c *ImageController) CreateImage(w http.ResponseWriter, r *http.Request) {
 	ctxUserID, err := domaincontext.GetUserID(r.Context())
 	if err != nil {
-		helper.RespondWithError(w, err)
+		helper.RespondWithError(w, r, err)
 		return
 	}
 
 	userID, err := valueobject.ParseUserID(ctxUserID)
 	if err != nil {
-		helper.RespondWithError(w, valueobject.NewMyError(valueobject.InvalidCode, "Invalid user ID"))
+		helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.InvalidCode, "invalid_user_id"))
 		return
 	}
 
 	postIDStr := r.FormValue("post_id")
 	if postIDStr == "" {
-		helper.RespondWithError(w, valueobject.NewMyError(valueobject.InvalidCode, "Post ID is required"))
+		helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.InvalidCode, "post_id_is_required"))
 		return
 	}
 
 	postID, err := valueobject.ParsePostID(postIDStr)
 	if err != nil {
-		helper.RespondWithError(w, valueobject.NewMyError(valueobject.InvalidCode, "Invalid post ID"))
+		helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.InvalidCode, "invalid_post_id"))
 		return
 	}
 
@@ -58,36 +58,38 @@ func (c *ImageController) CreateImage(w http.ResponseWriter, r *http.Request) {
 	if sortOrderStr != "" {
 		sortOrder, err = strconv.Atoi(sortOrderStr)
 		if err != nil {
-			helper.RespondWithError(w, valueobject.NewMyError(valueobject.InvalidCode, "Invalid sort order"))
+			helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.InvalidCode, "invalid_sort_order"))
 			return
 		}
 	}
 	if sortOrder < 0 || sortOrder > 999 {
-		helper.RespondWithError(w, valueobject.NewMyError(valueobject.InvalidCode, "Invalid sort order"))
+		helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.InvalidCode, "invalid_sort_order"))
 		return
 	}
 
 	_, header, err := r.FormFile("image")
 	if err != nil {
-		http.Error(w, "image required", http.StatusBadRequest)
+		helper.RespondWithError(w, r, valueobject.NewValidationError([]valueobject.FieldError{
+			valueobject.NewFieldError("image", "required", "field_required", "image"),
+		}))
 		return
 	}
 
 	filenameStr := header.Filename
 	filename, err := valueobject.NewImageFilename(filenameStr)
 	if err != nil {
-		helper.RespondWithError(w, valueobject.NewMyError(valueobject.InvalidCode, "Invalid filename"))
+		helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.InvalidCode, "invalid_filename"))
 		return
 	}
 
 	if header.Size > 10*1024*1024 {
-		helper.RespondWithError(w, valueobject.NewMyError(valueobject.InvalidCode, "File too large"))
+		helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.InvalidCode, "file_too_large"))
 		return
 	}
 
 	file, err := header.Open()
 	if err != nil {
-		helper.RespondWithError(w, valueobject.NewMyError(valueobject.InvalidCode, "Failed to open file"))
+		helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.InvalidCode, "failed_to_open_file"))
 		return
 	}
 	defer file.Close()
@@ -102,7 +104,7 @@ func (c *ImageController) CreateImage(w http.ResponseWriter, r *http.Request) {
 
 	output, err := c.createImageUsecase.Execute(r.Context(), input)
 	if err != nil {
-		helper.RespondWithError(w, err)
+		helper.RespondWithError(w, r, err)
 		return
 	}
 
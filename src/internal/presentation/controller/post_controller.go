@@ -10,25 +10,26 @@ import (
 	"github.com/MizukiShigi/cms-go/internal/presentation/helper"
 	"github.com/MizukiShigi/cms-go/internal/usecase"
 
-	"github.com/go-playground/validator/v10"
 	"github.com/gorilla/mux"
 )
 
 type PostController struct {
-	listPostsUsecase  *usecase.ListPostsUsecase
-	createPostUsecase *usecase.CreatePostUsecase
-	getPostUsecase    *usecase.GetPostUsecase
-	updatePostUsecase *usecase.UpdatePostUsecase
-	patchPostUsecase  *usecase.PatchPostUsecase
+	listPostsUsecase       *usecase.ListPostsUsecase
+	createPostUsecase      *usecase.CreatePostUsecase
+	getPostUsecase         *usecase.GetPostUsecase
+	updatePostUsecase      *usecase.UpdatePostUsecase
+	patchPostUsecase       *usecase.PatchPostUsecase
+	bulkUpdatePostsUsecase *usecase.BulkUpdatePostsUsecase
 }
 
-func NewPostController(listPostsUsecase *usecase.ListPostsUsecase, createPostUsecase *usecase.CreatePostUsecase, getPostUsecase *usecase.GetPostUsecase, updatePostUsecase *usecase.UpdatePostUsecase, patchPostUsecase *usecase.PatchPostUsecase) *PostController {
+func NewPostController(listPostsUsecase *usecase.ListPostsUsecase, createPostUsecase *usecase.CreatePostUsecase, getPostUsecase *usecase.GetPostUsecase, updatePostUsecase *usecase.UpdatePostUsecase, patchPostUsecase *usecase.PatchPostUsecase, bulkUpdatePostsUsecase *usecase.BulkUpdatePostsUsecase) *PostController {
 	return &PostController{
-		listPostsUsecase:  listPostsUsecase,
-		createPostUsecase: createPostUsecase,
-		getPostUsecase:    getPostUsecase,
-		updatePostUsecase: updatePostUsecase,
-		patchPostUsecase:  patchPostUsecase,
+		listPostsUsecase:       listPostsUsecase,
+		createPostUsecase:      createPostUsecase,
+		getPostUsecase:         getPostUsecase,
+		updatePostUsecase:      updatePostUsecase,
+		patchPostUsecase:       patchPostUsecase,
+		bulkUpdatePostsUsecase: bulkUpdatePostsUsecase,
 	}
 }
 
@@ -39,59 +40,61 @@ type Post struct {
 }
 
 type CreatePostRequest struct {
-	Title   string   `json:"title" validate:"required"`
-	Content string   `json:"content" validate:"required"`
-	Tags    []string `json:"tags"`
-	Status  string   `json:"status" validate:"required,oneof=draft published"`
+	Title             string   `json:"title" validate:"required"`
+	Content           string   `json:"content" validate:"required"`
+	Tags              []string `json:"tags"`
+	Status            string   `json:"status" validate:"required,oneof=draft"`
+	PrimaryCategoryID *string  `json:"primary_category_id"`
+	CategoryIDs       []string `json:"category_ids"`
+	PostSEORequest
 }
 
 type CreatePostResponse struct {
-	ID      string   `json:"id"`
-	Title   string   `json:"title"`
-	Content string   `json:"content"`
-	Tags    []string `json:"tags"`
+	ID                string   `json:"id"`
+	Title             string   `json:"title"`
+	Content           string   `json:"content"`
+	Tags              []string `json:"tags"`
+	PrimaryCategoryID *string  `json:"primary_category_id"`
+	CategoryIDs       []string `json:"category_ids"`
+	PostSEOResponse
 }
 
 func (pc *PostController) CreatePost(w http.ResponseWriter, r *http.Request) {
 	ctxUserID, err := domaincontext.GetUserID(r.Context())
 	if err != nil {
-		helper.RespondWithError(w, err)
+		helper.RespondWithError(w, r, err)
 		return
 	}
 
 	var req CreatePostRequest
 	err = json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
-		MyError := valueobject.NewMyError(valueobject.InvalidCode, "Invalid request payload")
-		helper.RespondWithError(w, MyError)
+		MyError := valueobject.NewMyError(valueobject.InvalidCode, "invalid_request_payload")
+		helper.RespondWithError(w, r, MyError)
 		return
 	}
 
-	validate := validator.New()
-	err = validate.Struct(req)
+	err = helper.ValidateStruct(req)
 	if err != nil {
-		for _, err := range err.(validator.ValidationErrors) {
-			myError := valueobject.NewMyError(valueobject.InvalidCode, err.Error())
-			helper.RespondWithError(w, myError)
-			return
-		}
+		helper.RespondWithError(w, r, err)
+		return
 	}
 
 	title, err := valueobject.NewPostTitle(req.Title)
 	if err != nil {
-		helper.RespondWithError(w, valueobject.NewMyError(valueobject.InvalidCode, "Invalid title"))
+		helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.InvalidCode, "invalid_title"))
 		return
 	}
 
 	content, err := valueobject.NewPostContent(req.Content)
 	if err != nil {
-		helper.RespondWithError(w, valueobject.NewMyError(valueobject.InvalidCode, "Invalid content"))
+		helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.InvalidCode, "invalid_content"))
 		return
 	}
 
 	userID, err := valueobject.ParseUserID(ctxUserID)
 	if err != nil {
-		helper.RespondWithError(w, valueobject.NewMyError(valueobject.InvalidCode, "Invalid user ID"))
+		helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.InvalidCode, "invalid_user_id"))
 		return
 	}
 
@@ -99,7 +102,7 @@ func (pc *PostController) CreatePost(w http.ResponseWriter, r *http.Request) {
 	for _, tag := range req.Tags {
 		tag, err := valueobject.NewTagName(tag)
 		if err != nil {
-			helper.RespondWithError(w, valueobject.NewMyError(valueobject.InvalidCode, "Invalid tag"))
+			helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.InvalidCode, "invalid_tag"))
 			return
 		}
 		inputTags = append(inputTags, tag)
@@ -107,21 +110,36 @@ func (pc *PostController) CreatePost(w http.ResponseWriter, r *http.Request) {
 
 	status, err := valueobject.NewPostStatus(req.Status)
 	if err != nil {
-		helper.RespondWithError(w, valueobject.NewMyError(valueobject.InvalidCode, "Invalid status"))
+		helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.InvalidCode, "invalid_status"))
+		return
+	}
+
+	inputPrimaryCategoryID, inputCategoryIDs, err := parseCategoryIDs(req.PrimaryCategoryID, req.CategoryIDs)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	seo, err := req.PostSEORequest.toInput()
+	if err != nil {
+		helper.RespondWithError(w, r, err)
 		return
 	}
 
 	input := &usecase.CreatePostInput{
-		Title:   title,
-		Content: content,
-		Tags:    inputTags,
-		UserID:  userID,
-		Status:  status,
+		Title:             title,
+		Content:           content,
+		Tags:              inputTags,
+		UserID:            userID,
+		Status:            status,
+		PrimaryCategoryID: inputPrimaryCategoryID,
+		CategoryIDs:       inputCategoryIDs,
+		SEO:               seo,
 	}
 
 	output, err := pc.createPostUsecase.Execute(r.Context(), input)
 	if err != nil {
-		helper.RespondWithError(w, err)
+		helper.RespondWithError(w, r, err)
 		return
 	}
 
@@ -130,44 +148,56 @@ func (pc *PostController) CreatePost(w http.ResponseWriter, r *http.Request) {
 		oiutputTags = append(oiutputTags, tag.String())
 	}
 
+	outputPrimaryCategoryID, outputCategoryIDs := formatCategoryIDs(output.PrimaryCategoryID, output.CategoryIDs)
+
 	createPostResponse := CreatePostResponse{
-		ID:      output.ID.String(),
-		Title:   output.Title.String(),
-		Content: output.Content.String(),
-		Tags:    oiutputTags,
+		ID:                output.ID.String(),
+		Title:             output.Title.String(),
+		Content:           output.Content.String(),
+		Tags:              oiutputTags,
+		PrimaryCategoryID: outputPrimaryCategoryID,
+		CategoryIDs:       outputCategoryIDs,
+		PostSEOResponse:   toPostSEOResponse(output.SEO),
 	}
 
 	helper.RespondWithJSON(w, http.StatusCreated, createPostResponse)
 }
 
 type GetPostResponse struct {
-	ID               string     `json:"id"`
-	Title            string     `json:"title"`
-	Content          string     `json:"content"`
-	Status           string     `json:"status"`
-	Tags             []string   `json:"tags"`
-	FirstPublishedAt *time.Time `json:"first_published_at"`
-	ContentUpdatedAt *time.Time `json:"content_updated_at"`
+	ID                string     `json:"id"`
+	Title             string     `json:"title"`
+	Content           string     `json:"content"`
+	Status            string     `json:"status"`
+	Tags              []string   `json:"tags"`
+	PrimaryCategoryID *string    `json:"primary_category_id"`
+	CategoryIDs       []string   `json:"category_ids"`
+	FirstPublishedAt  *time.Time `json:"first_published_at"`
+	ContentUpdatedAt  *time.Time `json:"content_updated_at"`
+	ReviewerID        *string    `json:"reviewer_id"`
+	Version           int        `json:"version"`
+	// 編集中のユーザーがいない場合は null
+	EditLock *PostLockResponse `json:"edit_lock"`
+	PostSEOResponse
 }
 
 func (pc *PostController) GetPost(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	id, exists := vars["id"]
 	if !exists {
-		helper.RespondWithError(w, valueobject.NewMyError(valueobject.InvalidCode, "Required post ID"))
+		helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.InvalidCode, "required_post_id"))
 		return
 	}
 
 	postID, err := valueobject.ParsePostID(id)
 	if err != nil {
-		helper.RespondWithError(w, valueobject.NewMyError(valueobject.InvalidCode, "Invalid post ID"))
+		helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.InvalidCode, "invalid_post_id"))
 		return
 	}
 
 	input := &usecase.GetPostInput{ID: postID}
 	output, err := pc.getPostUsecase.Execute(r.Context(), input)
 	if err != nil {
-		helper.RespondWithError(w, err)
+		helper.RespondWithError(w, r, err)
 		return
 	}
 
@@ -180,75 +210,100 @@ func (pc *PostController) GetPost(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	res := GetPostResponse{
-		ID:               output.ID.String(),
-		Title:            output.Title.String(),
-		Content:          output.Content.String(),
-		Status:           output.Status.String(),
-		Tags:             tags,
-		FirstPublishedAt: output.FirstPublishedAt,
-		ContentUpdatedAt: output.ContentUpdatedAt,
-	}
+	primaryCategoryID, categoryIDs := formatCategoryIDs(output.PrimaryCategoryID, output.CategoryIDs)
 
+	res := GetPostResponse{
+		ID:                output.ID.String(),
+		Title:             output.Title.String(),
+		Content:           output.Content.String(),
+		Status:            output.Status.String(),
+		Tags:              tags,
+		PrimaryCategoryID: primaryCategoryID,
+		CategoryIDs:       categoryIDs,
+		FirstPublishedAt:  output.FirstPublishedAt,
+		ContentUpdatedAt:  output.ContentUpdatedAt,
+		ReviewerID:        formatUserID(output.ReviewerID),
+		PostSEOResponse:   toPostSEOResponse(output.SEO),
+		Version:           output.Version,
+		EditLock:          toPostLockResponse(output.EditLock),
+	}
+
+	helper.SetETag(w, output.Version)
 	helper.RespondWithJSON(w, http.StatusOK, res)
 }
 
 type UpdatePostRequest struct {
-	Title   string   `json:"title" validate:"required,min=1"`
-	Content string   `json:"content" validate:"required,min=1"`
-	Tags    []string `json:"tags"`
+	Title             string   `json:"title" validate:"required,min=1"`
+	Content           string   `json:"content" validate:"required,min=1"`
+	Tags              []string `json:"tags"`
+	PrimaryCategoryID *string  `json:"primary_category_id"`
+	CategoryIDs       []string `json:"category_ids"`
+	// 未指定のSEO項目は未設定に戻る
+	PostSEORequest
 }
 
 type UpdatePostResponse struct {
-	ID               string     `json:"id"`
-	Title            string     `json:"title"`
-	Content          string     `json:"content"`
-	Status           string     `json:"status"`
-	Tags             []string   `json:"tags"`
-	FirstPublishedAt *time.Time `json:"first_published_at"`
-	ContentUpdatedAt *time.Time `json:"content_updated_at"`
+	ID                string     `json:"id"`
+	Title             string     `json:"title"`
+	Content           string     `json:"content"`
+	Status            string     `json:"status"`
+	Tags              []string   `json:"tags"`
+	PrimaryCategoryID *string    `json:"primary_category_id"`
+	CategoryIDs       []string   `json:"category_ids"`
+	FirstPublishedAt  *time.Time `json:"first_published_at"`
+	ContentUpdatedAt  *time.Time `json:"content_updated_at"`
+	Version           int        `json:"version"`
+	PostSEOResponse
 }
 
 func (pc *PostController) UpdatePost(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	id, exists := vars["id"]
 	if !exists {
-		helper.RespondWithError(w, valueobject.NewMyError(valueobject.InvalidCode, "Required post ID"))
+		helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.InvalidCode, "required_post_id"))
 		return
 	}
 
 	var req UpdatePostRequest
 	err := json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
-		helper.RespondWithError(w, valueobject.NewMyError(valueobject.InvalidCode, "Invalid request payload"))
+		helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.InvalidCode, "invalid_request_payload"))
 		return
 	}
 
-	validate := validator.New()
-	err = validate.Struct(req)
+	err = helper.ValidateStruct(req)
 	if err != nil {
-		for _, err := range err.(validator.ValidationErrors) {
-			myError := valueobject.NewMyError(valueobject.InvalidCode, err.Error())
-			helper.RespondWithError(w, myError)
-			return
-		}
+		helper.RespondWithError(w, r, err)
+		return
 	}
 
 	postID, err := valueobject.ParsePostID(id)
 	if err != nil {
-		helper.RespondWithError(w, valueobject.NewMyError(valueobject.InvalidCode, "Invalid post ID"))
+		helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.InvalidCode, "invalid_post_id"))
+		return
+	}
+
+	version, err := helper.ParseIfMatch(r)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	userID, err := parseUserIDFromContext(r)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
 		return
 	}
 
 	content, err := valueobject.NewPostContent(req.Content)
 	if err != nil {
-		helper.RespondWithError(w, valueobject.NewMyError(valueobject.InvalidCode, "Invalid content"))
+		helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.InvalidCode, "invalid_content"))
 		return
 	}
 
 	title, err := valueobject.NewPostTitle(req.Title)
 	if err != nil {
-		helper.RespondWithError(w, valueobject.NewMyError(valueobject.InvalidCode, "Invalid title"))
+		helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.InvalidCode, "invalid_title"))
 		return
 	}
 
@@ -256,22 +311,39 @@ func (pc *PostController) UpdatePost(w http.ResponseWriter, r *http.Request) {
 	for _, tag := range req.Tags {
 		tag, err := valueobject.NewTagName(tag)
 		if err != nil {
-			helper.RespondWithError(w, valueobject.NewMyError(valueobject.InvalidCode, "Invalid tag"))
+			helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.InvalidCode, "invalid_tag"))
 			return
 		}
 		inputTags = append(inputTags, tag)
 	}
 
+	inputPrimaryCategoryID, inputCategoryIDs, err := parseCategoryIDs(req.PrimaryCategoryID, req.CategoryIDs)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	seo, err := req.PostSEORequest.toInput()
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
 	input := &usecase.UpdatePostInput{
-		ID:      postID,
-		Title:   title,
-		Content: content,
-		Tags:    inputTags,
+		ID:                postID,
+		Version:           version,
+		UserID:            userID,
+		Title:             title,
+		Content:           content,
+		Tags:              inputTags,
+		PrimaryCategoryID: inputPrimaryCategoryID,
+		CategoryIDs:       inputCategoryIDs,
+		SEO:               seo,
 	}
 
 	output, err := pc.updatePostUsecase.Execute(r.Context(), input)
 	if err != nil {
-		helper.RespondWithError(w, err)
+		helper.RespondWithError(w, r, err)
 		return
 	}
 
@@ -283,69 +355,100 @@ func (pc *PostController) UpdatePost(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	res := UpdatePostResponse{
-		ID:               output.ID.String(),
-		Title:            output.Title.String(),
-		Content:          output.Content.String(),
-		Status:           output.Status.String(),
-		Tags:             tags,
-		FirstPublishedAt: output.FirstPublishedAt,
-		ContentUpdatedAt: output.ContentUpdatedAt,
-	}
+	primaryCategoryID, categoryIDs := formatCategoryIDs(output.PrimaryCategoryID, output.CategoryIDs)
 
+	res := UpdatePostResponse{
+		ID:                output.ID.String(),
+		Title:             output.Title.String(),
+		Content:           output.Content.String(),
+		Status:            output.Status.String(),
+		Tags:              tags,
+		PrimaryCategoryID: primaryCategoryID,
+		CategoryIDs:       categoryIDs,
+		FirstPublishedAt:  output.FirstPublishedAt,
+		ContentUpdatedAt:  output.ContentUpdatedAt,
+		PostSEOResponse:   toPostSEOResponse(output.SEO),
+		Version:           output.Version,
+	}
+
+	helper.SetETag(w, output.Version)
 	helper.RespondWithJSON(w, http.StatusOK, res)
 }
 
 type PatchPostRequest struct {
-	Title   string   `json:"title" validate:"omitempty,min=1"`
-	Content string   `json:"content" validate:"omitempty,min=1"`
-	Tags    []string `json:"tags"`
-	Status  string   `json:"status" validate:"omitempty,oneof=draft published private deleted"`
+	Title   string `json:"title" validate:"omitempty,min=1"`
+	Content string `json:"content" validate:"omitempty,min=1"`
+	Status  string `json:"status" validate:"omitempty,oneof=draft in_review approved published private deleted"`
+	// tags はタグの置き換え（空配列ですべて外す）。add_tags / remove_tags とは同時に指定できない
+	Tags       *[]string `json:"tags"`
+	AddTags    []string  `json:"add_tags"`
+	RemoveTags []string  `json:"remove_tags"`
+	// 未指定（null）の場合は現在のカテゴリを維持する
+	PrimaryCategoryID *string   `json:"primary_category_id"`
+	CategoryIDs       *[]string `json:"category_ids"`
+	// SEO項目は未指定（null）の場合は変更せず、空文字の場合は未設定に戻す
+	Excerpt         *string `json:"excerpt"`
+	MetaDescription *string `json:"meta_description"`
+	CanonicalURL    *string `json:"canonical_url"`
+	OGImageID       *string `json:"og_image_id"`
 }
 
 type PatchPostResponse struct {
-	ID               string     `json:"id"`
-	Title            string     `json:"title"`
-	Content          string     `json:"content"`
-	Status           string     `json:"status"`
-	Tags             []string   `json:"tags"`
-	FirstPublishedAt *time.Time `json:"first_published_at"`
-	ContentUpdatedAt *time.Time `json:"content_updated_at"`
+	ID                string     `json:"id"`
+	Title             string     `json:"title"`
+	Content           string     `json:"content"`
+	Status            string     `json:"status"`
+	Tags              []string   `json:"tags"`
+	PrimaryCategoryID *string    `json:"primary_category_id"`
+	CategoryIDs       []string   `json:"category_ids"`
+	FirstPublishedAt  *time.Time `json:"first_published_at"`
+	ContentUpdatedAt  *time.Time `json:"content_updated_at"`
+	Version           int        `json:"version"`
+	PostSEOResponse
 }
 
 func (pc *PostController) PatchPost(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	id, exists := vars["id"]
 	if !exists {
-		helper.RespondWithError(w, valueobject.NewMyError(valueobject.InvalidCode, "Required post ID"))
+		helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.InvalidCode, "required_post_id"))
 		return
 	}
 
 	postID, err := valueobject.ParsePostID(id)
 	if err != nil {
-		helper.RespondWithError(w, valueobject.NewMyError(valueobject.InvalidCode, "Invalid post ID"))
+		helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.InvalidCode, "invalid_post_id"))
+		return
+	}
+
+	version, err := helper.ParseIfMatch(r)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	userID, err := parseUserIDFromContext(r)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
 		return
 	}
 
 	var req PatchPostRequest
 	err = json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
-		helper.RespondWithError(w, valueobject.NewMyError(valueobject.InvalidCode, "Invalid request payload"))
+		helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.InvalidCode, "invalid_request_payload"))
 		return
 	}
 
-	validate := validator.New()
-	err = validate.Struct(req)
+	err = helper.ValidateStruct(req)
 	if err != nil {
-		for _, err := range err.(validator.ValidationErrors) {
-			myError := valueobject.NewMyError(valueobject.InvalidCode, err.Error())
-			helper.RespondWithError(w, myError)
-			return
-		}
+		helper.RespondWithError(w, r, err)
+		return
 	}
 
-	if req.Title == "" && req.Content == "" && req.Status == "" && len(req.Tags) == 0 {
-		helper.RespondWithError(w, valueobject.NewMyError(valueobject.InvalidCode, "No update fields"))
+	if req.Title == "" && req.Content == "" && req.Status == "" && req.Tags == nil && len(req.AddTags) == 0 && len(req.RemoveTags) == 0 && req.PrimaryCategoryID == nil && req.CategoryIDs == nil &&
+		req.Excerpt == nil && req.MetaDescription == nil && req.CanonicalURL == nil && req.OGImageID == nil {
+		helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.InvalidCode, "no_update_fields"))
 		return
 	}
 
@@ -353,7 +456,7 @@ func (pc *PostController) PatchPost(w http.ResponseWriter, r *http.Request) {
 	if req.Title != "" {
 		t, err := valueobject.NewPostTitle(req.Title)
 		if err != nil {
-			helper.RespondWithError(w, valueobject.NewMyError(valueobject.InvalidCode, "Invalid title"))
+			helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.InvalidCode, "invalid_title"))
 			return
 		}
 		title = &t
@@ -363,7 +466,7 @@ func (pc *PostController) PatchPost(w http.ResponseWriter, r *http.Request) {
 	if req.Content != "" {
 		c, err := valueobject.NewPostContent(req.Content)
 		if err != nil {
-			helper.RespondWithError(w, valueobject.NewMyError(valueobject.InvalidCode, "Invalid content"))
+			helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.InvalidCode, "invalid_content"))
 			return
 		}
 		content = &c
@@ -373,37 +476,114 @@ func (pc *PostController) PatchPost(w http.ResponseWriter, r *http.Request) {
 	if req.Status != "" {
 		s, err := valueobject.NewPostStatus(req.Status)
 		if err != nil {
-			helper.RespondWithError(w, valueobject.NewMyError(valueobject.InvalidCode, "Invalid status"))
+			helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.InvalidCode, "invalid_status"))
 			return
 		}
 		status = &s
 	}
 
 	var inputTags []valueobject.TagName
-	if len(req.Tags) > 0 {
-		tags := make([]valueobject.TagName, 0, len(req.Tags))
-		for _, tag := range req.Tags {
-			t, err := valueobject.NewTagName(tag)
+	if req.Tags != nil {
+		// 空配列指定ですべてのタグを外せるよう、nilと区別する
+		inputTags, err = parseTagNames(*req.Tags)
+		if err != nil {
+			helper.RespondWithError(w, r, err)
+			return
+		}
+		if inputTags == nil {
+			inputTags = []valueobject.TagName{}
+		}
+	}
+
+	addTags, err := parseTagNames(req.AddTags)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	removeTags, err := parseTagNames(req.RemoveTags)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	var reqCategoryIDs []string
+	if req.CategoryIDs != nil {
+		// 空配列指定で副カテゴリを解除できるよう、nilと区別する
+		reqCategoryIDs = append([]string{}, *req.CategoryIDs...)
+	}
+	inputPrimaryCategoryID, inputCategoryIDs, err := parseCategoryIDs(req.PrimaryCategoryID, reqCategoryIDs)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	var excerpt *valueobject.PostExcerpt
+	if req.Excerpt != nil {
+		e, err := valueobject.NewPostExcerpt(*req.Excerpt)
+		if err != nil {
+			helper.RespondWithError(w, r, err)
+			return
+		}
+		excerpt = &e
+	}
+
+	var metaDescription *valueobject.MetaDescription
+	if req.MetaDescription != nil {
+		m, err := valueobject.NewMetaDescription(*req.MetaDescription)
+		if err != nil {
+			helper.RespondWithError(w, r, err)
+			return
+		}
+		metaDescription = &m
+	}
+
+	var canonicalURL *valueobject.CanonicalURL
+	if req.CanonicalURL != nil {
+		c, err := valueobject.NewCanonicalURL(*req.CanonicalURL)
+		if err != nil {
+			helper.RespondWithError(w, r, err)
+			return
+		}
+		canonicalURL = &c
+	}
+
+	var ogImageID *valueobject.ImageID
+	if req.OGImageID != nil {
+		// 空文字はOGP画像を外す指定として空のIDのまま渡す
+		i := valueobject.ImageID("")
+		if *req.OGImageID != "" {
+			i, err = valueobject.ParseImageID(*req.OGImageID)
 			if err != nil {
-				helper.RespondWithError(w, valueobject.NewMyError(valueobject.InvalidCode, "Invalid tag"))
+				helper.RespondWithError(w, r, err)
 				return
 			}
-			tags = append(tags, t)
 		}
-		inputTags = tags
+		ogImageID = &i
 	}
 
 	input := &usecase.PatchPostInput{
-		ID:      postID,
-		Title:   title,
-		Content: content,
-		Status:  status,
-		Tags:    inputTags,
+		ID:                postID,
+		Version:           version,
+		UserID:            userID,
+		Roles:             domaincontext.GetUserRoles(r.Context()),
+		Title:             title,
+		Content:           content,
+		Status:            status,
+		Tags:              inputTags,
+		AddTags:           addTags,
+		RemoveTags:        removeTags,
+		PrimaryCategoryID: inputPrimaryCategoryID,
+		CategoryIDs:       inputCategoryIDs,
+		Excerpt:           excerpt,
+		MetaDescription:   metaDescription,
+		CanonicalURL:      canonicalURL,
+		OGImageID:         ogImageID,
 	}
 
 	output, err := pc.patchPostUsecase.Execute(r.Context(), input)
 	if err != nil {
-		helper.RespondWithError(w, err)
+		helper.RespondWithError(w, r, err)
 		return
 	}
 
@@ -415,17 +595,224 @@ func (pc *PostController) PatchPost(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	primaryCategoryID, categoryIDs := formatCategoryIDs(output.PrimaryCategoryID, output.CategoryIDs)
+
 	res := PatchPostResponse{
-		ID:               output.ID.String(),
-		Title:            output.Title.String(),
-		Content:          output.Content.String(),
-		Status:           output.Status.String(),
-		Tags:             outputTags,
-		FirstPublishedAt: output.FirstPublishedAt,
-		ContentUpdatedAt: output.ContentUpdatedAt,
+		ID:                output.ID.String(),
+		Title:             output.Title.String(),
+		Content:           output.Content.String(),
+		Status:            output.Status.String(),
+		Tags:              outputTags,
+		PrimaryCategoryID: primaryCategoryID,
+		CategoryIDs:       categoryIDs,
+		FirstPublishedAt:  output.FirstPublishedAt,
+		ContentUpdatedAt:  output.ContentUpdatedAt,
+		PostSEOResponse:   toPostSEOResponse(output.SEO),
+		Version:           output.Version,
+	}
+
+	helper.SetETag(w, output.Version)
+	helper.RespondWithJSON(w, http.StatusOK, res)
+}
+
+type BulkUpdatePostsRequest struct {
+	PostIDs   []string `json:"post_ids" validate:"required,min=1"`
+	Operation string   `json:"operation" validate:"required,oneof=set_status add_tags remove_tags delete restore"`
+	// operation が set_status の場合に指定する
+	Status string `json:"status" validate:"omitempty,oneof=draft in_review approved published private deleted"`
+	// operation が add_tags / remove_tags の場合に指定する
+	Tags []string `json:"tags"`
+	// true の場合は1件でも失敗したらどの投稿も変更しない
+	AllOrNothing bool `json:"all_or_nothing"`
+}
+
+type BulkUpdatePostsResponse struct {
+	Results   []BulkPostResultResponse `json:"results"`
+	Succeeded int                      `json:"succeeded"`
+	Failed    int                      `json:"failed"`
+}
+
+type BulkPostResultResponse struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+	// 成功時のみ設定する
+	Version *int `json:"version,omitempty"`
+	// 失敗時のみ設定する
+	Error *helper.ErrorResponse `json:"error,omitempty"`
+}
+
+func (pc *PostController) BulkUpdatePosts(w http.ResponseWriter, r *http.Request) {
+	userID, err := parseUserIDFromContext(r)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
 	}
 
-	helper.RespondWithJSON(w, http.StatusOK, res)
+	var req BulkUpdatePostsRequest
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.InvalidCode, "invalid_request_payload"))
+		return
+	}
+
+	err = helper.ValidateStruct(req)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	postIDs := make([]valueobject.PostID, 0, len(req.PostIDs))
+	for _, id := range req.PostIDs {
+		postID, err := valueobject.ParsePostID(id)
+		if err != nil {
+			helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.InvalidCode, "invalid_post_id"))
+			return
+		}
+		postIDs = append(postIDs, postID)
+	}
+
+	operation, err := valueobject.NewBulkPostOperation(req.Operation)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	var status *valueobject.PostStatus
+	if req.Status != "" {
+		s, err := valueobject.NewPostStatus(req.Status)
+		if err != nil {
+			helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.InvalidCode, "invalid_status"))
+			return
+		}
+		status = &s
+	}
+
+	tags, err := parseTagNames(req.Tags)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	input := &usecase.BulkUpdatePostsInput{
+		PostIDs:      postIDs,
+		Operation:    operation,
+		Status:       status,
+		Tags:         tags,
+		AllOrNothing: req.AllOrNothing,
+		UserID:       userID,
+		Roles:        domaincontext.GetUserRoles(r.Context()),
+	}
+
+	output, err := pc.bulkUpdatePostsUsecase.Execute(r.Context(), input)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	lang := helper.NegotiateLanguage(w, r)
+	results := make([]BulkPostResultResponse, 0, len(output.Results))
+	for _, result := range output.Results {
+		res := BulkPostResultResponse{
+			ID:     result.ID.String(),
+			Status: string(result.Status),
+		}
+		if result.Error != nil {
+			res.Error = helper.LocalizeError(result.Error, lang)
+		}
+		if result.Status == usecase.BulkPostResultSuccess {
+			version := result.Version
+			res.Version = &version
+		}
+		results = append(results, res)
+	}
+
+	helper.RespondWithJSON(w, http.StatusOK, BulkUpdatePostsResponse{
+		Results:   results,
+		Succeeded: output.Succeeded,
+		Failed:    output.Failed,
+	})
+}
+
+// PostSEORequest は作成・置き換え時のSEO項目のリクエスト
+type PostSEORequest struct {
+	Excerpt         string  `json:"excerpt"`
+	MetaDescription string  `json:"meta_description"`
+	CanonicalURL    string  `json:"canonical_url"`
+	OGImageID       *string `json:"og_image_id"`
+}
+
+func (req PostSEORequest) toInput() (usecase.PostSEOInput, error) {
+	excerpt, err := valueobject.NewPostExcerpt(req.Excerpt)
+	if err != nil {
+		return usecase.PostSEOInput{}, err
+	}
+
+	metaDescription, err := valueobject.NewMetaDescription(req.MetaDescription)
+	if err != nil {
+		return usecase.PostSEOInput{}, err
+	}
+
+	canonicalURL, err := valueobject.NewCanonicalURL(req.CanonicalURL)
+	if err != nil {
+		return usecase.PostSEOInput{}, err
+	}
+
+	var ogImageID *valueobject.ImageID
+	if req.OGImageID != nil && *req.OGImageID != "" {
+		id, err := valueobject.ParseImageID(*req.OGImageID)
+		if err != nil {
+			return usecase.PostSEOInput{}, err
+		}
+		ogImageID = &id
+	}
+
+	return usecase.PostSEOInput{
+		Excerpt:         excerpt,
+		MetaDescription: metaDescription,
+		CanonicalURL:    canonicalURL,
+		OGImageID:       ogImageID,
+	}, nil
+}
+
+// PostSEOResponse は投稿のSEO項目のレスポンス
+type PostSEOResponse struct {
+	// 未設定の場合は本文から生成した抜粋
+	Excerpt         string               `json:"excerpt"`
+	MetaDescription string               `json:"meta_description"`
+	CanonicalURL    string               `json:"canonical_url"`
+	OGImage         *PostOGImageResponse `json:"og_image"`
+}
+
+type PostOGImageResponse struct {
+	ID  string `json:"id"`
+	URL string `json:"url"`
+}
+
+func toPostSEOResponse(output usecase.PostSEOOutput) PostSEOResponse {
+	res := PostSEOResponse{
+		Excerpt:         output.Excerpt.String(),
+		MetaDescription: output.MetaDescription.String(),
+		CanonicalURL:    output.CanonicalURL.String(),
+	}
+	if output.OGImage != nil {
+		res.OGImage = &PostOGImageResponse{
+			ID:  output.OGImage.ID.String(),
+			URL: output.OGImage.URL,
+		}
+	}
+	return res
+}
+
+func parseTagNames(tags []string) ([]valueobject.TagName, error) {
+	var tagNames []valueobject.TagName
+	for _, tag := range tags {
+		t, err := valueobject.NewTagName(tag)
+		if err != nil {
+			return nil, valueobject.NewMyError(valueobject.InvalidCode, "invalid_tag")
+		}
+		tagNames = append(tagNames, t)
+	}
+	return tagNames, nil
 }
 
 func (pc *PostController) ListPosts(w http.ResponseWriter, r *http.Request) {
@@ -433,16 +820,17 @@ func (pc *PostController) ListPosts(w http.ResponseWriter, r *http.Request) {
 	// クエリパラメータを取得
 	query := r.URL.Query()
 	req := &usecase.ListPostsRequest{
-		Limit:  query.Get("limit"),
-		Offset: query.Get("offset"),
-		Status: query.Get("status"),
-		Sort:   query.Get("sort"),
+		Limit:    query.Get("limit"),
+		Offset:   query.Get("offset"),
+		Status:   query.Get("status"),
+		Category: query.Get("category"),
+		Sort:     query.Get("sort"),
 	}
 
 	// ユースケース実行
 	response, err := pc.listPostsUsecase.Execute(r.Context(), req)
 	if err != nil {
-		helper.RespondWithError(w, err)
+		helper.RespondWithError(w, r, err)
 		return
 	}
 
@@ -0,0 +1,152 @@
+package controller
+
+import (
+	"net/http"
+	"time"
+
+	domaincontext "github.com/MizukiShigi/cms-go/internal/domain/context"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	"github.com/MizukiShigi/cms-go/internal/presentation/helper"
+	"github.com/MizukiShigi/cms-go/internal/usecase"
+
+	"github.com/gorilla/mux"
+)
+
+type PostLockController struct {
+	acquirePostLockUsecase      *usecase.AcquirePostLockUsecase
+	heartbeatPostLockUsecase    *usecase.HeartbeatPostLockUsecase
+	releasePostLockUsecase      *usecase.ReleasePostLockUsecase
+	forceReleasePostLockUsecase *usecase.ForceReleasePostLockUsecase
+}
+
+func NewPostLockController(acquirePostLockUsecase *usecase.AcquirePostLockUsecase, heartbeatPostLockUsecase *usecase.HeartbeatPostLockUsecase, releasePostLockUsecase *usecase.ReleasePostLockUsecase, forceReleasePostLockUsecase *usecase.ForceReleasePostLockUsecase) *PostLockController {
+	return &PostLockController{
+		acquirePostLockUsecase:      acquirePostLockUsecase,
+		heartbeatPostLockUsecase:    heartbeatPostLockUsecase,
+		releasePostLockUsecase:      releasePostLockUsecase,
+		forceReleasePostLockUsecase: forceReleasePostLockUsecase,
+	}
+}
+
+type PostLockResponse struct {
+	PostID     string    `json:"post_id"`
+	UserID     string    `json:"user_id"`
+	AcquiredAt time.Time `json:"acquired_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+func (pc *PostLockController) AcquirePostLock(w http.ResponseWriter, r *http.Request) {
+	postID, err := parsePostIDFromPath(r)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	userID, err := parseUserIDFromContext(r)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	output, err := pc.acquirePostLockUsecase.Execute(r.Context(), &usecase.AcquirePostLockInput{PostID: postID, UserID: userID})
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	helper.RespondWithJSON(w, http.StatusOK, toPostLockResponse(output))
+}
+
+func (pc *PostLockController) HeartbeatPostLock(w http.ResponseWriter, r *http.Request) {
+	postID, err := parsePostIDFromPath(r)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	userID, err := parseUserIDFromContext(r)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	output, err := pc.heartbeatPostLockUsecase.Execute(r.Context(), &usecase.HeartbeatPostLockInput{PostID: postID, UserID: userID})
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	helper.RespondWithJSON(w, http.StatusOK, toPostLockResponse(output))
+}
+
+func (pc *PostLockController) ReleasePostLock(w http.ResponseWriter, r *http.Request) {
+	postID, err := parsePostIDFromPath(r)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	userID, err := parseUserIDFromContext(r)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	if err := pc.releasePostLockUsecase.Execute(r.Context(), &usecase.ReleasePostLockInput{PostID: postID, UserID: userID}); err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ForceReleasePostLock は管理者が他のユーザーのロックを解放する（ルーティングで管理者ロールを必須にする）
+func (pc *PostLockController) ForceReleasePostLock(w http.ResponseWriter, r *http.Request) {
+	postID, err := parsePostIDFromPath(r)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	if err := pc.forceReleasePostLockUsecase.Execute(r.Context(), &usecase.ForceReleasePostLockInput{PostID: postID}); err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func toPostLockResponse(output *usecase.PostLockOutput) *PostLockResponse {
+	if output == nil {
+		return nil
+	}
+	return &PostLockResponse{
+		PostID:     output.PostID.String(),
+		UserID:     output.UserID.String(),
+		AcquiredAt: output.AcquiredAt,
+		ExpiresAt:  output.ExpiresAt,
+	}
+}
+
+func parsePostIDFromPath(r *http.Request) (valueobject.PostID, error) {
+	vars := mux.Vars(r)
+	id, exists := vars["id"]
+	if !exists {
+		return "", valueobject.NewMyError(valueobject.InvalidCode, "required_post_id")
+	}
+
+	return valueobject.ParsePostID(id)
+}
+
+func parseUserIDFromContext(r *http.Request) (valueobject.UserID, error) {
+	ctxUserID, err := domaincontext.GetUserID(r.Context())
+	if err != nil {
+		return "", err
+	}
+
+	userID, err := valueobject.ParseUserID(ctxUserID)
+	if err != nil {
+		return "", valueobject.NewMyError(valueobject.InvalidCode, "invalid_user_id")
+	}
+	return userID, nil
+}
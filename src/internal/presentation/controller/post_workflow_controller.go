@@ -0,0 +1,244 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	domaincontext "github.com/MizukiShigi/cms-go/internal/domain/context"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	"github.com/MizukiShigi/cms-go/internal/presentation/helper"
+	"github.com/MizukiShigi/cms-go/internal/usecase"
+)
+
+type PostWorkflowController struct {
+	submitPostForReviewUsecase       *usecase.SubmitPostForReviewUsecase
+	reviewPostUsecase                *usecase.ReviewPostUsecase
+	listPostWorkflowHistoriesUsecase *usecase.ListPostWorkflowHistoriesUsecase
+	listPostTransitionsUsecase       *usecase.ListPostTransitionsUsecase
+}
+
+func NewPostWorkflowController(submitPostForReviewUsecase *usecase.SubmitPostForReviewUsecase, reviewPostUsecase *usecase.ReviewPostUsecase, listPostWorkflowHistoriesUsecase *usecase.ListPostWorkflowHistoriesUsecase, listPostTransitionsUsecase *usecase.ListPostTransitionsUsecase) *PostWorkflowController {
+	return &PostWorkflowController{
+		submitPostForReviewUsecase:       submitPostForReviewUsecase,
+		reviewPostUsecase:                reviewPostUsecase,
+		listPostWorkflowHistoriesUsecase: listPostWorkflowHistoriesUsecase,
+		listPostTransitionsUsecase:       listPostTransitionsUsecase,
+	}
+}
+
+type SubmitPostForReviewRequest struct {
+	ReviewerID string `json:"reviewer_id" validate:"required,uuid"`
+	Comment    string `json:"comment" validate:"max=1000"`
+}
+
+type ReviewPostRequest struct {
+	Decision string `json:"decision" validate:"required,oneof=approve request_changes"`
+	Comment  string `json:"comment" validate:"max=1000"`
+}
+
+type PostWorkflowResponse struct {
+	ID         string  `json:"id"`
+	Status     string  `json:"status"`
+	ReviewerID *string `json:"reviewer_id"`
+	Version    int     `json:"version"`
+}
+
+type PostWorkflowHistoryResponse struct {
+	FromStatus string    `json:"from_status"`
+	ToStatus   string    `json:"to_status"`
+	ActorID    string    `json:"actor_id"`
+	Comment    string    `json:"comment"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type ListPostWorkflowHistoriesResponse struct {
+	Histories []*PostWorkflowHistoryResponse `json:"histories"`
+}
+
+type PostTransitionResponse struct {
+	Name string `json:"name"`
+	To   string `json:"to"`
+}
+
+type ListPostTransitionsResponse struct {
+	Status      string                    `json:"status"`
+	Transitions []*PostTransitionResponse `json:"transitions"`
+}
+
+func (pc *PostWorkflowController) SubmitPostForReview(w http.ResponseWriter, r *http.Request) {
+	postID, err := parsePostIDFromPath(r)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	userID, err := parseUserIDFromContext(r)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	var req SubmitPostForReviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.InvalidCode, "invalid_request_payload"))
+		return
+	}
+
+	err = helper.ValidateStruct(req)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	reviewerID, err := valueobject.ParseUserID(req.ReviewerID)
+	if err != nil {
+		helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.InvalidCode, "invalid_reviewer_id"))
+		return
+	}
+
+	output, err := pc.submitPostForReviewUsecase.Execute(r.Context(), &usecase.SubmitPostForReviewInput{
+		ID:         postID,
+		UserID:     userID,
+		Roles:      domaincontext.GetUserRoles(r.Context()),
+		ReviewerID: reviewerID,
+		Comment:    req.Comment,
+	})
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	helper.SetETag(w, output.Version)
+	helper.RespondWithJSON(w, http.StatusOK, toPostWorkflowResponse(output))
+}
+
+func (pc *PostWorkflowController) ReviewPost(w http.ResponseWriter, r *http.Request) {
+	postID, err := parsePostIDFromPath(r)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	userID, err := parseUserIDFromContext(r)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	var req ReviewPostRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.InvalidCode, "invalid_request_payload"))
+		return
+	}
+
+	err = helper.ValidateStruct(req)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	decision, err := valueobject.NewReviewDecision(req.Decision)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	output, err := pc.reviewPostUsecase.Execute(r.Context(), &usecase.ReviewPostInput{
+		ID:       postID,
+		UserID:   userID,
+		Roles:    domaincontext.GetUserRoles(r.Context()),
+		Decision: decision,
+		Comment:  req.Comment,
+	})
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	helper.SetETag(w, output.Version)
+	helper.RespondWithJSON(w, http.StatusOK, toPostWorkflowResponse(output))
+}
+
+func (pc *PostWorkflowController) ListPostWorkflowHistories(w http.ResponseWriter, r *http.Request) {
+	postID, err := parsePostIDFromPath(r)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	outputs, err := pc.listPostWorkflowHistoriesUsecase.Execute(r.Context(), &usecase.ListPostWorkflowHistoriesInput{PostID: postID})
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	histories := make([]*PostWorkflowHistoryResponse, 0, len(outputs))
+	for _, output := range outputs {
+		histories = append(histories, &PostWorkflowHistoryResponse{
+			FromStatus: output.FromStatus.String(),
+			ToStatus:   output.ToStatus.String(),
+			ActorID:    output.ActorID.String(),
+			Comment:    output.Comment,
+			CreatedAt:  output.CreatedAt,
+		})
+	}
+
+	helper.RespondWithJSON(w, http.StatusOK, &ListPostWorkflowHistoriesResponse{Histories: histories})
+}
+
+// ListPostTransitions は認証ユーザーが実行できるステータス遷移を返す（UIのボタン表示用）
+func (pc *PostWorkflowController) ListPostTransitions(w http.ResponseWriter, r *http.Request) {
+	postID, err := parsePostIDFromPath(r)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	userID, err := parseUserIDFromContext(r)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	output, err := pc.listPostTransitionsUsecase.Execute(r.Context(), &usecase.ListPostTransitionsInput{
+		PostID: postID,
+		UserID: userID,
+		Roles:  domaincontext.GetUserRoles(r.Context()),
+	})
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	transitions := make([]*PostTransitionResponse, 0, len(output.Transitions))
+	for _, transition := range output.Transitions {
+		transitions = append(transitions, &PostTransitionResponse{
+			Name: transition.Name,
+			To:   transition.To.String(),
+		})
+	}
+
+	helper.RespondWithJSON(w, http.StatusOK, &ListPostTransitionsResponse{
+		Status:      output.Status.String(),
+		Transitions: transitions,
+	})
+}
+
+func toPostWorkflowResponse(output *usecase.PostWorkflowOutput) *PostWorkflowResponse {
+	return &PostWorkflowResponse{
+		ID:         output.ID.String(),
+		Status:     output.Status.String(),
+		ReviewerID: formatUserID(output.ReviewerID),
+		Version:    output.Version,
+	}
+}
+
+// formatUserID は任意のユーザーIDをレスポンス用の文字列に変換する
+func formatUserID(userID *valueobject.UserID) *string {
+	if userID == nil {
+		return nil
+	}
+	s := userID.String()
+	return &s
+}
@@ -0,0 +1,63 @@
+package controller
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	"github.com/MizukiShigi/cms-go/internal/presentation/helper"
+	"github.com/MizukiShigi/cms-go/internal/usecase"
+
+	"github.com/gorilla/mux"
+)
+
+type SitemapController struct {
+	getSitemapUsecase *usecase.GetSitemapUsecase
+}
+
+func NewSitemapController(getSitemapUsecase *usecase.GetSitemapUsecase) *SitemapController {
+	return &SitemapController{
+		getSitemapUsecase: getSitemapUsecase,
+	}
+}
+
+func (sc *SitemapController) GetSitemap(w http.ResponseWriter, r *http.Request) {
+	sc.respondSitemap(w, r, 0)
+}
+
+func (sc *SitemapController) GetSitemapPage(w http.ResponseWriter, r *http.Request) {
+	page, err := strconv.Atoi(mux.Vars(r)["page"])
+	if err != nil || page < 1 {
+		helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.NotFoundCode, "sitemap_not_found"))
+		return
+	}
+
+	sc.respondSitemap(w, r, page)
+}
+
+func (sc *SitemapController) respondSitemap(w http.ResponseWriter, r *http.Request, page int) {
+	output, err := sc.getSitemapUsecase.Execute(r.Context(), &usecase.GetSitemapInput{Page: page})
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	body, err := helper.EncodeSitemap(output)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	sum := sha256.Sum256(body)
+	etag := `W/"` + hex.EncodeToString(sum[:16]) + `"`
+	w.Header().Set("Cache-Control", "public, max-age=3600")
+	if helper.RespondNotModifiedIfFresh(w, r, etag, output.LastModified) {
+		return
+	}
+
+	w.Header().Set("Content-Type", helper.SitemapContentType)
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
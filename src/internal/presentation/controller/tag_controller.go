@@ -0,0 +1,165 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	"github.com/MizukiShigi/cms-go/internal/presentation/helper"
+	"github.com/MizukiShigi/cms-go/internal/usecase"
+
+	"github.com/gorilla/mux"
+)
+
+type TagController struct {
+	listTagsUsecase         *usecase.ListTagsUsecase
+	renameTagUsecase        *usecase.RenameTagUsecase
+	mergeTagsUsecase        *usecase.MergeTagsUsecase
+	deleteUnusedTagsUsecase *usecase.DeleteUnusedTagsUsecase
+}
+
+func NewTagController(listTagsUsecase *usecase.ListTagsUsecase, renameTagUsecase *usecase.RenameTagUsecase, mergeTagsUsecase *usecase.MergeTagsUsecase, deleteUnusedTagsUsecase *usecase.DeleteUnusedTagsUsecase) *TagController {
+	return &TagController{
+		listTagsUsecase:         listTagsUsecase,
+		renameTagUsecase:        renameTagUsecase,
+		mergeTagsUsecase:        mergeTagsUsecase,
+		deleteUnusedTagsUsecase: deleteUnusedTagsUsecase,
+	}
+}
+
+func (tc *TagController) ListTags(w http.ResponseWriter, r *http.Request) {
+	// クエリパラメータを取得
+	query := r.URL.Query()
+	req := &usecase.ListTagsRequest{
+		Limit:  query.Get("limit"),
+		Offset: query.Get("offset"),
+		Prefix: query.Get("prefix"),
+		Sort:   query.Get("sort"),
+	}
+
+	response, err := tc.listTagsUsecase.Execute(r.Context(), req)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	helper.RespondWithJSON(w, http.StatusOK, response)
+}
+
+type RenameTagRequest struct {
+	Name string `json:"name" validate:"required"`
+}
+
+type TagResponse struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func (tc *TagController) RenameTag(w http.ResponseWriter, r *http.Request) {
+	tagID, err := parseTagIDFromPath(r)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	var req RenameTagRequest
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.InvalidCode, "invalid_request_payload"))
+		return
+	}
+
+	err = helper.ValidateStruct(req)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	name, err := valueobject.NewTagName(req.Name)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	output, err := tc.renameTagUsecase.Execute(r.Context(), &usecase.RenameTagInput{ID: tagID, Name: name})
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	helper.RespondWithJSON(w, http.StatusOK, TagResponse{
+		ID:   output.ID.String(),
+		Name: output.Name.String(),
+	})
+}
+
+type MergeTagRequest struct {
+	TargetID string `json:"target_id" validate:"required,uuid"`
+}
+
+func (tc *TagController) MergeTag(w http.ResponseWriter, r *http.Request) {
+	sourceID, err := parseTagIDFromPath(r)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	var req MergeTagRequest
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.InvalidCode, "invalid_request_payload"))
+		return
+	}
+
+	err = helper.ValidateStruct(req)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	targetID, err := valueobject.ParseTagID(req.TargetID)
+	if err != nil {
+		helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.InvalidCode, "invalid_target_tag_id"))
+		return
+	}
+
+	output, err := tc.mergeTagsUsecase.Execute(r.Context(), &usecase.MergeTagsInput{SourceID: sourceID, TargetID: targetID})
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	helper.RespondWithJSON(w, http.StatusOK, TagResponse{
+		ID:   output.ID.String(),
+		Name: output.Name.String(),
+	})
+}
+
+type DeleteUnusedTagsResponse struct {
+	DeletedCount int `json:"deleted_count"`
+}
+
+func (tc *TagController) DeleteUnusedTags(w http.ResponseWriter, r *http.Request) {
+	output, err := tc.deleteUnusedTagsUsecase.Execute(r.Context())
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	helper.RespondWithJSON(w, http.StatusOK, DeleteUnusedTagsResponse{DeletedCount: output.DeletedCount})
+}
+
+func parseTagIDFromPath(r *http.Request) (valueobject.TagID, error) {
+	vars := mux.Vars(r)
+	id, exists := vars["id"]
+	if !exists {
+		return "", valueobject.NewMyError(valueobject.InvalidCode, "required_tag_id")
+	}
+
+	tagID, err := valueobject.ParseTagID(id)
+	if err != nil {
+		return "", valueobject.NewMyError(valueobject.InvalidCode, "invalid_tag_id")
+	}
+
+	return tagID, nil
+}
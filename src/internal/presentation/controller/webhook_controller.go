@@ -0,0 +1,234 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	"github.com/MizukiShigi/cms-go/internal/presentation/helper"
+	"github.com/MizukiShigi/cms-go/internal/usecase"
+
+	"github.com/gorilla/mux"
+)
+
+type WebhookController struct {
+	createWebhookSubscriptionUsecase *usecase.CreateWebhookSubscriptionUsecase
+	listWebhookSubscriptionsUsecase  *usecase.ListWebhookSubscriptionsUsecase
+	deleteWebhookSubscriptionUsecase *usecase.DeleteWebhookSubscriptionUsecase
+	listWebhookDeliveriesUsecase     *usecase.ListWebhookDeliveriesUsecase
+	redeliverWebhookUsecase          *usecase.RedeliverWebhookUsecase
+}
+
+func NewWebhookController(
+	createWebhookSubscriptionUsecase *usecase.CreateWebhookSubscriptionUsecase,
+	listWebhookSubscriptionsUsecase *usecase.ListWebhookSubscriptionsUsecase,
+	deleteWebhookSubscriptionUsecase *usecase.DeleteWebhookSubscriptionUsecase,
+	listWebhookDeliveriesUsecase *usecase.ListWebhookDeliveriesUsecase,
+	redeliverWebhookUsecase *usecase.RedeliverWebhookUsecase,
+) *WebhookController {
+	return &WebhookController{
+		createWebhookSubscriptionUsecase: createWebhookSubscriptionUsecase,
+		listWebhookSubscriptionsUsecase:  listWebhookSubscriptionsUsecase,
+		deleteWebhookSubscriptionUsecase: deleteWebhookSubscriptionUsecase,
+		listWebhookDeliveriesUsecase:     listWebhookDeliveriesUsecase,
+		redeliverWebhookUsecase:          redeliverWebhookUsecase,
+	}
+}
+
+type CreateWebhookSubscriptionRequest struct {
+	URL        string   `json:"url"`
+	Secret     string   `json:"secret"`
+	EventTypes []string `json:"event_types"`
+}
+
+// WebhookSubscriptionResponse は購読の情報（署名用シークレットは返さない）
+type WebhookSubscriptionResponse struct {
+	ID         string    `json:"id"`
+	URL        string    `json:"url"`
+	EventTypes []string  `json:"event_types"`
+	Active     bool      `json:"active"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+type ListWebhookSubscriptionsResponse struct {
+	Subscriptions []WebhookSubscriptionResponse `json:"subscriptions"`
+}
+
+type WebhookDeliveryResponse struct {
+	ID             int64           `json:"id"`
+	SubscriptionID string          `json:"subscription_id"`
+	EventType      string          `json:"event_type"`
+	Payload        json.RawMessage `json:"payload"`
+	RequestID      string          `json:"request_id"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	NextAttemptAt  time.Time       `json:"next_attempt_at"`
+	LastStatusCode *int            `json:"last_status_code"`
+	LastError      string          `json:"last_error"`
+	DeliveredAt    *time.Time      `json:"delivered_at"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
+
+type ListWebhookDeliveriesResponse struct {
+	Deliveries []WebhookDeliveryResponse `json:"deliveries"`
+	Meta       *usecase.PaginationMeta   `json:"meta"`
+}
+
+func (wc *WebhookController) CreateWebhookSubscription(w http.ResponseWriter, r *http.Request) {
+	var req CreateWebhookSubscriptionRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.InvalidCode, "invalid_request_payload"))
+		return
+	}
+
+	eventTypes := make([]valueobject.WebhookEventType, 0, len(req.EventTypes))
+	for _, et := range req.EventTypes {
+		eventType, err := valueobject.NewWebhookEventType(et)
+		if err != nil {
+			helper.RespondWithError(w, r, err)
+			return
+		}
+		eventTypes = append(eventTypes, eventType)
+	}
+
+	output, err := wc.createWebhookSubscriptionUsecase.Execute(r.Context(), &usecase.CreateWebhookSubscriptionInput{
+		URL:        req.URL,
+		Secret:     req.Secret,
+		EventTypes: eventTypes,
+	})
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	helper.RespondWithJSON(w, http.StatusCreated, toWebhookSubscriptionResponse(output.Subscription))
+}
+
+func (wc *WebhookController) ListWebhookSubscriptions(w http.ResponseWriter, r *http.Request) {
+	output, err := wc.listWebhookSubscriptionsUsecase.Execute(r.Context())
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	subscriptions := make([]WebhookSubscriptionResponse, 0, len(output.Subscriptions))
+	for _, subscription := range output.Subscriptions {
+		subscriptions = append(subscriptions, toWebhookSubscriptionResponse(subscription))
+	}
+
+	helper.RespondWithJSON(w, http.StatusOK, ListWebhookSubscriptionsResponse{Subscriptions: subscriptions})
+}
+
+func (wc *WebhookController) DeleteWebhookSubscription(w http.ResponseWriter, r *http.Request) {
+	subscriptionID, err := parseWebhookSubscriptionIDFromPath(r)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	err = wc.deleteWebhookSubscriptionUsecase.Execute(r.Context(), &usecase.DeleteWebhookSubscriptionInput{ID: subscriptionID})
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (wc *WebhookController) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+	subscriptionID, err := parseWebhookSubscriptionIDFromPath(r)
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	// 不正な値はユースケース側でデフォルト値に置き換える
+	query := r.URL.Query()
+	limit, _ := strconv.Atoi(query.Get("limit"))
+	offset, _ := strconv.Atoi(query.Get("offset"))
+
+	output, err := wc.listWebhookDeliveriesUsecase.Execute(r.Context(), &usecase.ListWebhookDeliveriesInput{
+		SubscriptionID: subscriptionID,
+		Limit:          limit,
+		Offset:         offset,
+	})
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	deliveries := make([]WebhookDeliveryResponse, 0, len(output.Deliveries))
+	for _, delivery := range output.Deliveries {
+		deliveries = append(deliveries, toWebhookDeliveryResponse(delivery))
+	}
+
+	helper.RespondWithJSON(w, http.StatusOK, ListWebhookDeliveriesResponse{
+		Deliveries: deliveries,
+		Meta:       output.Meta,
+	})
+}
+
+func (wc *WebhookController) RedeliverWebhook(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	deliveryID, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil || deliveryID <= 0 {
+		helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.InvalidCode, "invalid_webhook_delivery_id"))
+		return
+	}
+
+	output, err := wc.redeliverWebhookUsecase.Execute(r.Context(), &usecase.RedeliverWebhookInput{DeliveryID: deliveryID})
+	if err != nil {
+		helper.RespondWithError(w, r, err)
+		return
+	}
+
+	helper.RespondWithJSON(w, http.StatusAccepted, toWebhookDeliveryResponse(output.Delivery))
+}
+
+func toWebhookSubscriptionResponse(subscription *entity.WebhookSubscription) WebhookSubscriptionResponse {
+	eventTypes := make([]string, 0, len(subscription.EventTypes))
+	for _, eventType := range subscription.EventTypes {
+		eventTypes = append(eventTypes, eventType.String())
+	}
+
+	return WebhookSubscriptionResponse{
+		ID:         subscription.ID.String(),
+		URL:        subscription.URL,
+		EventTypes: eventTypes,
+		Active:     subscription.Active,
+		CreatedAt:  subscription.CreatedAt,
+		UpdatedAt:  subscription.UpdatedAt,
+	}
+}
+
+func toWebhookDeliveryResponse(delivery *entity.WebhookDelivery) WebhookDeliveryResponse {
+	return WebhookDeliveryResponse{
+		ID:             delivery.ID,
+		SubscriptionID: delivery.SubscriptionID.String(),
+		EventType:      delivery.EventType.String(),
+		Payload:        json.RawMessage(delivery.Payload),
+		RequestID:      delivery.RequestID,
+		Status:         delivery.Status.String(),
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		LastStatusCode: delivery.LastStatusCode,
+		LastError:      delivery.LastError,
+		DeliveredAt:    delivery.DeliveredAt,
+		CreatedAt:      delivery.CreatedAt,
+	}
+}
+
+func parseWebhookSubscriptionIDFromPath(r *http.Request) (valueobject.WebhookSubscriptionID, error) {
+	vars := mux.Vars(r)
+	id, exists := vars["id"]
+	if !exists {
+		return "", valueobject.NewMyError(valueobject.InvalidCode, "required_webhook_subscription_id")
+	}
+
+	return valueobject.ParseWebhookSubscriptionID(id)
+}
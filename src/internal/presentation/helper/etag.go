@@ -0,0 +1,69 @@
+package helper
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+// SetETag はリソースのバージョンをETagヘッダーに設定する
+func SetETag(w http.ResponseWriter, version int) {
+	w.Header().Set("ETag", strconv.Quote(strconv.Itoa(version)))
+}
+
+// ParseIfMatch はIf-Matchヘッダーからクライアントが取得したバージョンを取り出す
+func ParseIfMatch(r *http.Request) (int, error) {
+	value := strings.TrimSpace(r.Header.Get("If-Match"))
+	if value == "" {
+		return 0, valueobject.NewMyError(valueobject.InvalidCode, "if_match_header_is_required").
+			WithStatusCode(http.StatusPreconditionRequired)
+	}
+
+	// 弱いETagも同じバージョンとして扱う。"*" は更新前の状態を特定できないため受け付けない
+	value = strings.TrimPrefix(value, "W/")
+	unquoted, err := strconv.Unquote(value)
+	if err != nil {
+		return 0, valueobject.NewMyError(valueobject.InvalidCode, "invalid_if_match_header")
+	}
+
+	version, err := strconv.Atoi(unquoted)
+	if err != nil || version < 1 {
+		return 0, valueobject.NewMyError(valueobject.InvalidCode, "invalid_if_match_header")
+	}
+
+	return version, nil
+}
+
+// RespondNotModifiedIfFresh は If-None-Match / If-Modified-Since を評価し、
+// クライアントのキャッシュが有効な場合は 304 を返して true を返す
+func RespondNotModifiedIfFresh(w http.ResponseWriter, r *http.Request, etag string, lastModified time.Time) bool {
+	w.Header().Set("ETag", etag)
+	if !lastModified.IsZero() {
+		w.Header().Set("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+	}
+
+	// If-None-Match がある場合は If-Modified-Since より優先する（RFC 9110）
+	if ifNoneMatch := r.Header.Get("If-None-Match"); ifNoneMatch != "" {
+		for _, candidate := range strings.Split(ifNoneMatch, ",") {
+			candidate = strings.TrimSpace(candidate)
+			if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+				w.WriteHeader(http.StatusNotModified)
+				return true
+			}
+		}
+		return false
+	}
+
+	if ifModifiedSince := r.Header.Get("If-Modified-Since"); ifModifiedSince != "" && !lastModified.IsZero() {
+		since, err := http.ParseTime(ifModifiedSince)
+		if err == nil && !lastModified.Truncate(time.Second).After(since) {
+			w.WriteHeader(http.StatusNotModified)
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,230 @@
+package helper
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/usecase"
+)
+
+// フィードのContent-Type
+const (
+	RSSContentType      = "application/rss+xml; charset=utf-8"
+	AtomContentType     = "application/atom+xml; charset=utf-8"
+	JSONFeedContentType = "application/feed+json; charset=utf-8"
+)
+
+type rssDocument struct {
+	XMLName   xml.Name   `xml:"rss"`
+	Version   string     `xml:"version,attr"`
+	AtomXMLNS string     `xml:"xmlns:atom,attr"`
+	Channel   rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string      `xml:"title"`
+	Link          string      `xml:"link"`
+	Description   string      `xml:"description"`
+	AtomLink      rssAtomLink `xml:"atom:link"`
+	LastBuildDate string      `xml:"lastBuildDate,omitempty"`
+	Items         []rssItem   `xml:"item"`
+}
+
+type rssAtomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type rssItem struct {
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link"`
+	GUID        rssGUID  `xml:"guid"`
+	PubDate     string   `xml:"pubDate"`
+	Description string   `xml:"description"`
+	Categories  []string `xml:"category"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// EncodeRSS はフィードをRSS 2.0で出力する。文字列のエスケープはエンコーダーが行う
+func EncodeRSS(feed *usecase.Feed, selfURL string) ([]byte, error) {
+	doc := rssDocument{
+		Version:   "2.0",
+		AtomXMLNS: "http://www.w3.org/2005/Atom",
+		Channel: rssChannel{
+			Title:       feed.Title,
+			Link:        feed.SiteURL,
+			Description: feed.Title,
+			AtomLink:    rssAtomLink{Href: selfURL, Rel: "self", Type: "application/rss+xml"},
+			Items:       make([]rssItem, 0, len(feed.Items)),
+		},
+	}
+	if !feed.Updated.IsZero() {
+		doc.Channel.LastBuildDate = feed.Updated.UTC().Format(time.RFC1123Z)
+	}
+
+	for _, item := range feed.Items {
+		doc.Channel.Items = append(doc.Channel.Items, rssItem{
+			Title:       item.Title,
+			Link:        item.URL,
+			GUID:        rssGUID{IsPermaLink: true, Value: item.URL},
+			PubDate:     item.PublishedAt.UTC().Format(time.RFC1123Z),
+			Description: item.Content,
+			Categories:  item.Tags,
+		})
+	}
+
+	return encodeXML(doc)
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"feed"`
+	XMLNS   string      `xml:"xmlns,attr"`
+	Title   string      `xml:"title"`
+	ID      string      `xml:"id"`
+	Updated string      `xml:"updated"`
+	Links   []atomLink  `xml:"link"`
+	Author  atomAuthor  `xml:"author"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomEntry struct {
+	Title      atomText       `xml:"title"`
+	ID         string         `xml:"id"`
+	Link       atomLink       `xml:"link"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+	Summary    *atomText      `xml:"summary,omitempty"`
+	Content    atomText       `xml:"content"`
+	Categories []atomCategory `xml:"category"`
+}
+
+type atomText struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+// EncodeAtom はフィードをAtom 1.0で出力する
+func EncodeAtom(feed *usecase.Feed, selfURL string) ([]byte, error) {
+	doc := atomFeed{
+		XMLNS:   "http://www.w3.org/2005/Atom",
+		Title:   feed.Title,
+		ID:      selfURL,
+		Updated: formatFeedTime(feed.Updated),
+		Links: []atomLink{
+			{Href: selfURL, Rel: "self", Type: "application/atom+xml"},
+			{Href: feed.SiteURL, Rel: "alternate", Type: "text/html"},
+		},
+		Author:  atomAuthor{Name: feed.Title},
+		Entries: make([]atomEntry, 0, len(feed.Items)),
+	}
+
+	for _, item := range feed.Items {
+		categories := make([]atomCategory, 0, len(item.Tags))
+		for _, tag := range item.Tags {
+			categories = append(categories, atomCategory{Term: tag})
+		}
+
+		var summary *atomText
+		if item.Summary != "" {
+			summary = &atomText{Type: "text", Value: item.Summary}
+		}
+
+		doc.Entries = append(doc.Entries, atomEntry{
+			Title:      atomText{Type: "text", Value: item.Title},
+			ID:         item.URL,
+			Link:       atomLink{Href: item.URL, Rel: "alternate", Type: "text/html"},
+			Published:  formatFeedTime(item.PublishedAt),
+			Updated:    formatFeedTime(item.UpdatedAt),
+			Summary:    summary,
+			Content:    atomText{Type: "text", Value: item.Content},
+			Categories: categories,
+		})
+	}
+
+	return encodeXML(doc)
+}
+
+type jsonFeed struct {
+	Version     string         `json:"version"`
+	Title       string         `json:"title"`
+	HomePageURL string         `json:"home_page_url"`
+	FeedURL     string         `json:"feed_url"`
+	Items       []jsonFeedItem `json:"items"`
+}
+
+type jsonFeedItem struct {
+	ID            string   `json:"id"`
+	URL           string   `json:"url"`
+	Title         string   `json:"title"`
+	ContentText   string   `json:"content_text"`
+	Summary       string   `json:"summary,omitempty"`
+	Image         string   `json:"image,omitempty"`
+	DatePublished string   `json:"date_published"`
+	DateModified  string   `json:"date_modified"`
+	Tags          []string `json:"tags,omitempty"`
+}
+
+// EncodeJSONFeed はフィードをJSON Feed 1.1で出力する
+func EncodeJSONFeed(feed *usecase.Feed, selfURL string) ([]byte, error) {
+	doc := jsonFeed{
+		Version:     "https://jsonfeed.org/version/1.1",
+		Title:       feed.Title,
+		HomePageURL: feed.SiteURL,
+		FeedURL:     selfURL,
+		Items:       make([]jsonFeedItem, 0, len(feed.Items)),
+	}
+
+	for _, item := range feed.Items {
+		doc.Items = append(doc.Items, jsonFeedItem{
+			ID:            item.ID,
+			URL:           item.URL,
+			Title:         item.Title,
+			ContentText:   item.Content,
+			Summary:       item.Summary,
+			Image:         item.ImageURL,
+			DatePublished: formatFeedTime(item.PublishedAt),
+			DateModified:  formatFeedTime(item.UpdatedAt),
+			Tags:          item.Tags,
+		})
+	}
+
+	return json.Marshal(doc)
+}
+
+func encodeXML(doc any) ([]byte, error) {
+	body, err := xml.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), body...), nil
+}
+
+// formatFeedTime はRFC3339で日時を返す。投稿がなく日時が不明な場合はUNIXエポックとする
+func formatFeedTime(t time.Time) string {
+	if t.IsZero() {
+		t = time.Unix(0, 0)
+	}
+
+	return t.UTC().Format(time.RFC3339)
+}
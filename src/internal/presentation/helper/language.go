@@ -0,0 +1,75 @@
+package helper
+
+import (
+	"net/http"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	"github.com/MizukiShigi/cms-go/internal/i18n"
+	"golang.org/x/text/language"
+)
+
+// languageMatcher は Accept-Language からメッセージを用意している言語を選ぶ
+var languageMatcher = newLanguageMatcher()
+
+func newLanguageMatcher() language.Matcher {
+	tags := make([]language.Tag, 0, len(i18n.SupportedLanguages))
+	for _, lang := range i18n.SupportedLanguages {
+		tags = append(tags, language.Make(string(lang)))
+	}
+	return language.NewMatcher(tags)
+}
+
+// NegotiateLanguage は Accept-Language ヘッダーからレスポンスの言語を決め、Content-Language ヘッダーに設定する
+// 指定がない場合や対応していない言語のみの場合は既定の言語（英語）を返す
+func NegotiateLanguage(w http.ResponseWriter, r *http.Request) i18n.Language {
+	lang := i18n.DefaultLanguage
+	if tags, _, err := language.ParseAcceptLanguage(r.Header.Get("Accept-Language")); err == nil && len(tags) > 0 {
+		if _, index, confidence := languageMatcher.Match(tags...); confidence != language.No {
+			lang = i18n.SupportedLanguages[index]
+		}
+	}
+
+	w.Header().Set("Content-Language", string(lang))
+	w.Header().Add("Vary", "Accept-Language")
+	return lang
+}
+
+// ErrorResponse は利用者の言語に翻訳したエラー（一括操作の結果など、problem+json 以外でエラーを返す場合に使う）
+type ErrorResponse struct {
+	Code    valueobject.Code     `json:"code"`
+	Message string               `json:"message"`
+	Errors  []FieldErrorResponse `json:"errors,omitempty"`
+}
+
+// FieldErrorResponse は利用者の言語に翻訳した入力項目ごとの検証エラー
+type FieldErrorResponse struct {
+	// リクエストボディ上の項目名（ネストした項目は "tags[0]" のように表す）
+	Field string `json:"field"`
+	// 満たさなかった制約（"required", "max" など）
+	Constraint string `json:"constraint"`
+	Message    string `json:"message"`
+}
+
+// LocalizeError はエラーのメッセージを指定した言語に翻訳する（コードは言語によらず変わらない）
+func LocalizeError(err *valueobject.MyError, lang i18n.Language) *ErrorResponse {
+	return &ErrorResponse{
+		Code:    err.Code,
+		Message: i18n.Translate(lang, string(err.Key()), err.Args()...),
+		Errors:  localizeFieldErrors(err.Errors, lang),
+	}
+}
+
+func localizeFieldErrors(fieldErrors []valueobject.FieldError, lang i18n.Language) []FieldErrorResponse {
+	if len(fieldErrors) == 0 {
+		return nil
+	}
+	localized := make([]FieldErrorResponse, 0, len(fieldErrors))
+	for _, fieldError := range fieldErrors {
+		localized = append(localized, FieldErrorResponse{
+			Field:      fieldError.Field,
+			Constraint: fieldError.Constraint,
+			Message:    i18n.Translate(lang, string(fieldError.Key()), fieldError.Args()...),
+		})
+	}
+	return localized
+}
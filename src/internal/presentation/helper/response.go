@@ -4,11 +4,17 @@ import (
 	"encoding/json"
 	"errors"
 	"log"
+	"math"
 	"net/http"
+	"strconv"
 
+	domaincontext "github.com/MizukiShigi/cms-go/internal/domain/context"
 	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
 )
 
+// RequestIDHeader はリクエストIDを受け取り、レスポンスで返すヘッダー
+const RequestIDHeader = "X-Request-ID"
+
 func RespondWithJSON(w http.ResponseWriter, statusCode int, data interface{}) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(statusCode)
@@ -20,13 +26,65 @@ func RespondWithJSON(w http.ResponseWriter, statusCode int, data interface{}) {
 	}
 }
 
-func RespondWithError(w http.ResponseWriter, err error) {
+// problemContentType は RFC 7807 のエラーレスポンスの Content-Type
+const problemContentType = "application/problem+json"
+
+// Problem は RFC 7807 形式のエラーレスポンス
+type Problem struct {
+	// 問題の種類を表すURI（種類ごとのドキュメントは用意していないため about:blank とし、code で区別する）
+	Type   string `json:"type"`
+	Title  string `json:"title"`
+	Status int    `json:"status"`
+	Detail string `json:"detail,omitempty"`
+	// エラーが発生したリクエストのパス
+	Instance string `json:"instance,omitempty"`
+	// 以下は拡張メンバー
+	Code valueobject.Code `json:"code"`
+	// エラーが発生したリクエストのID（問い合わせ時にログ・監査ログと突き合わせるために返す）
+	RequestID string               `json:"request_id,omitempty"`
+	Errors    []FieldErrorResponse `json:"errors,omitempty"`
+}
+
+// RespondWithError はエラーを RFC 7807 の problem+json で返す
+// MyError 以外のエラーは内部情報を返さないよう InternalServerError として返す
+// detail と errors のメッセージは Accept-Language に応じて翻訳する
+func RespondWithError(w http.ResponseWriter, r *http.Request, err error) {
+	myError := valueobject.InternalServerError
 	var domainErr *valueobject.MyError
 	if errors.As(err, &domainErr) {
-		RespondWithJSON(w, domainErr.StatusCode(), domainErr)
-		return
+		myError = domainErr
 	}
+	// コードは変えずに、メッセージのみ利用者の言語に翻訳する
+	localized := LocalizeError(myError, NegotiateLanguage(w, r))
 
-	myError := valueobject.InternalServerError
-	RespondWithJSON(w, myError.StatusCode(), myError)
+	status := myError.StatusCode()
+	problem := Problem{
+		Type:      "about:blank",
+		Title:     http.StatusText(status),
+		Status:    status,
+		Detail:    localized.Message,
+		Instance:  r.URL.Path,
+		Code:      localized.Code,
+		RequestID: domaincontext.GetRequestID(r.Context()),
+		Errors:    localized.Errors,
+	}
+
+	if retryAfter := myError.RetryAfter(); retryAfter > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	}
+	w.Header().Set("Content-Type", problemContentType)
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(problem); err != nil {
+		log.Printf("JSON encoding failed: %v", err)
+	}
+}
+
+// NotFoundHandler はルートが見つからない場合に problem+json で 404 を返す
+func NotFoundHandler(w http.ResponseWriter, r *http.Request) {
+	RespondWithError(w, r, valueobject.NotFoundError)
+}
+
+// MethodNotAllowedHandler はルートが対応していないメソッドの場合に problem+json で 405 を返す
+func MethodNotAllowedHandler(w http.ResponseWriter, r *http.Request) {
+	RespondWithError(w, r, valueobject.NewMyError(valueobject.InvalidCode, "method_not_allowed").WithStatusCode(http.StatusMethodNotAllowed))
 }
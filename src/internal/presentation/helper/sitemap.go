@@ -0,0 +1,51 @@
+package helper
+
+import (
+	"encoding/xml"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/usecase"
+)
+
+const (
+	SitemapContentType = "application/xml; charset=utf-8"
+	sitemapXMLNS       = "http://www.sitemaps.org/schemas/sitemap/0.9"
+)
+
+type sitemapURLSet struct {
+	XMLName xml.Name          `xml:"urlset"`
+	XMLNS   string            `xml:"xmlns,attr"`
+	URLs    []sitemapLocation `xml:"url"`
+}
+
+type sitemapIndex struct {
+	XMLName  xml.Name          `xml:"sitemapindex"`
+	XMLNS    string            `xml:"xmlns,attr"`
+	Sitemaps []sitemapLocation `xml:"sitemap"`
+}
+
+type sitemapLocation struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+// EncodeSitemap はサイトマップ、または分割したサイトマップのインデックスをXMLで出力する
+func EncodeSitemap(output *usecase.GetSitemapOutput) ([]byte, error) {
+	if output.Sitemaps != nil {
+		return encodeXML(sitemapIndex{XMLNS: sitemapXMLNS, Sitemaps: toSitemapLocations(output.Sitemaps)})
+	}
+
+	return encodeXML(sitemapURLSet{XMLNS: sitemapXMLNS, URLs: toSitemapLocations(output.URLs)})
+}
+
+func toSitemapLocations(urls []*usecase.SitemapURL) []sitemapLocation {
+	locations := make([]sitemapLocation, 0, len(urls))
+	for _, u := range urls {
+		location := sitemapLocation{Loc: u.Loc}
+		if !u.LastModified.IsZero() {
+			location.LastMod = u.LastModified.UTC().Format(time.RFC3339)
+		}
+		locations = append(locations, location)
+	}
+	return locations
+}
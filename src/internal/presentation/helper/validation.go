@@ -0,0 +1,102 @@
+package helper
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	"github.com/go-playground/validator/v10"
+)
+
+// validate はリクエストの検証に使う（構造体ごとの検証ルールをキャッシュするため使い回す）
+var validate = newValidator()
+
+func newValidator() *validator.Validate {
+	v := validator.New()
+	// エラーの項目名をリクエストボディと同じ JSON のキー名にする
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			return ""
+		}
+		return name
+	})
+	return v
+}
+
+// ValidateStruct はリクエストを検証し、失敗した項目をすべて含むエラーを返す
+func ValidateStruct(req interface{}) error {
+	err := validate.Struct(req)
+	if err == nil {
+		return nil
+	}
+
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return valueobject.InvalidRequestError
+	}
+
+	fieldErrors := make([]valueobject.FieldError, 0, len(validationErrors))
+	for _, fe := range validationErrors {
+		field := fieldPath(reflect.TypeOf(req), fe)
+		key, args := fieldErrorKey(field, fe)
+		fieldErrors = append(fieldErrors, valueobject.NewFieldError(field, fe.Tag(), key, args...))
+	}
+	return valueobject.NewValidationError(fieldErrors)
+}
+
+// fieldPath はリクエストボディ上の項目のパス（"tags[0]" など）を返す
+// JSON と同じく、タグのない埋め込み構造体の項目は親の項目として扱う
+func fieldPath(root reflect.Type, fe validator.FieldError) string {
+	names := strings.Split(fe.Namespace(), ".")
+	structNames := strings.Split(fe.StructNamespace(), ".")
+	if len(names) < 2 || len(names) != len(structNames) {
+		return fe.Field()
+	}
+
+	typ := root
+	path := make([]string, 0, len(names)-1)
+	for i := 1; i < len(names); i++ {
+		for typ.Kind() == reflect.Ptr || typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array || typ.Kind() == reflect.Map {
+			typ = typ.Elem()
+		}
+		if typ.Kind() == reflect.Struct {
+			goName, _, _ := strings.Cut(structNames[i], "[")
+			if field, ok := typ.FieldByName(goName); ok {
+				typ = field.Type
+				if field.Anonymous && field.Tag.Get("json") == "" {
+					continue
+				}
+			}
+		}
+		path = append(path, names[i])
+	}
+	return strings.Join(path, ".")
+}
+
+// fieldErrorKey は制約ごとのメッセージのキーと埋め込む値を返す
+func fieldErrorKey(field string, fe validator.FieldError) (valueobject.MessageKey, []any) {
+	switch fe.Tag() {
+	case "required", "email", "uuid":
+		return valueobject.MessageKey("field_" + fe.Tag()), []any{field}
+	case "oneof":
+		return "field_oneof", []any{field, fe.Param()}
+	case "min", "max":
+		return valueobject.MessageKey("field_" + fe.Tag() + lengthUnit(fe.Kind())), []any{field, fe.Param()}
+	default:
+		return "field_invalid", []any{field}
+	}
+}
+
+// lengthUnit は min / max の対象が長さの場合に、キーに付ける単位を返す
+func lengthUnit(kind reflect.Kind) string {
+	switch kind {
+	case reflect.String:
+		return "_length"
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return "_items"
+	default:
+		return ""
+	}
+}
@@ -40,30 +40,30 @@ func AuthMiddleware(auth0Domain, audience string) func(http.Handler) http.Handle
 			tokenString, err := extractTokenFromHeader(r)
 			if err != nil {
 				slog.ErrorContext(ctx, err.Error())
-				myerr := valueobject.NewMyError(valueobject.UnauthorizedCode, err.Error())
-				helper.RespondWithError(w, myerr)
+				helper.RespondWithError(w, r, err)
 				return
 			}
 
 			// JWT トークンを解析・検証
-			claims, err := validateAuth0Token(tokenString, auth0Domain, audience)
+			claims, err := validateAuth0Token(ctx, tokenString, auth0Domain, audience)
 			if err != nil {
+				// 検証に失敗した理由はログのみに出力する
 				slog.ErrorContext(ctx, err.Error())
-				myerr := valueobject.NewMyError(valueobject.UnauthorizedCode, err.Error())
-				helper.RespondWithError(w, myerr)
+				helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.UnauthorizedCode, "invalid_token"))
 				return
 			}
 
 			// auth0のカスタムクレームにあるapp_user_idをユーザーIDとして使用する
 			claimUserID, ok := claims["app_user_id"].(string)
 			if !ok {
-				myerr := valueobject.NewMyError(valueobject.UnauthorizedCode, "app_user_id is not a string")
-				helper.RespondWithError(w, myerr)
+				myerr := valueobject.NewMyError(valueobject.UnauthorizedCode, "app_user_id_is_not_a_string")
+				helper.RespondWithError(w, r, myerr)
 				return
 			}
 
 			// 検証されたクレームをコンテキストに追加
 			ctx = context.WithValue(r.Context(), domaincontext.UserID, claimUserID)
+			ctx = context.WithValue(ctx, domaincontext.UserRoles, extractRoles(claims))
 
 			// 検証されたクレームをログコンテキストに追加
 			ctx = domaincontext.WithValue(ctx, "user_id", claimUserID)
@@ -73,26 +73,51 @@ func AuthMiddleware(auth0Domain, audience string) func(http.Handler) http.Handle
 	}
 }
 
+// extractRoles はauth0のカスタムクレームにあるapp_rolesからロール一覧を取得する
+// クレームがない場合はロールなしとして扱う
+func extractRoles(claims jwt.MapClaims) []valueobject.Role {
+	claimRoles, ok := claims["app_roles"].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	roles := make([]valueobject.Role, 0, len(claimRoles))
+	for _, claimRole := range claimRoles {
+		if role, ok := claimRole.(string); ok {
+			roles = append(roles, valueobject.Role(role))
+		}
+	}
+	return roles
+}
+
 // extractTokenFromHeader は Authorization ヘッダーから JWT トークンを抽出
 func extractTokenFromHeader(r *http.Request) (string, error) {
 	// Authorization ヘッダーを取得
 	authHeader := r.Header.Get("Authorization")
 	if authHeader == "" {
-		return "", errors.New("authorization header is missing")
+		return "", valueobject.NewMyError(valueobject.UnauthorizedCode, "authorization_header_missing")
 	}
 
 	// Bearer プレフィックスを確認
 	parts := strings.Split(authHeader, " ")
 	if len(parts) != 2 || strings.ToLower(parts[0]) != "bearer" {
-		return "", errors.New("invalid authorization header format. 'Bearer <token>' format is required")
+		return "", valueobject.NewMyError(valueobject.UnauthorizedCode, "authorization_header_invalid_format")
 	}
 
 	return parts[1], nil
 }
 
 // getJWKS はAuth0のJWKSエンドポイントから公開鍵を取得
-func getJWKS(auth0Domain string) (*JWKS, error) {
-	resp, err := http.Get(fmt.Sprintf("https://%s/.well-known/jwks.json", auth0Domain))
+func getJWKS(ctx context.Context, auth0Domain string) (*JWKS, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("https://%s/.well-known/jwks.json", auth0Domain), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create JWKS request: %w", err)
+	}
+	if requestID := domaincontext.GetRequestID(ctx); requestID != "" {
+		req.Header.Set(helper.RequestIDHeader, requestID)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get JWKS: %w", err)
 	}
@@ -133,9 +158,9 @@ func getPubKey(jwk JWK) (*rsa.PublicKey, error) {
 }
 
 // validateAuth0Token はAuth0のJWTトークンを検証
-func validateAuth0Token(tokenString string, auth0Domain string, audience string) (jwt.MapClaims, error) {
+func validateAuth0Token(ctx context.Context, tokenString string, auth0Domain string, audience string) (jwt.MapClaims, error) {
 	// JWKS取得
-	jwks, err := getJWKS(auth0Domain)
+	jwks, err := getJWKS(ctx, auth0Domain)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get JWKS: %w", err)
 	}
@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+
+	domaincontext "github.com/MizukiShigi/cms-go/internal/domain/context"
+)
+
+// ClientIPMiddleware はリクエスト元のIPアドレスをコンテキストに設定する
+// trustedProxies はサーバーの前段にあるプロキシ（ロードバランサーなど）の数。
+// 0 の場合は X-Forwarded-For を信用せず接続元アドレスを使う
+func ClientIPMiddleware(trustedProxies int) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), domaincontext.ClientIP, clientIP(r, trustedProxies))
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// clientIP はリクエスト元のIPアドレスを返す
+// X-Forwarded-For はクライアントが任意の値を付けられるため、信頼するプロキシが追加した末尾から数えた値のみ使う
+func clientIP(r *http.Request, trustedProxies int) string {
+	if trustedProxies > 0 {
+		var forwarded []string
+		for _, value := range r.Header.Values("X-Forwarded-For") {
+			for _, ip := range strings.Split(value, ",") {
+				forwarded = append(forwarded, strings.TrimSpace(ip))
+			}
+		}
+		if len(forwarded) >= trustedProxies {
+			if ip := net.ParseIP(forwarded[len(forwarded)-trustedProxies]); ip != nil {
+				return ip.String()
+			}
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
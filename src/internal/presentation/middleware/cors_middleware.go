@@ -34,7 +34,10 @@ func CORSMiddleware(next http.Handler) http.Handler {
 		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
 
 		// 許可するヘッダーを設定
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, If-Match, X-Request-ID, traceparent, tracestate")
+
+		// 楽観的排他制御のためETagを、問い合わせ用にリクエストIDを、再試行の制御のためレート制限の状態をクライアントから参照できるようにする
+		w.Header().Set("Access-Control-Expose-Headers", "ETag, X-Request-ID, RateLimit-Limit, RateLimit-Remaining, RateLimit-Reset, RateLimit-Policy, Retry-After")
 
 		// 認証情報の送信を許可
 		w.Header().Set("Access-Control-Allow-Credentials", "true")
@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"bytes"
+	"context"
 	"io"
 	"log/slog"
 	"net/http"
@@ -9,64 +10,100 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	domaincontext "github.com/MizukiShigi/cms-go/internal/domain/context"
+	"github.com/MizukiShigi/cms-go/internal/presentation/helper"
 )
 
-func LoggingMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		startTime := time.Now()
-
-		// リクエストIDとコンテキスト設定
-		ctx := domaincontext.WithValue(r.Context(), "request_id", uuid.New().String())
-		ctx = domaincontext.WithValue(ctx, "method", r.Method)
-		ctx = domaincontext.WithValue(ctx, "url", r.URL.String())
-		ctx = domaincontext.WithValue(ctx, "remote_addr", r.RemoteAddr)
-		ctx = domaincontext.WithValue(ctx, "user_agent", r.Header.Get("User-Agent"))
-
-		// リクエストボディの読み取りと復元
-		var requestBody string
-		if shouldLogBody(r) {
-			body, err := readAndRestoreBody(r)
-			if err != nil {
-				slog.ErrorContext(ctx, "Failed to read request body", "error", err)
-			} else {
-				// 機密情報をマスキング
-				maskedBody := maskSensitiveData(body)
-				requestBody = maskedBody
-				ctx = domaincontext.WithValue(ctx, "request_body", requestBody)
+// LoggingMiddleware はリクエストとレスポンスをログに出力する
+// ボディとクエリ文字列の機密情報は redactor で伏せてから出力する
+func LoggingMiddleware(redactor *Redactor) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			startTime := time.Now()
+
+			// リクエストIDとコンテキスト設定（監査ログでも参照する）
+			// クライアントやロードバランサーが付与したIDがあれば引き継ぎ、レスポンスでも返す
+			requestID := r.Header.Get(helper.RequestIDHeader)
+			if !isValidRequestID(requestID) {
+				requestID = uuid.New().String()
+			}
+			w.Header().Set(helper.RequestIDHeader, requestID)
+			ctx := context.WithValue(r.Context(), domaincontext.RequestID, requestID)
+			ctx = domaincontext.WithValue(ctx, "request_id", requestID)
+			ctx = domaincontext.WithValue(ctx, "method", r.Method)
+			ctx = domaincontext.WithValue(ctx, "url", redactor.RedactURL(r.URL))
+			ctx = domaincontext.WithValue(ctx, "remote_addr", r.RemoteAddr)
+			ctx = domaincontext.WithValue(ctx, "user_agent", r.Header.Get("User-Agent"))
+
+			// リクエストIDからトレースを検索できるようにする
+			trace.SpanFromContext(ctx).SetAttributes(attribute.String("request_id", requestID))
+
+			// リクエストボディの読み取りと復元
+			var requestBody string
+			if shouldLogBody(r) {
+				body, err := readAndRestoreBody(r)
+				if err != nil {
+					slog.ErrorContext(ctx, "Failed to read request body", "error", err)
+				} else {
+					// 切り詰めると解析できなくなるため、機密情報を伏せてから切り詰める
+					requestBody = truncateBody(redactor.RedactBody(r.Header.Get("Content-Type"), body), maxRequestBodyLogSize)
+					ctx = domaincontext.WithValue(ctx, "request_body", requestBody)
+				}
 			}
-		}
 
-		// レスポンスキャプチャのためのラッパー
-		capture := &ResponseCapture{
-			ResponseWriter: w,
-			statusCode:     http.StatusOK,
-		}
+			// レスポンスキャプチャのためのラッパー
+			capture := &ResponseCapture{
+				ResponseWriter: w,
+				statusCode:     http.StatusOK,
+			}
 
-		// リクエストログ
-		slog.InfoContext(ctx, "request started")
+			// リクエストログ
+			slog.InfoContext(ctx, "request started")
 
-		// 次のハンドラーを実行
-		next.ServeHTTP(capture, r.WithContext(ctx))
+			// 次のハンドラーを実行
+			next.ServeHTTP(capture, r.WithContext(ctx))
 
-		// 処理時間計算
-		duration := time.Since(startTime)
+			// 処理時間計算
+			duration := time.Since(startTime)
 
-		// レスポンス情報をコンテキストに追加
-		ctx = domaincontext.WithValue(ctx, "status_code", capture.statusCode)
-		ctx = domaincontext.WithValue(ctx, "response_size", capture.body.Len())
-		ctx = domaincontext.WithValue(ctx, "duration_ms", duration.Milliseconds())
+			// レスポンス情報をコンテキストに追加
+			ctx = domaincontext.WithValue(ctx, "status_code", capture.statusCode)
+			ctx = domaincontext.WithValue(ctx, "response_size", capture.body.Len())
+			ctx = domaincontext.WithValue(ctx, "duration_ms", duration.Milliseconds())
 
-		// レスポンスボディをログに追加（必要な場合）
-		if shouldLogResponseBody(capture) {
-			responseBody := maskSensitiveData(capture.body.String())
-			ctx = domaincontext.WithValue(ctx, "response_body", responseBody)
-		}
+			// レスポンスボディをログに追加（必要な場合）
+			if shouldLogResponseBody(capture) {
+				responseBody := truncateBody(redactor.RedactBody(capture.Header().Get("Content-Type"), capture.body.String()), maxRequestBodyLogSize)
+				ctx = domaincontext.WithValue(ctx, "response_body", responseBody)
+			}
 
-		// レスポンスログ
-		slog.InfoContext(ctx, "request completed")
-	})
+			// レスポンスログ
+			slog.InfoContext(ctx, "request completed")
+		})
+	}
+}
+
+// maxRequestIDLength は受け付けるリクエストIDの最大長（監査ログの request_id カラムの長さ）
+const maxRequestIDLength = 64
+
+// isValidRequestID は受け取ったリクエストIDをそのまま使えるか判定する
+// ログやヘッダーへの注入を防ぐため、英数字と "-" "_" "." ":" のみ受け付ける
+func isValidRequestID(requestID string) bool {
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		return false
+	}
+	for _, c := range requestID {
+		switch {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9':
+		case c == '-' || c == '_' || c == '.' || c == ':':
+		default:
+			return false
+		}
+	}
+	return true
 }
 
 // ResponseCapture はレスポンスの内容をキャプチャするためのラッパー
@@ -105,16 +142,18 @@ func readAndRestoreBody(r *http.Request) (string, error) {
 	// ボディを復元
 	r.Body = io.NopCloser(bytes.NewReader(bodyBytes))
 
-	// 文字列として返却（適切な場合のみ）
-	bodyStr := string(bodyBytes)
+	return string(bodyBytes), nil
+}
+
+// maxRequestBodyLogSize はログに出力するボディの最大サイズ（10KB）
+const maxRequestBodyLogSize = 10 * 1024
 
-	// サイズ制限（10KB）
-	const maxBodySize = 10 * 1024
-	if len(bodyStr) > maxBodySize {
-		return bodyStr[:maxBodySize] + "...[truncated]", nil
+// truncateBody はログに出力するボディを最大サイズで切り詰める
+func truncateBody(body string, maxSize int) string {
+	if len(body) > maxSize {
+		return body[:maxSize] + "...[truncated]"
 	}
-
-	return bodyStr, nil
+	return body
 }
 
 // shouldLogBody はリクエストボディをログに記録すべきかを判定
@@ -169,34 +208,3 @@ func shouldLogResponseBody(capture *ResponseCapture) bool {
 
 	return true
 }
-
-// maskSensitiveData は機密情報をマスキングする
-func maskSensitiveData(body string) string {
-	if body == "" {
-		return body
-	}
-
-	// パスワード関連のフィールドをマスキング
-	sensitiveFields := []string{
-		"password",
-		"passwd",
-		"pwd",
-		"secret",
-		"token",
-		"authorization",
-		"auth",
-		"key",
-		"credential",
-	}
-
-	result := body
-	for _, field := range sensitiveFields {
-		// JSON形式のフィールドを単純に置換
-		if strings.Contains(strings.ToLower(result), `"`+strings.ToLower(field)+`":`) {
-			result = strings.ReplaceAll(result,
-				`"`+field+`":`, `"`+field+`": "***" // masked`)
-		}
-	}
-
-	return result
-}
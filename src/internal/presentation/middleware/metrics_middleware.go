@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/service"
+)
+
+// MetricsMiddleware はリクエスト数と処理時間をルートのテンプレート単位で記録する
+// パスそのものではなくテンプレート（例: /cms/v1/posts/{id}）を使い、ラベルの種類が増え続けないようにする
+func MetricsMiddleware(metrics service.Metrics) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			startTime := time.Now()
+
+			capture := &ResponseCapture{
+				ResponseWriter: w,
+				statusCode:     http.StatusOK,
+			}
+
+			next.ServeHTTP(capture, r)
+
+			route, ok := routeTemplate(r)
+			if !ok {
+				route = "unknown"
+			}
+			metrics.ObserveHTTPRequest(r.Method, route, capture.statusCode, time.Since(startTime))
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package middleware
+
+import (
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+
+	domaincontext "github.com/MizukiShigi/cms-go/internal/domain/context"
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/service"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	"github.com/MizukiShigi/cms-go/internal/presentation/helper"
+)
+
+// RateLimitKeyFunc はリクエストからレート制限のキーを返す。false の場合は制限しない
+type RateLimitKeyFunc func(r *http.Request) (string, bool)
+
+// RateLimitMiddleware はキーごとにポリシーの回数を超えたリクエストを 429 で拒否する
+// レスポンスには RateLimit-Limit / RateLimit-Remaining / RateLimit-Reset / RateLimit-Policy を、
+// 拒否した場合は Retry-After を付ける
+func RateLimitMiddleware(store service.RateLimitStore, policy valueobject.RateLimitPolicy, keyFunc RateLimitKeyFunc) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// プリフライトリクエストは制限しない
+			if r.Method == http.MethodOptions {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			key, ok := keyFunc(r)
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ctx := r.Context()
+			decision, err := store.Take(ctx, policy.Name+":"+key, policy)
+			if err != nil {
+				// 保存先の障害でAPI全体を止めないよう、制限せずに処理を続ける
+				slog.ErrorContext(ctx, "Failed to check rate limit", "policy", policy.Name, "error", err)
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			setRateLimitHeaders(w, policy, decision)
+			if !decision.Allowed {
+				slog.WarnContext(ctx, "Rate limit exceeded", "policy", policy.Name)
+				helper.RespondWithError(w, r, valueobject.NewTooManyRequestsError("too_many_requests", decision.RetryAfter))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// RateLimitByIP はリクエスト元のIPアドレスごとに制限する（ClientIPMiddleware の後に適用する）
+func RateLimitByIP(r *http.Request) (string, bool) {
+	clientIP := domaincontext.GetClientIP(r.Context())
+	return clientIP, clientIP != ""
+}
+
+// RateLimitByUser は認証済みユーザーごとに制限する（AuthMiddleware の後に適用する）
+func RateLimitByUser(r *http.Request) (string, bool) {
+	userID, err := domaincontext.GetUserID(r.Context())
+	if err != nil {
+		return "", false
+	}
+	return userID, true
+}
+
+// RateLimitByRoute はルートごとに、認証済みの場合はユーザー、未認証の場合はIPアドレス単位で制限する
+func RateLimitByRoute(r *http.Request) (string, bool) {
+	route, ok := routeTemplate(r)
+	if !ok {
+		return "", false
+	}
+
+	client, ok := RateLimitByUser(r)
+	if ok {
+		client = "user:" + client
+	} else if clientIP, ok := RateLimitByIP(r); ok {
+		client = "ip:" + clientIP
+	} else {
+		return "", false
+	}
+	return r.Method + " " + route + ":" + client, true
+}
+
+// setRateLimitHeaders はレート制限の状態をヘッダーに設定する
+// 複数のポリシーを適用した場合は、残り回数が最も少ないポリシーの値を返す
+func setRateLimitHeaders(w http.ResponseWriter, policy valueobject.RateLimitPolicy, decision entity.RateLimitDecision) {
+	if current, err := strconv.Atoi(w.Header().Get("RateLimit-Remaining")); err == nil && current <= decision.Remaining && decision.Allowed {
+		return
+	}
+
+	w.Header().Set("RateLimit-Limit", strconv.Itoa(decision.Limit))
+	w.Header().Set("RateLimit-Remaining", strconv.Itoa(decision.Remaining))
+	w.Header().Set("RateLimit-Reset", strconv.Itoa(int(math.Ceil(decision.ResetAfter.Seconds()))))
+	w.Header().Set("RateLimit-Policy", policy.HeaderValue())
+}
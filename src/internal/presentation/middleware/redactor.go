@@ -0,0 +1,164 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// RedactedValue は伏せた値の代わりに出力する文字列
+const RedactedValue = "[REDACTED]"
+
+// DefaultSensitiveKeyPatterns は値を伏せるキーのパターン
+// キーを小文字にして "_" と "-" を除いた上で部分一致させる（例: "Access-Token" や "client_secret" も一致する）
+var DefaultSensitiveKeyPatterns = []string{
+	"password",
+	"passwd",
+	"pwd",
+	"secret",
+	"token",
+	"authorization",
+	"apikey",
+	"accesskey",
+	"privatekey",
+	"credential",
+	"cookie",
+	"session",
+	"signature",
+}
+
+// Redactor はログに出力するリクエスト・レスポンスから機密情報を伏せる
+type Redactor struct {
+	patterns []string
+}
+
+// NewRedactor はキーのパターンを指定して作成する（パターンは大文字小文字を区別しない）
+func NewRedactor(patterns []string) *Redactor {
+	normalized := make([]string, 0, len(patterns))
+	for _, pattern := range patterns {
+		if p := normalizeKey(pattern); p != "" {
+			normalized = append(normalized, p)
+		}
+	}
+	return &Redactor{patterns: normalized}
+}
+
+// IsSensitiveKey はキーの値を伏せる必要があるか判定する
+func (r *Redactor) IsSensitiveKey(key string) bool {
+	normalized := normalizeKey(key)
+	for _, pattern := range r.patterns {
+		if strings.Contains(normalized, pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactBody は Content-Type に応じてボディ内の機密情報を伏せる
+// JSON・フォームとして解析できない場合や、それ以外の形式（XML・テキストなど）の場合は内容を出力しない
+func (r *Redactor) RedactBody(contentType string, body string) string {
+	if body == "" {
+		return body
+	}
+
+	contentType = strings.ToLower(contentType)
+	switch {
+	case strings.Contains(contentType, "json"):
+		return r.redactJSON(body)
+	case strings.Contains(contentType, "application/x-www-form-urlencoded"):
+		return r.redactForm(body)
+	case contentType == "":
+		// Content-Type が不明な場合は形式を推測する
+		trimmed := strings.TrimSpace(body)
+		if strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
+			return r.redactJSON(body)
+		}
+		return r.redactForm(body)
+	default:
+		// 機密情報の位置を判別できないため、長さのみ出力する
+		return RedactedValue + " (" + strconv.Itoa(len(body)) + " bytes)"
+	}
+}
+
+// RedactURL はクエリ文字列の機密情報を伏せたURLを返す
+func (r *Redactor) RedactURL(u *url.URL) string {
+	if u.RawQuery == "" {
+		return u.String()
+	}
+
+	redacted := *u
+	query, err := url.ParseQuery(u.RawQuery)
+	if err != nil {
+		redacted.RawQuery = RedactedValue
+		return redacted.String()
+	}
+	redacted.RawQuery = r.redactValues(query).Encode()
+	return redacted.String()
+}
+
+func (r *Redactor) redactJSON(body string) string {
+	decoder := json.NewDecoder(strings.NewReader(body))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return RedactedValue + " (invalid JSON)"
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(r.redactValue(value)); err != nil {
+		return RedactedValue
+	}
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// redactValue はオブジェクト・配列を再帰的にたどり、一致したキーの値を伏せる
+func (r *Redactor) redactValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, child := range v {
+			if r.IsSensitiveKey(key) {
+				v[key] = RedactedValue
+				continue
+			}
+			v[key] = r.redactValue(child)
+		}
+		return v
+	case []any:
+		for i, child := range v {
+			v[i] = r.redactValue(child)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+func (r *Redactor) redactForm(body string) string {
+	values, err := url.ParseQuery(body)
+	if err != nil {
+		return RedactedValue + " (invalid form)"
+	}
+	return r.redactValues(values).Encode()
+}
+
+func (r *Redactor) redactValues(values url.Values) url.Values {
+	for key := range values {
+		if r.IsSensitiveKey(key) {
+			for i := range values[key] {
+				values[key][i] = RedactedValue
+			}
+		}
+	}
+	return values
+}
+
+func normalizeKey(key string) string {
+	key = strings.ToLower(strings.TrimSpace(key))
+	key = strings.ReplaceAll(key, "_", "")
+	return strings.ReplaceAll(key, "-", "")
+}
@@ -0,0 +1,150 @@
+package middleware
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testSecret = "s3cr3t-value"
+
+func TestRedactor_RedactBody(t *testing.T) {
+	redactor := NewRedactor(DefaultSensitiveKeyPatterns)
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		contains    []string
+	}{
+		{
+			name:        "トップレベルのキーを伏せる",
+			contentType: "application/json",
+			body:        `{"email":"user@example.com","password":"` + testSecret + `"}`,
+			contains:    []string{`"email":"user@example.com"`, `"password":"[REDACTED]"`},
+		},
+		{
+			name:        "ネストしたオブジェクトのキーを伏せる",
+			contentType: "application/json; charset=utf-8",
+			body:        `{"user":{"name":"taro","credentials":{"apiKey":"` + testSecret + `"}}}`,
+			contains:    []string{`"name":"taro"`, `"credentials":"[REDACTED]"`},
+		},
+		{
+			name:        "配列内のオブジェクトのキーを伏せる",
+			contentType: "application/json",
+			body:        `{"accounts":[{"id":1,"token":"` + testSecret + `"},{"id":2,"token":"` + testSecret + `"}]}`,
+			contains:    []string{`"id":1`, `"id":2`, `"token":"[REDACTED]"`},
+		},
+		{
+			name:        "大文字小文字や区切り文字が異なるキーを伏せる",
+			contentType: "application/json",
+			body:        `{"Password":"` + testSecret + `","ACCESS_TOKEN":"` + testSecret + `","clientSecret":"` + testSecret + `"}`,
+			contains:    []string{`"Password":"[REDACTED]"`, `"ACCESS_TOKEN":"[REDACTED]"`, `"clientSecret":"[REDACTED]"`},
+		},
+		{
+			name:        "機密でない値に機密キーと同じ文字列が含まれても伏せない",
+			contentType: "application/json",
+			body:        `{"title":"password reset guide","count":12345678901234567890}`,
+			contains:    []string{`"title":"password reset guide"`, `"count":12345678901234567890`},
+		},
+		{
+			name:        "不正なJSONは内容を出力しない",
+			contentType: "application/json",
+			body:        `{"password":"` + testSecret + `"`,
+			contains:    []string{"[REDACTED] (invalid JSON)"},
+		},
+		{
+			name:        "フォームのキーを伏せる",
+			contentType: "application/x-www-form-urlencoded",
+			body:        "grant_type=password&client_secret=" + testSecret + "&username=taro",
+			contains:    []string{"grant_type=password", "username=taro", "client_secret=" + url.QueryEscape(RedactedValue)},
+		},
+		{
+			name:        "Content-Type がない場合はJSONとして推測する",
+			contentType: "",
+			body:        `[{"refresh_token":"` + testSecret + `"}]`,
+			contains:    []string{`"refresh_token":"[REDACTED]"`},
+		},
+		{
+			name:        "10KBを超えるボディでも伏せる",
+			contentType: "application/json",
+			body:        `{"content":"` + strings.Repeat("a", 11*1024) + `","password":"` + testSecret + `"}`,
+			contains:    []string{`"password":"[REDACTED]"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := redactor.RedactBody(tt.contentType, tt.body)
+
+			assert.NotContains(t, got, testSecret)
+			for _, want := range tt.contains {
+				assert.Contains(t, got, want)
+			}
+		})
+	}
+}
+
+func TestRedactor_RedactBody_その他のContentTypeは内容を出力しない(t *testing.T) {
+	redactor := NewRedactor(DefaultSensitiveKeyPatterns)
+
+	xmlBody := "<login><password>" + testSecret + "</password></login>"
+	got := redactor.RedactBody("application/xml", xmlBody)
+	assert.NotContains(t, got, testSecret)
+	assert.Equal(t, RedactedValue+" ("+strconv.Itoa(len(xmlBody))+" bytes)", got)
+
+	assert.NotContains(t, redactor.RedactBody("text/plain; charset=utf-8", "token "+testSecret), testSecret)
+	assert.Equal(t, "", redactor.RedactBody("application/json", ""))
+}
+
+func TestRedactor_RedactURL(t *testing.T) {
+	redactor := NewRedactor(DefaultSensitiveKeyPatterns)
+
+	tests := []struct {
+		name     string
+		rawURL   string
+		contains []string
+	}{
+		{
+			name:     "クエリ文字列のキーを伏せる",
+			rawURL:   "/cms/v1/posts?status=published&access_token=" + testSecret,
+			contains: []string{"/cms/v1/posts?", "status=published", "access_token=" + url.QueryEscape(RedactedValue)},
+		},
+		{
+			name:     "クエリ文字列がない場合はそのまま返す",
+			rawURL:   "/cms/v1/posts/123",
+			contains: []string{"/cms/v1/posts/123"},
+		},
+		{
+			name:     "不正なクエリ文字列は内容を出力しない",
+			rawURL:   "/cms/v1/posts?token=" + testSecret + ";x",
+			contains: []string{"/cms/v1/posts?" + RedactedValue},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, err := url.Parse(tt.rawURL)
+			assert.NoError(t, err)
+
+			got := redactor.RedactURL(u)
+
+			assert.NotContains(t, got, testSecret)
+			for _, want := range tt.contains {
+				assert.Contains(t, got, want)
+			}
+		})
+	}
+}
+
+func TestRedactor_追加したキーを伏せる(t *testing.T) {
+	redactor := NewRedactor(append(DefaultSensitiveKeyPatterns, "Internal-Id", " "))
+
+	got := redactor.RedactBody("application/json", `{"internal_id":"`+testSecret+`","name":"taro"}`)
+
+	assert.NotContains(t, got, testSecret)
+	assert.Contains(t, got, `"name":"taro"`)
+}
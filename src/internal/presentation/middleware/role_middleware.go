@@ -0,0 +1,23 @@
+package middleware
+
+import (
+	"net/http"
+
+	domaincontext "github.com/MizukiShigi/cms-go/internal/domain/context"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	"github.com/MizukiShigi/cms-go/internal/presentation/helper"
+)
+
+// RequireRole は指定ロールを持つユーザーのみ許可するミドルウェア。AuthMiddleware の後に適用する
+func RequireRole(role valueobject.Role) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !domaincontext.HasRole(r.Context(), role) {
+				helper.RespondWithError(w, r, valueobject.NewMyError(valueobject.ForbiddenCode, "insufficient_role"))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	domaincontext "github.com/MizukiShigi/cms-go/internal/domain/context"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequireRole(t *testing.T) {
+	tests := []struct {
+		name       string
+		roles      []valueobject.Role
+		wantStatus int
+	}{
+		{name: "ロールを持たないユーザーは403になる", roles: nil, wantStatus: http.StatusForbidden},
+		{name: "別のロールのみ持つユーザーは403になる", roles: []valueobject.Role{valueobject.RoleReviewer}, wantStatus: http.StatusForbidden},
+		{name: "指定ロールを持つユーザーは許可される", roles: []valueobject.Role{valueobject.RoleReviewer, valueobject.RoleAdmin}, wantStatus: http.StatusNoContent},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			handler := RequireRole(valueobject.RoleAdmin)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				called = true
+				w.WriteHeader(http.StatusNoContent)
+			}))
+
+			req := httptest.NewRequest(http.MethodPatch, "/cms/v1/tags/1", nil)
+			req = req.WithContext(context.WithValue(req.Context(), domaincontext.UserRoles, tt.roles))
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.Equal(t, tt.wantStatus == http.StatusNoContent, called)
+		})
+	}
+}
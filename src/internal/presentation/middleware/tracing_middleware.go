@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracingMiddleware はサーバースパン（otelhttp で開始したスパン）の名前をルートのテンプレートにする
+func TracingMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if route, ok := routeTemplate(r); ok {
+			span := trace.SpanFromContext(r.Context())
+			span.SetName(r.Method + " " + route)
+			span.SetAttributes(attribute.String("http.route", route))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// routeTemplate はリクエストに一致したルートのテンプレート（例: /cms/v1/posts/{id}）を返す
+func routeTemplate(r *http.Request) (string, bool) {
+	currentRoute := mux.CurrentRoute(r)
+	if currentRoute == nil {
+		return "", false
+	}
+	template, err := currentRoute.GetPathTemplate()
+	if err != nil {
+		return "", false
+	}
+	return template, true
+}
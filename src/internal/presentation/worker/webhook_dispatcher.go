@@ -0,0 +1,61 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/usecase"
+)
+
+// WebhookDispatcher は一定間隔でWebhookのディスパッチを実行する
+type WebhookDispatcher struct {
+	dispatchWebhooksUsecase *usecase.DispatchWebhooksUsecase
+	interval                time.Duration
+	// done は Run の終了時に閉じる
+	done chan struct{}
+}
+
+func NewWebhookDispatcher(dispatchWebhooksUsecase *usecase.DispatchWebhooksUsecase, interval time.Duration) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		dispatchWebhooksUsecase: dispatchWebhooksUsecase,
+		interval:                interval,
+		done:                    make(chan struct{}),
+	}
+}
+
+// Done は Run が終了すると閉じられるチャネルを返す
+func (d *WebhookDispatcher) Done() <-chan struct{} {
+	return d.done
+}
+
+// Run は ctx がキャンセルされるまでディスパッチを繰り返す
+// キャンセル時は送信中の配信の記録を終えてから戻る
+func (d *WebhookDispatcher) Run(ctx context.Context) {
+	defer close(d.done)
+
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			output, err := d.dispatchWebhooksUsecase.Execute(ctx)
+			if err != nil {
+				slog.ErrorContext(ctx, "Failed to dispatch webhooks", "error", err)
+			}
+			// 一部の配信の記録に失敗した場合も、送信した結果は出力する
+			if output == nil {
+				continue
+			}
+			if output.FannedOutEvents > 0 || output.Succeeded > 0 || output.Failed > 0 {
+				slog.InfoContext(ctx, "Dispatched webhooks",
+					"events", output.FannedOutEvents,
+					"succeeded", output.Succeeded,
+					"failed", output.Failed)
+			}
+		}
+	}
+}
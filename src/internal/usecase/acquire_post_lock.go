@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/repository"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+type AcquirePostLockInput struct {
+	PostID valueobject.PostID
+	UserID valueobject.UserID
+}
+
+// PostLockOutput は編集ロックの取得・延長・投稿取得で共通の出力
+type PostLockOutput struct {
+	PostID     valueobject.PostID
+	UserID     valueobject.UserID
+	AcquiredAt time.Time
+	ExpiresAt  time.Time
+}
+
+type AcquirePostLockUsecase struct {
+	postRepository     repository.PostRepository
+	postLockRepository repository.PostLockRepository
+}
+
+func NewAcquirePostLockUsecase(postRepository repository.PostRepository, postLockRepository repository.PostLockRepository) *AcquirePostLockUsecase {
+	return &AcquirePostLockUsecase{postRepository: postRepository, postLockRepository: postLockRepository}
+}
+
+// Execute は投稿の編集ロックを取得する。既に自分が保持している場合は有効期限を延長する
+func (u *AcquirePostLockUsecase) Execute(ctx context.Context, input *AcquirePostLockInput) (*PostLockOutput, error) {
+	ctx, span := startSpan(ctx, "AcquirePostLockUsecase.Execute")
+	defer span.End()
+
+	if _, err := u.postRepository.Get(ctx, input.PostID); err != nil {
+		return nil, valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_post"))
+	}
+
+	lock := entity.NewPostLock(input.PostID, input.UserID, time.Now())
+	if err := u.postLockRepository.Acquire(ctx, lock); err != nil {
+		return nil, valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_acquire_post_lock"))
+	}
+
+	return toPostLockOutput(lock), nil
+}
+
+func toPostLockOutput(lock *entity.PostLock) *PostLockOutput {
+	if lock == nil {
+		return nil
+	}
+	return &PostLockOutput{
+		PostID:     lock.PostID,
+		UserID:     lock.UserID,
+		AcquiredAt: lock.AcquiredAt,
+		ExpiresAt:  lock.ExpiresAt,
+	}
+}
@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	repositoryMock "github.com/MizukiShigi/cms-go/mocks/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAcquirePostLockUsecase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPostRepo := repositoryMock.NewMockPostRepository(ctrl)
+	mockPostLockRepo := repositoryMock.NewMockPostLockRepository(ctrl)
+
+	t.Run("編集ロックの取得が成功する", func(t *testing.T) {
+		usecase := NewAcquirePostLockUsecase(mockPostRepo, mockPostLockRepo)
+
+		postID := valueobject.NewPostID()
+		userID := valueobject.NewUserID()
+		title, _ := valueobject.NewPostTitle("タイトル")
+		content, _ := valueobject.NewPostContent("内容")
+		post, _ := entity.NewPost(title, content, userID, valueobject.StatusDraft)
+		post.ID = postID
+
+		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(post, nil)
+		mockPostLockRepo.EXPECT().Acquire(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, lock *entity.PostLock) error {
+				assert.Equal(t, postID, lock.PostID)
+				assert.Equal(t, userID, lock.UserID)
+				return nil
+			})
+
+		output, err := usecase.Execute(context.Background(), &AcquirePostLockInput{PostID: postID, UserID: userID})
+
+		assert.NoError(t, err)
+		assert.Equal(t, postID, output.PostID)
+		assert.Equal(t, userID, output.UserID)
+		assert.Equal(t, entity.PostLockDuration, output.ExpiresAt.Sub(output.AcquiredAt))
+	})
+
+	t.Run("同じユーザーの再取得では取得日時が維持される", func(t *testing.T) {
+		usecase := NewAcquirePostLockUsecase(mockPostRepo, mockPostLockRepo)
+
+		postID := valueobject.NewPostID()
+		userID := valueobject.NewUserID()
+		title, _ := valueobject.NewPostTitle("タイトル")
+		content, _ := valueobject.NewPostContent("内容")
+		post, _ := entity.NewPost(title, content, userID, valueobject.StatusDraft)
+		post.ID = postID
+
+		acquiredAt := time.Now().Add(-2 * time.Minute)
+
+		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(post, nil)
+		mockPostLockRepo.EXPECT().Acquire(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, lock *entity.PostLock) error {
+				lock.AcquiredAt = acquiredAt
+				return nil
+			})
+
+		output, err := usecase.Execute(context.Background(), &AcquirePostLockInput{PostID: postID, UserID: userID})
+
+		assert.NoError(t, err)
+		assert.Equal(t, acquiredAt, output.AcquiredAt)
+	})
+
+	t.Run("他のユーザーがロック中の場合にエラーが発生する", func(t *testing.T) {
+		usecase := NewAcquirePostLockUsecase(mockPostRepo, mockPostLockRepo)
+
+		postID := valueobject.NewPostID()
+		title, _ := valueobject.NewPostTitle("タイトル")
+		content, _ := valueobject.NewPostContent("内容")
+		post, _ := entity.NewPost(title, content, valueobject.NewUserID(), valueobject.StatusDraft)
+		post.ID = postID
+
+		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(post, nil)
+		mockPostLockRepo.EXPECT().Acquire(context.Background(), gomock.Any()).
+			Return(valueobject.NewMyError(valueobject.ConflictCode, "Post is locked by another user"))
+
+		output, err := usecase.Execute(context.Background(), &AcquirePostLockInput{PostID: postID, UserID: valueobject.NewUserID()})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, valueobject.ConflictCode, myErr.Code)
+	})
+
+	t.Run("投稿が存在しない場合にエラーが発生する", func(t *testing.T) {
+		usecase := NewAcquirePostLockUsecase(mockPostRepo, mockPostLockRepo)
+
+		postID := valueobject.NewPostID()
+
+		mockPostRepo.EXPECT().Get(context.Background(), postID).
+			Return(nil, valueobject.NewMyError(valueobject.NotFoundCode, "Post not found"))
+
+		output, err := usecase.Execute(context.Background(), &AcquirePostLockInput{PostID: postID, UserID: valueobject.NewUserID()})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, valueobject.NotFoundCode, myErr.Code)
+	})
+}
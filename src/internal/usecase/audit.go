@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"context"
+
+	domaincontext "github.com/MizukiShigi/cms-go/internal/domain/context"
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/repository"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+// recordAudit は認証ユーザーとリクエストIDをコンテキストから取得して監査イベントを記録する
+// 変更操作と同じトランザクション内で呼び出す。更新で差分がない場合は記録しない
+func recordAudit(
+	ctx context.Context,
+	auditEventRepository repository.AuditEventRepository,
+	entityType valueobject.AuditEntityType,
+	entityID string,
+	action valueobject.AuditAction,
+	before entity.AuditFields,
+	after entity.AuditFields,
+) error {
+	var actorID *valueobject.UserID
+	if ctxUserID, err := domaincontext.GetUserID(ctx); err == nil {
+		if userID, err := valueobject.ParseUserID(ctxUserID); err == nil {
+			actorID = &userID
+		}
+	}
+
+	event := entity.NewAuditEvent(actorID, domaincontext.GetRequestID(ctx), entityType, entityID, action, before, after)
+	if action == valueobject.AuditActionUpdate && !event.HasChanges() {
+		return nil
+	}
+
+	if err := auditEventRepository.Create(ctx, event); err != nil {
+		return valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_create_audit_event"))
+	}
+	return nil
+}
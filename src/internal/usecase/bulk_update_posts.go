@@ -0,0 +1,358 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	domaincontext "github.com/MizukiShigi/cms-go/internal/domain/context"
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/repository"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+// MaxBulkPostCount は一括操作で一度に指定できる投稿数の上限
+const MaxBulkPostCount = 100
+
+// BulkPostResultStatus は一括操作の投稿ごとの結果
+type BulkPostResultStatus string
+
+const (
+	BulkPostResultSuccess BulkPostResultStatus = "success"
+	// 入力値や遷移ルールに反する場合
+	BulkPostResultInvalid   BulkPostResultStatus = "invalid"
+	BulkPostResultForbidden BulkPostResultStatus = "forbidden"
+	BulkPostResultNotFound  BulkPostResultStatus = "not_found"
+	// 他のユーザーが編集ロックを保持している場合など
+	BulkPostResultConflict BulkPostResultStatus = "conflict"
+	BulkPostResultFailed   BulkPostResultStatus = "failed"
+	// 一括適用モードで他の投稿が失敗したため変更しなかった場合
+	BulkPostResultSkipped BulkPostResultStatus = "skipped"
+)
+
+type BulkUpdatePostsInput struct {
+	PostIDs   []valueobject.PostID
+	Operation valueobject.BulkPostOperation
+	// set_status の場合のみ指定する
+	Status *valueobject.PostStatus
+	// add_tags / remove_tags の場合のみ指定する
+	Tags []valueobject.TagName
+	// trueの場合は1件でも失敗したらどの投稿も変更しない
+	AllOrNothing bool
+	// 操作者（編集ロックとステータス遷移の権限確認に使用する）
+	UserID valueobject.UserID
+	Roles  []valueobject.Role
+}
+
+type BulkPostResult struct {
+	ID     valueobject.PostID
+	Status BulkPostResultStatus
+	// 成功・スキップ時は nil
+	Error *valueobject.MyError
+	// 成功時の投稿のバージョン
+	Version int
+}
+
+type BulkUpdatePostsOutput struct {
+	// 指定された投稿の順に並ぶ
+	Results   []*BulkPostResult
+	Succeeded int
+	Failed    int
+}
+
+type BulkUpdatePostsUsecase struct {
+	transactionManager     repository.TransactionManager
+	postRepository         repository.PostRepository
+	tagRepository          repository.TagRepository
+	postLockRepository     repository.PostLockRepository
+	historyRepository      repository.PostWorkflowHistoryRepository
+	postStateMachine       *entity.PostStateMachine
+	auditEventRepository   repository.AuditEventRepository
+	webhookEventRepository repository.WebhookEventRepository
+}
+
+func NewBulkUpdatePostsUsecase(transactionManager repository.TransactionManager, postRepository repository.PostRepository, tagRepository repository.TagRepository, postLockRepository repository.PostLockRepository, historyRepository repository.PostWorkflowHistoryRepository, postStateMachine *entity.PostStateMachine, auditEventRepository repository.AuditEventRepository, webhookEventRepository repository.WebhookEventRepository) *BulkUpdatePostsUsecase {
+	return &BulkUpdatePostsUsecase{
+		transactionManager:     transactionManager,
+		postRepository:         postRepository,
+		tagRepository:          tagRepository,
+		postLockRepository:     postLockRepository,
+		historyRepository:      historyRepository,
+		postStateMachine:       postStateMachine,
+		auditEventRepository:   auditEventRepository,
+		webhookEventRepository: webhookEventRepository,
+	}
+}
+
+// bulkPostChange は保存前の投稿ごとの変更内容
+type bulkPostChange struct {
+	result      *BulkPostResult
+	post        *entity.Post
+	userID      valueobject.UserID
+	fromStatus  valueobject.PostStatus
+	before      entity.AuditFields
+	history     *entity.PostWorkflowHistory
+	tagsChanged bool
+}
+
+func (u *BulkUpdatePostsUsecase) Execute(ctx context.Context, input *BulkUpdatePostsInput) (*BulkUpdatePostsOutput, error) {
+	ctx, span := startSpan(ctx, "BulkUpdatePostsUsecase.Execute")
+	defer span.End()
+
+	if err := validateBulkUpdatePostsInput(input); err != nil {
+		return nil, err
+	}
+
+	// すべての投稿に変更を適用してから保存する（一括適用モードで保存前に失敗を検出するため）
+	results := make([]*BulkPostResult, 0, len(input.PostIDs))
+	changes := make([]*bulkPostChange, 0, len(input.PostIDs))
+	hasFailure := false
+	for _, postID := range input.PostIDs {
+		result := &BulkPostResult{ID: postID}
+		results = append(results, result)
+
+		change, err := u.prepare(ctx, input, postID)
+		if err != nil {
+			setBulkPostError(result, err)
+			hasFailure = true
+			continue
+		}
+		change.result = result
+		changes = append(changes, change)
+	}
+
+	if input.AllOrNothing {
+		if hasFailure {
+			for _, change := range changes {
+				change.result.Status = BulkPostResultSkipped
+			}
+			return newBulkUpdatePostsOutput(results), nil
+		}
+		u.saveAll(ctx, changes)
+		return newBulkUpdatePostsOutput(results), nil
+	}
+
+	// 投稿ごとにコミットし、失敗した投稿以外の変更は残す
+	for _, change := range changes {
+		err := u.transactionManager.Transaction(ctx, func(ctx context.Context) error {
+			return u.save(ctx, change)
+		})
+		if err != nil {
+			setBulkPostError(change.result, err)
+			continue
+		}
+		setBulkPostSuccess(change)
+	}
+
+	return newBulkUpdatePostsOutput(results), nil
+}
+
+// saveAll はすべての変更を1つのトランザクションで保存し、1件でも失敗した場合はすべて取り消す
+func (u *BulkUpdatePostsUsecase) saveAll(ctx context.Context, changes []*bulkPostChange) {
+	var failed *bulkPostChange
+	err := u.transactionManager.Transaction(ctx, func(ctx context.Context) error {
+		for _, change := range changes {
+			if err := u.save(ctx, change); err != nil {
+				failed = change
+				return err
+			}
+		}
+		return nil
+	})
+	if err == nil {
+		for _, change := range changes {
+			setBulkPostSuccess(change)
+		}
+		return
+	}
+
+	for _, change := range changes {
+		if change == failed || failed == nil {
+			// コミットの失敗など特定の投稿によらない場合はすべて失敗とする
+			setBulkPostError(change.result, err)
+			continue
+		}
+		change.result.Status = BulkPostResultSkipped
+	}
+}
+
+// prepare は投稿を取得して操作を適用する（保存はしない）
+func (u *BulkUpdatePostsUsecase) prepare(ctx context.Context, input *BulkUpdatePostsInput, postID valueobject.PostID) (*bulkPostChange, error) {
+	post, err := u.postRepository.Get(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+
+	change := &bulkPostChange{
+		post:       post,
+		userID:     input.UserID,
+		fromStatus: post.Status,
+		before:     post.AuditFields(),
+	}
+	beforeTags := slices.Clone(post.Tags)
+
+	actor := entity.NewActor(input.UserID, input.Roles)
+	switch input.Operation {
+	case valueobject.BulkPostSetStatus:
+		err = u.postStateMachine.Transit(post, *input.Status, actor)
+	case valueobject.BulkPostDelete:
+		err = u.postStateMachine.Transit(post, valueobject.StatusDeleted, actor)
+	case valueobject.BulkPostRestore:
+		if post.Status != valueobject.StatusDeleted {
+			return nil, valueobject.NewMyError(valueobject.InvalidCode, "only_deleted_posts_can_be_restored")
+		}
+		err = u.postStateMachine.Transit(post, valueobject.StatusDraft, actor)
+	case valueobject.BulkPostAddTags:
+		// 既に付いているタグの追加は無視する
+		for _, tag := range input.Tags {
+			if post.HasTag(tag) {
+				continue
+			}
+			if err = post.AddTag(tag); err != nil {
+				break
+			}
+		}
+	case valueobject.BulkPostRemoveTags:
+		for _, tag := range input.Tags {
+			post.RemoveTag(tag)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	change.tagsChanged = !slices.Equal(beforeTags, post.Tags)
+	if !post.Status.Equals(change.fromStatus) {
+		change.history, err = entity.NewPostWorkflowHistory(post.ID, change.fromStatus, post.Status, input.UserID, "")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return change, nil
+}
+
+// save は変更を保存する。トランザクション内で呼び出す
+func (u *BulkUpdatePostsUsecase) save(ctx context.Context, change *bulkPostChange) error {
+	post := change.post
+
+	// 他のユーザーが編集ロックを保持している投稿は変更できない
+	if err := checkPostEditable(ctx, u.postLockRepository, post.ID, change.userID, time.Now()); err != nil {
+		return err
+	}
+
+	after := post.AuditFields()
+	changed := len(entity.DiffAuditFields(change.before, after)) > 0
+	// 変更がない場合はバージョンを進めない
+	if !changed {
+		return nil
+	}
+
+	if err := u.postRepository.Update(ctx, post); err != nil {
+		return valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_update_post"))
+	}
+	domaincontext.RecordEvents(ctx, post.PullEvents()...)
+
+	if change.history != nil {
+		if err := u.historyRepository.Create(ctx, change.history); err != nil {
+			return valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_create_post_workflow_history"))
+		}
+	}
+
+	if change.tagsChanged {
+		tags := make([]*entity.Tag, 0, len(post.Tags))
+		for _, tagName := range post.Tags {
+			tag, err := u.tagRepository.FindOrCreateByName(ctx, entity.NewTagWithName(tagName))
+			if err != nil {
+				return valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_find_or_create_tag"))
+			}
+			// 表記揺れのあるタグ名は同一タグに解決されるため重複を除く
+			if slices.ContainsFunc(tags, func(t *entity.Tag) bool { return t.ID.Equals(tag.ID) }) {
+				continue
+			}
+			tags = append(tags, tag)
+		}
+
+		if err := u.postRepository.SetTags(ctx, post, tags); err != nil {
+			return valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_set_tags"))
+		}
+	}
+
+	action := valueobject.AuditActionUpdate
+	if change.history != nil {
+		action = valueobject.AuditActionStatusChange
+	}
+	if err := recordAudit(ctx, u.auditEventRepository, valueobject.AuditEntityPost, post.ID.String(), action, change.before, after); err != nil {
+		return err
+	}
+
+	return enqueuePostWebhookEvent(ctx, u.webhookEventRepository, change.fromStatus, post, changed)
+}
+
+func validateBulkUpdatePostsInput(input *BulkUpdatePostsInput) error {
+	if len(input.PostIDs) == 0 {
+		return valueobject.NewMyError(valueobject.InvalidCode, "post_ids_are_required")
+	}
+	if len(input.PostIDs) > MaxBulkPostCount {
+		return valueobject.NewMyError(valueobject.InvalidCode, "too_many_posts", MaxBulkPostCount)
+	}
+	for i, postID := range input.PostIDs {
+		if slices.Contains(input.PostIDs[:i], postID) {
+			return valueobject.NewMyError(valueobject.InvalidCode, "duplicate_post_id")
+		}
+	}
+
+	switch input.Operation {
+	case valueobject.BulkPostSetStatus:
+		if input.Status == nil {
+			return valueobject.NewMyError(valueobject.InvalidCode, "status_is_required_for_set_status")
+		}
+	case valueobject.BulkPostAddTags, valueobject.BulkPostRemoveTags:
+		if len(input.Tags) == 0 {
+			return valueobject.NewMyError(valueobject.InvalidCode, "tags_required_for_operation", input.Operation)
+		}
+	}
+	return nil
+}
+
+func setBulkPostSuccess(change *bulkPostChange) {
+	change.result.Status = BulkPostResultSuccess
+	change.result.Version = change.post.Version
+}
+
+// setBulkPostError はエラーの種類に応じて投稿ごとの結果を設定する
+func setBulkPostError(result *BulkPostResult, err error) {
+	var myErr *valueobject.MyError
+	if !errors.As(err, &myErr) {
+		myErr = valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_update_post")
+	}
+
+	result.Error = myErr
+	switch myErr.Code {
+	case valueobject.InvalidCode:
+		result.Status = BulkPostResultInvalid
+	case valueobject.ForbiddenCode:
+		result.Status = BulkPostResultForbidden
+	case valueobject.NotFoundCode:
+		result.Status = BulkPostResultNotFound
+	case valueobject.ConflictCode:
+		result.Status = BulkPostResultConflict
+	default:
+		result.Status = BulkPostResultFailed
+	}
+}
+
+func newBulkUpdatePostsOutput(results []*BulkPostResult) *BulkUpdatePostsOutput {
+	output := &BulkUpdatePostsOutput{Results: results}
+	for _, result := range results {
+		switch result.Status {
+		case BulkPostResultSuccess:
+			output.Succeeded++
+		case BulkPostResultSkipped:
+		default:
+			output.Failed++
+		}
+	}
+	return output
+}
@@ -0,0 +1,315 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	repositoryMock "github.com/MizukiShigi/cms-go/mocks/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestBulkUpdatePostsUsecase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTransactionManager := repositoryMock.NewMockTransactionManager(ctrl)
+	mockPostRepo := repositoryMock.NewMockPostRepository(ctrl)
+	mockTagRepo := repositoryMock.NewMockTagRepository(ctrl)
+	mockPostLockRepo := repositoryMock.NewMockPostLockRepository(ctrl)
+	mockHistoryRepo := repositoryMock.NewMockPostWorkflowHistoryRepository(ctrl)
+	mockAuditRepo := repositoryMock.NewMockAuditEventRepository(ctrl)
+	mockWebhookEventRepo := repositoryMock.NewMockWebhookEventRepository(ctrl)
+
+	title, _ := valueobject.NewPostTitle("タイトル")
+	content, _ := valueobject.NewPostContent("内容")
+	userID := valueobject.NewUserID()
+
+	newPost := func(status valueobject.PostStatus) *entity.Post {
+		post, _ := entity.NewPost(title, content, userID, status)
+		post.PullEvents()
+		return post
+	}
+
+	t.Run("投稿ごとにコミットし、成功と失敗の結果を返す", func(t *testing.T) {
+		usecase := NewBulkUpdatePostsUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockHistoryRepo, entity.DefaultPostStateMachine(), mockAuditRepo, mockWebhookEventRepo)
+
+		draftPost := newPost(valueobject.StatusDraft)
+		publishedPost := newPost(valueobject.StatusPublished)
+		missingPostID := valueobject.NewPostID()
+
+		input := &BulkUpdatePostsInput{
+			PostIDs:   []valueobject.PostID{draftPost.ID, publishedPost.ID, missingPostID},
+			Operation: valueobject.BulkPostDelete,
+			UserID:    userID,
+		}
+
+		mockPostRepo.EXPECT().Get(context.Background(), draftPost.ID).Return(draftPost, nil)
+		mockPostLockRepo.EXPECT().FindActiveForUpdate(context.Background(), draftPost.ID, gomock.Any()).Return(nil, nil)
+		mockPostRepo.EXPECT().Get(context.Background(), publishedPost.ID).Return(publishedPost, nil)
+		mockPostRepo.EXPECT().Get(context.Background(), missingPostID).
+			Return(nil, valueobject.NewMyError(valueobject.NotFoundCode, "Post not found"))
+
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockPostRepo.EXPECT().Update(ctx, draftPost).DoAndReturn(func(_ context.Context, post *entity.Post) error {
+					post.Version++
+					return nil
+				})
+				mockHistoryRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				mockAuditRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				return fn(ctx)
+			})
+
+		output, err := usecase.Execute(context.Background(), input)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, output.Succeeded)
+		assert.Equal(t, 2, output.Failed)
+		assert.Equal(t, BulkPostResultSuccess, output.Results[0].Status)
+		assert.Equal(t, 2, output.Results[0].Version)
+		assert.Equal(t, valueobject.StatusDeleted, draftPost.Status)
+		assert.Equal(t, BulkPostResultInvalid, output.Results[1].Status)
+		assert.Equal(t, "cannot_change_post_status", string(output.Results[1].Error.Key()))
+		assert.Equal(t, []any{valueobject.StatusPublished, valueobject.StatusDeleted}, output.Results[1].Error.Args())
+		assert.Equal(t, BulkPostResultNotFound, output.Results[2].Status)
+	})
+
+	t.Run("遷移の権限がない投稿はforbiddenになる", func(t *testing.T) {
+		usecase := NewBulkUpdatePostsUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockHistoryRepo, entity.DefaultPostStateMachine(), mockAuditRepo, mockWebhookEventRepo)
+
+		approvedPost := newPost(valueobject.StatusApproved)
+		status := valueobject.StatusPublished
+
+		input := &BulkUpdatePostsInput{
+			PostIDs:   []valueobject.PostID{approvedPost.ID},
+			Operation: valueobject.BulkPostSetStatus,
+			Status:    &status,
+			UserID:    userID,
+		}
+
+		mockPostRepo.EXPECT().Get(context.Background(), approvedPost.ID).Return(approvedPost, nil)
+
+		output, err := usecase.Execute(context.Background(), input)
+
+		assert.NoError(t, err)
+		assert.Equal(t, BulkPostResultForbidden, output.Results[0].Status)
+		assert.Equal(t, valueobject.ForbiddenCode, output.Results[0].Error.Code)
+		assert.Equal(t, 1, output.Failed)
+	})
+
+	t.Run("タグの一括追加で既に付いているタグは無視する", func(t *testing.T) {
+		usecase := NewBulkUpdatePostsUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockHistoryRepo, entity.DefaultPostStateMachine(), mockAuditRepo, mockWebhookEventRepo)
+
+		goTag, _ := valueobject.NewTagName("Go")
+		newTag, _ := valueobject.NewTagName("新機能")
+		post := newPost(valueobject.StatusDraft)
+		post.Tags = []valueobject.TagName{goTag}
+
+		input := &BulkUpdatePostsInput{
+			PostIDs:   []valueobject.PostID{post.ID},
+			Operation: valueobject.BulkPostAddTags,
+			Tags:      []valueobject.TagName{goTag, newTag},
+			UserID:    userID,
+		}
+
+		mockPostRepo.EXPECT().Get(context.Background(), post.ID).Return(post, nil)
+		mockPostLockRepo.EXPECT().FindActiveForUpdate(context.Background(), post.ID, gomock.Any()).Return(nil, nil)
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockPostRepo.EXPECT().Update(ctx, post).Return(nil)
+				mockTagRepo.EXPECT().FindOrCreateByName(ctx, gomock.Any()).Return(entity.ParseTag(valueobject.NewTagID(), goTag, time.Now(), time.Now()), nil)
+				mockTagRepo.EXPECT().FindOrCreateByName(ctx, gomock.Any()).Return(entity.ParseTag(valueobject.NewTagID(), newTag, time.Now(), time.Now()), nil)
+				mockPostRepo.EXPECT().SetTags(ctx, post, gomock.Len(2)).Return(nil)
+				mockAuditRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				return fn(ctx)
+			})
+
+		output, err := usecase.Execute(context.Background(), input)
+
+		assert.NoError(t, err)
+		assert.Equal(t, BulkPostResultSuccess, output.Results[0].Status)
+		assert.Equal(t, []valueobject.TagName{goTag, newTag}, post.Tags)
+	})
+
+	t.Run("公開中の投稿にタグを追加した場合は更新のWebhookイベントを書き込む", func(t *testing.T) {
+		usecase := NewBulkUpdatePostsUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockHistoryRepo, entity.DefaultPostStateMachine(), mockAuditRepo, mockWebhookEventRepo)
+
+		newTag, _ := valueobject.NewTagName("新機能")
+		post := newPost(valueobject.StatusPublished)
+
+		input := &BulkUpdatePostsInput{
+			PostIDs:   []valueobject.PostID{post.ID},
+			Operation: valueobject.BulkPostAddTags,
+			Tags:      []valueobject.TagName{newTag},
+			UserID:    userID,
+		}
+
+		mockPostRepo.EXPECT().Get(context.Background(), post.ID).Return(post, nil)
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockPostLockRepo.EXPECT().FindActiveForUpdate(ctx, post.ID, gomock.Any()).Return(nil, nil)
+				mockPostRepo.EXPECT().Update(ctx, post).Return(nil)
+				mockTagRepo.EXPECT().FindOrCreateByName(ctx, gomock.Any()).Return(entity.ParseTag(valueobject.NewTagID(), newTag, time.Now(), time.Now()), nil)
+				mockPostRepo.EXPECT().SetTags(ctx, post, gomock.Len(1)).Return(nil)
+				mockAuditRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				mockWebhookEventRepo.EXPECT().Create(ctx, gomock.Cond(func(event *entity.WebhookEvent) bool {
+					return event.EventType == valueobject.WebhookEventPostUpdated
+				})).Return(nil)
+				return fn(ctx)
+			})
+
+		output, err := usecase.Execute(context.Background(), input)
+
+		assert.NoError(t, err)
+		assert.Equal(t, BulkPostResultSuccess, output.Results[0].Status)
+	})
+
+	t.Run("一括適用モードで失敗した投稿がある場合はどの投稿も保存しない", func(t *testing.T) {
+		usecase := NewBulkUpdatePostsUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockHistoryRepo, entity.DefaultPostStateMachine(), mockAuditRepo, mockWebhookEventRepo)
+
+		draftPost := newPost(valueobject.StatusDraft)
+		lockedPost := newPost(valueobject.StatusDraft)
+		lock := entity.NewPostLock(lockedPost.ID, valueobject.NewUserID(), time.Now())
+
+		input := &BulkUpdatePostsInput{
+			PostIDs:      []valueobject.PostID{draftPost.ID, lockedPost.ID},
+			Operation:    valueobject.BulkPostDelete,
+			AllOrNothing: true,
+			UserID:       userID,
+		}
+
+		mockPostRepo.EXPECT().Get(context.Background(), draftPost.ID).Return(draftPost, nil)
+		mockPostRepo.EXPECT().Get(context.Background(), lockedPost.ID).Return(lockedPost, nil)
+		// ロックは保存のトランザクション内で確認され、ロールバックにより先に保存した投稿も取り消される
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockPostLockRepo.EXPECT().FindActiveForUpdate(ctx, draftPost.ID, gomock.Any()).Return(nil, nil)
+				mockPostRepo.EXPECT().Update(ctx, draftPost).Return(nil)
+				mockHistoryRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				mockAuditRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				mockPostLockRepo.EXPECT().FindActiveForUpdate(ctx, lockedPost.ID, gomock.Any()).Return(lock, nil)
+				return fn(ctx)
+			})
+
+		output, err := usecase.Execute(context.Background(), input)
+
+		assert.NoError(t, err)
+		assert.Equal(t, BulkPostResultSkipped, output.Results[0].Status)
+		assert.Nil(t, output.Results[0].Error)
+		assert.Equal(t, BulkPostResultConflict, output.Results[1].Status)
+		assert.Equal(t, 0, output.Succeeded)
+		assert.Equal(t, 1, output.Failed)
+	})
+
+	t.Run("一括適用モードで保存に失敗した場合はすべて取り消す", func(t *testing.T) {
+		usecase := NewBulkUpdatePostsUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockHistoryRepo, entity.DefaultPostStateMachine(), mockAuditRepo, mockWebhookEventRepo)
+
+		firstPost := newPost(valueobject.StatusDeleted)
+		secondPost := newPost(valueobject.StatusDeleted)
+
+		input := &BulkUpdatePostsInput{
+			PostIDs:      []valueobject.PostID{firstPost.ID, secondPost.ID},
+			Operation:    valueobject.BulkPostRestore,
+			AllOrNothing: true,
+			UserID:       userID,
+		}
+
+		mockPostRepo.EXPECT().Get(context.Background(), firstPost.ID).Return(firstPost, nil)
+		mockPostLockRepo.EXPECT().FindActiveForUpdate(context.Background(), firstPost.ID, gomock.Any()).Return(nil, nil)
+		mockPostRepo.EXPECT().Get(context.Background(), secondPost.ID).Return(secondPost, nil)
+		mockPostLockRepo.EXPECT().FindActiveForUpdate(context.Background(), secondPost.ID, gomock.Any()).Return(nil, nil)
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockPostRepo.EXPECT().Update(ctx, firstPost).Return(nil)
+				mockHistoryRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				mockAuditRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				mockPostRepo.EXPECT().Update(ctx, secondPost).Return(valueobject.NewStaleVersionError("Post has been modified by another request"))
+				return fn(ctx)
+			})
+
+		output, err := usecase.Execute(context.Background(), input)
+
+		assert.NoError(t, err)
+		assert.Equal(t, BulkPostResultSkipped, output.Results[0].Status)
+		assert.Equal(t, BulkPostResultConflict, output.Results[1].Status)
+		assert.Equal(t, 0, output.Succeeded)
+	})
+
+	t.Run("削除されていない投稿は復元できない", func(t *testing.T) {
+		usecase := NewBulkUpdatePostsUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockHistoryRepo, entity.DefaultPostStateMachine(), mockAuditRepo, mockWebhookEventRepo)
+
+		post := newPost(valueobject.StatusDraft)
+
+		input := &BulkUpdatePostsInput{
+			PostIDs:   []valueobject.PostID{post.ID},
+			Operation: valueobject.BulkPostRestore,
+			UserID:    userID,
+		}
+
+		mockPostRepo.EXPECT().Get(context.Background(), post.ID).Return(post, nil)
+
+		output, err := usecase.Execute(context.Background(), input)
+
+		assert.NoError(t, err)
+		assert.Equal(t, BulkPostResultInvalid, output.Results[0].Status)
+		assert.Equal(t, "only_deleted_posts_can_be_restored", string(output.Results[0].Error.Key()))
+	})
+
+	t.Run("入力が不正な場合はエラーが発生する", func(t *testing.T) {
+		usecase := NewBulkUpdatePostsUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockHistoryRepo, entity.DefaultPostStateMachine(), mockAuditRepo, mockWebhookEventRepo)
+
+		postID := valueobject.NewPostID()
+		tooManyPostIDs := make([]valueobject.PostID, 0, MaxBulkPostCount+1)
+		for range MaxBulkPostCount + 1 {
+			tooManyPostIDs = append(tooManyPostIDs, valueobject.NewPostID())
+		}
+
+		tests := []struct {
+			name        string
+			input       *BulkUpdatePostsInput
+			expectedErr string
+		}{
+			{
+				name:        "投稿IDが未指定",
+				input:       &BulkUpdatePostsInput{Operation: valueobject.BulkPostDelete},
+				expectedErr: "post_ids_are_required",
+			},
+			{
+				name:        "上限を超える投稿数",
+				input:       &BulkUpdatePostsInput{PostIDs: tooManyPostIDs, Operation: valueobject.BulkPostDelete},
+				expectedErr: "too_many_posts",
+			},
+			{
+				name:        "投稿IDの重複",
+				input:       &BulkUpdatePostsInput{PostIDs: []valueobject.PostID{postID, postID}, Operation: valueobject.BulkPostDelete},
+				expectedErr: "duplicate_post_id",
+			},
+			{
+				name:        "ステータス変更でステータスが未指定",
+				input:       &BulkUpdatePostsInput{PostIDs: []valueobject.PostID{postID}, Operation: valueobject.BulkPostSetStatus},
+				expectedErr: "status_is_required_for_set_status",
+			},
+			{
+				name:        "タグ追加でタグが未指定",
+				input:       &BulkUpdatePostsInput{PostIDs: []valueobject.PostID{postID}, Operation: valueobject.BulkPostAddTags},
+				expectedErr: "tags_required_for_operation",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				output, err := usecase.Execute(context.Background(), tt.input)
+
+				assert.Nil(t, output)
+				var myErr *valueobject.MyError
+				assert.ErrorAs(t, err, &myErr)
+				assert.Equal(t, valueobject.InvalidCode, myErr.Code)
+				assert.Equal(t, tt.expectedErr, string(myErr.Key()))
+			})
+		}
+	})
+}
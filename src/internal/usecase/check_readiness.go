@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/service"
+)
+
+const (
+	// DefaultHealthCheckTimeout は依存先ごとの確認のタイムアウト
+	DefaultHealthCheckTimeout = 2 * time.Second
+	// DefaultHealthCheckCacheTTL は確認結果を再利用する期間（プローブのたびに依存先へアクセスしないため）
+	DefaultHealthCheckCacheTTL = 5 * time.Second
+)
+
+type HealthCheckStatus string
+
+const (
+	HealthCheckOK   HealthCheckStatus = "ok"
+	HealthCheckFail HealthCheckStatus = "fail"
+)
+
+type HealthCheckSettings struct {
+	Timeout  time.Duration
+	CacheTTL time.Duration
+}
+
+type HealthCheckResult struct {
+	Name     string
+	Status   HealthCheckStatus
+	Error    string
+	Duration time.Duration
+	// 確認した日時（キャッシュした結果の場合は確認した時点）
+	CheckedAt time.Time
+}
+
+type CheckReadinessOutput struct {
+	Ready bool
+	// シャットダウン中の場合は依存先を確認せずに準備未完了とする
+	ShuttingDown bool
+	Checks       []HealthCheckResult
+}
+
+type CheckReadinessUsecase struct {
+	checkers []service.HealthChecker
+	settings HealthCheckSettings
+	now      func() time.Time
+
+	shuttingDown atomic.Bool
+	mu           sync.Mutex
+	cache        map[string]HealthCheckResult
+}
+
+func NewCheckReadinessUsecase(checkers []service.HealthChecker, settings HealthCheckSettings) *CheckReadinessUsecase {
+	if settings.Timeout <= 0 {
+		settings.Timeout = DefaultHealthCheckTimeout
+	}
+	if settings.CacheTTL < 0 {
+		settings.CacheTTL = 0
+	}
+	return &CheckReadinessUsecase{
+		checkers: checkers,
+		settings: settings,
+		now:      time.Now,
+		cache:    make(map[string]HealthCheckResult),
+	}
+}
+
+// Execute はすべての依存先を並行して確認し、1つでも失敗した場合は準備未完了とする
+func (u *CheckReadinessUsecase) Execute(ctx context.Context) *CheckReadinessOutput {
+	ctx, span := startSpan(ctx, "CheckReadinessUsecase.Execute")
+	defer span.End()
+
+	if u.shuttingDown.Load() {
+		return &CheckReadinessOutput{Ready: false, ShuttingDown: true, Checks: []HealthCheckResult{}}
+	}
+
+	results := make([]HealthCheckResult, len(u.checkers))
+	var wg sync.WaitGroup
+	for i, checker := range u.checkers {
+		wg.Add(1)
+		go func(i int, checker service.HealthChecker) {
+			defer wg.Done()
+			results[i] = u.check(ctx, checker)
+		}(i, checker)
+	}
+	wg.Wait()
+
+	ready := true
+	for _, result := range results {
+		if result.Status != HealthCheckOK {
+			ready = false
+		}
+	}
+	return &CheckReadinessOutput{Ready: ready, Checks: results}
+}
+
+// SetShuttingDown は以降の確認を準備未完了にする（グレースフルシャットダウン開始時に呼び出す）
+func (u *CheckReadinessUsecase) SetShuttingDown() {
+	u.shuttingDown.Store(true)
+}
+
+func (u *CheckReadinessUsecase) check(ctx context.Context, checker service.HealthChecker) HealthCheckResult {
+	name := checker.Name()
+	now := u.now()
+
+	u.mu.Lock()
+	cached, ok := u.cache[name]
+	u.mu.Unlock()
+	if ok && now.Sub(cached.CheckedAt) < u.settings.CacheTTL {
+		return cached
+	}
+
+	// 結果をキャッシュするため、呼び出し元のリクエストの中断には影響されないようにする
+	checkCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), u.settings.Timeout)
+	defer cancel()
+
+	err := checker.Check(checkCtx)
+	result := HealthCheckResult{
+		Name:      name,
+		Status:    HealthCheckOK,
+		Duration:  u.now().Sub(now),
+		CheckedAt: now,
+	}
+	if err != nil {
+		result.Status = HealthCheckFail
+		result.Error = err.Error()
+	}
+
+	u.mu.Lock()
+	u.cache[name] = result
+	u.mu.Unlock()
+	return result
+}
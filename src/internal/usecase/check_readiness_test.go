@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/service"
+	serviceMock "github.com/MizukiShigi/cms-go/mocks/service"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCheckReadinessUsecase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDatabase := serviceMock.NewMockHealthChecker(ctrl)
+	mockStorage := serviceMock.NewMockHealthChecker(ctrl)
+	mockDatabase.EXPECT().Name().Return("database").AnyTimes()
+	mockStorage.EXPECT().Name().Return("storage").AnyTimes()
+	settings := HealthCheckSettings{Timeout: time.Second, CacheTTL: 5 * time.Second}
+
+	t.Run("すべての依存先に到達できる場合は準備完了", func(t *testing.T) {
+		usecase := NewCheckReadinessUsecase([]service.HealthChecker{mockDatabase, mockStorage}, settings)
+
+		mockDatabase.EXPECT().Check(gomock.Any()).DoAndReturn(func(ctx context.Context) error {
+			_, hasDeadline := ctx.Deadline()
+			assert.True(t, hasDeadline)
+			return nil
+		})
+		mockStorage.EXPECT().Check(gomock.Any()).Return(nil)
+
+		output := usecase.Execute(context.Background())
+
+		assert.True(t, output.Ready)
+		assert.False(t, output.ShuttingDown)
+		assert.Len(t, output.Checks, 2)
+		assert.Equal(t, "database", output.Checks[0].Name)
+		assert.Equal(t, HealthCheckOK, output.Checks[0].Status)
+		assert.Equal(t, "storage", output.Checks[1].Name)
+		assert.Equal(t, HealthCheckOK, output.Checks[1].Status)
+	})
+
+	t.Run("依存先に到達できない場合は準備未完了とエラー内容を返す", func(t *testing.T) {
+		usecase := NewCheckReadinessUsecase([]service.HealthChecker{mockDatabase, mockStorage}, settings)
+
+		mockDatabase.EXPECT().Check(gomock.Any()).Return(nil)
+		mockStorage.EXPECT().Check(gomock.Any()).Return(errors.New("bucket not found"))
+
+		output := usecase.Execute(context.Background())
+
+		assert.False(t, output.Ready)
+		assert.Equal(t, HealthCheckOK, output.Checks[0].Status)
+		assert.Equal(t, HealthCheckFail, output.Checks[1].Status)
+		assert.Equal(t, "bucket not found", output.Checks[1].Error)
+	})
+
+	t.Run("キャッシュ期間内は前回の結果を再利用する", func(t *testing.T) {
+		usecase := NewCheckReadinessUsecase([]service.HealthChecker{mockDatabase}, settings)
+		now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		usecase.now = func() time.Time { return now }
+
+		mockDatabase.EXPECT().Check(gomock.Any()).Return(errors.New("connection refused")).Times(1)
+
+		first := usecase.Execute(context.Background())
+		now = now.Add(4 * time.Second)
+		second := usecase.Execute(context.Background())
+
+		assert.False(t, first.Ready)
+		assert.False(t, second.Ready)
+		assert.Equal(t, first.Checks[0].CheckedAt, second.Checks[0].CheckedAt)
+	})
+
+	t.Run("キャッシュ期間を過ぎた場合は再確認する", func(t *testing.T) {
+		usecase := NewCheckReadinessUsecase([]service.HealthChecker{mockDatabase}, settings)
+		now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		usecase.now = func() time.Time { return now }
+
+		gomock.InOrder(
+			mockDatabase.EXPECT().Check(gomock.Any()).Return(errors.New("connection refused")),
+			mockDatabase.EXPECT().Check(gomock.Any()).Return(nil),
+		)
+
+		first := usecase.Execute(context.Background())
+		now = now.Add(5 * time.Second)
+		second := usecase.Execute(context.Background())
+
+		assert.False(t, first.Ready)
+		assert.True(t, second.Ready)
+		assert.Equal(t, now, second.Checks[0].CheckedAt)
+	})
+
+	t.Run("シャットダウン中は依存先を確認せずに準備未完了とする", func(t *testing.T) {
+		usecase := NewCheckReadinessUsecase([]service.HealthChecker{mockDatabase, mockStorage}, settings)
+
+		usecase.SetShuttingDown()
+		output := usecase.Execute(context.Background())
+
+		assert.False(t, output.Ready)
+		assert.True(t, output.ShuttingDown)
+		assert.Empty(t, output.Checks)
+	})
+}
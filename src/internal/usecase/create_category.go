@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/repository"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+type CreateCategoryInput struct {
+	ParentID    *valueobject.CategoryID
+	Name        valueobject.CategoryName
+	Slug        valueobject.CategorySlug
+	Description string
+	SortOrder   int
+}
+
+type CreateCategoryOutput struct {
+	Category *entity.Category
+}
+
+type CreateCategoryUsecase struct {
+	transactionManager   repository.TransactionManager
+	categoryRepository   repository.CategoryRepository
+	auditEventRepository repository.AuditEventRepository
+}
+
+func NewCreateCategoryUsecase(transactionManager repository.TransactionManager, categoryRepository repository.CategoryRepository, auditEventRepository repository.AuditEventRepository) *CreateCategoryUsecase {
+	return &CreateCategoryUsecase{
+		transactionManager:   transactionManager,
+		categoryRepository:   categoryRepository,
+		auditEventRepository: auditEventRepository,
+	}
+}
+
+func (u *CreateCategoryUsecase) Execute(ctx context.Context, input *CreateCategoryInput) (*CreateCategoryOutput, error) {
+	ctx, span := startSpan(ctx, "CreateCategoryUsecase.Execute")
+	defer span.End()
+
+	category, err := entity.NewCategory(input.Name, input.Slug, input.Description, input.SortOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.ParentID != nil {
+		// 親カテゴリの存在確認
+		if _, err := u.categoryRepository.Get(ctx, *input.ParentID); err != nil {
+			return nil, valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_parent_category"))
+		}
+
+		// 新規カテゴリは子孫を持たないため循環は発生しない
+		if err := category.MoveTo(input.ParentID, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	err = u.transactionManager.Transaction(ctx, func(ctx context.Context) error {
+		if err := u.categoryRepository.Create(ctx, category); err != nil {
+			return err
+		}
+		return recordAudit(ctx, u.auditEventRepository, valueobject.AuditEntityCategory, category.ID.String(), valueobject.AuditActionCreate, nil, category.AuditFields())
+	})
+	if err != nil {
+		return nil, valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_create_category"))
+	}
+
+	return &CreateCategoryOutput{Category: category}, nil
+}
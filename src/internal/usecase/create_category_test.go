@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	repositoryMock "github.com/MizukiShigi/cms-go/mocks/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateCategoryUsecase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTransactionManager := repositoryMock.NewMockTransactionManager(ctrl)
+	mockCategoryRepo := repositoryMock.NewMockCategoryRepository(ctrl)
+	mockAuditRepo := repositoryMock.NewMockAuditEventRepository(ctrl)
+
+	name, _ := valueobject.NewCategoryName("Go言語")
+	slug, _ := valueobject.NewCategorySlug("golang")
+
+	t.Run("ルートカテゴリの作成が成功する", func(t *testing.T) {
+		usecase := NewCreateCategoryUsecase(mockTransactionManager, mockCategoryRepo, mockAuditRepo)
+
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockCategoryRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				mockAuditRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				return fn(ctx)
+			})
+
+		output, err := usecase.Execute(context.Background(), &CreateCategoryInput{
+			Name:        name,
+			Slug:        slug,
+			Description: "Go言語に関する記事",
+			SortOrder:   1,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, name, output.Category.Name)
+		assert.Equal(t, slug, output.Category.Slug)
+		assert.Nil(t, output.Category.ParentID)
+	})
+
+	t.Run("子カテゴリの作成が成功する", func(t *testing.T) {
+		usecase := NewCreateCategoryUsecase(mockTransactionManager, mockCategoryRepo, mockAuditRepo)
+
+		parentName, _ := valueobject.NewCategoryName("技術")
+		parentSlug, _ := valueobject.NewCategorySlug("technology")
+		parent, _ := entity.NewCategory(parentName, parentSlug, "", 0)
+
+		mockCategoryRepo.EXPECT().Get(context.Background(), parent.ID).Return(parent, nil)
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockCategoryRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				mockAuditRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				return fn(ctx)
+			})
+
+		output, err := usecase.Execute(context.Background(), &CreateCategoryInput{
+			ParentID: &parent.ID,
+			Name:     name,
+			Slug:     slug,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, parent.ID, *output.Category.ParentID)
+	})
+
+	t.Run("親カテゴリが存在しない場合にエラーが発生する", func(t *testing.T) {
+		usecase := NewCreateCategoryUsecase(mockTransactionManager, mockCategoryRepo, mockAuditRepo)
+
+		parentID := valueobject.NewCategoryID()
+		mockCategoryRepo.EXPECT().Get(context.Background(), parentID).
+			Return(nil, valueobject.NewMyError(valueobject.NotFoundCode, "Category not found"))
+
+		output, err := usecase.Execute(context.Background(), &CreateCategoryInput{
+			ParentID: &parentID,
+			Name:     name,
+			Slug:     slug,
+		})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, valueobject.NotFoundCode, myErr.Code)
+	})
+
+	t.Run("スラッグが重複する場合にエラーが発生する", func(t *testing.T) {
+		usecase := NewCreateCategoryUsecase(mockTransactionManager, mockCategoryRepo, mockAuditRepo)
+
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockCategoryRepo.EXPECT().Create(ctx, gomock.Any()).
+					Return(valueobject.NewMyError(valueobject.ConflictCode, "Category with this slug already exists"))
+				return fn(ctx)
+			})
+
+		output, err := usecase.Execute(context.Background(), &CreateCategoryInput{
+			Name: name,
+			Slug: slug,
+		})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, valueobject.ConflictCode, myErr.Code)
+	})
+
+	t.Run("ソート順が範囲外の場合にエラーが発生する", func(t *testing.T) {
+		usecase := NewCreateCategoryUsecase(mockTransactionManager, mockCategoryRepo, mockAuditRepo)
+
+		output, err := usecase.Execute(context.Background(), &CreateCategoryInput{
+			Name:      name,
+			Slug:      slug,
+			SortOrder: 1000,
+		})
+
+		assert.Error(t, err)
+		assert.Nil(t, output)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"io"
 	"os"
 
+	domaincontext "github.com/MizukiShigi/cms-go/internal/domain/context"
 	"github.com/MizukiShigi/cms-go/internal/domain/entity"
 	"github.com/MizukiShigi/cms-go/internal/domain/repository"
 	"github.com/MizukiShigi/cms-go/internal/domain/service"
@@ -30,15 +31,25 @@ type CreateImageOutput struct {
 }
 
 type CreateImageUsecase struct {
-	imageRepository repository.ImageRepository
-	storageService  service.StorageService
+	transactionManager   repository.TransactionManager
+	imageRepository      repository.ImageRepository
+	storageService       service.StorageService
+	auditEventRepository repository.AuditEventRepository
 }
 
-func NewCreateImageUsecase(imageRepository repository.ImageRepository, storageService service.StorageService) *CreateImageUsecase {
-	return &CreateImageUsecase{imageRepository: imageRepository, storageService: storageService}
+func NewCreateImageUsecase(transactionManager repository.TransactionManager, imageRepository repository.ImageRepository, storageService service.StorageService, auditEventRepository repository.AuditEventRepository) *CreateImageUsecase {
+	return &CreateImageUsecase{
+		transactionManager:   transactionManager,
+		imageRepository:      imageRepository,
+		storageService:       storageService,
+		auditEventRepository: auditEventRepository,
+	}
 }
 
 func (u *CreateImageUsecase) Execute(ctx context.Context, input *CreateImageInput) (*CreateImageOutput, error) {
+	ctx, span := startSpan(ctx, "CreateImageUsecase.Execute")
+	defer span.End()
+
 	bucketName := os.Getenv("GCS_IMAGE_BUCKET_NAME")
 	uploadResult, err := u.storageService.UploadImage(ctx, bucketName, input.OriginalFilename, input.File)
 	if err != nil {
@@ -46,7 +57,13 @@ func (u *CreateImageUsecase) Execute(ctx context.Context, input *CreateImageInpu
 	}
 
 	image := entity.NewImage(input.OriginalFilename, uploadResult.StoredFilename, uploadResult.URL, input.PostID, input.UserID, input.SortOrder)
-	err = u.imageRepository.Create(ctx, image)
+	err = u.transactionManager.Transaction(ctx, func(ctx context.Context) error {
+		if err := u.imageRepository.Create(ctx, image); err != nil {
+			return err
+		}
+		domaincontext.RecordEvents(ctx, image.PullEvents()...)
+		return recordAudit(ctx, u.auditEventRepository, valueobject.AuditEntityImage, image.ID.String(), valueobject.AuditActionUpload, nil, image.AuditFields())
+	})
 	if err != nil {
 		return nil, err
 	}
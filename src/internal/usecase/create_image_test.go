@@ -23,11 +23,13 @@ func TestCreateImageUsecase_Execute(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
+	mockTransactionManager := repositoryMock.NewMockTransactionManager(ctrl)
 	mockImageRepo := repositoryMock.NewMockImageRepository(ctrl)
 	mockStorageService := serviceMock.NewMockStorageService(ctrl)
+	mockAuditRepo := repositoryMock.NewMockAuditEventRepository(ctrl)
 
 	t.Run("画像作成が成功する（JPG）", func(t *testing.T) {
-		usecase := NewCreateImageUsecase(mockImageRepo, mockStorageService)
+		usecase := NewCreateImageUsecase(mockTransactionManager, mockImageRepo, mockStorageService, mockAuditRepo)
 
 		// テストデータ準備
 		userID := valueobject.NewUserID()
@@ -54,6 +56,12 @@ func TestCreateImageUsecase_Execute(t *testing.T) {
 			UploadImage(context.Background(), "test-bucket", filename, fileReader).
 			Return(uploadResult, nil)
 
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+		mockAuditRepo.EXPECT().Create(context.Background(), gomock.Any()).Return(nil)
+
 		mockImageRepo.EXPECT().
 			Create(context.Background(), gomock.Any()).
 			Return(nil)
@@ -74,7 +82,7 @@ func TestCreateImageUsecase_Execute(t *testing.T) {
 	})
 
 	t.Run("画像作成が成功する（PNG）", func(t *testing.T) {
-		usecase := NewCreateImageUsecase(mockImageRepo, mockStorageService)
+		usecase := NewCreateImageUsecase(mockTransactionManager, mockImageRepo, mockStorageService, mockAuditRepo)
 
 		userID := valueobject.NewUserID()
 		postID := valueobject.NewPostID()
@@ -99,6 +107,12 @@ func TestCreateImageUsecase_Execute(t *testing.T) {
 			UploadImage(context.Background(), "test-bucket", filename, fileReader).
 			Return(uploadResult, nil)
 
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+		mockAuditRepo.EXPECT().Create(context.Background(), gomock.Any()).Return(nil)
+
 		mockImageRepo.EXPECT().
 			Create(context.Background(), gomock.Any()).
 			Return(nil)
@@ -111,7 +125,7 @@ func TestCreateImageUsecase_Execute(t *testing.T) {
 	})
 
 	t.Run("画像作成が成功する（WebP）", func(t *testing.T) {
-		usecase := NewCreateImageUsecase(mockImageRepo, mockStorageService)
+		usecase := NewCreateImageUsecase(mockTransactionManager, mockImageRepo, mockStorageService, mockAuditRepo)
 
 		userID := valueobject.NewUserID()
 		postID := valueobject.NewPostID()
@@ -136,6 +150,12 @@ func TestCreateImageUsecase_Execute(t *testing.T) {
 			UploadImage(context.Background(), "test-bucket", filename, fileReader).
 			Return(uploadResult, nil)
 
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+		mockAuditRepo.EXPECT().Create(context.Background(), gomock.Any()).Return(nil)
+
 		mockImageRepo.EXPECT().
 			Create(context.Background(), gomock.Any()).
 			Return(nil)
@@ -147,7 +167,7 @@ func TestCreateImageUsecase_Execute(t *testing.T) {
 	})
 
 	t.Run("画像作成が成功する（GIF）", func(t *testing.T) {
-		usecase := NewCreateImageUsecase(mockImageRepo, mockStorageService)
+		usecase := NewCreateImageUsecase(mockTransactionManager, mockImageRepo, mockStorageService, mockAuditRepo)
 
 		userID := valueobject.NewUserID()
 		postID := valueobject.NewPostID()
@@ -172,6 +192,12 @@ func TestCreateImageUsecase_Execute(t *testing.T) {
 			UploadImage(context.Background(), "test-bucket", filename, fileReader).
 			Return(uploadResult, nil)
 
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+		mockAuditRepo.EXPECT().Create(context.Background(), gomock.Any()).Return(nil)
+
 		mockImageRepo.EXPECT().
 			Create(context.Background(), gomock.Any()).
 			Return(nil)
@@ -183,7 +209,7 @@ func TestCreateImageUsecase_Execute(t *testing.T) {
 	})
 
 	t.Run("画像作成が成功する（JPEG）", func(t *testing.T) {
-		usecase := NewCreateImageUsecase(mockImageRepo, mockStorageService)
+		usecase := NewCreateImageUsecase(mockTransactionManager, mockImageRepo, mockStorageService, mockAuditRepo)
 
 		userID := valueobject.NewUserID()
 		postID := valueobject.NewPostID()
@@ -208,6 +234,12 @@ func TestCreateImageUsecase_Execute(t *testing.T) {
 			UploadImage(context.Background(), "test-bucket", filename, fileReader).
 			Return(uploadResult, nil)
 
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+		mockAuditRepo.EXPECT().Create(context.Background(), gomock.Any()).Return(nil)
+
 		mockImageRepo.EXPECT().
 			Create(context.Background(), gomock.Any()).
 			Return(nil)
@@ -219,7 +251,7 @@ func TestCreateImageUsecase_Execute(t *testing.T) {
 	})
 
 	t.Run("ストレージサービスのアップロードに失敗する", func(t *testing.T) {
-		usecase := NewCreateImageUsecase(mockImageRepo, mockStorageService)
+		usecase := NewCreateImageUsecase(mockTransactionManager, mockImageRepo, mockStorageService, mockAuditRepo)
 
 		userID := valueobject.NewUserID()
 		postID := valueobject.NewPostID()
@@ -250,11 +282,11 @@ func TestCreateImageUsecase_Execute(t *testing.T) {
 		var myErr *valueobject.MyError
 		assert.ErrorAs(t, err, &myErr)
 		assert.Equal(t, valueobject.InternalServerErrorCode, myErr.Code)
-		assert.Equal(t, "Storage upload failed", myErr.Message)
+		assert.Equal(t, "Storage upload failed", string(myErr.Key()))
 	})
 
 	t.Run("リポジトリの保存に失敗する", func(t *testing.T) {
-		usecase := NewCreateImageUsecase(mockImageRepo, mockStorageService)
+		usecase := NewCreateImageUsecase(mockTransactionManager, mockImageRepo, mockStorageService, mockAuditRepo)
 
 		userID := valueobject.NewUserID()
 		postID := valueobject.NewPostID()
@@ -280,6 +312,11 @@ func TestCreateImageUsecase_Execute(t *testing.T) {
 			Return(uploadResult, nil)
 
 		// リポジトリの保存で失敗
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
 		mockImageRepo.EXPECT().
 			Create(context.Background(), gomock.Any()).
 			Return(valueobject.NewMyError(valueobject.InternalServerErrorCode, "Database error"))
@@ -292,7 +329,7 @@ func TestCreateImageUsecase_Execute(t *testing.T) {
 		var myErr *valueobject.MyError
 		assert.ErrorAs(t, err, &myErr)
 		assert.Equal(t, valueobject.InternalServerErrorCode, myErr.Code)
-		assert.Equal(t, "Database error", myErr.Message)
+		assert.Equal(t, "Database error", string(myErr.Key()))
 	})
 
 	t.Run("環境変数が設定されていない場合", func(t *testing.T) {
@@ -300,7 +337,7 @@ func TestCreateImageUsecase_Execute(t *testing.T) {
 		os.Unsetenv("GCS_IMAGE_BUCKET_NAME")
 		defer os.Setenv("GCS_IMAGE_BUCKET_NAME", "test-bucket")
 
-		usecase := NewCreateImageUsecase(mockImageRepo, mockStorageService)
+		usecase := NewCreateImageUsecase(mockTransactionManager, mockImageRepo, mockStorageService, mockAuditRepo)
 
 		userID := valueobject.NewUserID()
 		postID := valueobject.NewPostID()
@@ -327,7 +364,7 @@ func TestCreateImageUsecase_Execute(t *testing.T) {
 	})
 
 	t.Run("ソート順序が正しく設定される", func(t *testing.T) {
-		usecase := NewCreateImageUsecase(mockImageRepo, mockStorageService)
+		usecase := NewCreateImageUsecase(mockTransactionManager, mockImageRepo, mockStorageService, mockAuditRepo)
 
 		userID := valueobject.NewUserID()
 		postID := valueobject.NewPostID()
@@ -352,6 +389,12 @@ func TestCreateImageUsecase_Execute(t *testing.T) {
 			UploadImage(context.Background(), "test-bucket", filename, fileReader).
 			Return(uploadResult, nil)
 
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+		mockAuditRepo.EXPECT().Create(context.Background(), gomock.Any()).Return(nil)
+
 		mockImageRepo.EXPECT().
 			Create(context.Background(), gomock.Any()).
 			DoAndReturn(func(ctx context.Context, image interface{}) error {
@@ -367,7 +410,7 @@ func TestCreateImageUsecase_Execute(t *testing.T) {
 	})
 
 	t.Run("ファイルリーダーがnilの場合", func(t *testing.T) {
-		usecase := NewCreateImageUsecase(mockImageRepo, mockStorageService)
+		usecase := NewCreateImageUsecase(mockTransactionManager, mockImageRepo, mockStorageService, mockAuditRepo)
 
 		userID := valueobject.NewUserID()
 		postID := valueobject.NewPostID()
@@ -4,6 +4,7 @@ import (
 	"context"
 	"log/slog"
 
+	domaincontext "github.com/MizukiShigi/cms-go/internal/domain/context"
 	"github.com/MizukiShigi/cms-go/internal/domain/entity"
 	"github.com/MizukiShigi/cms-go/internal/domain/repository"
 	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
@@ -15,34 +16,54 @@ type CreatePostInput struct {
 	Tags    []valueobject.TagName
 	UserID  valueobject.UserID
 	Status  valueobject.PostStatus
+	// カテゴリ指定は任意
+	PrimaryCategoryID *valueobject.CategoryID
+	CategoryIDs       []valueobject.CategoryID
+	SEO               PostSEOInput
 }
 
 type CreatePostOutput struct {
-	ID      valueobject.PostID
-	Title   valueobject.PostTitle
-	Content valueobject.PostContent
-	Tags    []valueobject.TagName
-	UserID  valueobject.UserID
+	ID                valueobject.PostID
+	Title             valueobject.PostTitle
+	Content           valueobject.PostContent
+	Tags              []valueobject.TagName
+	UserID            valueobject.UserID
+	PrimaryCategoryID *valueobject.CategoryID
+	CategoryIDs       []valueobject.CategoryID
+	SEO               PostSEOOutput
 }
 
 type CreatePostUsecase struct {
-	transactionManager repository.TransactionManager
-	postRepository     repository.PostRepository
-	tagRepository      repository.TagRepository
+	transactionManager   repository.TransactionManager
+	postRepository       repository.PostRepository
+	tagRepository        repository.TagRepository
+	auditEventRepository repository.AuditEventRepository
+	// OGP画像の存在確認に使用する
+	imageRepository repository.ImageRepository
 }
 
-func NewCreatePostUsecase(transactionManager repository.TransactionManager, postRepository repository.PostRepository, tagRepository repository.TagRepository) *CreatePostUsecase {
+func NewCreatePostUsecase(transactionManager repository.TransactionManager, postRepository repository.PostRepository, tagRepository repository.TagRepository, auditEventRepository repository.AuditEventRepository, imageRepository repository.ImageRepository) *CreatePostUsecase {
 	return &CreatePostUsecase{
-		transactionManager: transactionManager,
-		postRepository:     postRepository,
-		tagRepository:      tagRepository,
+		transactionManager:   transactionManager,
+		postRepository:       postRepository,
+		tagRepository:        tagRepository,
+		auditEventRepository: auditEventRepository,
+		imageRepository:      imageRepository,
 	}
 }
 
 func (u *CreatePostUsecase) Execute(ctx context.Context, input *CreatePostInput) (*CreatePostOutput, error) {
+	ctx, span := startSpan(ctx, "CreatePostUsecase.Execute")
+	defer span.End()
+
+	// 公開はレビュー承認後に行うため、新規投稿は下書きとしてのみ作成できる
+	if !input.Status.Equals(valueobject.StatusDraft) {
+		return nil, valueobject.NewMyError(valueobject.InvalidCode, "new_posts_must_be_created_as_draft")
+	}
+
 	post, err := entity.NewPost(input.Title, input.Content, input.UserID, input.Status)
 	if err != nil {
-		return nil, valueobject.NewMyError(valueobject.InvalidCode, "Invalid content")
+		return nil, valueobject.NewMyError(valueobject.InvalidCode, "invalid_content")
 	}
 
 	for _, tag := range input.Tags {
@@ -52,28 +73,47 @@ func (u *CreatePostUsecase) Execute(ctx context.Context, input *CreatePostInput)
 		}
 	}
 
+	hasCategories := input.PrimaryCategoryID != nil || len(input.CategoryIDs) > 0
+	if hasCategories {
+		if err := post.SetCategories(input.PrimaryCategoryID, input.CategoryIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := applyPostSEO(ctx, u.imageRepository, post, input.UserID, input.SEO); err != nil {
+		return nil, err
+	}
+
 	transactionErr := u.transactionManager.Transaction(ctx, func(ctx context.Context) error {
 		err = u.postRepository.Create(ctx, post)
 		if err != nil {
 			slog.ErrorContext(ctx, err.Error())
-			return valueobject.NewMyError(valueobject.InternalServerErrorCode, "Failed to create post")
+			return valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_create_post")
 		}
+		domaincontext.RecordEvents(ctx, post.PullEvents()...)
 
 		tags := make([]*entity.Tag, 0, len(post.Tags))
 		for _, tagName := range post.Tags {
 			retTag, err := u.tagRepository.FindOrCreateByName(ctx, entity.NewTagWithName(tagName))
 			if err != nil {
-				return valueobject.NewMyError(valueobject.InternalServerErrorCode, "Failed to create tag")
+				return valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_create_tag")
 			}
 			tags = append(tags, retTag)
 		}
 
 		err = u.postRepository.SetTags(ctx, post, tags)
 		if err != nil {
-			return valueobject.NewMyError(valueobject.InternalServerErrorCode, "Failed to set tags")
+			return valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_set_tags")
+		}
+
+		if hasCategories {
+			err = u.postRepository.SetCategories(ctx, post)
+			if err != nil {
+				return valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_set_categories"))
+			}
 		}
 
-		return nil
+		return recordAudit(ctx, u.auditEventRepository, valueobject.AuditEntityPost, post.ID.String(), valueobject.AuditActionCreate, nil, post.AuditFields())
 	})
 
 	if transactionErr != nil {
@@ -81,10 +121,13 @@ func (u *CreatePostUsecase) Execute(ctx context.Context, input *CreatePostInput)
 	}
 
 	return &CreatePostOutput{
-		ID:      post.ID,
-		Title:   post.Title,
-		Content: post.Content,
-		Tags:    post.Tags,
-		UserID:  post.UserID,
+		ID:                post.ID,
+		Title:             post.Title,
+		Content:           post.Content,
+		Tags:              post.Tags,
+		UserID:            post.UserID,
+		PrimaryCategoryID: post.PrimaryCategoryID,
+		CategoryIDs:       post.CategoryIDs,
+		SEO:               toPostSEOOutput(post),
 	}, nil
 }
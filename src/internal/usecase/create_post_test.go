@@ -4,7 +4,9 @@ import (
 	"context"
 	"testing"
 
+	domaincontext "github.com/MizukiShigi/cms-go/internal/domain/context"
 	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/event"
 	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
 	repositoryMock "github.com/MizukiShigi/cms-go/mocks/repository"
 	"github.com/stretchr/testify/assert"
@@ -18,14 +20,16 @@ func TestCreatePostUsecase_Execute(t *testing.T) {
 	mockTransactionManager := repositoryMock.NewMockTransactionManager(ctrl)
 	mockPostRepo := repositoryMock.NewMockPostRepository(ctrl)
 	mockTagRepo := repositoryMock.NewMockTagRepository(ctrl)
+	mockAuditRepo := repositoryMock.NewMockAuditEventRepository(ctrl)
+	mockImageRepo := repositoryMock.NewMockImageRepository(ctrl)
 
 	t.Run("タグありの投稿作成が成功する", func(t *testing.T) {
-		usecase := NewCreatePostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo)
+		usecase := NewCreatePostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockAuditRepo, mockImageRepo)
 
 		title, _ := valueobject.NewPostTitle("テスト投稿")
 		content, _ := valueobject.NewPostContent("テスト内容")
 		userID := valueobject.NewUserID()
-		status := valueobject.StatusPublished
+		status := valueobject.StatusDraft
 		tagName1, _ := valueobject.NewTagName("タグ1")
 		tagName2, _ := valueobject.NewTagName("タグ2")
 
@@ -53,6 +57,7 @@ func TestCreatePostUsecase_Execute(t *testing.T) {
 				// タグ設定
 				mockPostRepo.EXPECT().SetTags(ctx, gomock.Any(), gomock.Any()).Return(nil)
 
+				mockAuditRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
 				return fn(ctx)
 			})
 
@@ -66,13 +71,169 @@ func TestCreatePostUsecase_Execute(t *testing.T) {
 		assert.Len(t, output.Tags, 2)
 	})
 
+	t.Run("カテゴリ付きの投稿作成が成功する", func(t *testing.T) {
+		usecase := NewCreatePostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockAuditRepo, mockImageRepo)
+
+		title, _ := valueobject.NewPostTitle("テスト投稿")
+		content, _ := valueobject.NewPostContent("テスト内容")
+		userID := valueobject.NewUserID()
+		primaryCategoryID := valueobject.NewCategoryID()
+		secondaryCategoryID := valueobject.NewCategoryID()
+
+		input := &CreatePostInput{
+			Title:             title,
+			Content:           content,
+			UserID:            userID,
+			Status:            valueobject.StatusDraft,
+			PrimaryCategoryID: &primaryCategoryID,
+			CategoryIDs:       []valueobject.CategoryID{secondaryCategoryID},
+		}
+
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockPostRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				mockPostRepo.EXPECT().SetTags(ctx, gomock.Any(), gomock.Any()).Return(nil)
+				mockPostRepo.EXPECT().SetCategories(ctx, gomock.Any()).Return(nil)
+				mockAuditRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				return fn(ctx)
+			})
+
+		output, err := usecase.Execute(context.Background(), input)
+
+		assert.NoError(t, err)
+		assert.Equal(t, &primaryCategoryID, output.PrimaryCategoryID)
+		assert.Equal(t, []valueobject.CategoryID{secondaryCategoryID}, output.CategoryIDs)
+	})
+
+	t.Run("SEO項目付きの投稿作成が成功する", func(t *testing.T) {
+		usecase := NewCreatePostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockAuditRepo, mockImageRepo)
+
+		title, _ := valueobject.NewPostTitle("テスト投稿")
+		content, _ := valueobject.NewPostContent("<p>テスト内容</p>")
+		metaDescription, _ := valueobject.NewMetaDescription("検索結果に表示する説明")
+		canonicalURL, _ := valueobject.NewCanonicalURL("https://example.com/posts/test")
+		userID := valueobject.NewUserID()
+		// 操作者が別の投稿にアップロードした画像
+		image := entity.NewImage(valueobject.ImageFilename("og.png"), "stored.png", "https://storage.example.com/og.png", valueobject.NewPostID(), userID, 0)
+
+		input := &CreatePostInput{
+			Title:   title,
+			Content: content,
+			UserID:  userID,
+			Status:  valueobject.StatusDraft,
+			SEO: PostSEOInput{
+				MetaDescription: metaDescription,
+				CanonicalURL:    canonicalURL,
+				OGImageID:       &image.ID,
+			},
+		}
+
+		mockImageRepo.EXPECT().Get(context.Background(), image.ID).Return(image, nil)
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockPostRepo.EXPECT().Create(ctx, gomock.Any()).
+					DoAndReturn(func(_ context.Context, post *entity.Post) error {
+						assert.Equal(t, image, post.OGImage)
+						assert.Equal(t, metaDescription, post.MetaDescription)
+						return nil
+					})
+				mockPostRepo.EXPECT().SetTags(ctx, gomock.Any(), gomock.Any()).Return(nil)
+				mockAuditRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				return fn(ctx)
+			})
+
+		output, err := usecase.Execute(context.Background(), input)
+
+		assert.NoError(t, err)
+		// 抜粋が未指定の場合は本文から生成する
+		assert.Equal(t, valueobject.PostExcerpt("テスト内容"), output.SEO.Excerpt)
+		assert.Equal(t, metaDescription, output.SEO.MetaDescription)
+		assert.Equal(t, canonicalURL, output.SEO.CanonicalURL)
+		assert.Equal(t, &PostOGImageOutput{ID: image.ID, URL: "https://storage.example.com/og.png"}, output.SEO.OGImage)
+	})
+
+	t.Run("存在しない画像をOGP画像に指定するとエラーが発生する", func(t *testing.T) {
+		usecase := NewCreatePostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockAuditRepo, mockImageRepo)
+
+		title, _ := valueobject.NewPostTitle("テスト投稿")
+		content, _ := valueobject.NewPostContent("テスト内容")
+		imageID := valueobject.NewImageID()
+
+		input := &CreatePostInput{
+			Title:   title,
+			Content: content,
+			UserID:  valueobject.NewUserID(),
+			Status:  valueobject.StatusDraft,
+			SEO:     PostSEOInput{OGImageID: &imageID},
+		}
+
+		mockImageRepo.EXPECT().Get(context.Background(), imageID).
+			Return(nil, valueobject.NewMyError(valueobject.NotFoundCode, "Image not found"))
+
+		output, err := usecase.Execute(context.Background(), input)
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, valueobject.InvalidCode, myErr.Code)
+		assert.Equal(t, "og_image_does_not_exist", string(myErr.Key()))
+	})
+
+	t.Run("他のユーザーの画像をOGP画像に指定するとエラーが発生する", func(t *testing.T) {
+		usecase := NewCreatePostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockAuditRepo, mockImageRepo)
+
+		title, _ := valueobject.NewPostTitle("テスト投稿")
+		content, _ := valueobject.NewPostContent("テスト内容")
+		image := entity.NewImage(valueobject.ImageFilename("og.png"), "stored.png", "https://storage.example.com/og.png", valueobject.NewPostID(), valueobject.NewUserID(), 0)
+
+		input := &CreatePostInput{
+			Title:   title,
+			Content: content,
+			UserID:  valueobject.NewUserID(),
+			Status:  valueobject.StatusDraft,
+			SEO:     PostSEOInput{OGImageID: &image.ID},
+		}
+
+		mockImageRepo.EXPECT().Get(context.Background(), image.ID).Return(image, nil)
+
+		output, err := usecase.Execute(context.Background(), input)
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, valueobject.InvalidCode, myErr.Code)
+		assert.Equal(t, "og_image_does_not_exist", string(myErr.Key()))
+	})
+
+	t.Run("主カテゴリなしで副カテゴリを指定するとエラーが発生する", func(t *testing.T) {
+		usecase := NewCreatePostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockAuditRepo, mockImageRepo)
+
+		title, _ := valueobject.NewPostTitle("テスト投稿")
+		content, _ := valueobject.NewPostContent("テスト内容")
+
+		input := &CreatePostInput{
+			Title:       title,
+			Content:     content,
+			UserID:      valueobject.NewUserID(),
+			Status:      valueobject.StatusDraft,
+			CategoryIDs: []valueobject.CategoryID{valueobject.NewCategoryID()},
+		}
+
+		output, err := usecase.Execute(context.Background(), input)
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, valueobject.InvalidCode, myErr.Code)
+	})
+
 	t.Run("タグなしの投稿作成が成功する", func(t *testing.T) {
-		usecase := NewCreatePostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo)
+		usecase := NewCreatePostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockAuditRepo, mockImageRepo)
 
 		title, _ := valueobject.NewPostTitle("テスト投稿")
 		content, _ := valueobject.NewPostContent("テスト内容")
 		userID := valueobject.NewUserID()
-		status := valueobject.StatusPublished
+		status := valueobject.StatusDraft
 
 		input := &CreatePostInput{
 			Title:   title,
@@ -91,6 +252,7 @@ func TestCreatePostUsecase_Execute(t *testing.T) {
 				// タグなしなのでSetTagsのみ
 				mockPostRepo.EXPECT().SetTags(ctx, gomock.Any(), gomock.Any()).Return(nil)
 
+				mockAuditRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
 				return fn(ctx)
 			})
 
@@ -105,12 +267,12 @@ func TestCreatePostUsecase_Execute(t *testing.T) {
 	})
 
 	t.Run("投稿作成に失敗する", func(t *testing.T) {
-		usecase := NewCreatePostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo)
+		usecase := NewCreatePostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockAuditRepo, mockImageRepo)
 
 		title, _ := valueobject.NewPostTitle("テスト投稿")
 		content, _ := valueobject.NewPostContent("テスト内容")
 		userID := valueobject.NewUserID()
-		status := valueobject.StatusPublished
+		status := valueobject.StatusDraft
 
 		input := &CreatePostInput{
 			Title:   title,
@@ -140,12 +302,12 @@ func TestCreatePostUsecase_Execute(t *testing.T) {
 	})
 
 	t.Run("タグ作成に失敗する", func(t *testing.T) {
-		usecase := NewCreatePostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo)
+		usecase := NewCreatePostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockAuditRepo, mockImageRepo)
 
 		title, _ := valueobject.NewPostTitle("テスト投稿")
 		content, _ := valueobject.NewPostContent("テスト内容")
 		userID := valueobject.NewUserID()
-		status := valueobject.StatusPublished
+		status := valueobject.StatusDraft
 		tagName, _ := valueobject.NewTagName("タグ1")
 
 		input := &CreatePostInput{
@@ -177,12 +339,12 @@ func TestCreatePostUsecase_Execute(t *testing.T) {
 	})
 
 	t.Run("タグ設定に失敗する", func(t *testing.T) {
-		usecase := NewCreatePostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo)
+		usecase := NewCreatePostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockAuditRepo, mockImageRepo)
 
 		title, _ := valueobject.NewPostTitle("テスト投稿")
 		content, _ := valueobject.NewPostContent("テスト内容")
 		userID := valueobject.NewUserID()
-		status := valueobject.StatusPublished
+		status := valueobject.StatusDraft
 		tagName, _ := valueobject.NewTagName("タグ1")
 
 		input := &CreatePostInput{
@@ -217,12 +379,12 @@ func TestCreatePostUsecase_Execute(t *testing.T) {
 	})
 
 	t.Run("トランザクション自体が失敗する", func(t *testing.T) {
-		usecase := NewCreatePostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo)
+		usecase := NewCreatePostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockAuditRepo, mockImageRepo)
 
 		title, _ := valueobject.NewPostTitle("テスト投稿")
 		content, _ := valueobject.NewPostContent("テスト内容")
 		userID := valueobject.NewUserID()
-		status := valueobject.StatusPublished
+		status := valueobject.StatusDraft
 
 		input := &CreatePostInput{
 			Title:   title,
@@ -241,4 +403,64 @@ func TestCreatePostUsecase_Execute(t *testing.T) {
 		assert.Error(t, err)
 		assert.Nil(t, output)
 	})
+
+	t.Run("下書き以外のステータスで作成するとエラーが発生する", func(t *testing.T) {
+		usecase := NewCreatePostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockAuditRepo, mockImageRepo)
+
+		title, _ := valueobject.NewPostTitle("テスト投稿")
+		content, _ := valueobject.NewPostContent("テスト内容")
+
+		output, err := usecase.Execute(context.Background(), &CreatePostInput{
+			Title:   title,
+			Content: content,
+			UserID:  valueobject.NewUserID(),
+			Status:  valueobject.StatusPublished,
+		})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, valueobject.InvalidCode, myErr.Code)
+	})
+}
+
+func TestCreatePostUsecase_Execute_Events(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTransactionManager := repositoryMock.NewMockTransactionManager(ctrl)
+	mockPostRepo := repositoryMock.NewMockPostRepository(ctrl)
+	mockTagRepo := repositoryMock.NewMockTagRepository(ctrl)
+	mockAuditRepo := repositoryMock.NewMockAuditEventRepository(ctrl)
+	mockImageRepo := repositoryMock.NewMockImageRepository(ctrl)
+
+	t.Run("作成した投稿のイベントがトランザクションに記録される", func(t *testing.T) {
+		usecase := NewCreatePostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockAuditRepo, mockImageRepo)
+
+		title, _ := valueobject.NewPostTitle("テスト投稿")
+		content, _ := valueobject.NewPostContent("テスト内容")
+
+		var collector *domaincontext.EventCollector
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				ctx, collector = domaincontext.WithEventCollector(ctx)
+				mockPostRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				mockPostRepo.EXPECT().SetTags(ctx, gomock.Any(), gomock.Any()).Return(nil)
+				mockAuditRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				return fn(ctx)
+			})
+
+		output, err := usecase.Execute(context.Background(), &CreatePostInput{
+			Title:   title,
+			Content: content,
+			UserID:  valueobject.NewUserID(),
+			Status:  valueobject.StatusDraft,
+		})
+
+		assert.NoError(t, err)
+		events := collector.Events()
+		assert.Len(t, events, 1)
+		assert.Equal(t, event.PostCreatedName, events[0].EventName())
+		assert.Equal(t, output.ID, events[0].(event.PostCreated).PostID)
+	})
 }
@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/repository"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+type CreateWebhookSubscriptionInput struct {
+	URL        string
+	Secret     string
+	EventTypes []valueobject.WebhookEventType
+}
+
+type CreateWebhookSubscriptionOutput struct {
+	Subscription *entity.WebhookSubscription
+}
+
+type CreateWebhookSubscriptionUsecase struct {
+	webhookSubscriptionRepository repository.WebhookSubscriptionRepository
+	// allowLocalNetwork はローカル環境向けに http と内部ネットワークの通知先を許可するか
+	allowLocalNetwork bool
+}
+
+func NewCreateWebhookSubscriptionUsecase(webhookSubscriptionRepository repository.WebhookSubscriptionRepository, allowLocalNetwork bool) *CreateWebhookSubscriptionUsecase {
+	return &CreateWebhookSubscriptionUsecase{
+		webhookSubscriptionRepository: webhookSubscriptionRepository,
+		allowLocalNetwork:             allowLocalNetwork,
+	}
+}
+
+func (u *CreateWebhookSubscriptionUsecase) Execute(ctx context.Context, input *CreateWebhookSubscriptionInput) (*CreateWebhookSubscriptionOutput, error) {
+	ctx, span := startSpan(ctx, "CreateWebhookSubscriptionUsecase.Execute")
+	defer span.End()
+
+	subscription, err := entity.NewWebhookSubscription(input.URL, input.Secret, input.EventTypes, u.allowLocalNetwork)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.webhookSubscriptionRepository.Create(ctx, subscription); err != nil {
+		return nil, valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_create_webhook_subscription"))
+	}
+
+	return &CreateWebhookSubscriptionOutput{Subscription: subscription}, nil
+}
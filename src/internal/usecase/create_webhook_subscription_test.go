@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	repositoryMock "github.com/MizukiShigi/cms-go/mocks/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateWebhookSubscriptionUsecase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSubscriptionRepo := repositoryMock.NewMockWebhookSubscriptionRepository(ctrl)
+
+	t.Run("購読の作成が成功する", func(t *testing.T) {
+		usecase := NewCreateWebhookSubscriptionUsecase(mockSubscriptionRepo, false)
+
+		mockSubscriptionRepo.EXPECT().Create(context.Background(), gomock.Any()).Return(nil)
+
+		output, err := usecase.Execute(context.Background(), &CreateWebhookSubscriptionInput{
+			URL:        "https://example.com/hooks",
+			Secret:     "0123456789abcdef",
+			EventTypes: []valueobject.WebhookEventType{valueobject.WebhookEventPostPublished},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "https://example.com/hooks", output.Subscription.URL)
+		assert.True(t, output.Subscription.Active)
+	})
+
+	t.Run("URLが不正な場合はエラーになる", func(t *testing.T) {
+		usecase := NewCreateWebhookSubscriptionUsecase(mockSubscriptionRepo, false)
+
+		output, err := usecase.Execute(context.Background(), &CreateWebhookSubscriptionInput{
+			URL:        "ftp://example.com/hooks",
+			Secret:     "0123456789abcdef",
+			EventTypes: []valueobject.WebhookEventType{valueobject.WebhookEventPostPublished},
+		})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.True(t, errors.As(err, &myErr))
+		assert.Equal(t, valueobject.InvalidCode, myErr.Code)
+	})
+
+	t.Run("内部ネットワークを指すURLはエラーになる", func(t *testing.T) {
+		usecase := NewCreateWebhookSubscriptionUsecase(mockSubscriptionRepo, false)
+
+		output, err := usecase.Execute(context.Background(), &CreateWebhookSubscriptionInput{
+			URL:        "https://169.254.169.254/latest/meta-data",
+			Secret:     "0123456789abcdef",
+			EventTypes: []valueobject.WebhookEventType{valueobject.WebhookEventPostPublished},
+		})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.True(t, errors.As(err, &myErr))
+		assert.Equal(t, valueobject.InvalidCode, myErr.Code)
+	})
+
+	t.Run("ローカル環境ではhttpの通知先を登録できる", func(t *testing.T) {
+		usecase := NewCreateWebhookSubscriptionUsecase(mockSubscriptionRepo, true)
+
+		mockSubscriptionRepo.EXPECT().Create(context.Background(), gomock.Any()).Return(nil)
+
+		output, err := usecase.Execute(context.Background(), &CreateWebhookSubscriptionInput{
+			URL:        "http://localhost:8080/hooks",
+			Secret:     "0123456789abcdef",
+			EventTypes: []valueobject.WebhookEventType{valueobject.WebhookEventPostPublished},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "http://localhost:8080/hooks", output.Subscription.URL)
+	})
+
+	t.Run("保存に失敗した場合はエラーになる", func(t *testing.T) {
+		usecase := NewCreateWebhookSubscriptionUsecase(mockSubscriptionRepo, false)
+
+		mockSubscriptionRepo.EXPECT().Create(context.Background(), gomock.Any()).Return(errors.New("db error"))
+
+		output, err := usecase.Execute(context.Background(), &CreateWebhookSubscriptionInput{
+			URL:        "https://example.com/hooks",
+			Secret:     "0123456789abcdef",
+			EventTypes: []valueobject.WebhookEventType{valueobject.WebhookEventPostPublished},
+		})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.True(t, errors.As(err, &myErr))
+		assert.Equal(t, valueobject.InternalServerErrorCode, myErr.Code)
+	})
+}
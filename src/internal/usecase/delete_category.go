@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/repository"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+type DeleteCategoryInput struct {
+	ID valueobject.CategoryID
+}
+
+type DeleteCategoryUsecase struct {
+	transactionManager   repository.TransactionManager
+	categoryRepository   repository.CategoryRepository
+	auditEventRepository repository.AuditEventRepository
+}
+
+func NewDeleteCategoryUsecase(transactionManager repository.TransactionManager, categoryRepository repository.CategoryRepository, auditEventRepository repository.AuditEventRepository) *DeleteCategoryUsecase {
+	return &DeleteCategoryUsecase{
+		transactionManager:   transactionManager,
+		categoryRepository:   categoryRepository,
+		auditEventRepository: auditEventRepository,
+	}
+}
+
+// Execute はカテゴリを削除する。子カテゴリを持つ場合は削除できない
+func (u *DeleteCategoryUsecase) Execute(ctx context.Context, input *DeleteCategoryInput) error {
+	ctx, span := startSpan(ctx, "DeleteCategoryUsecase.Execute")
+	defer span.End()
+
+	err := u.transactionManager.Transaction(ctx, func(ctx context.Context) error {
+		// 削除前の内容を監査ログに残す
+		category, err := u.categoryRepository.Get(ctx, input.ID)
+		if err != nil {
+			return err
+		}
+
+		if err := u.categoryRepository.Delete(ctx, input.ID); err != nil {
+			return err
+		}
+
+		return recordAudit(ctx, u.auditEventRepository, valueobject.AuditEntityCategory, input.ID.String(), valueobject.AuditActionDelete, category.AuditFields(), nil)
+	})
+	if err != nil {
+		return valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_delete_category"))
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	repositoryMock "github.com/MizukiShigi/cms-go/mocks/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDeleteCategoryUsecase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTransactionManager := repositoryMock.NewMockTransactionManager(ctrl)
+	mockCategoryRepo := repositoryMock.NewMockCategoryRepository(ctrl)
+	mockAuditRepo := repositoryMock.NewMockAuditEventRepository(ctrl)
+
+	t.Run("カテゴリの削除が成功する", func(t *testing.T) {
+		usecase := NewDeleteCategoryUsecase(mockTransactionManager, mockCategoryRepo, mockAuditRepo)
+
+		name, _ := valueobject.NewCategoryName("Go言語")
+		slug, _ := valueobject.NewCategorySlug("golang")
+		category, _ := entity.NewCategory(name, slug, "", 0)
+		categoryID := category.ID
+
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockCategoryRepo.EXPECT().Get(ctx, categoryID).Return(category, nil)
+				mockCategoryRepo.EXPECT().Delete(ctx, categoryID).Return(nil)
+				mockAuditRepo.EXPECT().Create(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, event *entity.AuditEvent) error {
+						// 削除前の内容が記録される
+						assert.Equal(t, valueobject.AuditActionDelete, event.Action)
+						assert.Equal(t, categoryID.String(), event.EntityID)
+						assert.True(t, event.HasChanges())
+						return nil
+					})
+				return fn(ctx)
+			})
+
+		err := usecase.Execute(context.Background(), &DeleteCategoryInput{ID: categoryID})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("子カテゴリを持つ場合にエラーが発生する", func(t *testing.T) {
+		usecase := NewDeleteCategoryUsecase(mockTransactionManager, mockCategoryRepo, mockAuditRepo)
+
+		categoryID := valueobject.NewCategoryID()
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockCategoryRepo.EXPECT().Get(ctx, categoryID).Return(&entity.Category{ID: categoryID}, nil)
+				mockCategoryRepo.EXPECT().Delete(ctx, categoryID).
+					Return(valueobject.NewMyError(valueobject.ConflictCode, "Category has child categories"))
+				return fn(ctx)
+			})
+
+		err := usecase.Execute(context.Background(), &DeleteCategoryInput{ID: categoryID})
+
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, valueobject.ConflictCode, myErr.Code)
+	})
+}
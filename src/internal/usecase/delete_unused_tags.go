@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/repository"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+type DeleteUnusedTagsOutput struct {
+	DeletedCount int
+}
+
+type DeleteUnusedTagsUsecase struct {
+	transactionManager   repository.TransactionManager
+	tagRepository        repository.TagRepository
+	auditEventRepository repository.AuditEventRepository
+}
+
+func NewDeleteUnusedTagsUsecase(transactionManager repository.TransactionManager, tagRepository repository.TagRepository, auditEventRepository repository.AuditEventRepository) *DeleteUnusedTagsUsecase {
+	return &DeleteUnusedTagsUsecase{
+		transactionManager:   transactionManager,
+		tagRepository:        tagRepository,
+		auditEventRepository: auditEventRepository,
+	}
+}
+
+// unusedTagsAuditEntityID は未使用タグの一括削除を監査ログに記録する際の対象ID
+const unusedTagsAuditEntityID = "unused"
+
+func (u *DeleteUnusedTagsUsecase) Execute(ctx context.Context) (*DeleteUnusedTagsOutput, error) {
+	ctx, span := startSpan(ctx, "DeleteUnusedTagsUsecase.Execute")
+	defer span.End()
+
+	var deleted int
+	err := u.transactionManager.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		deleted, err = u.tagRepository.DeleteUnused(ctx)
+		if err != nil {
+			return err
+		}
+		if deleted == 0 {
+			return nil
+		}
+
+		// 個々のタグではなく一括削除の件数を記録する
+		before := entity.AuditFields{"deleted_count": deleted}
+		return recordAudit(ctx, u.auditEventRepository, valueobject.AuditEntityTag, unusedTagsAuditEntityID, valueobject.AuditActionDelete, before, nil)
+	})
+	if err != nil {
+		return nil, valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_delete_unused_tags"))
+	}
+
+	return &DeleteUnusedTagsOutput{DeletedCount: deleted}, nil
+}
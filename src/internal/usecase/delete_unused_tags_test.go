@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	repositoryMock "github.com/MizukiShigi/cms-go/mocks/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDeleteUnusedTagsUsecase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTransactionManager := repositoryMock.NewMockTransactionManager(ctrl)
+	mockTagRepo := repositoryMock.NewMockTagRepository(ctrl)
+	mockAuditRepo := repositoryMock.NewMockAuditEventRepository(ctrl)
+
+	t.Run("未使用タグの削除が成功する", func(t *testing.T) {
+		usecase := NewDeleteUnusedTagsUsecase(mockTransactionManager, mockTagRepo, mockAuditRepo)
+
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockTagRepo.EXPECT().DeleteUnused(ctx).Return(5, nil)
+				mockAuditRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				return fn(ctx)
+			})
+
+		output, err := usecase.Execute(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 5, output.DeletedCount)
+	})
+
+	t.Run("削除対象がない場合は監査ログを記録しない", func(t *testing.T) {
+		usecase := NewDeleteUnusedTagsUsecase(mockTransactionManager, mockTagRepo, mockAuditRepo)
+
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockTagRepo.EXPECT().DeleteUnused(ctx).Return(0, nil)
+				return fn(ctx)
+			})
+
+		output, err := usecase.Execute(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, output.DeletedCount)
+	})
+
+	t.Run("削除に失敗する", func(t *testing.T) {
+		usecase := NewDeleteUnusedTagsUsecase(mockTransactionManager, mockTagRepo, mockAuditRepo)
+
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockTagRepo.EXPECT().DeleteUnused(ctx).
+					Return(0, valueobject.NewMyError(valueobject.InternalServerErrorCode, "Failed to delete unused tags"))
+				return fn(ctx)
+			})
+
+		output, err := usecase.Execute(context.Background())
+
+		assert.Error(t, err)
+		assert.Nil(t, output)
+	})
+}
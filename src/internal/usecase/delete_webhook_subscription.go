@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/repository"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+type DeleteWebhookSubscriptionInput struct {
+	ID valueobject.WebhookSubscriptionID
+}
+
+type DeleteWebhookSubscriptionUsecase struct {
+	webhookSubscriptionRepository repository.WebhookSubscriptionRepository
+}
+
+func NewDeleteWebhookSubscriptionUsecase(webhookSubscriptionRepository repository.WebhookSubscriptionRepository) *DeleteWebhookSubscriptionUsecase {
+	return &DeleteWebhookSubscriptionUsecase{webhookSubscriptionRepository: webhookSubscriptionRepository}
+}
+
+// Execute は購読を削除する。未配信の配信も削除され、以降は通知されない
+func (u *DeleteWebhookSubscriptionUsecase) Execute(ctx context.Context, input *DeleteWebhookSubscriptionInput) error {
+	ctx, span := startSpan(ctx, "DeleteWebhookSubscriptionUsecase.Execute")
+	defer span.End()
+
+	if err := u.webhookSubscriptionRepository.Delete(ctx, input.ID); err != nil {
+		return valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_delete_webhook_subscription"))
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	repositoryMock "github.com/MizukiShigi/cms-go/mocks/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDeleteWebhookSubscriptionUsecase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSubscriptionRepo := repositoryMock.NewMockWebhookSubscriptionRepository(ctrl)
+
+	t.Run("購読の削除が成功する", func(t *testing.T) {
+		usecase := NewDeleteWebhookSubscriptionUsecase(mockSubscriptionRepo)
+
+		id := valueobject.NewWebhookSubscriptionID()
+		mockSubscriptionRepo.EXPECT().Delete(context.Background(), id).Return(nil)
+
+		err := usecase.Execute(context.Background(), &DeleteWebhookSubscriptionInput{ID: id})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("購読が存在しない場合はエラーになる", func(t *testing.T) {
+		usecase := NewDeleteWebhookSubscriptionUsecase(mockSubscriptionRepo)
+
+		id := valueobject.NewWebhookSubscriptionID()
+		mockSubscriptionRepo.EXPECT().Delete(context.Background(), id).
+			Return(valueobject.NewMyError(valueobject.NotFoundCode, "Webhook subscription not found"))
+
+		err := usecase.Execute(context.Background(), &DeleteWebhookSubscriptionInput{ID: id})
+
+		var myErr *valueobject.MyError
+		assert.True(t, errors.As(err, &myErr))
+		assert.Equal(t, valueobject.NotFoundCode, myErr.Code)
+	})
+}
@@ -0,0 +1,172 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/repository"
+	"github.com/MizukiShigi/cms-go/internal/domain/service"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+const (
+	// webhookDispatchEventBatchSize は1回のディスパッチで配信に展開するイベントの最大数
+	webhookDispatchEventBatchSize = 100
+	// webhookDispatchDeliveryBatchSize は1回のディスパッチで送信する配信の最大数
+	webhookDispatchDeliveryBatchSize = 50
+	// webhookDeliveryLease は送信中の配信を他のディスパッチャーに取得させない期間
+	webhookDeliveryLease = 2 * time.Minute
+)
+
+type DispatchWebhooksOutput struct {
+	// 配信に展開したイベント数
+	FannedOutEvents int
+	Succeeded       int
+	Failed          int
+}
+
+type DispatchWebhooksUsecase struct {
+	transactionManager            repository.TransactionManager
+	webhookEventRepository        repository.WebhookEventRepository
+	webhookSubscriptionRepository repository.WebhookSubscriptionRepository
+	webhookDeliveryRepository     repository.WebhookDeliveryRepository
+	webhookSender                 service.WebhookSender
+}
+
+func NewDispatchWebhooksUsecase(
+	transactionManager repository.TransactionManager,
+	webhookEventRepository repository.WebhookEventRepository,
+	webhookSubscriptionRepository repository.WebhookSubscriptionRepository,
+	webhookDeliveryRepository repository.WebhookDeliveryRepository,
+	webhookSender service.WebhookSender,
+) *DispatchWebhooksUsecase {
+	return &DispatchWebhooksUsecase{
+		transactionManager:            transactionManager,
+		webhookEventRepository:        webhookEventRepository,
+		webhookSubscriptionRepository: webhookSubscriptionRepository,
+		webhookDeliveryRepository:     webhookDeliveryRepository,
+		webhookSender:                 webhookSender,
+	}
+}
+
+// Execute はアウトボックスのイベントを購読ごとの配信に展開し、送信時刻に達した配信を送信する
+func (u *DispatchWebhooksUsecase) Execute(ctx context.Context) (*DispatchWebhooksOutput, error) {
+	ctx, span := startSpan(ctx, "DispatchWebhooksUsecase.Execute")
+	defer span.End()
+
+	output := &DispatchWebhooksOutput{}
+
+	fannedOut, err := u.fanOut(ctx)
+	if err != nil {
+		return nil, err
+	}
+	output.FannedOutEvents = fannedOut
+
+	now := time.Now()
+	deliveries, err := u.webhookDeliveryRepository.ClaimDue(ctx, now, now.Add(webhookDeliveryLease), webhookDispatchDeliveryBatchSize)
+	if err != nil {
+		return nil, valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_claim_webhook_deliveries"))
+	}
+
+	// 停止要求を受けても送信中の配信は中断せず、結果まで記録する
+	deliverCtx := context.WithoutCancel(ctx)
+
+	// 1件の記録に失敗しても残りの配信は送信する（記録できなかった配信はリースの期限後に再送される）
+	var updateFailures int
+	for _, delivery := range deliveries {
+		// 停止要求を受けた後は新たに送信せず、残りの配信はリースの期限後に再取得させる
+		if ctx.Err() != nil {
+			break
+		}
+
+		if u.deliver(deliverCtx, delivery) {
+			output.Succeeded++
+		} else {
+			output.Failed++
+		}
+
+		if err := u.webhookDeliveryRepository.Update(deliverCtx, delivery); err != nil {
+			slog.ErrorContext(ctx, "Failed to update webhook delivery", "delivery_id", delivery.ID, "error", err)
+			updateFailures++
+		}
+	}
+
+	if updateFailures > 0 {
+		return output, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_update_webhook_deliveries", updateFailures)
+	}
+	return output, nil
+}
+
+// fanOut は未展開のイベントを購読ごとの配信として作成し、展開済みにする
+func (u *DispatchWebhooksUsecase) fanOut(ctx context.Context) (int, error) {
+	var fannedOut int
+	err := u.transactionManager.Transaction(ctx, func(ctx context.Context) error {
+		events, err := u.webhookEventRepository.ListUndispatched(ctx, webhookDispatchEventBatchSize)
+		if err != nil {
+			return valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_webhook_events"))
+		}
+
+		now := time.Now()
+		for _, event := range events {
+			subscriptions, err := u.webhookSubscriptionRepository.ListByEventType(ctx, event.EventType)
+			if err != nil {
+				return valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_webhook_subscriptions"))
+			}
+
+			for _, subscription := range subscriptions {
+				delivery := entity.NewWebhookDelivery(subscription.ID, event.EventType, event.Payload, event.RequestID, now)
+				if err := u.webhookDeliveryRepository.Create(ctx, delivery); err != nil {
+					return valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_create_webhook_delivery"))
+				}
+			}
+
+			if err := u.webhookEventRepository.MarkDispatched(ctx, event.ID, now); err != nil {
+				return valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_update_webhook_event"))
+			}
+		}
+
+		fannedOut = len(events)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return fannedOut, nil
+}
+
+// deliver は配信を1回試行し、結果を配信に記録する。成功した場合は true を返す
+func (u *DispatchWebhooksUsecase) deliver(ctx context.Context, delivery *entity.WebhookDelivery) bool {
+	subscription, err := u.webhookSubscriptionRepository.Get(ctx, delivery.SubscriptionID)
+	if err != nil {
+		delivery.MarkFailed(nil, "Failed to get webhook subscription", time.Now())
+		return false
+	}
+	if !subscription.Active {
+		delivery.MarkFailed(nil, "Webhook subscription is inactive", time.Now())
+		return false
+	}
+
+	resp, err := u.webhookSender.Send(ctx, service.WebhookRequest{
+		URL:        subscription.URL,
+		Secret:     subscription.Secret,
+		EventType:  delivery.EventType.String(),
+		DeliveryID: delivery.ID,
+		Payload:    delivery.Payload,
+		RequestID:  delivery.RequestID,
+	})
+	if err != nil {
+		var statusCode *int
+		if resp != nil {
+			statusCode = &resp.StatusCode
+		}
+		slog.WarnContext(ctx, "Failed to send webhook", "delivery_id", delivery.ID, "attempts", delivery.Attempts+1, "error", err)
+		delivery.MarkFailed(statusCode, err.Error(), time.Now())
+		return false
+	}
+
+	delivery.MarkSucceeded(resp.StatusCode, time.Now())
+	return true
+}
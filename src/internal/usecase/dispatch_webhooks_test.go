@@ -0,0 +1,217 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/service"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	repositoryMock "github.com/MizukiShigi/cms-go/mocks/repository"
+	serviceMock "github.com/MizukiShigi/cms-go/mocks/service"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDispatchWebhooksUsecase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTransactionManager := repositoryMock.NewMockTransactionManager(ctrl)
+	mockEventRepo := repositoryMock.NewMockWebhookEventRepository(ctrl)
+	mockSubscriptionRepo := repositoryMock.NewMockWebhookSubscriptionRepository(ctrl)
+	mockDeliveryRepo := repositoryMock.NewMockWebhookDeliveryRepository(ctrl)
+	mockSender := serviceMock.NewMockWebhookSender(ctrl)
+
+	eventTypes := []valueobject.WebhookEventType{valueobject.WebhookEventPostPublished}
+	subscription, _ := entity.NewWebhookSubscription("https://example.com/hooks", "0123456789abcdef", eventTypes, false)
+	payload := []byte(`{"event":"post.published"}`)
+
+	t.Run("イベントを購読ごとの配信に展開して送信する", func(t *testing.T) {
+		usecase := NewDispatchWebhooksUsecase(mockTransactionManager, mockEventRepo, mockSubscriptionRepo, mockDeliveryRepo, mockSender)
+
+		otherSubscription, _ := entity.NewWebhookSubscription("https://example.org/hooks", "fedcba9876543210", eventTypes, false)
+		event := entity.ParseWebhookEvent(1, valueobject.WebhookEventPostPublished, payload, "req-123", time.Now())
+		delivery := entity.NewWebhookDelivery(subscription.ID, valueobject.WebhookEventPostPublished, payload, "req-123", time.Now())
+		delivery.ID = 10
+
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockEventRepo.EXPECT().ListUndispatched(ctx, webhookDispatchEventBatchSize).Return([]*entity.WebhookEvent{event}, nil)
+				mockSubscriptionRepo.EXPECT().ListByEventType(ctx, valueobject.WebhookEventPostPublished).
+					Return([]*entity.WebhookSubscription{subscription, otherSubscription}, nil)
+				mockDeliveryRepo.EXPECT().Create(ctx, gomock.Any()).
+					DoAndReturn(func(_ context.Context, created *entity.WebhookDelivery) error {
+						assert.Equal(t, "req-123", created.RequestID)
+						return nil
+					}).Times(2)
+				mockEventRepo.EXPECT().MarkDispatched(ctx, int64(1), gomock.Any()).Return(nil)
+				return fn(ctx)
+			})
+		mockDeliveryRepo.EXPECT().ClaimDue(context.Background(), gomock.Any(), gomock.Any(), webhookDispatchDeliveryBatchSize).
+			Return([]*entity.WebhookDelivery{delivery}, nil)
+		mockSubscriptionRepo.EXPECT().Get(gomock.Any(), subscription.ID).Return(subscription, nil)
+		mockSender.EXPECT().Send(gomock.Any(), service.WebhookRequest{
+			URL:        subscription.URL,
+			Secret:     subscription.Secret,
+			EventType:  "post.published",
+			DeliveryID: 10,
+			Payload:    payload,
+			RequestID:  "req-123",
+		}).Return(&service.WebhookResponse{StatusCode: 200}, nil)
+		mockDeliveryRepo.EXPECT().Update(gomock.Any(), delivery).Return(nil)
+
+		output, err := usecase.Execute(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, output.FannedOutEvents)
+		assert.Equal(t, 1, output.Succeeded)
+		assert.Equal(t, 0, output.Failed)
+		assert.Equal(t, valueobject.WebhookDeliverySucceeded, delivery.Status)
+		assert.NotNil(t, delivery.DeliveredAt)
+	})
+
+	t.Run("送信に失敗した配信は再試行が予約される", func(t *testing.T) {
+		usecase := NewDispatchWebhooksUsecase(mockTransactionManager, mockEventRepo, mockSubscriptionRepo, mockDeliveryRepo, mockSender)
+
+		delivery := entity.NewWebhookDelivery(subscription.ID, valueobject.WebhookEventPostPublished, payload, "", time.Now())
+		delivery.ID = 11
+
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockEventRepo.EXPECT().ListUndispatched(ctx, webhookDispatchEventBatchSize).Return([]*entity.WebhookEvent{}, nil)
+				return fn(ctx)
+			})
+		mockDeliveryRepo.EXPECT().ClaimDue(context.Background(), gomock.Any(), gomock.Any(), webhookDispatchDeliveryBatchSize).
+			Return([]*entity.WebhookDelivery{delivery}, nil)
+		mockSubscriptionRepo.EXPECT().Get(gomock.Any(), subscription.ID).Return(subscription, nil)
+		mockSender.EXPECT().Send(gomock.Any(), gomock.Any()).
+			Return(&service.WebhookResponse{StatusCode: 503}, errors.New("webhook receiver responded with status 503"))
+		mockDeliveryRepo.EXPECT().Update(gomock.Any(), delivery).Return(nil)
+
+		before := time.Now()
+		output, err := usecase.Execute(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, output.Succeeded)
+		assert.Equal(t, 1, output.Failed)
+		assert.Equal(t, valueobject.WebhookDeliveryPending, delivery.Status)
+		assert.Equal(t, 1, delivery.Attempts)
+		assert.Equal(t, 503, *delivery.LastStatusCode)
+		assert.True(t, delivery.NextAttemptAt.After(before.Add(entity.WebhookRetryBaseInterval-time.Second)))
+	})
+
+	t.Run("無効な購読への配信は送信せずに失敗として記録する", func(t *testing.T) {
+		usecase := NewDispatchWebhooksUsecase(mockTransactionManager, mockEventRepo, mockSubscriptionRepo, mockDeliveryRepo, mockSender)
+
+		inactive, _ := entity.NewWebhookSubscription("https://example.net/hooks", "0123456789abcdef", eventTypes, false)
+		inactive.Active = false
+		delivery := entity.NewWebhookDelivery(inactive.ID, valueobject.WebhookEventPostPublished, payload, "", time.Now())
+
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockEventRepo.EXPECT().ListUndispatched(ctx, webhookDispatchEventBatchSize).Return([]*entity.WebhookEvent{}, nil)
+				return fn(ctx)
+			})
+		mockDeliveryRepo.EXPECT().ClaimDue(context.Background(), gomock.Any(), gomock.Any(), webhookDispatchDeliveryBatchSize).
+			Return([]*entity.WebhookDelivery{delivery}, nil)
+		mockSubscriptionRepo.EXPECT().Get(gomock.Any(), inactive.ID).Return(inactive, nil)
+		mockDeliveryRepo.EXPECT().Update(gomock.Any(), delivery).Return(nil)
+
+		output, err := usecase.Execute(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, output.Failed)
+		assert.Equal(t, "Webhook subscription is inactive", delivery.LastError)
+	})
+
+	t.Run("配信の記録に失敗しても残りの配信を送信し、最後にエラーを返す", func(t *testing.T) {
+		usecase := NewDispatchWebhooksUsecase(mockTransactionManager, mockEventRepo, mockSubscriptionRepo, mockDeliveryRepo, mockSender)
+
+		first := entity.NewWebhookDelivery(subscription.ID, valueobject.WebhookEventPostPublished, payload, "", time.Now())
+		first.ID = 21
+		second := entity.NewWebhookDelivery(subscription.ID, valueobject.WebhookEventPostPublished, payload, "", time.Now())
+		second.ID = 22
+
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockEventRepo.EXPECT().ListUndispatched(ctx, webhookDispatchEventBatchSize).Return([]*entity.WebhookEvent{}, nil)
+				return fn(ctx)
+			})
+		mockDeliveryRepo.EXPECT().ClaimDue(context.Background(), gomock.Any(), gomock.Any(), webhookDispatchDeliveryBatchSize).
+			Return([]*entity.WebhookDelivery{first, second}, nil)
+		mockSubscriptionRepo.EXPECT().Get(gomock.Any(), subscription.ID).Return(subscription, nil).Times(2)
+		mockSender.EXPECT().Send(gomock.Any(), gomock.Any()).Return(&service.WebhookResponse{StatusCode: 200}, nil).Times(2)
+		mockDeliveryRepo.EXPECT().Update(gomock.Any(), first).Return(errors.New("db error"))
+		mockDeliveryRepo.EXPECT().Update(gomock.Any(), second).Return(nil)
+
+		output, err := usecase.Execute(context.Background())
+
+		var myErr *valueobject.MyError
+		assert.True(t, errors.As(err, &myErr))
+		assert.Equal(t, valueobject.InternalServerErrorCode, myErr.Code)
+		assert.Equal(t, 2, output.Succeeded)
+		assert.Equal(t, valueobject.WebhookDeliverySucceeded, second.Status)
+	})
+
+	t.Run("停止要求を受けた場合は送信中の配信を記録し、残りの配信は送信しない", func(t *testing.T) {
+		usecase := NewDispatchWebhooksUsecase(mockTransactionManager, mockEventRepo, mockSubscriptionRepo, mockDeliveryRepo, mockSender)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		first := entity.NewWebhookDelivery(subscription.ID, valueobject.WebhookEventPostPublished, payload, "", time.Now())
+		first.ID = 31
+		second := entity.NewWebhookDelivery(subscription.ID, valueobject.WebhookEventPostPublished, payload, "", time.Now())
+		second.ID = 32
+
+		mockTransactionManager.EXPECT().Transaction(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockEventRepo.EXPECT().ListUndispatched(ctx, webhookDispatchEventBatchSize).Return([]*entity.WebhookEvent{}, nil)
+				return fn(ctx)
+			})
+		mockDeliveryRepo.EXPECT().ClaimDue(ctx, gomock.Any(), gomock.Any(), webhookDispatchDeliveryBatchSize).
+			Return([]*entity.WebhookDelivery{first, second}, nil)
+		mockSubscriptionRepo.EXPECT().Get(gomock.Any(), subscription.ID).Return(subscription, nil)
+		// 送信中に停止要求を受ける
+		mockSender.EXPECT().Send(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(sendCtx context.Context, _ service.WebhookRequest) (*service.WebhookResponse, error) {
+				cancel()
+				assert.NoError(t, sendCtx.Err())
+				return &service.WebhookResponse{StatusCode: 200}, nil
+			})
+		mockDeliveryRepo.EXPECT().Update(gomock.Any(), first).
+			DoAndReturn(func(updateCtx context.Context, _ *entity.WebhookDelivery) error {
+				assert.NoError(t, updateCtx.Err())
+				return nil
+			})
+
+		output, err := usecase.Execute(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, output.Succeeded)
+		assert.Equal(t, 0, output.Failed)
+		assert.Equal(t, valueobject.WebhookDeliverySucceeded, first.Status)
+		assert.Equal(t, valueobject.WebhookDeliveryPending, second.Status)
+		assert.Equal(t, 0, second.Attempts)
+	})
+
+	t.Run("イベントの展開に失敗した場合は送信しない", func(t *testing.T) {
+		usecase := NewDispatchWebhooksUsecase(mockTransactionManager, mockEventRepo, mockSubscriptionRepo, mockDeliveryRepo, mockSender)
+
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockEventRepo.EXPECT().ListUndispatched(ctx, webhookDispatchEventBatchSize).Return(nil, errors.New("db error"))
+				return fn(ctx)
+			})
+
+		output, err := usecase.Execute(context.Background())
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.True(t, errors.As(err, &myErr))
+		assert.Equal(t, valueobject.InternalServerErrorCode, myErr.Code)
+	})
+}
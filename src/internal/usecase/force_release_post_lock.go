@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/repository"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+type ForceReleasePostLockInput struct {
+	PostID valueobject.PostID
+}
+
+type ForceReleasePostLockUsecase struct {
+	postLockRepository repository.PostLockRepository
+}
+
+func NewForceReleasePostLockUsecase(postLockRepository repository.PostLockRepository) *ForceReleasePostLockUsecase {
+	return &ForceReleasePostLockUsecase{postLockRepository: postLockRepository}
+}
+
+// Execute は保持者に関わらず編集ロックを解放する（管理者向け）
+func (u *ForceReleasePostLockUsecase) Execute(ctx context.Context, input *ForceReleasePostLockInput) error {
+	ctx, span := startSpan(ctx, "ForceReleasePostLockUsecase.Execute")
+	defer span.End()
+
+	if err := u.postLockRepository.ForceRelease(ctx, input.PostID); err != nil {
+		return valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_release_post_lock"))
+	}
+
+	return nil
+}
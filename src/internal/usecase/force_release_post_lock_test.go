@@ -0,0 +1,29 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	repositoryMock "github.com/MizukiShigi/cms-go/mocks/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestForceReleasePostLockUsecase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPostLockRepo := repositoryMock.NewMockPostLockRepository(ctrl)
+
+	t.Run("保持者に関わらずロックの解放が成功する", func(t *testing.T) {
+		usecase := NewForceReleasePostLockUsecase(mockPostLockRepo)
+
+		postID := valueobject.NewPostID()
+		mockPostLockRepo.EXPECT().ForceRelease(context.Background(), postID).Return(nil)
+
+		err := usecase.Execute(context.Background(), &ForceReleasePostLockInput{PostID: postID})
+
+		assert.NoError(t, err)
+	})
+}
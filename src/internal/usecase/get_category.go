@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/repository"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+type GetCategoryInput struct {
+	ID valueobject.CategoryID
+}
+
+type GetCategoryOutput struct {
+	Category *entity.Category
+	// AncestorIDs は親から順に並んだ祖先カテゴリID（パンくず表示用）
+	AncestorIDs []valueobject.CategoryID
+}
+
+type GetCategoryUsecase struct {
+	categoryRepository repository.CategoryRepository
+}
+
+func NewGetCategoryUsecase(categoryRepository repository.CategoryRepository) *GetCategoryUsecase {
+	return &GetCategoryUsecase{categoryRepository: categoryRepository}
+}
+
+func (u *GetCategoryUsecase) Execute(ctx context.Context, input *GetCategoryInput) (*GetCategoryOutput, error) {
+	ctx, span := startSpan(ctx, "GetCategoryUsecase.Execute")
+	defer span.End()
+
+	category, err := u.categoryRepository.Get(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	ancestorIDs, err := u.categoryRepository.GetAncestorIDs(ctx, input.ID)
+	if err != nil {
+		return nil, valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_category_ancestors"))
+	}
+
+	return &GetCategoryOutput{
+		Category:    category,
+		AncestorIDs: ancestorIDs,
+	}, nil
+}
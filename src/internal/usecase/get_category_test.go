@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	repositoryMock "github.com/MizukiShigi/cms-go/mocks/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetCategoryUsecase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCategoryRepo := repositoryMock.NewMockCategoryRepository(ctrl)
+
+	t.Run("カテゴリと祖先の取得が成功する", func(t *testing.T) {
+		usecase := NewGetCategoryUsecase(mockCategoryRepo)
+
+		name, _ := valueobject.NewCategoryName("Go言語")
+		slug, _ := valueobject.NewCategorySlug("golang")
+		category, _ := entity.NewCategory(name, slug, "", 0)
+		ancestorIDs := []valueobject.CategoryID{valueobject.NewCategoryID(), valueobject.NewCategoryID()}
+
+		mockCategoryRepo.EXPECT().Get(context.Background(), category.ID).Return(category, nil)
+		mockCategoryRepo.EXPECT().GetAncestorIDs(context.Background(), category.ID).Return(ancestorIDs, nil)
+
+		output, err := usecase.Execute(context.Background(), &GetCategoryInput{ID: category.ID})
+
+		assert.NoError(t, err)
+		assert.Equal(t, category, output.Category)
+		assert.Equal(t, ancestorIDs, output.AncestorIDs)
+	})
+
+	t.Run("カテゴリが存在しない場合にエラーが発生する", func(t *testing.T) {
+		usecase := NewGetCategoryUsecase(mockCategoryRepo)
+
+		categoryID := valueobject.NewCategoryID()
+		mockCategoryRepo.EXPECT().Get(context.Background(), categoryID).
+			Return(nil, valueobject.NewMyError(valueobject.NotFoundCode, "Category not found"))
+
+		output, err := usecase.Execute(context.Background(), &GetCategoryInput{ID: categoryID})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, valueobject.NotFoundCode, myErr.Code)
+	})
+}
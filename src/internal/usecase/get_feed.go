@@ -0,0 +1,166 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/repository"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+const (
+	// DefaultFeedItemCount はフィードに含める投稿数の既定値
+	DefaultFeedItemCount = 20
+	// maxFeedItemCount はフィードに含める投稿数の上限
+	maxFeedItemCount = 100
+)
+
+// FeedSettings はフィードに記載するサイト情報
+type FeedSettings struct {
+	Title string
+	// 投稿URLの基点（末尾のスラッシュは除く）
+	SiteURL   string
+	ItemCount int
+}
+
+type GetFeedInput struct {
+	// 空の場合は全投稿のフィード
+	Tag   string
+	Limit string
+}
+
+// Feed は出力形式に依存しないフィードの内容
+type Feed struct {
+	Title   string
+	SiteURL string
+	Tag     *valueobject.TagName
+	// 最も新しい投稿の更新日時（投稿がない場合はゼロ値）
+	Updated time.Time
+	Items   []*FeedItem
+}
+
+// FeedItem はフィードの1投稿。文字列はエスケープされていない値を持つ
+type FeedItem struct {
+	ID      string
+	Title   string
+	URL     string
+	Content string
+	// 抜粋（未設定の場合は本文から生成した抜粋）
+	Summary string
+	// OGP画像のURL（未設定の場合は空）
+	ImageURL    string
+	Tags        []string
+	PublishedAt time.Time
+	UpdatedAt   time.Time
+}
+
+type GetFeedUsecase struct {
+	postRepository repository.PostRepository
+	settings       FeedSettings
+}
+
+func NewGetFeedUsecase(postRepository repository.PostRepository, settings FeedSettings) *GetFeedUsecase {
+	if settings.ItemCount <= 0 || settings.ItemCount > maxFeedItemCount {
+		settings.ItemCount = DefaultFeedItemCount
+	}
+	settings.SiteURL = strings.TrimRight(settings.SiteURL, "/")
+
+	return &GetFeedUsecase{
+		postRepository: postRepository,
+		settings:       settings,
+	}
+}
+
+// Execute は公開中の投稿を初回公開日時の新しい順に取得し、フィードを作成する
+func (u *GetFeedUsecase) Execute(ctx context.Context, input *GetFeedInput) (*Feed, error) {
+	ctx, span := startSpan(ctx, "GetFeedUsecase.Execute")
+	defer span.End()
+
+	limit := u.settings.ItemCount
+	if input.Limit != "" {
+		if l, err := strconv.Atoi(input.Limit); err == nil && l > 0 && l <= maxFeedItemCount {
+			limit = l
+		}
+	}
+
+	status := valueobject.StatusPublished
+	options := &repository.ListPostsOptions{
+		Limit:  limit,
+		Offset: 0,
+		Status: &status,
+		Sort:   "first_published_at_desc",
+	}
+
+	var tag *valueobject.TagName
+	if input.Tag != "" {
+		t, err := valueobject.NewTagName(input.Tag)
+		if err != nil {
+			return nil, err
+		}
+		tag = &t
+		options.TagName = tag
+	}
+
+	posts, _, err := u.postRepository.List(ctx, options)
+	if err != nil {
+		return nil, valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_posts"))
+	}
+
+	feed := &Feed{
+		Title:   u.settings.Title,
+		SiteURL: u.settings.SiteURL,
+		Tag:     tag,
+		Items:   make([]*FeedItem, 0, len(posts)),
+	}
+	if tag != nil {
+		feed.Title = u.settings.Title + " - " + tag.String()
+	}
+
+	for _, post := range posts {
+		item := u.convertToItem(post)
+		if item.UpdatedAt.After(feed.Updated) {
+			feed.Updated = item.UpdatedAt
+		}
+		feed.Items = append(feed.Items, item)
+	}
+
+	return feed, nil
+}
+
+func (u *GetFeedUsecase) convertToItem(post *entity.Post) *FeedItem {
+	publishedAt := post.CreatedAt
+	if post.FirstPublishedAt != nil {
+		publishedAt = *post.FirstPublishedAt
+	}
+	// 読者にとっての更新は本文の更新とする
+	updatedAt := publishedAt
+	if post.ContentUpdatedAt != nil && post.ContentUpdatedAt.After(updatedAt) {
+		updatedAt = *post.ContentUpdatedAt
+	}
+
+	tags := make([]string, 0, len(post.Tags))
+	for _, tag := range post.Tags {
+		tags = append(tags, tag.String())
+	}
+
+	var imageURL string
+	if post.OGImage != nil {
+		imageURL = post.OGImage.GCSURL
+	}
+
+	return &FeedItem{
+		ID: post.ID.String(),
+		// タイトルはHTMLエスケープして保存されているため、フィードのエンコード時に二重にエスケープされないよう戻しておく
+		Title:       post.Title.Value(),
+		URL:         u.settings.SiteURL + "/posts/" + post.ID.String(),
+		Content:     post.Content.String(),
+		Summary:     post.DisplayExcerpt().String(),
+		ImageURL:    imageURL,
+		Tags:        tags,
+		PublishedAt: publishedAt,
+		UpdatedAt:   updatedAt,
+	}
+}
@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/repository"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	repositoryMock "github.com/MizukiShigi/cms-go/mocks/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetFeedUsecase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPostRepo := repositoryMock.NewMockPostRepository(ctrl)
+	settings := FeedSettings{Title: "テストブログ", SiteURL: "https://example.com/", ItemCount: 10}
+	published := valueobject.StatusPublished
+
+	t.Run("公開中の投稿から初回公開日時順のフィードを作成する", func(t *testing.T) {
+		usecase := NewGetFeedUsecase(mockPostRepo, settings)
+
+		title, _ := valueobject.NewPostTitle(`Go & "Rust" の比較`)
+		content, _ := valueobject.NewPostContent("<p>本文</p>")
+		tagName, _ := valueobject.NewTagName("Go")
+		firstPublishedAt := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+		contentUpdatedAt := time.Date(2025, 1, 2, 9, 0, 0, 0, time.UTC)
+		post := entity.ParsePost(valueobject.NewPostID(), title, content, valueobject.NewUserID(), valueobject.StatusPublished,
+			firstPublishedAt, contentUpdatedAt, &firstPublishedAt, &contentUpdatedAt, []valueobject.TagName{tagName})
+
+		mockPostRepo.EXPECT().List(context.Background(), &repository.ListPostsOptions{
+			Limit:  10,
+			Status: &published,
+			Sort:   "first_published_at_desc",
+		}).Return([]*entity.Post{post}, 1, nil)
+
+		feed, err := usecase.Execute(context.Background(), &GetFeedInput{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "テストブログ", feed.Title)
+		assert.Equal(t, "https://example.com", feed.SiteURL)
+		assert.Equal(t, contentUpdatedAt, feed.Updated)
+		assert.Len(t, feed.Items, 1)
+		assert.Equal(t, `Go & "Rust" の比較`, feed.Items[0].Title)
+		assert.Equal(t, "<p>本文</p>", feed.Items[0].Content)
+		assert.Equal(t, "本文", feed.Items[0].Summary)
+		assert.Equal(t, "https://example.com/posts/"+post.ID.String(), feed.Items[0].URL)
+		assert.Equal(t, firstPublishedAt, feed.Items[0].PublishedAt)
+		assert.Equal(t, []string{"Go"}, feed.Items[0].Tags)
+	})
+
+	t.Run("タグと件数を指定してフィードを作成する", func(t *testing.T) {
+		usecase := NewGetFeedUsecase(mockPostRepo, settings)
+
+		tagName, _ := valueobject.NewTagName("golang")
+		mockPostRepo.EXPECT().List(context.Background(), &repository.ListPostsOptions{
+			Limit:   5,
+			Status:  &published,
+			TagName: &tagName,
+			Sort:    "first_published_at_desc",
+		}).Return([]*entity.Post{}, 0, nil)
+
+		feed, err := usecase.Execute(context.Background(), &GetFeedInput{Tag: "golang", Limit: "5"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "テストブログ - golang", feed.Title)
+		assert.Equal(t, tagName, *feed.Tag)
+		assert.Empty(t, feed.Items)
+		assert.True(t, feed.Updated.IsZero())
+	})
+
+	t.Run("全角のタグは正規化キーが一致するタグで絞り込む", func(t *testing.T) {
+		usecase := NewGetFeedUsecase(mockPostRepo, settings)
+
+		mockPostRepo.EXPECT().List(context.Background(), gomock.Cond(func(options *repository.ListPostsOptions) bool {
+			return options.TagName != nil && options.TagName.Key() == "golang"
+		})).Return([]*entity.Post{}, 0, nil)
+
+		_, err := usecase.Execute(context.Background(), &GetFeedInput{Tag: "Ｇｏｌａｎｇ"})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("件数が範囲外の場合は設定値を使う", func(t *testing.T) {
+		usecase := NewGetFeedUsecase(mockPostRepo, FeedSettings{Title: "テストブログ"})
+
+		mockPostRepo.EXPECT().List(context.Background(), &repository.ListPostsOptions{
+			Limit:  DefaultFeedItemCount,
+			Status: &published,
+			Sort:   "first_published_at_desc",
+		}).Return([]*entity.Post{}, 0, nil)
+
+		_, err := usecase.Execute(context.Background(), &GetFeedInput{Limit: "1000"})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("不正なタグはエラーになる", func(t *testing.T) {
+		usecase := NewGetFeedUsecase(mockPostRepo, settings)
+
+		feed, err := usecase.Execute(context.Background(), &GetFeedInput{Tag: "<script>"})
+
+		assert.Nil(t, feed)
+		var myErr *valueobject.MyError
+		assert.True(t, errors.As(err, &myErr))
+		assert.Equal(t, valueobject.InvalidCode, myErr.Code)
+	})
+}
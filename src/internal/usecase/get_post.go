@@ -13,36 +13,58 @@ type GetPostInput struct {
 }
 
 type GetPostOutput struct {
-	ID               valueobject.PostID
-	Title            valueobject.PostTitle
-	Content          valueobject.PostContent
-	Tags             []valueobject.TagName
-	Status           valueobject.PostStatus
-	FirstPublishedAt *time.Time
-	ContentUpdatedAt *time.Time
+	ID                valueobject.PostID
+	Title             valueobject.PostTitle
+	Content           valueobject.PostContent
+	Tags              []valueobject.TagName
+	Status            valueobject.PostStatus
+	FirstPublishedAt  *time.Time
+	ContentUpdatedAt  *time.Time
+	PrimaryCategoryID *valueobject.CategoryID
+	CategoryIDs       []valueobject.CategoryID
+	ReviewerID        *valueobject.UserID
+	SEO               PostSEOOutput
+	Version           int
+	// 編集中のユーザーがいない場合は nil
+	EditLock *PostLockOutput
 }
 
 type GetPostUsecase struct {
-	postRepository repository.PostRepository
+	postRepository     repository.PostRepository
+	postLockRepository repository.PostLockRepository
 }
 
-func NewGetPostUsecase(postRepository repository.PostRepository) *GetPostUsecase {
-	return &GetPostUsecase{postRepository: postRepository}
+func NewGetPostUsecase(postRepository repository.PostRepository, postLockRepository repository.PostLockRepository) *GetPostUsecase {
+	return &GetPostUsecase{postRepository: postRepository, postLockRepository: postLockRepository}
 }
 
 func (u *GetPostUsecase) Execute(ctx context.Context, input *GetPostInput) (*GetPostOutput, error) {
+	ctx, span := startSpan(ctx, "GetPostUsecase.Execute")
+	defer span.End()
+
 	post, err := u.postRepository.Get(ctx, input.ID)
 	if err != nil {
 		return nil, err
 	}
 
+	lock, err := u.postLockRepository.FindActive(ctx, input.ID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
 	return &GetPostOutput{
-		ID:               post.ID,
-		Title:            post.Title,
-		Content:          post.Content,
-		Status:           post.Status,
-		Tags:             post.Tags,
-		FirstPublishedAt: post.FirstPublishedAt,
-		ContentUpdatedAt: post.ContentUpdatedAt,
+		ID:                post.ID,
+		Title:             post.Title,
+		Content:           post.Content,
+		Status:            post.Status,
+		Tags:              post.Tags,
+		FirstPublishedAt:  post.FirstPublishedAt,
+		ContentUpdatedAt:  post.ContentUpdatedAt,
+		PrimaryCategoryID: post.PrimaryCategoryID,
+		CategoryIDs:       post.CategoryIDs,
+		ReviewerID:        post.ReviewerID,
+		SEO:               toPostSEOOutput(post),
+		Version:           post.Version,
+		EditLock:          toPostLockOutput(lock),
 	}, nil
 }
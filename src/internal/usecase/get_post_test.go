@@ -17,9 +17,10 @@ func TestGetPostUsecase_Execute(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockPostRepo := repositoryMock.NewMockPostRepository(ctrl)
+	mockPostLockRepo := repositoryMock.NewMockPostLockRepository(ctrl)
 
 	t.Run("公開済み投稿の取得が成功する", func(t *testing.T) {
-		usecase := NewGetPostUsecase(mockPostRepo)
+		usecase := NewGetPostUsecase(mockPostRepo, mockPostLockRepo)
 
 		postID := valueobject.NewPostID()
 		title, _ := valueobject.NewPostTitle("テスト投稿")
@@ -39,6 +40,7 @@ func TestGetPostUsecase_Execute(t *testing.T) {
 		}
 
 		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(post, nil)
+		mockPostLockRepo.EXPECT().FindActive(context.Background(), postID, gomock.Any()).Return(nil, nil)
 
 		output, err := usecase.Execute(context.Background(), input)
 
@@ -53,7 +55,7 @@ func TestGetPostUsecase_Execute(t *testing.T) {
 	})
 
 	t.Run("下書き投稿の取得が成功する", func(t *testing.T) {
-		usecase := NewGetPostUsecase(mockPostRepo)
+		usecase := NewGetPostUsecase(mockPostRepo, mockPostLockRepo)
 
 		postID := valueobject.NewPostID()
 		title, _ := valueobject.NewPostTitle("下書き投稿")
@@ -73,6 +75,7 @@ func TestGetPostUsecase_Execute(t *testing.T) {
 		post.FirstPublishedAt = nil
 
 		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(post, nil)
+		mockPostLockRepo.EXPECT().FindActive(context.Background(), postID, gomock.Any()).Return(nil, nil)
 
 		output, err := usecase.Execute(context.Background(), input)
 
@@ -87,7 +90,7 @@ func TestGetPostUsecase_Execute(t *testing.T) {
 	})
 
 	t.Run("投稿が存在しない場合にエラーが発生する", func(t *testing.T) {
-		usecase := NewGetPostUsecase(mockPostRepo)
+		usecase := NewGetPostUsecase(mockPostRepo, mockPostLockRepo)
 
 		postID := valueobject.NewPostID()
 
@@ -109,7 +112,7 @@ func TestGetPostUsecase_Execute(t *testing.T) {
 	})
 
 	t.Run("リポジトリエラーでエラーが発生する", func(t *testing.T) {
-		usecase := NewGetPostUsecase(mockPostRepo)
+		usecase := NewGetPostUsecase(mockPostRepo, mockPostLockRepo)
 
 		postID := valueobject.NewPostID()
 
@@ -129,4 +132,28 @@ func TestGetPostUsecase_Execute(t *testing.T) {
 		assert.ErrorAs(t, err, &myErr)
 		assert.Equal(t, valueobject.InternalServerErrorCode, myErr.Code)
 	})
+
+	t.Run("編集中の投稿はロック情報を含めて取得できる", func(t *testing.T) {
+		usecase := NewGetPostUsecase(mockPostRepo, mockPostLockRepo)
+
+		postID := valueobject.NewPostID()
+		title, _ := valueobject.NewPostTitle("編集中の投稿")
+		content, _ := valueobject.NewPostContent("編集中の内容")
+
+		post, _ := entity.NewPost(title, content, valueobject.NewUserID(), valueobject.StatusDraft)
+		post.ID = postID
+
+		editorID := valueobject.NewUserID()
+		lock := entity.NewPostLock(postID, editorID, time.Now())
+
+		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(post, nil)
+		mockPostLockRepo.EXPECT().FindActive(context.Background(), postID, gomock.Any()).Return(lock, nil)
+
+		output, err := usecase.Execute(context.Background(), &GetPostInput{ID: postID})
+
+		assert.NoError(t, err)
+		assert.NotNil(t, output.EditLock)
+		assert.Equal(t, editorID, output.EditLock.UserID)
+		assert.Equal(t, lock.ExpiresAt, output.EditLock.ExpiresAt)
+	})
 }
@@ -0,0 +1,235 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/event"
+	"github.com/MizukiShigi/cms-go/internal/domain/repository"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+const (
+	// SitemapMaxURLs は1つのサイトマップに載せられるURLの上限（sitemaps.org の仕様）
+	SitemapMaxURLs = 50000
+	// sitemapRebuildInterval はイベントで反映できない変更（本文の更新など）を取り込むための全件再構築の間隔
+	sitemapRebuildInterval = 1 * time.Hour
+)
+
+type GetSitemapInput struct {
+	// 0 の場合はルートのサイトマップ（分割が必要な場合はインデックス）、1以上の場合は分割したサイトマップのページ
+	Page int
+}
+
+// SitemapURL はサイトマップに載せる1URL
+type SitemapURL struct {
+	Loc          string
+	LastModified time.Time
+}
+
+// GetSitemapOutput はサイトマップの内容。URLs と Sitemaps のどちらか一方を持つ
+type GetSitemapOutput struct {
+	URLs []*SitemapURL
+	// 分割したサイトマップへの参照（サイトマップインデックス）
+	Sitemaps []*SitemapURL
+	// 含まれるURLの最終更新日時の最大値
+	LastModified time.Time
+}
+
+// GetSitemapUsecase は公開中の投稿とタグ一覧ページのサイトマップを返す
+// 公開中の投稿はメモリに保持し、公開・公開取り下げのイベントで差分を反映する
+type GetSitemapUsecase struct {
+	postRepository repository.PostRepository
+	siteURL        string
+	pageSize       int
+
+	mu      sync.Mutex
+	entries map[valueobject.PostID]*repository.SitemapPostEntry
+	builtAt time.Time
+}
+
+func NewGetSitemapUsecase(postRepository repository.PostRepository, siteURL string) *GetSitemapUsecase {
+	return &GetSitemapUsecase{
+		postRepository: postRepository,
+		siteURL:        strings.TrimRight(siteURL, "/"),
+		pageSize:       SitemapMaxURLs,
+	}
+}
+
+func (u *GetSitemapUsecase) Execute(ctx context.Context, input *GetSitemapInput) (*GetSitemapOutput, error) {
+	ctx, span := startSpan(ctx, "GetSitemapUsecase.Execute")
+	defer span.End()
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if u.entries == nil || time.Since(u.builtAt) >= sitemapRebuildInterval {
+		if err := u.rebuild(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	urls := u.urls()
+	pageCount := (len(urls) + u.pageSize - 1) / u.pageSize
+
+	if input.Page == 0 {
+		if pageCount <= 1 {
+			return newSitemapOutput(urls, nil), nil
+		}
+
+		sitemaps := make([]*SitemapURL, 0, pageCount)
+		for page := 1; page <= pageCount; page++ {
+			sitemaps = append(sitemaps, &SitemapURL{
+				Loc:          fmt.Sprintf("%s/sitemaps/%d.xml", u.siteURL, page),
+				LastModified: latestModified(u.page(urls, page)),
+			})
+		}
+		return newSitemapOutput(nil, sitemaps), nil
+	}
+
+	if input.Page < 0 || input.Page > pageCount || pageCount <= 1 {
+		return nil, valueobject.NewMyError(valueobject.NotFoundCode, "sitemap_not_found")
+	}
+
+	return newSitemapOutput(u.page(urls, input.Page), nil), nil
+}
+
+// HandlePostEvent は投稿の公開・公開取り下げをサイトマップに反映する
+func (u *GetSitemapUsecase) HandlePostEvent(ctx context.Context, e event.Event) error {
+	switch e := e.(type) {
+	case event.PostPublished:
+		// 未構築の場合は次回の取得時に全件を読み込む
+		if !u.isBuilt() {
+			return nil
+		}
+
+		// 取得中に他のリクエストを待たせないよう、ロックの外で取得する
+		post, err := u.postRepository.Get(ctx, e.PostID)
+
+		u.mu.Lock()
+		defer u.mu.Unlock()
+
+		if u.entries == nil {
+			return nil
+		}
+		if err != nil {
+			// 反映できなかったため、次回の取得時に全件を読み込み直す
+			slog.WarnContext(ctx, "Failed to get published post for sitemap", "post_id", e.PostID, "error", err)
+			u.entries = nil
+			return nil
+		}
+
+		lastModified := post.UpdatedAt
+		if post.ContentUpdatedAt != nil {
+			lastModified = *post.ContentUpdatedAt
+		}
+		u.entries[post.ID] = &repository.SitemapPostEntry{
+			ID:           post.ID,
+			LastModified: lastModified,
+			Tags:         post.Tags,
+		}
+	case event.PostUnpublished:
+		u.mu.Lock()
+		defer u.mu.Unlock()
+
+		if u.entries != nil {
+			delete(u.entries, e.PostID)
+		}
+	}
+
+	return nil
+}
+
+// isBuilt はサイトマップを構築済みかを返す
+func (u *GetSitemapUsecase) isBuilt() bool {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return u.entries != nil
+}
+
+func (u *GetSitemapUsecase) rebuild(ctx context.Context) error {
+	entries, err := u.postRepository.ListSitemapEntries(ctx)
+	if err != nil {
+		return valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_sitemap_entries"))
+	}
+
+	u.entries = make(map[valueobject.PostID]*repository.SitemapPostEntry, len(entries))
+	for _, entry := range entries {
+		u.entries[entry.ID] = entry
+	}
+	u.builtAt = time.Now()
+
+	return nil
+}
+
+// urls は投稿ページ、タグ一覧ページの順にURLを返す。ページ分割が変わらないようID・タグ名順に並べる
+func (u *GetSitemapUsecase) urls() []*SitemapURL {
+	postIDs := make([]valueobject.PostID, 0, len(u.entries))
+	tagModified := make(map[valueobject.TagName]time.Time)
+	for id, entry := range u.entries {
+		postIDs = append(postIDs, id)
+		for _, tag := range entry.Tags {
+			if entry.LastModified.After(tagModified[tag]) {
+				tagModified[tag] = entry.LastModified
+			}
+		}
+	}
+	slices.Sort(postIDs)
+
+	tags := make([]valueobject.TagName, 0, len(tagModified))
+	for tag := range tagModified {
+		tags = append(tags, tag)
+	}
+	slices.Sort(tags)
+
+	urls := make([]*SitemapURL, 0, len(postIDs)+len(tags))
+	for _, id := range postIDs {
+		urls = append(urls, &SitemapURL{
+			Loc:          u.siteURL + "/posts/" + id.String(),
+			LastModified: u.entries[id].LastModified,
+		})
+	}
+	for _, tag := range tags {
+		urls = append(urls, &SitemapURL{
+			Loc:          u.siteURL + "/tags/" + url.PathEscape(tag.String()),
+			LastModified: tagModified[tag],
+		})
+	}
+
+	return urls
+}
+
+func (u *GetSitemapUsecase) page(urls []*SitemapURL, page int) []*SitemapURL {
+	start := (page - 1) * u.pageSize
+	end := min(start+u.pageSize, len(urls))
+	return urls[start:end]
+}
+
+func newSitemapOutput(urls []*SitemapURL, sitemaps []*SitemapURL) *GetSitemapOutput {
+	lastModified := latestModified(urls)
+	if sitemapsModified := latestModified(sitemaps); sitemapsModified.After(lastModified) {
+		lastModified = sitemapsModified
+	}
+
+	return &GetSitemapOutput{
+		URLs:         urls,
+		Sitemaps:     sitemaps,
+		LastModified: lastModified,
+	}
+}
+
+func latestModified(urls []*SitemapURL) time.Time {
+	var latest time.Time
+	for _, u := range urls {
+		if u.LastModified.After(latest) {
+			latest = u.LastModified
+		}
+	}
+	return latest
+}
@@ -0,0 +1,155 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/event"
+	"github.com/MizukiShigi/cms-go/internal/domain/repository"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	repositoryMock "github.com/MizukiShigi/cms-go/mocks/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetSitemapUsecase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPostRepo := repositoryMock.NewMockPostRepository(ctrl)
+
+	goTag, _ := valueobject.NewTagName("Go")
+	dbTag, _ := valueobject.NewTagName("日本語")
+	older := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	postID1 := valueobject.PostID("00000000-0000-0000-0000-000000000001")
+	postID2 := valueobject.PostID("00000000-0000-0000-0000-000000000002")
+
+	newEntries := func() []*repository.SitemapPostEntry {
+		return []*repository.SitemapPostEntry{
+			{ID: postID2, LastModified: newer, Tags: []valueobject.TagName{goTag}},
+			{ID: postID1, LastModified: older, Tags: []valueobject.TagName{goTag, dbTag}},
+		}
+	}
+
+	t.Run("公開中の投稿とタグ一覧ページのサイトマップを返す", func(t *testing.T) {
+		usecase := NewGetSitemapUsecase(mockPostRepo, "https://example.com/")
+
+		mockPostRepo.EXPECT().ListSitemapEntries(context.Background()).Return(newEntries(), nil)
+
+		output, err := usecase.Execute(context.Background(), &GetSitemapInput{})
+
+		assert.NoError(t, err)
+		assert.Nil(t, output.Sitemaps)
+		assert.Equal(t, []*SitemapURL{
+			{Loc: "https://example.com/posts/" + postID1.String(), LastModified: older},
+			{Loc: "https://example.com/posts/" + postID2.String(), LastModified: newer},
+			{Loc: "https://example.com/tags/Go", LastModified: newer},
+			{Loc: "https://example.com/tags/%E6%97%A5%E6%9C%AC%E8%AA%9E", LastModified: older},
+		}, output.URLs)
+		assert.Equal(t, newer, output.LastModified)
+	})
+
+	t.Run("URL数が上限を超える場合はインデックスと分割したサイトマップを返す", func(t *testing.T) {
+		usecase := NewGetSitemapUsecase(mockPostRepo, "https://example.com")
+		usecase.pageSize = 3
+
+		mockPostRepo.EXPECT().ListSitemapEntries(context.Background()).Return(newEntries(), nil)
+
+		index, err := usecase.Execute(context.Background(), &GetSitemapInput{})
+		assert.NoError(t, err)
+		assert.Nil(t, index.URLs)
+		assert.Equal(t, []*SitemapURL{
+			{Loc: "https://example.com/sitemaps/1.xml", LastModified: newer},
+			{Loc: "https://example.com/sitemaps/2.xml", LastModified: older},
+		}, index.Sitemaps)
+
+		page2, err := usecase.Execute(context.Background(), &GetSitemapInput{Page: 2})
+		assert.NoError(t, err)
+		assert.Len(t, page2.URLs, 1)
+
+		_, err = usecase.Execute(context.Background(), &GetSitemapInput{Page: 3})
+		var myErr *valueobject.MyError
+		assert.True(t, errors.As(err, &myErr))
+		assert.Equal(t, valueobject.NotFoundCode, myErr.Code)
+	})
+
+	t.Run("分割していない場合はページ指定がNotFoundになる", func(t *testing.T) {
+		usecase := NewGetSitemapUsecase(mockPostRepo, "https://example.com")
+
+		mockPostRepo.EXPECT().ListSitemapEntries(context.Background()).Return(newEntries(), nil)
+
+		output, err := usecase.Execute(context.Background(), &GetSitemapInput{Page: 1})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.True(t, errors.As(err, &myErr))
+		assert.Equal(t, valueobject.NotFoundCode, myErr.Code)
+	})
+
+	t.Run("公開・公開取り下げのイベントを全件再取得せずに反映する", func(t *testing.T) {
+		usecase := NewGetSitemapUsecase(mockPostRepo, "https://example.com")
+
+		mockPostRepo.EXPECT().ListSitemapEntries(context.Background()).Return(newEntries(), nil).Times(1)
+		_, err := usecase.Execute(context.Background(), &GetSitemapInput{})
+		assert.NoError(t, err)
+
+		title, _ := valueobject.NewPostTitle("新しい投稿")
+		content, _ := valueobject.NewPostContent("本文")
+		published, _ := entity.NewPost(title, content, valueobject.NewUserID(), valueobject.StatusPublished)
+		mockPostRepo.EXPECT().Get(context.Background(), published.ID).Return(published, nil)
+
+		assert.NoError(t, usecase.HandlePostEvent(context.Background(), event.PostPublished{PostID: published.ID, At: time.Now()}))
+		assert.NoError(t, usecase.HandlePostEvent(context.Background(), event.PostUnpublished{PostID: postID1, To: valueobject.StatusPrivate, At: time.Now()}))
+
+		output, err := usecase.Execute(context.Background(), &GetSitemapInput{})
+
+		assert.NoError(t, err)
+		locs := make([]string, 0, len(output.URLs))
+		for _, u := range output.URLs {
+			locs = append(locs, u.Loc)
+		}
+		assert.Contains(t, locs, "https://example.com/posts/"+published.ID.String())
+		assert.NotContains(t, locs, "https://example.com/posts/"+postID1.String())
+		assert.NotContains(t, locs, "https://example.com/tags/%E6%97%A5%E6%9C%AC%E8%AA%9E")
+	})
+
+	t.Run("構築前のイベントは無視する", func(t *testing.T) {
+		usecase := NewGetSitemapUsecase(mockPostRepo, "https://example.com")
+
+		err := usecase.HandlePostEvent(context.Background(), event.PostPublished{PostID: postID1, At: time.Now()})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("公開した投稿を取得できない場合は次回の取得時に全件を読み込み直す", func(t *testing.T) {
+		usecase := NewGetSitemapUsecase(mockPostRepo, "https://example.com")
+
+		mockPostRepo.EXPECT().ListSitemapEntries(context.Background()).Return(newEntries(), nil).Times(2)
+		_, err := usecase.Execute(context.Background(), &GetSitemapInput{})
+		assert.NoError(t, err)
+
+		postID := valueobject.NewPostID()
+		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(nil, errors.New("db error"))
+
+		assert.NoError(t, usecase.HandlePostEvent(context.Background(), event.PostPublished{PostID: postID, At: time.Now()}))
+
+		_, err = usecase.Execute(context.Background(), &GetSitemapInput{})
+		assert.NoError(t, err)
+	})
+
+	t.Run("取得に失敗した場合はエラーになる", func(t *testing.T) {
+		usecase := NewGetSitemapUsecase(mockPostRepo, "https://example.com")
+
+		mockPostRepo.EXPECT().ListSitemapEntries(context.Background()).
+			Return(nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "Failed to get sitemap entries"))
+
+		output, err := usecase.Execute(context.Background(), &GetSitemapInput{})
+
+		assert.Nil(t, output)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/repository"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+type HeartbeatPostLockInput struct {
+	PostID valueobject.PostID
+	UserID valueobject.UserID
+}
+
+type HeartbeatPostLockUsecase struct {
+	postLockRepository repository.PostLockRepository
+}
+
+func NewHeartbeatPostLockUsecase(postLockRepository repository.PostLockRepository) *HeartbeatPostLockUsecase {
+	return &HeartbeatPostLockUsecase{postLockRepository: postLockRepository}
+}
+
+// Execute は保持中の編集ロックの有効期限を延長する。期限切れ後は再取得が必要
+func (u *HeartbeatPostLockUsecase) Execute(ctx context.Context, input *HeartbeatPostLockInput) (*PostLockOutput, error) {
+	ctx, span := startSpan(ctx, "HeartbeatPostLockUsecase.Execute")
+	defer span.End()
+
+	now := time.Now()
+	lock, err := u.postLockRepository.FindActive(ctx, input.PostID, now)
+	if err != nil {
+		return nil, valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_post_lock"))
+	}
+	if lock == nil || !lock.IsHeldBy(input.UserID) {
+		return nil, valueobject.NewMyError(valueobject.ConflictCode, "post_lock_is_not_held_by_the_user")
+	}
+
+	lock.Extend(now)
+	if err := u.postLockRepository.Extend(ctx, lock); err != nil {
+		return nil, valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_extend_post_lock"))
+	}
+
+	return toPostLockOutput(lock), nil
+}
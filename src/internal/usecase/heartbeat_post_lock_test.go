@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	repositoryMock "github.com/MizukiShigi/cms-go/mocks/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHeartbeatPostLockUsecase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPostLockRepo := repositoryMock.NewMockPostLockRepository(ctrl)
+
+	t.Run("ロックの有効期限の延長が成功する", func(t *testing.T) {
+		usecase := NewHeartbeatPostLockUsecase(mockPostLockRepo)
+
+		postID := valueobject.NewPostID()
+		userID := valueobject.NewUserID()
+		lock := entity.NewPostLock(postID, userID, time.Now().Add(-3*time.Minute))
+		oldExpiresAt := lock.ExpiresAt
+
+		mockPostLockRepo.EXPECT().FindActive(context.Background(), postID, gomock.Any()).Return(lock, nil)
+		mockPostLockRepo.EXPECT().Extend(context.Background(), lock).Return(nil)
+
+		output, err := usecase.Execute(context.Background(), &HeartbeatPostLockInput{PostID: postID, UserID: userID})
+
+		assert.NoError(t, err)
+		assert.True(t, output.ExpiresAt.After(oldExpiresAt))
+	})
+
+	t.Run("ロックがない場合にエラーが発生する", func(t *testing.T) {
+		usecase := NewHeartbeatPostLockUsecase(mockPostLockRepo)
+
+		postID := valueobject.NewPostID()
+
+		mockPostLockRepo.EXPECT().FindActive(context.Background(), postID, gomock.Any()).Return(nil, nil)
+
+		output, err := usecase.Execute(context.Background(), &HeartbeatPostLockInput{PostID: postID, UserID: valueobject.NewUserID()})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, valueobject.ConflictCode, myErr.Code)
+	})
+
+	t.Run("他のユーザーのロックは延長できない", func(t *testing.T) {
+		usecase := NewHeartbeatPostLockUsecase(mockPostLockRepo)
+
+		postID := valueobject.NewPostID()
+		lock := entity.NewPostLock(postID, valueobject.NewUserID(), time.Now())
+
+		mockPostLockRepo.EXPECT().FindActive(context.Background(), postID, gomock.Any()).Return(lock, nil)
+
+		output, err := usecase.Execute(context.Background(), &HeartbeatPostLockInput{PostID: postID, UserID: valueobject.NewUserID()})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, valueobject.ConflictCode, myErr.Code)
+	})
+}
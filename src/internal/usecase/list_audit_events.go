@@ -0,0 +1,185 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/repository"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+type ListAuditEventsUsecase struct {
+	auditEventRepository repository.AuditEventRepository
+}
+
+func NewListAuditEventsUsecase(auditEventRepository repository.AuditEventRepository) *ListAuditEventsUsecase {
+	return &ListAuditEventsUsecase{
+		auditEventRepository: auditEventRepository,
+	}
+}
+
+// ListAuditEventsRequest は監査イベント一覧取得のリクエスト
+type ListAuditEventsRequest struct {
+	Limit      string
+	Offset     string
+	ActorID    string
+	EntityType string
+	EntityID   string
+	Action     string
+	RequestID  string
+	// RFC3339形式の日時
+	From string
+	To   string
+}
+
+// ListAuditEventsResponse は監査イベント一覧取得のレスポンス
+type ListAuditEventsResponse struct {
+	Events []*AuditEventSummary `json:"events"`
+	Meta   *PaginationMeta      `json:"meta"`
+}
+
+// AuditEventSummary は監査イベントの情報
+type AuditEventSummary struct {
+	ID         int64                `json:"id"`
+	ActorID    *string              `json:"actor_id"`
+	RequestID  string               `json:"request_id"`
+	EntityType string               `json:"entity_type"`
+	EntityID   string               `json:"entity_id"`
+	Action     string               `json:"action"`
+	Changes    []*AuditChangeDetail `json:"changes"`
+	CreatedAt  string               `json:"created_at"`
+}
+
+// AuditChangeDetail は1項目の変更前後の値
+type AuditChangeDetail struct {
+	Field  string `json:"field"`
+	Before any    `json:"before"`
+	After  any    `json:"after"`
+}
+
+func (u *ListAuditEventsUsecase) Execute(ctx context.Context, req *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	ctx, span := startSpan(ctx, "ListAuditEventsUsecase.Execute")
+	defer span.End()
+
+	// パラメータのバリデーションとデフォルト値設定
+	limit := 20
+	if req.Limit != "" {
+		if l, err := strconv.Atoi(req.Limit); err == nil && l > 0 && l <= 100 {
+			limit = l
+		}
+	}
+
+	offset := 0
+	if req.Offset != "" {
+		if o, err := strconv.Atoi(req.Offset); err == nil && o >= 0 {
+			offset = o
+		}
+	}
+
+	options := &repository.ListAuditEventsOptions{
+		Limit:     limit,
+		Offset:    offset,
+		EntityID:  strings.TrimSpace(req.EntityID),
+		RequestID: strings.TrimSpace(req.RequestID),
+	}
+
+	// 絞り込み条件の処理（不正な値はエラー）
+	if req.ActorID != "" {
+		actorID, err := valueobject.ParseUserID(req.ActorID)
+		if err != nil {
+			return nil, valueobject.NewMyError(valueobject.InvalidCode, "invalid_actor_id")
+		}
+		options.ActorID = &actorID
+	}
+
+	if req.EntityType != "" {
+		entityType, err := valueobject.NewAuditEntityType(req.EntityType)
+		if err != nil {
+			return nil, err
+		}
+		options.EntityType = &entityType
+	}
+
+	if req.Action != "" {
+		action, err := valueobject.NewAuditAction(req.Action)
+		if err != nil {
+			return nil, err
+		}
+		options.Action = &action
+	}
+
+	if req.From != "" {
+		from, err := time.Parse(time.RFC3339, req.From)
+		if err != nil {
+			return nil, valueobject.NewMyError(valueobject.InvalidCode, "invalid_from")
+		}
+		options.From = &from
+	}
+
+	if req.To != "" {
+		to, err := time.Parse(time.RFC3339, req.To)
+		if err != nil {
+			return nil, valueobject.NewMyError(valueobject.InvalidCode, "invalid_to")
+		}
+		options.To = &to
+	}
+
+	if options.From != nil && options.To != nil && options.From.After(*options.To) {
+		return nil, valueobject.NewMyError(valueobject.InvalidCode, "from_must_be_before_to")
+	}
+
+	// 監査イベント一覧取得
+	events, total, err := u.auditEventRepository.List(ctx, options)
+	if err != nil {
+		return nil, valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_list_audit_events"))
+	}
+
+	// レスポンス作成
+	summaries := make([]*AuditEventSummary, 0, len(events))
+	for _, event := range events {
+		summaries = append(summaries, u.convertToSummary(event))
+	}
+
+	meta := &PaginationMeta{
+		Total:   total,
+		Limit:   limit,
+		Offset:  offset,
+		HasNext: offset+limit < total,
+	}
+
+	return &ListAuditEventsResponse{
+		Events: summaries,
+		Meta:   meta,
+	}, nil
+}
+
+func (u *ListAuditEventsUsecase) convertToSummary(event *entity.AuditEvent) *AuditEventSummary {
+	var actorID *string
+	if event.ActorID != nil {
+		id := event.ActorID.String()
+		actorID = &id
+	}
+
+	changes := make([]*AuditChangeDetail, 0, len(event.Changes))
+	for _, change := range event.Changes {
+		changes = append(changes, &AuditChangeDetail{
+			Field:  change.Field,
+			Before: change.Before,
+			After:  change.After,
+		})
+	}
+
+	return &AuditEventSummary{
+		ID:         event.ID,
+		ActorID:    actorID,
+		RequestID:  event.RequestID,
+		EntityType: event.EntityType.String(),
+		EntityID:   event.EntityID,
+		Action:     event.Action.String(),
+		Changes:    changes,
+		CreatedAt:  event.CreatedAt.Format(time.RFC3339),
+	}
+}
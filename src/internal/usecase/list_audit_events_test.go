@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/repository"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	repositoryMock "github.com/MizukiShigi/cms-go/mocks/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListAuditEventsUsecase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuditRepo := repositoryMock.NewMockAuditEventRepository(ctrl)
+
+	t.Run("絞り込み条件を指定して監査イベント一覧を取得できる", func(t *testing.T) {
+		usecase := NewListAuditEventsUsecase(mockAuditRepo)
+
+		actorID := valueobject.NewUserID()
+		postID := valueobject.NewPostID()
+		entityType := valueobject.AuditEntityPost
+		action := valueobject.AuditActionUpdate
+		from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+		createdAt := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+
+		events := []*entity.AuditEvent{
+			entity.ParseAuditEvent(1, &actorID, "req-1", entityType, postID.String(), action,
+				[]entity.AuditChange{{Field: "title", Before: "旧タイトル", After: "新タイトル"}}, createdAt),
+		}
+
+		expectedOptions := &repository.ListAuditEventsOptions{
+			Limit:      10,
+			Offset:     0,
+			ActorID:    &actorID,
+			EntityType: &entityType,
+			EntityID:   postID.String(),
+			Action:     &action,
+			RequestID:  "req-1",
+			From:       &from,
+			To:         &to,
+		}
+
+		mockAuditRepo.EXPECT().
+			List(gomock.Any(), expectedOptions).
+			Return(events, 11, nil)
+
+		req := &ListAuditEventsRequest{
+			Limit:      "10",
+			ActorID:    actorID.String(),
+			EntityType: "post",
+			EntityID:   postID.String(),
+			Action:     "update",
+			RequestID:  "req-1",
+			From:       "2025-01-01T00:00:00Z",
+			To:         "2025-01-31T00:00:00Z",
+		}
+
+		result, err := usecase.Execute(context.Background(), req)
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Events, 1)
+		assert.Equal(t, int64(1), result.Events[0].ID)
+		assert.Equal(t, actorID.String(), *result.Events[0].ActorID)
+		assert.Equal(t, "post", result.Events[0].EntityType)
+		assert.Equal(t, "update", result.Events[0].Action)
+		assert.Equal(t, "title", result.Events[0].Changes[0].Field)
+		assert.Equal(t, "新タイトル", result.Events[0].Changes[0].After)
+		assert.Equal(t, "2025-01-10T12:00:00Z", result.Events[0].CreatedAt)
+		assert.Equal(t, 11, result.Meta.Total)
+		assert.True(t, result.Meta.HasNext)
+	})
+
+	t.Run("操作ユーザーがいないイベントはactor_idがnilになる", func(t *testing.T) {
+		usecase := NewListAuditEventsUsecase(mockAuditRepo)
+
+		events := []*entity.AuditEvent{
+			entity.ParseAuditEvent(2, nil, "", valueobject.AuditEntityTag, "unused", valueobject.AuditActionDelete, []entity.AuditChange{}, time.Now()),
+		}
+
+		mockAuditRepo.EXPECT().
+			List(gomock.Any(), &repository.ListAuditEventsOptions{Limit: 20, Offset: 0}).
+			Return(events, 1, nil)
+
+		result, err := usecase.Execute(context.Background(), &ListAuditEventsRequest{})
+
+		assert.NoError(t, err)
+		assert.Nil(t, result.Events[0].ActorID)
+		assert.Empty(t, result.Events[0].Changes)
+		assert.False(t, result.Meta.HasNext)
+	})
+
+	tests := []struct {
+		name    string
+		req     *ListAuditEventsRequest
+		wantKey string
+	}{
+		{name: "不正なactor_id", req: &ListAuditEventsRequest{ActorID: "invalid"}, wantKey: "invalid_actor_id"},
+		{name: "不正なentity_type", req: &ListAuditEventsRequest{EntityType: "user"}, wantKey: "invalid_audit_entity_type"},
+		{name: "不正なaction", req: &ListAuditEventsRequest{Action: "read"}, wantKey: "invalid_audit_action"},
+		{name: "不正なfrom", req: &ListAuditEventsRequest{From: "2025-01-01"}, wantKey: "invalid_from"},
+		{name: "fromがtoより後", req: &ListAuditEventsRequest{From: "2025-02-01T00:00:00Z", To: "2025-01-01T00:00:00Z"}, wantKey: "from_must_be_before_to"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name+"はエラーになる", func(t *testing.T) {
+			usecase := NewListAuditEventsUsecase(mockAuditRepo)
+
+			result, err := usecase.Execute(context.Background(), tt.req)
+
+			assert.Error(t, err)
+			assert.Nil(t, result)
+			var myErr *valueobject.MyError
+			assert.True(t, errors.As(err, &myErr))
+			assert.Equal(t, valueobject.InvalidCode, myErr.Code)
+			assert.Equal(t, tt.wantKey, string(myErr.Key()))
+		})
+	}
+}
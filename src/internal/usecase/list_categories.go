@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/repository"
+)
+
+// CategoryNode はカテゴリツリーのノード
+type CategoryNode struct {
+	ID          string          `json:"id"`
+	ParentID    *string         `json:"parent_id"`
+	Name        string          `json:"name"`
+	Slug        string          `json:"slug"`
+	Description string          `json:"description"`
+	SortOrder   int             `json:"sort_order"`
+	Children    []*CategoryNode `json:"children"`
+}
+
+// ListCategoriesResponse はカテゴリツリー取得のレスポンス
+type ListCategoriesResponse struct {
+	Categories []*CategoryNode `json:"categories"`
+}
+
+type ListCategoriesUsecase struct {
+	categoryRepository repository.CategoryRepository
+}
+
+func NewListCategoriesUsecase(categoryRepository repository.CategoryRepository) *ListCategoriesUsecase {
+	return &ListCategoriesUsecase{categoryRepository: categoryRepository}
+}
+
+func (u *ListCategoriesUsecase) Execute(ctx context.Context) (*ListCategoriesResponse, error) {
+	ctx, span := startSpan(ctx, "ListCategoriesUsecase.Execute")
+	defer span.End()
+
+	// リポジトリは並び順（sort_order, name）でソート済みのため、その順序のままツリーを組み立てる
+	categories, err := u.categoryRepository.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make(map[string]*CategoryNode, len(categories))
+	for _, category := range categories {
+		nodes[category.ID.String()] = u.convertToNode(category)
+	}
+
+	roots := make([]*CategoryNode, 0)
+	for _, category := range categories {
+		node := nodes[category.ID.String()]
+		if category.ParentID == nil {
+			roots = append(roots, node)
+			continue
+		}
+
+		parent, ok := nodes[category.ParentID.String()]
+		if !ok {
+			// 親が見つからない場合はルートとして扱う
+			roots = append(roots, node)
+			continue
+		}
+		parent.Children = append(parent.Children, node)
+	}
+
+	return &ListCategoriesResponse{Categories: roots}, nil
+}
+
+func (u *ListCategoriesUsecase) convertToNode(category *entity.Category) *CategoryNode {
+	var parentID *string
+	if category.ParentID != nil {
+		id := category.ParentID.String()
+		parentID = &id
+	}
+
+	return &CategoryNode{
+		ID:          category.ID.String(),
+		ParentID:    parentID,
+		Name:        category.Name.String(),
+		Slug:        category.Slug.String(),
+		Description: category.Description,
+		SortOrder:   category.SortOrder,
+		Children:    []*CategoryNode{},
+	}
+}
@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	repositoryMock "github.com/MizukiShigi/cms-go/mocks/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListCategoriesUsecase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCategoryRepo := repositoryMock.NewMockCategoryRepository(ctrl)
+
+	newCategory := func(name, slug string, sortOrder int, parent *entity.Category) *entity.Category {
+		voName, _ := valueobject.NewCategoryName(name)
+		voSlug, _ := valueobject.NewCategorySlug(slug)
+		category, _ := entity.NewCategory(voName, voSlug, "", sortOrder)
+		if parent != nil {
+			category.ParentID = &parent.ID
+		}
+		return category
+	}
+
+	t.Run("カテゴリツリーの組み立てが成功する", func(t *testing.T) {
+		usecase := NewListCategoriesUsecase(mockCategoryRepo)
+
+		tech := newCategory("技術", "technology", 0, nil)
+		life := newCategory("生活", "life", 1, nil)
+		golang := newCategory("Go言語", "golang", 0, tech)
+		generics := newCategory("ジェネリクス", "generics", 0, golang)
+		rust := newCategory("Rust", "rust", 1, tech)
+
+		mockCategoryRepo.EXPECT().List(context.Background()).
+			Return([]*entity.Category{tech, golang, generics, life, rust}, nil)
+
+		result, err := usecase.Execute(context.Background())
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Categories, 2)
+		assert.Equal(t, "technology", result.Categories[0].Slug)
+		assert.Equal(t, "life", result.Categories[1].Slug)
+		assert.Empty(t, result.Categories[1].Children)
+
+		techChildren := result.Categories[0].Children
+		assert.Len(t, techChildren, 2)
+		assert.Equal(t, "golang", techChildren[0].Slug)
+		assert.Equal(t, "rust", techChildren[1].Slug)
+		assert.Equal(t, tech.ID.String(), *techChildren[0].ParentID)
+
+		assert.Len(t, techChildren[0].Children, 1)
+		assert.Equal(t, "generics", techChildren[0].Children[0].Slug)
+	})
+
+	t.Run("カテゴリがない場合は空配列を返す", func(t *testing.T) {
+		usecase := NewListCategoriesUsecase(mockCategoryRepo)
+
+		mockCategoryRepo.EXPECT().List(context.Background()).Return([]*entity.Category{}, nil)
+
+		result, err := usecase.Execute(context.Background())
+
+		assert.NoError(t, err)
+		assert.NotNil(t, result.Categories)
+		assert.Empty(t, result.Categories)
+	})
+
+	t.Run("リポジトリエラーが返される", func(t *testing.T) {
+		usecase := NewListCategoriesUsecase(mockCategoryRepo)
+
+		mockCategoryRepo.EXPECT().List(context.Background()).
+			Return(nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "Failed to get categories"))
+
+		result, err := usecase.Execute(context.Background())
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/repository"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+type ListPostTransitionsInput struct {
+	PostID valueobject.PostID
+	UserID valueobject.UserID
+	Roles  []valueobject.Role
+}
+
+type PostTransitionOutput struct {
+	Name string
+	To   valueobject.PostStatus
+}
+
+type ListPostTransitionsOutput struct {
+	Status      valueobject.PostStatus
+	Transitions []PostTransitionOutput
+}
+
+type ListPostTransitionsUsecase struct {
+	postRepository   repository.PostRepository
+	postStateMachine *entity.PostStateMachine
+}
+
+func NewListPostTransitionsUsecase(postRepository repository.PostRepository, postStateMachine *entity.PostStateMachine) *ListPostTransitionsUsecase {
+	return &ListPostTransitionsUsecase{postRepository: postRepository, postStateMachine: postStateMachine}
+}
+
+// Execute は投稿の現在のステータスからユーザーが実行できる遷移を返す
+func (u *ListPostTransitionsUsecase) Execute(ctx context.Context, input *ListPostTransitionsInput) (*ListPostTransitionsOutput, error) {
+	ctx, span := startSpan(ctx, "ListPostTransitionsUsecase.Execute")
+	defer span.End()
+
+	post, err := u.postRepository.Get(ctx, input.PostID)
+	if err != nil {
+		return nil, valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_post"))
+	}
+
+	transitions := u.postStateMachine.AvailableTransitions(post, entity.NewActor(input.UserID, input.Roles))
+
+	outputs := make([]PostTransitionOutput, 0, len(transitions))
+	for _, transition := range transitions {
+		outputs = append(outputs, PostTransitionOutput{Name: transition.Name, To: transition.To})
+	}
+
+	return &ListPostTransitionsOutput{Status: post.Status, Transitions: outputs}, nil
+}
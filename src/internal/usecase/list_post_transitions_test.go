@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	repositoryMock "github.com/MizukiShigi/cms-go/mocks/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListPostTransitionsUsecase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPostRepo := repositoryMock.NewMockPostRepository(ctrl)
+
+	title, _ := valueobject.NewPostTitle("タイトル")
+	content, _ := valueobject.NewPostContent("内容")
+
+	t.Run("公開権限を持つユーザーは承認済みの投稿を公開できる", func(t *testing.T) {
+		usecase := NewListPostTransitionsUsecase(mockPostRepo, entity.DefaultPostStateMachine())
+
+		post, _ := entity.NewPost(title, content, valueobject.NewUserID(), valueobject.StatusApproved)
+		mockPostRepo.EXPECT().Get(context.Background(), post.ID).Return(post, nil)
+
+		output, err := usecase.Execute(context.Background(), &ListPostTransitionsInput{
+			PostID: post.ID,
+			UserID: valueobject.NewUserID(),
+			Roles:  []valueobject.Role{valueobject.RolePublisher},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, valueobject.StatusApproved, output.Status)
+		assert.Equal(t, []PostTransitionOutput{{Name: "publish", To: valueobject.StatusPublished}}, output.Transitions)
+	})
+
+	t.Run("実行できる遷移がない場合は空配列を返す", func(t *testing.T) {
+		usecase := NewListPostTransitionsUsecase(mockPostRepo, entity.DefaultPostStateMachine())
+
+		post, _ := entity.NewPost(title, content, valueobject.NewUserID(), valueobject.StatusPublished)
+		mockPostRepo.EXPECT().Get(context.Background(), post.ID).Return(post, nil)
+
+		output, err := usecase.Execute(context.Background(), &ListPostTransitionsInput{
+			PostID: post.ID,
+			UserID: post.UserID,
+		})
+
+		assert.NoError(t, err)
+		assert.NotNil(t, output.Transitions)
+		assert.Empty(t, output.Transitions)
+	})
+
+	t.Run("投稿が存在しない場合にエラーが発生する", func(t *testing.T) {
+		usecase := NewListPostTransitionsUsecase(mockPostRepo, entity.DefaultPostStateMachine())
+
+		postID := valueobject.NewPostID()
+		mockPostRepo.EXPECT().Get(context.Background(), postID).
+			Return(nil, valueobject.NewMyError(valueobject.NotFoundCode, "Post not found"))
+
+		output, err := usecase.Execute(context.Background(), &ListPostTransitionsInput{PostID: postID})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, valueobject.NotFoundCode, myErr.Code)
+	})
+}
@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/repository"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+type ListPostWorkflowHistoriesInput struct {
+	PostID valueobject.PostID
+}
+
+type PostWorkflowHistoryOutput struct {
+	FromStatus valueobject.PostStatus
+	ToStatus   valueobject.PostStatus
+	ActorID    valueobject.UserID
+	Comment    string
+	CreatedAt  time.Time
+}
+
+type ListPostWorkflowHistoriesUsecase struct {
+	postRepository    repository.PostRepository
+	historyRepository repository.PostWorkflowHistoryRepository
+}
+
+func NewListPostWorkflowHistoriesUsecase(postRepository repository.PostRepository, historyRepository repository.PostWorkflowHistoryRepository) *ListPostWorkflowHistoriesUsecase {
+	return &ListPostWorkflowHistoriesUsecase{postRepository: postRepository, historyRepository: historyRepository}
+}
+
+// Execute は投稿のワークフロー履歴を古い順に返す
+func (u *ListPostWorkflowHistoriesUsecase) Execute(ctx context.Context, input *ListPostWorkflowHistoriesInput) ([]*PostWorkflowHistoryOutput, error) {
+	ctx, span := startSpan(ctx, "ListPostWorkflowHistoriesUsecase.Execute")
+	defer span.End()
+
+	if _, err := u.postRepository.Get(ctx, input.PostID); err != nil {
+		return nil, valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_post"))
+	}
+
+	histories, err := u.historyRepository.ListByPostID(ctx, input.PostID)
+	if err != nil {
+		return nil, valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_post_workflow_histories"))
+	}
+
+	outputs := make([]*PostWorkflowHistoryOutput, 0, len(histories))
+	for _, history := range histories {
+		outputs = append(outputs, &PostWorkflowHistoryOutput{
+			FromStatus: history.FromStatus,
+			ToStatus:   history.ToStatus,
+			ActorID:    history.ActorID,
+			Comment:    history.Comment,
+			CreatedAt:  history.CreatedAt,
+		})
+	}
+
+	return outputs, nil
+}
@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	repositoryMock "github.com/MizukiShigi/cms-go/mocks/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListPostWorkflowHistoriesUsecase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPostRepo := repositoryMock.NewMockPostRepository(ctrl)
+	mockHistoryRepo := repositoryMock.NewMockPostWorkflowHistoryRepository(ctrl)
+
+	t.Run("履歴の取得が成功する", func(t *testing.T) {
+		usecase := NewListPostWorkflowHistoriesUsecase(mockPostRepo, mockHistoryRepo)
+
+		title, _ := valueobject.NewPostTitle("タイトル")
+		content, _ := valueobject.NewPostContent("内容")
+		post, _ := entity.NewPost(title, content, valueobject.NewUserID(), valueobject.StatusDraft)
+		reviewerID := valueobject.NewUserID()
+		createdAt := time.Now()
+
+		histories := []*entity.PostWorkflowHistory{
+			entity.ParsePostWorkflowHistory(1, post.ID, valueobject.StatusDraft, valueobject.StatusInReview, post.UserID, "", createdAt.Add(-time.Hour)),
+			entity.ParsePostWorkflowHistory(2, post.ID, valueobject.StatusInReview, valueobject.StatusDraft, reviewerID, "要修正", createdAt),
+		}
+
+		mockPostRepo.EXPECT().Get(context.Background(), post.ID).Return(post, nil)
+		mockHistoryRepo.EXPECT().ListByPostID(context.Background(), post.ID).Return(histories, nil)
+
+		output, err := usecase.Execute(context.Background(), &ListPostWorkflowHistoriesInput{PostID: post.ID})
+
+		assert.NoError(t, err)
+		assert.Len(t, output, 2)
+		assert.Equal(t, valueobject.StatusInReview, output[0].ToStatus)
+		assert.Equal(t, reviewerID, output[1].ActorID)
+		assert.Equal(t, "要修正", output[1].Comment)
+	})
+
+	t.Run("投稿が存在しない場合にエラーが発生する", func(t *testing.T) {
+		usecase := NewListPostWorkflowHistoriesUsecase(mockPostRepo, mockHistoryRepo)
+
+		postID := valueobject.NewPostID()
+		mockPostRepo.EXPECT().Get(context.Background(), postID).
+			Return(nil, valueobject.NewMyError(valueobject.NotFoundCode, "Post not found"))
+
+		output, err := usecase.Execute(context.Background(), &ListPostWorkflowHistoriesInput{PostID: postID})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, valueobject.NotFoundCode, myErr.Code)
+	})
+}
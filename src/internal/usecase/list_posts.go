@@ -21,10 +21,11 @@ func NewListPostsUsecase(postRepository repository.PostRepository) *ListPostsUse
 
 // ListPostsRequest は投稿一覧取得のリクエスト
 type ListPostsRequest struct {
-	Limit  string
-	Offset string
-	Status string
-	Sort   string
+	Limit    string
+	Offset   string
+	Status   string
+	Category string
+	Sort     string
 }
 
 // ListPostsResponse は投稿一覧取得のレスポンス
@@ -35,12 +36,17 @@ type ListPostsResponse struct {
 
 // PostSummary は投稿の概要情報
 type PostSummary struct {
-	ID               string   `json:"id"`
-	Title            string   `json:"title"`
-	Status           string   `json:"status"`
-	Tags             []string `json:"tags"`
-	FirstPublishedAt *string  `json:"first_published_at"`
-	ContentUpdatedAt *string  `json:"content_updated_at"`
+	ID                string   `json:"id"`
+	Title             string   `json:"title"`
+	Status            string   `json:"status"`
+	Tags              []string `json:"tags"`
+	PrimaryCategoryID *string  `json:"primary_category_id"`
+	CategoryIDs       []string `json:"category_ids"`
+	FirstPublishedAt  *string  `json:"first_published_at"`
+	ContentUpdatedAt  *string  `json:"content_updated_at"`
+	// 未設定の場合は本文から生成した抜粋
+	Excerpt    string  `json:"excerpt"`
+	OGImageURL *string `json:"og_image_url"`
 }
 
 // PaginationMeta はページネーション情報
@@ -52,6 +58,9 @@ type PaginationMeta struct {
 }
 
 func (u *ListPostsUsecase) Execute(ctx context.Context, req *ListPostsRequest) (*ListPostsResponse, error) {
+	ctx, span := startSpan(ctx, "ListPostsUsecase.Execute")
+	defer span.End()
+
 	// パラメータのバリデーションとデフォルト値設定
 	limit := 20
 	if req.Limit != "" {
@@ -83,12 +92,23 @@ func (u *ListPostsUsecase) Execute(ctx context.Context, req *ListPostsRequest) (
 		}
 	}
 
+	// カテゴリフィルタの処理（不正なIDはエラー）
+	var categoryID *valueobject.CategoryID
+	if req.Category != "" {
+		c, err := valueobject.ParseCategoryID(req.Category)
+		if err != nil {
+			return nil, err
+		}
+		categoryID = &c
+	}
+
 	// リポジトリオプション作成
 	options := &repository.ListPostsOptions{
-		Limit:  limit,
-		Offset: offset,
-		Status: status,
-		Sort:   sort,
+		Limit:      limit,
+		Offset:     offset,
+		Status:     status,
+		CategoryID: categoryID,
+		Sort:       sort,
 	}
 
 	// 投稿一覧取得
@@ -122,6 +142,17 @@ func (u *ListPostsUsecase) convertToSummary(post *entity.Post) *PostSummary {
 		tags = append(tags, tag.String())
 	}
 
+	var primaryCategoryID *string
+	if post.PrimaryCategoryID != nil {
+		id := post.PrimaryCategoryID.String()
+		primaryCategoryID = &id
+	}
+
+	categoryIDs := make([]string, 0, len(post.CategoryIDs))
+	for _, categoryID := range post.CategoryIDs {
+		categoryIDs = append(categoryIDs, categoryID.String())
+	}
+
 	var firstPublishedAt *string
 	if post.FirstPublishedAt != nil {
 		iso := post.FirstPublishedAt.Format("2006-01-02T15:04:05Z")
@@ -134,12 +165,21 @@ func (u *ListPostsUsecase) convertToSummary(post *entity.Post) *PostSummary {
 		contentUpdatedAt = &iso
 	}
 
+	var ogImageURL *string
+	if post.OGImage != nil {
+		ogImageURL = &post.OGImage.GCSURL
+	}
+
 	return &PostSummary{
-		ID:               post.ID.String(),
-		Title:            post.Title.String(),
-		Status:           post.Status.String(),
-		Tags:             tags,
-		FirstPublishedAt: firstPublishedAt,
-		ContentUpdatedAt: contentUpdatedAt,
+		ID:                post.ID.String(),
+		Title:             post.Title.String(),
+		Status:            post.Status.String(),
+		Tags:              tags,
+		PrimaryCategoryID: primaryCategoryID,
+		CategoryIDs:       categoryIDs,
+		FirstPublishedAt:  firstPublishedAt,
+		ContentUpdatedAt:  contentUpdatedAt,
+		Excerpt:           post.DisplayExcerpt().String(),
+		OGImageURL:        ogImageURL,
 	}
 }
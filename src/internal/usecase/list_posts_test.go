@@ -137,6 +137,42 @@ func TestListPostsUsecase_Execute(t *testing.T) {
 		assert.Equal(t, 10, result.Meta.Total)
 	})
 
+	t.Run("カテゴリフィルタが正しく動作する", func(t *testing.T) {
+		usecase := NewListPostsUsecase(mockPostRepo)
+
+		categoryID := valueobject.NewCategoryID()
+		expectedOptions := &repository.ListPostsOptions{
+			Limit:      20,
+			Offset:     0,
+			CategoryID: &categoryID,
+			Sort:       "created_at_desc",
+		}
+
+		mockPostRepo.EXPECT().
+			List(gomock.Any(), expectedOptions).
+			Return([]*entity.Post{}, 0, nil)
+
+		result, err := usecase.Execute(context.Background(), &ListPostsRequest{
+			Category: categoryID.String(),
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, result.Meta.Total)
+	})
+
+	t.Run("不正なカテゴリIDでエラーが発生する", func(t *testing.T) {
+		usecase := NewListPostsUsecase(mockPostRepo)
+
+		result, err := usecase.Execute(context.Background(), &ListPostsRequest{
+			Category: "invalid",
+		})
+
+		assert.Nil(t, result)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, valueobject.InvalidCode, myErr.Code)
+	})
+
 	t.Run("ソート設定が正しく動作する", func(t *testing.T) {
 		usecase := NewListPostsUsecase(mockPostRepo)
 
@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/repository"
+)
+
+type ListTagsUsecase struct {
+	tagRepository repository.TagRepository
+}
+
+func NewListTagsUsecase(tagRepository repository.TagRepository) *ListTagsUsecase {
+	return &ListTagsUsecase{
+		tagRepository: tagRepository,
+	}
+}
+
+// ListTagsRequest はタグ一覧取得のリクエスト
+type ListTagsRequest struct {
+	Limit  string
+	Offset string
+	Prefix string
+	Sort   string
+}
+
+// ListTagsResponse はタグ一覧取得のレスポンス
+type ListTagsResponse struct {
+	Tags []*TagSummary   `json:"tags"`
+	Meta *PaginationMeta `json:"meta"`
+}
+
+// TagSummary はタグの概要情報
+type TagSummary struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	PostCount int    `json:"post_count"`
+}
+
+func (u *ListTagsUsecase) Execute(ctx context.Context, req *ListTagsRequest) (*ListTagsResponse, error) {
+	ctx, span := startSpan(ctx, "ListTagsUsecase.Execute")
+	defer span.End()
+
+	// パラメータのバリデーションとデフォルト値設定
+	limit := 20
+	if req.Limit != "" {
+		if l, err := strconv.Atoi(req.Limit); err == nil && l > 0 && l <= 100 {
+			limit = l
+		}
+	}
+
+	offset := 0
+	if req.Offset != "" {
+		if o, err := strconv.Atoi(req.Offset); err == nil && o >= 0 {
+			offset = o
+		}
+	}
+
+	sort := "name_asc"
+	if req.Sort != "" {
+		switch req.Sort {
+		case "name_asc", "name_desc", "post_count_asc", "post_count_desc":
+			sort = req.Sort
+		}
+	}
+
+	// リポジトリオプション作成
+	options := &repository.ListTagsOptions{
+		Limit:  limit,
+		Offset: offset,
+		Prefix: strings.TrimSpace(req.Prefix),
+		Sort:   sort,
+	}
+
+	// タグ一覧取得
+	tags, total, err := u.tagRepository.List(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	// レスポンス作成
+	summaries := make([]*TagSummary, 0, len(tags))
+	for _, tag := range tags {
+		summaries = append(summaries, &TagSummary{
+			ID:        tag.Tag.ID.String(),
+			Name:      tag.Tag.Name.String(),
+			PostCount: tag.PostCount,
+		})
+	}
+
+	meta := &PaginationMeta{
+		Total:   total,
+		Limit:   limit,
+		Offset:  offset,
+		HasNext: offset+limit < total,
+	}
+
+	return &ListTagsResponse{
+		Tags: summaries,
+		Meta: meta,
+	}, nil
+}
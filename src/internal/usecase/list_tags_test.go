@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/repository"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	repositoryMock "github.com/MizukiShigi/cms-go/mocks/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListTagsUsecase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTagRepo := repositoryMock.NewMockTagRepository(ctrl)
+
+	t.Run("タグ一覧の取得が成功する", func(t *testing.T) {
+		usecase := NewListTagsUsecase(mockTagRepo)
+
+		tagName1, _ := valueobject.NewTagName("golang")
+		tagName2, _ := valueobject.NewTagName("go-test")
+		tag1 := entity.NewTagWithName(tagName1)
+		tag1.ID = valueobject.NewTagID()
+		tag2 := entity.NewTagWithName(tagName2)
+		tag2.ID = valueobject.NewTagID()
+
+		tags := []*repository.TagWithPostCount{
+			{Tag: tag1, PostCount: 3},
+			{Tag: tag2, PostCount: 0},
+		}
+
+		expectedOptions := &repository.ListTagsOptions{
+			Limit:  20,
+			Offset: 0,
+			Prefix: "go",
+			Sort:   "name_asc",
+		}
+
+		mockTagRepo.EXPECT().
+			List(gomock.Any(), expectedOptions).
+			Return(tags, 2, nil)
+
+		req := &ListTagsRequest{
+			Prefix: " go ",
+		}
+
+		result, err := usecase.Execute(context.Background(), req)
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Tags, 2)
+		assert.Equal(t, tag1.ID.String(), result.Tags[0].ID)
+		assert.Equal(t, "golang", result.Tags[0].Name)
+		assert.Equal(t, 3, result.Tags[0].PostCount)
+		assert.Equal(t, 0, result.Tags[1].PostCount)
+		assert.Equal(t, 2, result.Meta.Total)
+		assert.False(t, result.Meta.HasNext)
+	})
+
+	t.Run("不正なパラメータはデフォルト値になる", func(t *testing.T) {
+		usecase := NewListTagsUsecase(mockTagRepo)
+
+		expectedOptions := &repository.ListTagsOptions{
+			Limit:  20,
+			Offset: 0,
+			Sort:   "name_asc",
+		}
+
+		mockTagRepo.EXPECT().
+			List(gomock.Any(), expectedOptions).
+			Return([]*repository.TagWithPostCount{}, 0, nil)
+
+		req := &ListTagsRequest{
+			Limit:  "1000",
+			Offset: "-1",
+			Sort:   "invalid",
+		}
+
+		result, err := usecase.Execute(context.Background(), req)
+
+		assert.NoError(t, err)
+		assert.Empty(t, result.Tags)
+		assert.Equal(t, 20, result.Meta.Limit)
+	})
+
+	t.Run("投稿数順のソートが指定できる", func(t *testing.T) {
+		usecase := NewListTagsUsecase(mockTagRepo)
+
+		expectedOptions := &repository.ListTagsOptions{
+			Limit:  10,
+			Offset: 10,
+			Sort:   "post_count_desc",
+		}
+
+		mockTagRepo.EXPECT().
+			List(gomock.Any(), expectedOptions).
+			Return([]*repository.TagWithPostCount{}, 30, nil)
+
+		req := &ListTagsRequest{
+			Limit:  "10",
+			Offset: "10",
+			Sort:   "post_count_desc",
+		}
+
+		result, err := usecase.Execute(context.Background(), req)
+
+		assert.NoError(t, err)
+		assert.True(t, result.Meta.HasNext)
+	})
+
+	t.Run("リポジトリエラーが返される", func(t *testing.T) {
+		usecase := NewListTagsUsecase(mockTagRepo)
+
+		mockTagRepo.EXPECT().
+			List(gomock.Any(), gomock.Any()).
+			Return(nil, 0, valueobject.NewMyError(valueobject.InternalServerErrorCode, "Failed to get tags"))
+
+		result, err := usecase.Execute(context.Background(), &ListTagsRequest{})
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
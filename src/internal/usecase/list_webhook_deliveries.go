@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/repository"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+type ListWebhookDeliveriesInput struct {
+	SubscriptionID valueobject.WebhookSubscriptionID
+	Limit          int
+	Offset         int
+}
+
+type ListWebhookDeliveriesOutput struct {
+	Deliveries []*entity.WebhookDelivery
+	Meta       *PaginationMeta
+}
+
+type ListWebhookDeliveriesUsecase struct {
+	webhookSubscriptionRepository repository.WebhookSubscriptionRepository
+	webhookDeliveryRepository     repository.WebhookDeliveryRepository
+}
+
+func NewListWebhookDeliveriesUsecase(webhookSubscriptionRepository repository.WebhookSubscriptionRepository, webhookDeliveryRepository repository.WebhookDeliveryRepository) *ListWebhookDeliveriesUsecase {
+	return &ListWebhookDeliveriesUsecase{
+		webhookSubscriptionRepository: webhookSubscriptionRepository,
+		webhookDeliveryRepository:     webhookDeliveryRepository,
+	}
+}
+
+// Execute は購読の配信記録を新しい順に返す
+func (u *ListWebhookDeliveriesUsecase) Execute(ctx context.Context, input *ListWebhookDeliveriesInput) (*ListWebhookDeliveriesOutput, error) {
+	ctx, span := startSpan(ctx, "ListWebhookDeliveriesUsecase.Execute")
+	defer span.End()
+
+	limit := input.Limit
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	offset := max(input.Offset, 0)
+
+	if _, err := u.webhookSubscriptionRepository.Get(ctx, input.SubscriptionID); err != nil {
+		return nil, valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_webhook_subscription"))
+	}
+
+	deliveries, total, err := u.webhookDeliveryRepository.ListBySubscription(ctx, input.SubscriptionID, limit, offset)
+	if err != nil {
+		return nil, valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_webhook_deliveries"))
+	}
+
+	return &ListWebhookDeliveriesOutput{
+		Deliveries: deliveries,
+		Meta: &PaginationMeta{
+			Total:   total,
+			Limit:   limit,
+			Offset:  offset,
+			HasNext: offset+limit < total,
+		},
+	}, nil
+}
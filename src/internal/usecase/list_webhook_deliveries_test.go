@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	repositoryMock "github.com/MizukiShigi/cms-go/mocks/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListWebhookDeliveriesUsecase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSubscriptionRepo := repositoryMock.NewMockWebhookSubscriptionRepository(ctrl)
+	mockDeliveryRepo := repositoryMock.NewMockWebhookDeliveryRepository(ctrl)
+
+	subscription, _ := entity.NewWebhookSubscription("https://example.com/hooks", "0123456789abcdef", []valueobject.WebhookEventType{valueobject.WebhookEventPostPublished}, false)
+
+	t.Run("配信一覧の取得が成功する", func(t *testing.T) {
+		usecase := NewListWebhookDeliveriesUsecase(mockSubscriptionRepo, mockDeliveryRepo)
+
+		delivery := entity.NewWebhookDelivery(subscription.ID, valueobject.WebhookEventPostPublished, []byte(`{}`), "", time.Now())
+		mockSubscriptionRepo.EXPECT().Get(context.Background(), subscription.ID).Return(subscription, nil)
+		mockDeliveryRepo.EXPECT().ListBySubscription(context.Background(), subscription.ID, 10, 0).
+			Return([]*entity.WebhookDelivery{delivery}, 11, nil)
+
+		output, err := usecase.Execute(context.Background(), &ListWebhookDeliveriesInput{SubscriptionID: subscription.ID, Limit: 10})
+
+		assert.NoError(t, err)
+		assert.Len(t, output.Deliveries, 1)
+		assert.Equal(t, 11, output.Meta.Total)
+		assert.True(t, output.Meta.HasNext)
+	})
+
+	t.Run("件数の指定が範囲外の場合はデフォルト値を使う", func(t *testing.T) {
+		usecase := NewListWebhookDeliveriesUsecase(mockSubscriptionRepo, mockDeliveryRepo)
+
+		mockSubscriptionRepo.EXPECT().Get(context.Background(), subscription.ID).Return(subscription, nil)
+		mockDeliveryRepo.EXPECT().ListBySubscription(context.Background(), subscription.ID, 20, 0).
+			Return([]*entity.WebhookDelivery{}, 0, nil)
+
+		output, err := usecase.Execute(context.Background(), &ListWebhookDeliveriesInput{SubscriptionID: subscription.ID, Limit: 1000, Offset: -1})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 20, output.Meta.Limit)
+		assert.False(t, output.Meta.HasNext)
+	})
+
+	t.Run("購読が存在しない場合はエラーになる", func(t *testing.T) {
+		usecase := NewListWebhookDeliveriesUsecase(mockSubscriptionRepo, mockDeliveryRepo)
+
+		id := valueobject.NewWebhookSubscriptionID()
+		mockSubscriptionRepo.EXPECT().Get(context.Background(), id).
+			Return(nil, valueobject.NewMyError(valueobject.NotFoundCode, "Webhook subscription not found"))
+
+		output, err := usecase.Execute(context.Background(), &ListWebhookDeliveriesInput{SubscriptionID: id})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.True(t, errors.As(err, &myErr))
+		assert.Equal(t, valueobject.NotFoundCode, myErr.Code)
+	})
+}
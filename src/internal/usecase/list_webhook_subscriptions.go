@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/repository"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+type ListWebhookSubscriptionsOutput struct {
+	Subscriptions []*entity.WebhookSubscription
+}
+
+type ListWebhookSubscriptionsUsecase struct {
+	webhookSubscriptionRepository repository.WebhookSubscriptionRepository
+}
+
+func NewListWebhookSubscriptionsUsecase(webhookSubscriptionRepository repository.WebhookSubscriptionRepository) *ListWebhookSubscriptionsUsecase {
+	return &ListWebhookSubscriptionsUsecase{webhookSubscriptionRepository: webhookSubscriptionRepository}
+}
+
+func (u *ListWebhookSubscriptionsUsecase) Execute(ctx context.Context) (*ListWebhookSubscriptionsOutput, error) {
+	ctx, span := startSpan(ctx, "ListWebhookSubscriptionsUsecase.Execute")
+	defer span.End()
+
+	subscriptions, err := u.webhookSubscriptionRepository.List(ctx)
+	if err != nil {
+		return nil, valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_webhook_subscriptions"))
+	}
+
+	return &ListWebhookSubscriptionsOutput{Subscriptions: subscriptions}, nil
+}
@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	repositoryMock "github.com/MizukiShigi/cms-go/mocks/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListWebhookSubscriptionsUsecase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSubscriptionRepo := repositoryMock.NewMockWebhookSubscriptionRepository(ctrl)
+
+	t.Run("購読一覧の取得が成功する", func(t *testing.T) {
+		usecase := NewListWebhookSubscriptionsUsecase(mockSubscriptionRepo)
+
+		subscription, _ := entity.NewWebhookSubscription("https://example.com/hooks", "0123456789abcdef", []valueobject.WebhookEventType{valueobject.WebhookEventPostUpdated}, false)
+		mockSubscriptionRepo.EXPECT().List(context.Background()).Return([]*entity.WebhookSubscription{subscription}, nil)
+
+		output, err := usecase.Execute(context.Background())
+
+		assert.NoError(t, err)
+		assert.Len(t, output.Subscriptions, 1)
+	})
+
+	t.Run("取得に失敗した場合はエラーになる", func(t *testing.T) {
+		usecase := NewListWebhookSubscriptionsUsecase(mockSubscriptionRepo)
+
+		mockSubscriptionRepo.EXPECT().List(context.Background()).Return(nil, errors.New("db error"))
+
+		output, err := usecase.Execute(context.Background())
+
+		assert.Nil(t, output)
+		assert.Error(t, err)
+	})
+}
@@ -2,7 +2,11 @@ package usecase
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
 
+	domaincontext "github.com/MizukiShigi/cms-go/internal/domain/context"
 	"github.com/MizukiShigi/cms-go/internal/domain/repository"
 	"github.com/MizukiShigi/cms-go/internal/domain/service"
 	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
@@ -27,32 +31,60 @@ type UserDTO struct {
 type LoginUserUsecase struct {
 	userRepository repository.UserRepository
 	authService    service.AuthService
+	rateLimitStore service.RateLimitStore
+	// メールアドレスとリクエスト元IPごとにログイン失敗を許容する回数と期間。超えた場合は補充されるまでログインできない
+	lockoutPolicy valueobject.RateLimitPolicy
+	// アカウントごとにログイン失敗を許容する回数と期間
+	// IPアドレスを変えながらの総当たりを防ぐため、lockoutPolicy より多い回数を指定する
+	accountLockoutPolicy valueobject.RateLimitPolicy
 }
 
-func NewLoginUserUsecase(userRepository repository.UserRepository, authService service.AuthService) *LoginUserUsecase {
+func NewLoginUserUsecase(
+	userRepository repository.UserRepository,
+	authService service.AuthService,
+	rateLimitStore service.RateLimitStore,
+	lockoutPolicy valueobject.RateLimitPolicy,
+	accountLockoutPolicy valueobject.RateLimitPolicy,
+) *LoginUserUsecase {
 	return &LoginUserUsecase{
-		userRepository: userRepository,
-		authService:    authService,
+		userRepository:       userRepository,
+		authService:          authService,
+		rateLimitStore:       rateLimitStore,
+		lockoutPolicy:        lockoutPolicy,
+		accountLockoutPolicy: accountLockoutPolicy,
 	}
 }
 
 func (u *LoginUserUsecase) Execute(ctx context.Context, input *LoginUserInput) (*LoginUserOutput, error) {
+	ctx, span := startSpan(ctx, "LoginUserUsecase.Execute")
+	defer span.End()
+
 	email, err := valueobject.NewEmail(input.Email)
 	if err != nil {
 		return nil, err
 	}
 
+	// 試行ごとにトークンを消費し、成功した場合のみ満杯に戻す（失敗が続いた場合にロックする）
+	lockoutKey := u.lockoutKey(ctx, email)
+	if err := u.takeLoginAttempt(ctx, lockoutKey, u.lockoutPolicy); err != nil {
+		return nil, err
+	}
+	accountLockoutKey := u.accountLockoutKey(email)
+	if err := u.takeLoginAttempt(ctx, accountLockoutKey, u.accountLockoutPolicy); err != nil {
+		return nil, err
+	}
+
 	user, err := u.userRepository.FindByEmail(ctx, email)
 	if err != nil {
 		return nil, err
 	}
 
 	if user == nil {
-		return nil, valueobject.NewMyError(valueobject.NotFoundCode, "user not found")
+		return nil, valueobject.NewMyError(valueobject.NotFoundCode, "user_not_found")
 	}
 
 	if !user.Authenticate(input.Password) {
-		return nil, valueobject.NewMyError(valueobject.UnauthorizedCode, "invalid password")
+		return nil, valueobject.NewMyError(valueobject.UnauthorizedCode, "invalid_password")
 	}
 
 	token, err := u.authService.GenerateToken(ctx, user.ID, user.Email)
@@ -60,6 +92,12 @@ func (u *LoginUserUsecase) Execute(ctx context.Context, input *LoginUserInput) (
 		return nil, err
 	}
 
+	for _, key := range []string{lockoutKey, accountLockoutKey} {
+		if err := u.rateLimitStore.Reset(ctx, key); err != nil {
+			slog.WarnContext(ctx, "Failed to reset login attempts", "error", err)
+		}
+	}
+
 	return &LoginUserOutput{
 		Token: token,
 		User: UserDTO{
@@ -69,3 +107,30 @@ func (u *LoginUserUsecase) Execute(ctx context.Context, input *LoginUserInput) (
 		},
 	}, nil
 }
+
+// lockoutKey はメールアドレスとリクエスト元IPごとのキーを返す
+// 第三者が他人のアカウントをロックできないようIPアドレスも含め、保存先にメールアドレスを残さないようハッシュ化する
+func (u *LoginUserUsecase) lockoutKey(ctx context.Context, email valueobject.Email) string {
+	sum := sha256.Sum256([]byte(email.String() + "|" + domaincontext.GetClientIP(ctx)))
+	return u.lockoutPolicy.Name + ":" + hex.EncodeToString(sum[:])
+}
+
+// accountLockoutKey はメールアドレスごとのキーを返す（保存先にメールアドレスを残さないようハッシュ化する）
+func (u *LoginUserUsecase) accountLockoutKey(email valueobject.Email) string {
+	sum := sha256.Sum256([]byte(email.String()))
+	return u.accountLockoutPolicy.Name + ":" + hex.EncodeToString(sum[:])
+}
+
+// takeLoginAttempt はバケットからトークンを1つ消費し、使い切っている場合はエラーを返す
+func (u *LoginUserUsecase) takeLoginAttempt(ctx context.Context, key string, policy valueobject.RateLimitPolicy) error {
+	decision, err := u.rateLimitStore.Take(ctx, key, policy)
+	if err != nil {
+		// 保存先の障害でログインできなくならないよう、制限せずに処理を続ける
+		slog.ErrorContext(ctx, "Failed to check login attempts", "policy", policy.Name, "error", err)
+		return nil
+	}
+	if !decision.Allowed {
+		return valueobject.NewTooManyRequestsError("too_many_login_attempts", decision.RetryAfter)
+	}
+	return nil
+}
@@ -2,7 +2,9 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/MizukiShigi/cms-go/internal/domain/entity"
 	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
@@ -18,9 +20,14 @@ func TestLoginUserUsecase_Execute(t *testing.T) {
 
 	mockUserRepo := repositoryMock.NewMockUserRepository(ctrl)
 	mockAuthService := serviceMock.NewMockAuthService(ctrl)
+	mockRateLimitStore := serviceMock.NewMockRateLimitStore(ctrl)
+
+	lockoutPolicy, _ := valueobject.NewRateLimitPolicy("login", 5, 15*time.Minute)
+	accountLockoutPolicy, _ := valueobject.NewRateLimitPolicy("login_account", 20, time.Hour)
+	allowed := entity.RateLimitDecision{Allowed: true, Limit: 5, Remaining: 4}
 
 	t.Run("ログインが成功する", func(t *testing.T) {
-		usecase := NewLoginUserUsecase(mockUserRepo, mockAuthService)
+		usecase := NewLoginUserUsecase(mockUserRepo, mockAuthService, mockRateLimitStore, lockoutPolicy, accountLockoutPolicy)
 		input := &LoginUserInput{
 			Email:    "test@example.com",
 			Password: "password123",
@@ -29,6 +36,9 @@ func TestLoginUserUsecase_Execute(t *testing.T) {
 		email, _ := valueobject.NewEmail("test@example.com")
 		user, _ := entity.NewUser("テストユーザー", email, "password123")
 
+		mockRateLimitStore.EXPECT().Take(context.Background(), usecase.lockoutKey(context.Background(), email), lockoutPolicy).Return(allowed, nil)
+		mockRateLimitStore.EXPECT().Take(context.Background(), usecase.accountLockoutKey(email), accountLockoutPolicy).Return(allowed, nil)
+
 		// ユーザーを正常に取得
 		mockUserRepo.EXPECT().FindByEmail(context.Background(), email).Return(user, nil)
 
@@ -36,6 +46,10 @@ func TestLoginUserUsecase_Execute(t *testing.T) {
 		mockAuthService.EXPECT().GenerateToken(context.Background(), user.ID, user.Email).
 			Return("test-token", nil)
 
+		// 成功したため失敗回数を消す
+		mockRateLimitStore.EXPECT().Reset(context.Background(), usecase.lockoutKey(context.Background(), email)).Return(nil)
+		mockRateLimitStore.EXPECT().Reset(context.Background(), usecase.accountLockoutKey(email)).Return(nil)
+
 		output, err := usecase.Execute(context.Background(), input)
 
 		assert.NoError(t, err)
@@ -47,7 +61,7 @@ func TestLoginUserUsecase_Execute(t *testing.T) {
 	})
 
 	t.Run("無効なメールアドレスでエラーが発生する", func(t *testing.T) {
-		usecase := NewLoginUserUsecase(mockUserRepo, mockAuthService)
+		usecase := NewLoginUserUsecase(mockUserRepo, mockAuthService, mockRateLimitStore, lockoutPolicy, accountLockoutPolicy)
 		input := &LoginUserInput{
 			Email:    "invalid-email",
 			Password: "password123",
@@ -60,7 +74,7 @@ func TestLoginUserUsecase_Execute(t *testing.T) {
 	})
 
 	t.Run("ユーザーが存在しない場合にエラーが発生する", func(t *testing.T) {
-		usecase := NewLoginUserUsecase(mockUserRepo, mockAuthService)
+		usecase := NewLoginUserUsecase(mockUserRepo, mockAuthService, mockRateLimitStore, lockoutPolicy, accountLockoutPolicy)
 		input := &LoginUserInput{
 			Email:    "notfound@example.com",
 			Password: "password123",
@@ -68,6 +82,9 @@ func TestLoginUserUsecase_Execute(t *testing.T) {
 
 		email, _ := valueobject.NewEmail("notfound@example.com")
 
+		mockRateLimitStore.EXPECT().Take(context.Background(), usecase.lockoutKey(context.Background(), email), lockoutPolicy).Return(allowed, nil)
+		mockRateLimitStore.EXPECT().Take(context.Background(), usecase.accountLockoutKey(email), accountLockoutPolicy).Return(allowed, nil)
+
 		// ユーザーが見つからない
 		mockUserRepo.EXPECT().FindByEmail(context.Background(), email).
 			Return(nil, valueobject.NewMyError(valueobject.NotFoundCode, "User not found"))
@@ -79,7 +96,7 @@ func TestLoginUserUsecase_Execute(t *testing.T) {
 	})
 
 	t.Run("ユーザーがnilの場合にエラーが発生する", func(t *testing.T) {
-		usecase := NewLoginUserUsecase(mockUserRepo, mockAuthService)
+		usecase := NewLoginUserUsecase(mockUserRepo, mockAuthService, mockRateLimitStore, lockoutPolicy, accountLockoutPolicy)
 		input := &LoginUserInput{
 			Email:    "test@example.com",
 			Password: "password123",
@@ -87,6 +104,9 @@ func TestLoginUserUsecase_Execute(t *testing.T) {
 
 		email, _ := valueobject.NewEmail("test@example.com")
 
+		mockRateLimitStore.EXPECT().Take(context.Background(), usecase.lockoutKey(context.Background(), email), lockoutPolicy).Return(allowed, nil)
+		mockRateLimitStore.EXPECT().Take(context.Background(), usecase.accountLockoutKey(email), accountLockoutPolicy).Return(allowed, nil)
+
 		// ユーザーがnilで返される
 		mockUserRepo.EXPECT().FindByEmail(context.Background(), email).Return(nil, nil)
 
@@ -101,7 +121,7 @@ func TestLoginUserUsecase_Execute(t *testing.T) {
 	})
 
 	t.Run("パスワードが一致しない場合にエラーが発生する", func(t *testing.T) {
-		usecase := NewLoginUserUsecase(mockUserRepo, mockAuthService)
+		usecase := NewLoginUserUsecase(mockUserRepo, mockAuthService, mockRateLimitStore, lockoutPolicy, accountLockoutPolicy)
 		input := &LoginUserInput{
 			Email:    "test@example.com",
 			Password: "wrongpassword",
@@ -110,6 +130,9 @@ func TestLoginUserUsecase_Execute(t *testing.T) {
 		email, _ := valueobject.NewEmail("test@example.com")
 		user, _ := entity.NewUser("テストユーザー", email, "password123")
 
+		mockRateLimitStore.EXPECT().Take(context.Background(), usecase.lockoutKey(context.Background(), email), lockoutPolicy).Return(allowed, nil)
+		mockRateLimitStore.EXPECT().Take(context.Background(), usecase.accountLockoutKey(email), accountLockoutPolicy).Return(allowed, nil)
+
 		// ユーザーを正常に取得
 		mockUserRepo.EXPECT().FindByEmail(context.Background(), email).Return(user, nil)
 
@@ -124,7 +147,7 @@ func TestLoginUserUsecase_Execute(t *testing.T) {
 	})
 
 	t.Run("トークン生成が失敗する場合にエラーが発生する", func(t *testing.T) {
-		usecase := NewLoginUserUsecase(mockUserRepo, mockAuthService)
+		usecase := NewLoginUserUsecase(mockUserRepo, mockAuthService, mockRateLimitStore, lockoutPolicy, accountLockoutPolicy)
 		input := &LoginUserInput{
 			Email:    "test@example.com",
 			Password: "password123",
@@ -133,6 +156,9 @@ func TestLoginUserUsecase_Execute(t *testing.T) {
 		email, _ := valueobject.NewEmail("test@example.com")
 		user, _ := entity.NewUser("テストユーザー", email, "password123")
 
+		mockRateLimitStore.EXPECT().Take(context.Background(), usecase.lockoutKey(context.Background(), email), lockoutPolicy).Return(allowed, nil)
+		mockRateLimitStore.EXPECT().Take(context.Background(), usecase.accountLockoutKey(email), accountLockoutPolicy).Return(allowed, nil)
+
 		// ユーザーを正常に取得
 		mockUserRepo.EXPECT().FindByEmail(context.Background(), email).Return(user, nil)
 
@@ -147,7 +173,7 @@ func TestLoginUserUsecase_Execute(t *testing.T) {
 	})
 
 	t.Run("リポジトリエラーでエラーが発生する", func(t *testing.T) {
-		usecase := NewLoginUserUsecase(mockUserRepo, mockAuthService)
+		usecase := NewLoginUserUsecase(mockUserRepo, mockAuthService, mockRateLimitStore, lockoutPolicy, accountLockoutPolicy)
 		input := &LoginUserInput{
 			Email:    "test@example.com",
 			Password: "password123",
@@ -155,6 +181,9 @@ func TestLoginUserUsecase_Execute(t *testing.T) {
 
 		email, _ := valueobject.NewEmail("test@example.com")
 
+		mockRateLimitStore.EXPECT().Take(context.Background(), usecase.lockoutKey(context.Background(), email), lockoutPolicy).Return(allowed, nil)
+		mockRateLimitStore.EXPECT().Take(context.Background(), usecase.accountLockoutKey(email), accountLockoutPolicy).Return(allowed, nil)
+
 		// リポジトリでエラーが発生
 		mockUserRepo.EXPECT().FindByEmail(context.Background(), email).
 			Return(nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "Database error"))
@@ -164,4 +193,75 @@ func TestLoginUserUsecase_Execute(t *testing.T) {
 		assert.Error(t, err)
 		assert.Nil(t, output)
 	})
+	t.Run("ログインの失敗が続いた場合はロックされる", func(t *testing.T) {
+		usecase := NewLoginUserUsecase(mockUserRepo, mockAuthService, mockRateLimitStore, lockoutPolicy, accountLockoutPolicy)
+		input := &LoginUserInput{
+			Email:    "test@example.com",
+			Password: "password123",
+		}
+
+		email, _ := valueobject.NewEmail("test@example.com")
+
+		// 許容回数を使い切っている（ユーザーの取得・パスワードの検証は行わない）
+		mockRateLimitStore.EXPECT().Take(context.Background(), usecase.lockoutKey(context.Background(), email), lockoutPolicy).
+			Return(entity.RateLimitDecision{Allowed: false, Limit: 5, RetryAfter: 3 * time.Minute}, nil)
+
+		output, err := usecase.Execute(context.Background(), input)
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, valueobject.TooManyRequestsCode, myErr.Code)
+		assert.Equal(t, 3*time.Minute, myErr.RetryAfter())
+	})
+
+	t.Run("IPアドレスを変えてもアカウントごとの失敗回数を超えた場合はロックされる", func(t *testing.T) {
+		usecase := NewLoginUserUsecase(mockUserRepo, mockAuthService, mockRateLimitStore, lockoutPolicy, accountLockoutPolicy)
+		input := &LoginUserInput{
+			Email:    "test@example.com",
+			Password: "password123",
+		}
+
+		email, _ := valueobject.NewEmail("test@example.com")
+
+		mockRateLimitStore.EXPECT().Take(context.Background(), usecase.lockoutKey(context.Background(), email), lockoutPolicy).Return(allowed, nil)
+		mockRateLimitStore.EXPECT().Take(context.Background(), usecase.accountLockoutKey(email), accountLockoutPolicy).
+			Return(entity.RateLimitDecision{Allowed: false, Limit: 20, RetryAfter: 10 * time.Minute}, nil)
+
+		output, err := usecase.Execute(context.Background(), input)
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, valueobject.TooManyRequestsCode, myErr.Code)
+		assert.Equal(t, 10*time.Minute, myErr.RetryAfter())
+	})
+
+	t.Run("ロック状態を確認できない場合もログインできる", func(t *testing.T) {
+		usecase := NewLoginUserUsecase(mockUserRepo, mockAuthService, mockRateLimitStore, lockoutPolicy, accountLockoutPolicy)
+		input := &LoginUserInput{
+			Email:    "test@example.com",
+			Password: "password123",
+		}
+
+		email, _ := valueobject.NewEmail("test@example.com")
+		user, _ := entity.NewUser("テストユーザー", email, "password123")
+		lockoutKey := usecase.lockoutKey(context.Background(), email)
+
+		accountLockoutKey := usecase.accountLockoutKey(email)
+
+		mockRateLimitStore.EXPECT().Take(context.Background(), lockoutKey, lockoutPolicy).
+			Return(entity.RateLimitDecision{}, errors.New("connection refused"))
+		mockRateLimitStore.EXPECT().Take(context.Background(), accountLockoutKey, accountLockoutPolicy).
+			Return(entity.RateLimitDecision{}, errors.New("connection refused"))
+		mockUserRepo.EXPECT().FindByEmail(context.Background(), email).Return(user, nil)
+		mockAuthService.EXPECT().GenerateToken(context.Background(), user.ID, user.Email).Return("test-token", nil)
+		mockRateLimitStore.EXPECT().Reset(context.Background(), lockoutKey).Return(errors.New("connection refused"))
+		mockRateLimitStore.EXPECT().Reset(context.Background(), accountLockoutKey).Return(errors.New("connection refused"))
+
+		output, err := usecase.Execute(context.Background(), input)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "test-token", output.Token)
+	})
 }
@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/repository"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+type MergeTagsInput struct {
+	SourceID valueobject.TagID
+	TargetID valueobject.TagID
+}
+
+type MergeTagsOutput struct {
+	ID   valueobject.TagID
+	Name valueobject.TagName
+}
+
+type MergeTagsUsecase struct {
+	transactionManager   repository.TransactionManager
+	tagRepository        repository.TagRepository
+	auditEventRepository repository.AuditEventRepository
+}
+
+func NewMergeTagsUsecase(transactionManager repository.TransactionManager, tagRepository repository.TagRepository, auditEventRepository repository.AuditEventRepository) *MergeTagsUsecase {
+	return &MergeTagsUsecase{
+		transactionManager:   transactionManager,
+		tagRepository:        tagRepository,
+		auditEventRepository: auditEventRepository,
+	}
+}
+
+// Execute は統合元タグを統合先タグへまとめる。統合元タグは削除される
+func (u *MergeTagsUsecase) Execute(ctx context.Context, input *MergeTagsInput) (*MergeTagsOutput, error) {
+	ctx, span := startSpan(ctx, "MergeTagsUsecase.Execute")
+	defer span.End()
+
+	if input.SourceID.Equals(input.TargetID) {
+		return nil, valueobject.NewMyError(valueobject.InvalidCode, "cannot_merge_a_tag_into_itself")
+	}
+
+	var output *MergeTagsOutput
+	err := u.transactionManager.Transaction(ctx, func(ctx context.Context) error {
+		source, err := u.tagRepository.Get(ctx, input.SourceID)
+		if err != nil {
+			return valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_tag"))
+		}
+
+		target, err := u.tagRepository.Get(ctx, input.TargetID)
+		if err != nil {
+			return valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_tag"))
+		}
+
+		if err := u.tagRepository.Merge(ctx, input.SourceID, input.TargetID); err != nil {
+			return valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_merge_tags"))
+		}
+
+		// 統合元タグに統合先を記録する
+		after := entity.AuditFields{"merged_into": target.ID.String()}
+		if err := recordAudit(ctx, u.auditEventRepository, valueobject.AuditEntityTag, source.ID.String(), valueobject.AuditActionMerge, source.AuditFields(), after); err != nil {
+			return err
+		}
+
+		output = &MergeTagsOutput{
+			ID:   target.ID,
+			Name: target.Name,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_merge_tags"))
+	}
+
+	return output, nil
+}
@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	repositoryMock "github.com/MizukiShigi/cms-go/mocks/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestMergeTagsUsecase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTransactionManager := repositoryMock.NewMockTransactionManager(ctrl)
+	mockTagRepo := repositoryMock.NewMockTagRepository(ctrl)
+	mockAuditRepo := repositoryMock.NewMockAuditEventRepository(ctrl)
+
+	sourceName, _ := valueobject.NewTagName("golang")
+	targetName, _ := valueobject.NewTagName("go")
+
+	t.Run("タグの統合が成功する", func(t *testing.T) {
+		usecase := NewMergeTagsUsecase(mockTransactionManager, mockTagRepo, mockAuditRepo)
+
+		source := entity.NewTagWithName(sourceName)
+		source.ID = valueobject.NewTagID()
+		target := entity.NewTagWithName(targetName)
+		target.ID = valueobject.NewTagID()
+
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockTagRepo.EXPECT().Get(ctx, source.ID).Return(source, nil)
+				mockTagRepo.EXPECT().Get(ctx, target.ID).Return(target, nil)
+				mockTagRepo.EXPECT().Merge(ctx, source.ID, target.ID).Return(nil)
+				mockAuditRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				return fn(ctx)
+			})
+
+		output, err := usecase.Execute(context.Background(), &MergeTagsInput{SourceID: source.ID, TargetID: target.ID})
+
+		assert.NoError(t, err)
+		assert.Equal(t, target.ID, output.ID)
+		assert.Equal(t, targetName, output.Name)
+	})
+
+	t.Run("同一タグへの統合はエラーになる", func(t *testing.T) {
+		usecase := NewMergeTagsUsecase(mockTransactionManager, mockTagRepo, mockAuditRepo)
+
+		tagID := valueobject.NewTagID()
+
+		output, err := usecase.Execute(context.Background(), &MergeTagsInput{SourceID: tagID, TargetID: tagID})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, valueobject.InvalidCode, myErr.Code)
+	})
+
+	t.Run("統合先タグが存在しない場合にエラーが発生する", func(t *testing.T) {
+		usecase := NewMergeTagsUsecase(mockTransactionManager, mockTagRepo, mockAuditRepo)
+
+		source := entity.NewTagWithName(sourceName)
+		source.ID = valueobject.NewTagID()
+		targetID := valueobject.NewTagID()
+
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockTagRepo.EXPECT().Get(ctx, source.ID).Return(source, nil)
+				mockTagRepo.EXPECT().Get(ctx, targetID).
+					Return(nil, valueobject.NewMyError(valueobject.NotFoundCode, "Tag not found"))
+				return fn(ctx)
+			})
+
+		output, err := usecase.Execute(context.Background(), &MergeTagsInput{SourceID: source.ID, TargetID: targetID})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, valueobject.NotFoundCode, myErr.Code)
+	})
+
+	t.Run("統合処理に失敗する", func(t *testing.T) {
+		usecase := NewMergeTagsUsecase(mockTransactionManager, mockTagRepo, mockAuditRepo)
+
+		source := entity.NewTagWithName(sourceName)
+		source.ID = valueobject.NewTagID()
+		target := entity.NewTagWithName(targetName)
+		target.ID = valueobject.NewTagID()
+
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockTagRepo.EXPECT().Get(ctx, source.ID).Return(source, nil)
+				mockTagRepo.EXPECT().Get(ctx, target.ID).Return(target, nil)
+				mockTagRepo.EXPECT().Merge(ctx, source.ID, target.ID).
+					Return(valueobject.NewMyError(valueobject.InternalServerErrorCode, "Failed to merge tags"))
+				return fn(ctx)
+			})
+
+		output, err := usecase.Execute(context.Background(), &MergeTagsInput{SourceID: source.ID, TargetID: target.ID})
+
+		assert.Error(t, err)
+		assert.Nil(t, output)
+	})
+}
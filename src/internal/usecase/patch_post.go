@@ -2,8 +2,11 @@ package usecase
 
 import (
 	"context"
+	"slices"
 	"time"
 
+	domaincontext "github.com/MizukiShigi/cms-go/internal/domain/context"
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
 	"github.com/MizukiShigi/cms-go/internal/domain/repository"
 	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
 )
@@ -13,68 +16,265 @@ type PatchPostInput struct {
 	Title   *valueobject.PostTitle
 	Content *valueobject.PostContent
 	Status  *valueobject.PostStatus
-	Tags    []valueobject.TagName
+	// nilの場合はタグを変更しない。空配列の場合はすべてのタグを外す
+	Tags []valueobject.TagName
+	// Tagsと同時には指定できない
+	AddTags    []valueobject.TagName
+	RemoveTags []valueobject.TagName
+	// nilの場合は現在のカテゴリを維持する
+	PrimaryCategoryID *valueobject.CategoryID
+	CategoryIDs       []valueobject.CategoryID
+	// SEO項目（nilの場合は変更しない。空の値を指定すると未設定に戻す）
+	Excerpt         *valueobject.PostExcerpt
+	MetaDescription *valueobject.MetaDescription
+	CanonicalURL    *valueobject.CanonicalURL
+	OGImageID       *valueobject.ImageID
+	// クライアントが取得した時点のバージョン（If-Match）
+	Version int
+	// 更新者（編集ロックとステータス遷移の権限確認に使用する）
+	UserID valueobject.UserID
+	Roles  []valueobject.Role
 }
 
 type PatchPostOutput struct {
-	ID               valueobject.PostID
-	Title            valueobject.PostTitle
-	Content          valueobject.PostContent
-	Status           valueobject.PostStatus
-	Tags             []valueobject.TagName
-	FirstPublishedAt *time.Time
-	ContentUpdatedAt *time.Time
+	ID                valueobject.PostID
+	Title             valueobject.PostTitle
+	Content           valueobject.PostContent
+	Status            valueobject.PostStatus
+	Tags              []valueobject.TagName
+	FirstPublishedAt  *time.Time
+	ContentUpdatedAt  *time.Time
+	PrimaryCategoryID *valueobject.CategoryID
+	CategoryIDs       []valueobject.CategoryID
+	SEO               PostSEOOutput
+	Version           int
 }
 
 type PatchPostUsecase struct {
-	postRepository repository.PostRepository
+	transactionManager     repository.TransactionManager
+	postRepository         repository.PostRepository
+	tagRepository          repository.TagRepository
+	postLockRepository     repository.PostLockRepository
+	historyRepository      repository.PostWorkflowHistoryRepository
+	postStateMachine       *entity.PostStateMachine
+	auditEventRepository   repository.AuditEventRepository
+	webhookEventRepository repository.WebhookEventRepository
+	imageRepository        repository.ImageRepository
 }
 
-func NewPatchPostUsecase(postRepository repository.PostRepository) *PatchPostUsecase {
+func NewPatchPostUsecase(transactionManager repository.TransactionManager, postRepository repository.PostRepository, tagRepository repository.TagRepository, postLockRepository repository.PostLockRepository, historyRepository repository.PostWorkflowHistoryRepository, postStateMachine *entity.PostStateMachine, auditEventRepository repository.AuditEventRepository, webhookEventRepository repository.WebhookEventRepository, imageRepository repository.ImageRepository) *PatchPostUsecase {
 	return &PatchPostUsecase{
-		postRepository: postRepository,
+		transactionManager:     transactionManager,
+		postRepository:         postRepository,
+		tagRepository:          tagRepository,
+		postLockRepository:     postLockRepository,
+		historyRepository:      historyRepository,
+		postStateMachine:       postStateMachine,
+		auditEventRepository:   auditEventRepository,
+		webhookEventRepository: webhookEventRepository,
+		imageRepository:        imageRepository,
 	}
 }
 
 func (u *PatchPostUsecase) Execute(ctx context.Context, input *PatchPostInput) (*PatchPostOutput, error) {
+	ctx, span := startSpan(ctx, "PatchPostUsecase.Execute")
+	defer span.End()
+
+	if input.Tags != nil && (len(input.AddTags) > 0 || len(input.RemoveTags) > 0) {
+		return nil, valueobject.NewMyError(valueobject.InvalidCode, "tags_and_tag_changes_combined")
+	}
+	for _, tag := range input.AddTags {
+		if slices.ContainsFunc(input.RemoveTags, tag.SameKey) {
+			return nil, valueobject.NewMyError(valueobject.InvalidCode, "tag_cannot_be_both_added_and_removed")
+		}
+	}
+
 	post, err := u.postRepository.Get(ctx, input.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	if input.Title != nil {
-		post.Title = *input.Title
+	if err := post.CheckVersion(input.Version); err != nil {
+		return nil, err
 	}
 
+	before := post.AuditFields()
+	fromStatus := post.Status
+
+	// ステータス遷移より先に確認し、本文の変更と公開を同時に行ってレビューを迂回できないようにする
+	title, content := post.Title, post.Content
+	if input.Title != nil {
+		title = *input.Title
+	}
 	if input.Content != nil {
-		post.Content = *input.Content
+		content = *input.Content
+	}
+	if err := post.CheckContentEditable(title, content); err != nil {
+		return nil, err
+	}
+	post.Title = title
+	post.Content = content
+
+	// ステータス遷移はワークフロー履歴に記録する
+	var history *entity.PostWorkflowHistory
+	if input.Status != nil && !post.Status.Equals(*input.Status) {
+		if err := u.postStateMachine.Transit(post, *input.Status, entity.NewActor(input.UserID, input.Roles)); err != nil {
+			return nil, err
+		}
+		history, err = entity.NewPostWorkflowHistory(post.ID, fromStatus, post.Status, input.UserID, "")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	hasTags, err := u.applyTagChanges(post, input)
+	if err != nil {
+		return nil, err
 	}
 
-	if input.Status != nil {
-		if err := post.SetStatus(*input.Status); err != nil {
+	hasCategories := input.PrimaryCategoryID != nil || input.CategoryIDs != nil
+	if hasCategories {
+		primaryCategoryID := post.PrimaryCategoryID
+		if input.PrimaryCategoryID != nil {
+			primaryCategoryID = input.PrimaryCategoryID
+		}
+		categoryIDs := post.CategoryIDs
+		if input.CategoryIDs != nil {
+			categoryIDs = input.CategoryIDs
+		}
+		if err := post.SetCategories(primaryCategoryID, categoryIDs); err != nil {
 			return nil, err
 		}
 	}
 
-	if len(input.Tags) > 0 {
-		post.Tags = input.Tags
+	if err := u.applySEOChanges(ctx, post, input); err != nil {
+		return nil, err
 	}
 
-	if err := u.postRepository.Update(ctx, post); err != nil {
-		return nil, valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "Failed to update post"))
+	// 本文・ステータス履歴・タグ・カテゴリの変更はまとめてコミットする
+	err = u.transactionManager.Transaction(ctx, func(ctx context.Context) error {
+		// 他のユーザーが編集ロックを保持している間は保存できない
+		if err := checkPostEditable(ctx, u.postLockRepository, input.ID, input.UserID, time.Now()); err != nil {
+			return err
+		}
+
+		if err := u.postRepository.Update(ctx, post); err != nil {
+			return valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_update_post"))
+		}
+		domaincontext.RecordEvents(ctx, post.PullEvents()...)
+
+		if history != nil {
+			if err := u.historyRepository.Create(ctx, history); err != nil {
+				return valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_create_post_workflow_history"))
+			}
+		}
+
+		if hasTags {
+			tags := make([]*entity.Tag, 0, len(post.Tags))
+			for _, tagName := range post.Tags {
+				tag, err := u.tagRepository.FindOrCreateByName(ctx, entity.NewTagWithName(tagName))
+				if err != nil {
+					return valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_find_or_create_tag"))
+				}
+				// 表記揺れのあるタグ名は同一タグに解決されるため重複を除く
+				if slices.ContainsFunc(tags, func(t *entity.Tag) bool { return t.ID.Equals(tag.ID) }) {
+					continue
+				}
+				tags = append(tags, tag)
+			}
+
+			if err := u.postRepository.SetTags(ctx, post, tags); err != nil {
+				return valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_set_tags"))
+			}
+		}
+
+		if hasCategories {
+			if err := u.postRepository.SetCategories(ctx, post); err != nil {
+				return valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_set_categories"))
+			}
+		}
+
+		action := valueobject.AuditActionUpdate
+		if history != nil {
+			action = valueobject.AuditActionStatusChange
+		}
+		after := post.AuditFields()
+		if err := recordAudit(ctx, u.auditEventRepository, valueobject.AuditEntityPost, post.ID.String(), action, before, after); err != nil {
+			return err
+		}
+
+		return enqueuePostWebhookEvent(ctx, u.webhookEventRepository, fromStatus, post, len(entity.DiffAuditFields(before, after)) > 0)
+	})
+	if err != nil {
+		return nil, valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_update_post"))
 	}
 
 	updatePost, err := u.postRepository.Get(ctx, input.ID)
 	if err != nil {
-		return nil, valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "Failed to get post"))
+		return nil, valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_post"))
 	}
 	return &PatchPostOutput{
-		ID:               updatePost.ID,
-		Title:            updatePost.Title,
-		Content:          updatePost.Content,
-		Status:           updatePost.Status,
-		Tags:             updatePost.Tags,
-		FirstPublishedAt: updatePost.FirstPublishedAt,
-		ContentUpdatedAt: updatePost.ContentUpdatedAt,
+		ID:                updatePost.ID,
+		Title:             updatePost.Title,
+		Content:           updatePost.Content,
+		Status:            updatePost.Status,
+		Tags:              updatePost.Tags,
+		FirstPublishedAt:  updatePost.FirstPublishedAt,
+		ContentUpdatedAt:  updatePost.ContentUpdatedAt,
+		PrimaryCategoryID: updatePost.PrimaryCategoryID,
+		CategoryIDs:       updatePost.CategoryIDs,
+		SEO:               toPostSEOOutput(updatePost),
+		Version:           updatePost.Version,
 	}, nil
 }
+
+// applyTagChanges は置き換え・追加・削除のタグ操作を投稿に適用し、タグに変更があったかを返す
+func (u *PatchPostUsecase) applyTagChanges(post *entity.Post, input *PatchPostInput) (bool, error) {
+	if input.Tags != nil {
+		if err := post.ReplaceTags(input.Tags); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+
+	if len(input.AddTags) == 0 && len(input.RemoveTags) == 0 {
+		return false, nil
+	}
+
+	for _, tag := range input.RemoveTags {
+		post.RemoveTag(tag)
+	}
+
+	// 既に付いているタグの追加は無視する
+	for _, tag := range input.AddTags {
+		if post.HasTag(tag) {
+			continue
+		}
+		if err := post.AddTag(tag); err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// applySEOChanges は指定されたSEO項目のみを投稿に適用する
+func (u *PatchPostUsecase) applySEOChanges(ctx context.Context, post *entity.Post, input *PatchPostInput) error {
+	if input.Excerpt != nil {
+		post.Excerpt = *input.Excerpt
+	}
+	if input.MetaDescription != nil {
+		post.MetaDescription = *input.MetaDescription
+	}
+	if input.CanonicalURL != nil {
+		post.CanonicalURL = *input.CanonicalURL
+	}
+	if input.OGImageID != nil {
+		ogImage, err := findOGImage(ctx, u.imageRepository, post, input.UserID, input.OGImageID)
+		if err != nil {
+			return err
+		}
+		post.OGImage = ogImage
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"net/http"
 	"testing"
 	"time"
 
@@ -16,10 +17,17 @@ func TestPatchPostUsecase_Execute(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
+	mockTransactionManager := repositoryMock.NewMockTransactionManager(ctrl)
 	mockPostRepo := repositoryMock.NewMockPostRepository(ctrl)
+	mockTagRepo := repositoryMock.NewMockTagRepository(ctrl)
+	mockPostLockRepo := repositoryMock.NewMockPostLockRepository(ctrl)
+	mockHistoryRepo := repositoryMock.NewMockPostWorkflowHistoryRepository(ctrl)
+	mockAuditRepo := repositoryMock.NewMockAuditEventRepository(ctrl)
+	mockWebhookEventRepo := repositoryMock.NewMockWebhookEventRepository(ctrl)
+	mockImageRepo := repositoryMock.NewMockImageRepository(ctrl)
 
 	t.Run("タイトルのみの更新が成功する", func(t *testing.T) {
-		usecase := NewPatchPostUsecase(mockPostRepo)
+		usecase := NewPatchPostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockHistoryRepo, entity.DefaultPostStateMachine(), mockAuditRepo, mockWebhookEventRepo, mockImageRepo)
 
 		postID := valueobject.NewPostID()
 		oldTitle, _ := valueobject.NewPostTitle("旧タイトル")
@@ -37,15 +45,23 @@ func TestPatchPostUsecase_Execute(t *testing.T) {
 		updatedPost.ID = postID
 
 		input := &PatchPostInput{
-			ID:    postID,
-			Title: &newTitle,
+			ID:      postID,
+			Version: 1,
+			Title:   &newTitle,
 		}
 
 		// 既存投稿取得
 		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(oldPost, nil)
+		mockPostLockRepo.EXPECT().FindActiveForUpdate(context.Background(), postID, gomock.Any()).Return(nil, nil)
 
-		// 投稿更新
-		mockPostRepo.EXPECT().Update(context.Background(), gomock.Any()).Return(nil)
+		// トランザクション内の処理をモック（タグ未指定のためSetTagsは呼ばれない）
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockPostRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+				mockAuditRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				mockWebhookEventRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				return fn(ctx)
+			})
 
 		// 更新後の投稿取得
 		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(updatedPost, nil)
@@ -59,8 +75,71 @@ func TestPatchPostUsecase_Execute(t *testing.T) {
 		assert.Equal(t, status, output.Status)
 	})
 
+	t.Run("SEO項目のみの更新が成功しOGP画像を外せる", func(t *testing.T) {
+		usecase := NewPatchPostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockHistoryRepo, entity.DefaultPostStateMachine(), mockAuditRepo, mockWebhookEventRepo, mockImageRepo)
+
+		title, _ := valueobject.NewPostTitle("タイトル")
+		content, _ := valueobject.NewPostContent("内容")
+		userID := valueobject.NewUserID()
+		excerpt, _ := valueobject.NewPostExcerpt("手動で設定した抜粋")
+		emptyImageID := valueobject.ImageID("")
+
+		post, _ := entity.NewPost(title, content, userID, valueobject.StatusDraft)
+		post.OGImage = entity.NewImage(valueobject.ImageFilename("og.png"), "stored.png", "https://storage.example.com/og.png", post.ID, userID, 0)
+
+		input := &PatchPostInput{
+			ID:        post.ID,
+			Version:   1,
+			Excerpt:   &excerpt,
+			OGImageID: &emptyImageID,
+		}
+
+		mockPostRepo.EXPECT().Get(context.Background(), post.ID).Return(post, nil)
+		mockPostLockRepo.EXPECT().FindActiveForUpdate(context.Background(), post.ID, gomock.Any()).Return(nil, nil)
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockPostRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+				mockAuditRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				return fn(ctx)
+			})
+		mockPostRepo.EXPECT().Get(context.Background(), post.ID).Return(post, nil)
+
+		output, err := usecase.Execute(context.Background(), input)
+
+		assert.NoError(t, err)
+		assert.Equal(t, excerpt, output.SEO.Excerpt)
+		assert.Nil(t, output.SEO.OGImage)
+	})
+
+	t.Run("他のユーザーの投稿の画像をOGP画像に指定するとエラーが発生する", func(t *testing.T) {
+		usecase := NewPatchPostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockHistoryRepo, entity.DefaultPostStateMachine(), mockAuditRepo, mockWebhookEventRepo, mockImageRepo)
+
+		title, _ := valueobject.NewPostTitle("タイトル")
+		content, _ := valueobject.NewPostContent("内容")
+		userID := valueobject.NewUserID()
+
+		post, _ := entity.NewPost(title, content, userID, valueobject.StatusDraft)
+		image := entity.NewImage(valueobject.ImageFilename("og.png"), "stored.png", "https://storage.example.com/og.png", valueobject.NewPostID(), valueobject.NewUserID(), 0)
+
+		mockPostRepo.EXPECT().Get(context.Background(), post.ID).Return(post, nil)
+		mockImageRepo.EXPECT().Get(context.Background(), image.ID).Return(image, nil)
+
+		output, err := usecase.Execute(context.Background(), &PatchPostInput{
+			ID:        post.ID,
+			UserID:    userID,
+			Version:   1,
+			OGImageID: &image.ID,
+		})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, valueobject.InvalidCode, myErr.Code)
+		assert.Equal(t, "og_image_does_not_exist", string(myErr.Key()))
+	})
+
 	t.Run("内容のみの更新が成功する", func(t *testing.T) {
-		usecase := NewPatchPostUsecase(mockPostRepo)
+		usecase := NewPatchPostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockHistoryRepo, entity.DefaultPostStateMachine(), mockAuditRepo, mockWebhookEventRepo, mockImageRepo)
 
 		postID := valueobject.NewPostID()
 		title, _ := valueobject.NewPostTitle("タイトル")
@@ -79,14 +158,22 @@ func TestPatchPostUsecase_Execute(t *testing.T) {
 
 		input := &PatchPostInput{
 			ID:      postID,
+			Version: 1,
 			Content: &newContent,
 		}
 
 		// 既存投稿取得
 		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(oldPost, nil)
+		mockPostLockRepo.EXPECT().FindActiveForUpdate(context.Background(), postID, gomock.Any()).Return(nil, nil)
 
-		// 投稿更新
-		mockPostRepo.EXPECT().Update(context.Background(), gomock.Any()).Return(nil)
+		// トランザクション内の処理をモック
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockPostRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+				mockAuditRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				mockWebhookEventRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				return fn(ctx)
+			})
 
 		// 更新後の投稿取得
 		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(updatedPost, nil)
@@ -101,16 +188,16 @@ func TestPatchPostUsecase_Execute(t *testing.T) {
 	})
 
 	t.Run("ステータスのみの更新が成功する", func(t *testing.T) {
-		usecase := NewPatchPostUsecase(mockPostRepo)
+		usecase := NewPatchPostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockHistoryRepo, entity.DefaultPostStateMachine(), mockAuditRepo, mockWebhookEventRepo, mockImageRepo)
 
 		postID := valueobject.NewPostID()
 		title, _ := valueobject.NewPostTitle("タイトル")
 		content, _ := valueobject.NewPostContent("内容")
 		userID := valueobject.NewUserID()
-		oldStatus := valueobject.StatusDraft
+		oldStatus := valueobject.StatusApproved
 		newStatus := valueobject.StatusPublished
 
-		// 既存の投稿（下書き）
+		// 既存の投稿（承認済み）
 		oldPost, _ := entity.NewPost(title, content, userID, oldStatus)
 		oldPost.ID = postID
 
@@ -121,15 +208,33 @@ func TestPatchPostUsecase_Execute(t *testing.T) {
 		updatedPost.FirstPublishedAt = &now
 
 		input := &PatchPostInput{
-			ID:     postID,
-			Status: &newStatus,
+			ID:      postID,
+			Version: 1,
+			UserID:  userID,
+			Roles:   []valueobject.Role{valueobject.RolePublisher},
+			Status:  &newStatus,
 		}
 
 		// 既存投稿取得
 		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(oldPost, nil)
-
-		// 投稿更新
-		mockPostRepo.EXPECT().Update(context.Background(), gomock.Any()).Return(nil)
+		mockPostLockRepo.EXPECT().FindActiveForUpdate(context.Background(), postID, gomock.Any()).Return(nil, nil)
+
+		// トランザクション内の処理をモック
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockPostRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+				// ステータス遷移の履歴を記録
+				mockHistoryRepo.EXPECT().Create(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, history *entity.PostWorkflowHistory) error {
+						assert.Equal(t, oldStatus, history.FromStatus)
+						assert.Equal(t, newStatus, history.ToStatus)
+						assert.Equal(t, userID, history.ActorID)
+						return nil
+					})
+				mockAuditRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				mockWebhookEventRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				return fn(ctx)
+			})
 
 		// 更新後の投稿取得
 		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(updatedPost, nil)
@@ -144,20 +249,22 @@ func TestPatchPostUsecase_Execute(t *testing.T) {
 		assert.NotNil(t, output.FirstPublishedAt)
 	})
 
-	t.Run("タグのみの更新が成功する", func(t *testing.T) {
-		usecase := NewPatchPostUsecase(mockPostRepo)
+	t.Run("タグの置き換えが永続化される", func(t *testing.T) {
+		usecase := NewPatchPostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockHistoryRepo, entity.DefaultPostStateMachine(), mockAuditRepo, mockWebhookEventRepo, mockImageRepo)
 
 		postID := valueobject.NewPostID()
 		title, _ := valueobject.NewPostTitle("タイトル")
 		content, _ := valueobject.NewPostContent("内容")
 		userID := valueobject.NewUserID()
 		status := valueobject.StatusPublished
+		oldTag, _ := valueobject.NewTagName("旧タグ")
 		tag1, _ := valueobject.NewTagName("タグ1")
 		tag2, _ := valueobject.NewTagName("タグ2")
 
 		// 既存の投稿
 		oldPost, _ := entity.NewPost(title, content, userID, status)
 		oldPost.ID = postID
+		oldPost.Tags = []valueobject.TagName{oldTag}
 
 		// 更新後の投稿
 		updatedPost, _ := entity.NewPost(title, content, userID, status)
@@ -165,15 +272,29 @@ func TestPatchPostUsecase_Execute(t *testing.T) {
 		updatedPost.Tags = []valueobject.TagName{tag1, tag2}
 
 		input := &PatchPostInput{
-			ID:   postID,
-			Tags: []valueobject.TagName{tag1, tag2},
+			ID:      postID,
+			Version: 1,
+			Tags:    []valueobject.TagName{tag1, tag2},
 		}
 
+		dbTag1 := entity.ParseTag(valueobject.NewTagID(), tag1, time.Now(), time.Now())
+		dbTag2 := entity.ParseTag(valueobject.NewTagID(), tag2, time.Now(), time.Now())
+
 		// 既存投稿取得
 		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(oldPost, nil)
-
-		// 投稿更新
-		mockPostRepo.EXPECT().Update(context.Background(), gomock.Any()).Return(nil)
+		mockPostLockRepo.EXPECT().FindActiveForUpdate(context.Background(), postID, gomock.Any()).Return(nil, nil)
+
+		// トランザクション内の処理をモック
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockPostRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+				mockTagRepo.EXPECT().FindOrCreateByName(ctx, gomock.Any()).Return(dbTag1, nil)
+				mockTagRepo.EXPECT().FindOrCreateByName(ctx, gomock.Any()).Return(dbTag2, nil)
+				mockPostRepo.EXPECT().SetTags(ctx, gomock.Any(), []*entity.Tag{dbTag1, dbTag2}).Return(nil)
+				mockAuditRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				mockWebhookEventRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				return fn(ctx)
+			})
 
 		// 更新後の投稿取得
 		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(updatedPost, nil)
@@ -190,8 +311,258 @@ func TestPatchPostUsecase_Execute(t *testing.T) {
 		assert.Contains(t, output.Tags, tag2)
 	})
 
+	t.Run("空配列の指定ですべてのタグが外れる", func(t *testing.T) {
+		usecase := NewPatchPostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockHistoryRepo, entity.DefaultPostStateMachine(), mockAuditRepo, mockWebhookEventRepo, mockImageRepo)
+
+		postID := valueobject.NewPostID()
+		title, _ := valueobject.NewPostTitle("タイトル")
+		content, _ := valueobject.NewPostContent("内容")
+		userID := valueobject.NewUserID()
+		oldTag, _ := valueobject.NewTagName("旧タグ")
+
+		oldPost, _ := entity.NewPost(title, content, userID, valueobject.StatusDraft)
+		oldPost.ID = postID
+		oldPost.Tags = []valueobject.TagName{oldTag}
+
+		updatedPost, _ := entity.NewPost(title, content, userID, valueobject.StatusDraft)
+		updatedPost.ID = postID
+
+		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(oldPost, nil)
+		mockPostLockRepo.EXPECT().FindActiveForUpdate(context.Background(), postID, gomock.Any()).Return(nil, nil)
+
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockPostRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+				mockPostRepo.EXPECT().SetTags(ctx, gomock.Any(), []*entity.Tag{}).Return(nil)
+				mockAuditRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				return fn(ctx)
+			})
+
+		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(updatedPost, nil)
+
+		output, err := usecase.Execute(context.Background(), &PatchPostInput{
+			ID:      postID,
+			Version: 1,
+			Tags:    []valueobject.TagName{},
+		})
+
+		assert.NoError(t, err)
+		assert.Empty(t, output.Tags)
+	})
+
+	t.Run("タグの追加と削除が既存タグに適用される", func(t *testing.T) {
+		usecase := NewPatchPostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockHistoryRepo, entity.DefaultPostStateMachine(), mockAuditRepo, mockWebhookEventRepo, mockImageRepo)
+
+		postID := valueobject.NewPostID()
+		title, _ := valueobject.NewPostTitle("タイトル")
+		content, _ := valueobject.NewPostContent("内容")
+		userID := valueobject.NewUserID()
+		keepTag, _ := valueobject.NewTagName("golang")
+		removeTag, _ := valueobject.NewTagName("python")
+		removeTagVariant, _ := valueobject.NewTagName("Python")
+		addTag, _ := valueobject.NewTagName("rust")
+		duplicateTag, _ := valueobject.NewTagName("GoLang")
+
+		oldPost, _ := entity.NewPost(title, content, userID, valueobject.StatusDraft)
+		oldPost.ID = postID
+		oldPost.Tags = []valueobject.TagName{keepTag, removeTag}
+
+		updatedPost, _ := entity.NewPost(title, content, userID, valueobject.StatusDraft)
+		updatedPost.ID = postID
+		updatedPost.Tags = []valueobject.TagName{keepTag, addTag}
+
+		dbKeepTag := entity.ParseTag(valueobject.NewTagID(), keepTag, time.Now(), time.Now())
+		dbAddTag := entity.ParseTag(valueobject.NewTagID(), addTag, time.Now(), time.Now())
+
+		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(oldPost, nil)
+		mockPostLockRepo.EXPECT().FindActiveForUpdate(context.Background(), postID, gomock.Any()).Return(nil, nil)
+
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockPostRepo.EXPECT().Update(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, post *entity.Post) error {
+						// 表記揺れのある削除指定も反映され、既存タグの再追加は無視される
+						assert.Equal(t, []valueobject.TagName{keepTag, addTag}, post.Tags)
+						return nil
+					})
+				mockTagRepo.EXPECT().FindOrCreateByName(ctx, gomock.Any()).Return(dbKeepTag, nil)
+				mockTagRepo.EXPECT().FindOrCreateByName(ctx, gomock.Any()).Return(dbAddTag, nil)
+				mockPostRepo.EXPECT().SetTags(ctx, gomock.Any(), []*entity.Tag{dbKeepTag, dbAddTag}).Return(nil)
+				mockAuditRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				return fn(ctx)
+			})
+
+		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(updatedPost, nil)
+
+		output, err := usecase.Execute(context.Background(), &PatchPostInput{
+			ID:         postID,
+			Version:    1,
+			AddTags:    []valueobject.TagName{addTag, duplicateTag},
+			RemoveTags: []valueobject.TagName{removeTagVariant},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []valueobject.TagName{keepTag, addTag}, output.Tags)
+	})
+
+	t.Run("タグの置き換えと追加削除を同時に指定するとエラーが発生する", func(t *testing.T) {
+		usecase := NewPatchPostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockHistoryRepo, entity.DefaultPostStateMachine(), mockAuditRepo, mockWebhookEventRepo, mockImageRepo)
+
+		tag, _ := valueobject.NewTagName("タグ1")
+
+		output, err := usecase.Execute(context.Background(), &PatchPostInput{
+			ID:      valueobject.NewPostID(),
+			Version: 1,
+			Tags:    []valueobject.TagName{tag},
+			AddTags: []valueobject.TagName{tag},
+		})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, valueobject.InvalidCode, myErr.Code)
+	})
+
+	t.Run("同じタグの追加と削除を同時に指定するとエラーが発生する", func(t *testing.T) {
+		usecase := NewPatchPostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockHistoryRepo, entity.DefaultPostStateMachine(), mockAuditRepo, mockWebhookEventRepo, mockImageRepo)
+
+		tag, _ := valueobject.NewTagName("go")
+		tagVariant, _ := valueobject.NewTagName("Go")
+
+		output, err := usecase.Execute(context.Background(), &PatchPostInput{
+			ID:         valueobject.NewPostID(),
+			Version:    1,
+			AddTags:    []valueobject.TagName{tag},
+			RemoveTags: []valueobject.TagName{tagVariant},
+		})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, "tag_cannot_be_both_added_and_removed", string(myErr.Key()))
+	})
+
+	t.Run("タグの追加で上限を超えるとエラーが発生する", func(t *testing.T) {
+		usecase := NewPatchPostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockHistoryRepo, entity.DefaultPostStateMachine(), mockAuditRepo, mockWebhookEventRepo, mockImageRepo)
+
+		postID := valueobject.NewPostID()
+		title, _ := valueobject.NewPostTitle("タイトル")
+		content, _ := valueobject.NewPostContent("内容")
+
+		post, _ := entity.NewPost(title, content, valueobject.NewUserID(), valueobject.StatusDraft)
+		post.ID = postID
+		for i := 0; i < 10; i++ {
+			tagName, _ := valueobject.NewTagName("tag" + string(rune('0'+i)))
+			post.AddTag(tagName)
+		}
+
+		newTag, _ := valueobject.NewTagName("new")
+
+		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(post, nil)
+
+		output, err := usecase.Execute(context.Background(), &PatchPostInput{
+			ID:      postID,
+			Version: 1,
+			AddTags: []valueobject.TagName{newTag},
+		})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, "maximum_number_of_tags_reached", string(myErr.Key()))
+	})
+
+	t.Run("タグ設定に失敗した場合は全体がエラーになる", func(t *testing.T) {
+		usecase := NewPatchPostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockHistoryRepo, entity.DefaultPostStateMachine(), mockAuditRepo, mockWebhookEventRepo, mockImageRepo)
+
+		postID := valueobject.NewPostID()
+		title, _ := valueobject.NewPostTitle("タイトル")
+		newTitle, _ := valueobject.NewPostTitle("新タイトル")
+		content, _ := valueobject.NewPostContent("内容")
+		tag, _ := valueobject.NewTagName("タグ1")
+
+		post, _ := entity.NewPost(title, content, valueobject.NewUserID(), valueobject.StatusDraft)
+		post.ID = postID
+
+		dbTag := entity.ParseTag(valueobject.NewTagID(), tag, time.Now(), time.Now())
+
+		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(post, nil)
+		mockPostLockRepo.EXPECT().FindActiveForUpdate(context.Background(), postID, gomock.Any()).Return(nil, nil)
+
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockPostRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+				mockTagRepo.EXPECT().FindOrCreateByName(ctx, gomock.Any()).Return(dbTag, nil)
+				mockPostRepo.EXPECT().SetTags(ctx, gomock.Any(), gomock.Any()).
+					Return(valueobject.NewMyError(valueobject.InternalServerErrorCode, "Failed to set tags"))
+				return fn(ctx)
+			})
+
+		output, err := usecase.Execute(context.Background(), &PatchPostInput{
+			ID:      postID,
+			Version: 1,
+			Title:   &newTitle,
+			AddTags: []valueobject.TagName{tag},
+		})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, "Failed to set tags", string(myErr.Key()))
+	})
+
+	t.Run("副カテゴリのみの更新で主カテゴリが維持される", func(t *testing.T) {
+		usecase := NewPatchPostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockHistoryRepo, entity.DefaultPostStateMachine(), mockAuditRepo, mockWebhookEventRepo, mockImageRepo)
+
+		postID := valueobject.NewPostID()
+		title, _ := valueobject.NewPostTitle("タイトル")
+		content, _ := valueobject.NewPostContent("内容")
+		userID := valueobject.NewUserID()
+		primaryCategoryID := valueobject.NewCategoryID()
+		categoryIDs := []valueobject.CategoryID{valueobject.NewCategoryID()}
+
+		// 既存の投稿
+		oldPost, _ := entity.NewPost(title, content, userID, valueobject.StatusDraft)
+		oldPost.ID = postID
+		oldPost.PrimaryCategoryID = &primaryCategoryID
+
+		// 更新後の投稿
+		updatedPost, _ := entity.NewPost(title, content, userID, valueobject.StatusDraft)
+		updatedPost.ID = postID
+		updatedPost.PrimaryCategoryID = &primaryCategoryID
+		updatedPost.CategoryIDs = categoryIDs
+
+		input := &PatchPostInput{
+			ID:          postID,
+			Version:     1,
+			CategoryIDs: categoryIDs,
+		}
+
+		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(oldPost, nil)
+		mockPostLockRepo.EXPECT().FindActiveForUpdate(context.Background(), postID, gomock.Any()).Return(nil, nil)
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockPostRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+				mockPostRepo.EXPECT().SetCategories(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, post *entity.Post) error {
+						assert.Equal(t, &primaryCategoryID, post.PrimaryCategoryID)
+						assert.Equal(t, categoryIDs, post.CategoryIDs)
+						return nil
+					})
+				mockAuditRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				return fn(ctx)
+			})
+		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(updatedPost, nil)
+
+		output, err := usecase.Execute(context.Background(), input)
+
+		assert.NoError(t, err)
+		assert.Equal(t, &primaryCategoryID, output.PrimaryCategoryID)
+		assert.Equal(t, categoryIDs, output.CategoryIDs)
+	})
+
 	t.Run("複数フィールドの同時更新が成功する", func(t *testing.T) {
-		usecase := NewPatchPostUsecase(mockPostRepo)
+		usecase := NewPatchPostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockHistoryRepo, entity.DefaultPostStateMachine(), mockAuditRepo, mockWebhookEventRepo, mockImageRepo)
 
 		postID := valueobject.NewPostID()
 		oldTitle, _ := valueobject.NewPostTitle("旧タイトル")
@@ -199,13 +570,15 @@ func TestPatchPostUsecase_Execute(t *testing.T) {
 		oldContent, _ := valueobject.NewPostContent("旧内容")
 		newContent, _ := valueobject.NewPostContent("新内容")
 		userID := valueobject.NewUserID()
+		reviewerID := valueobject.NewUserID()
 		oldStatus := valueobject.StatusDraft
-		newStatus := valueobject.StatusPublished
+		newStatus := valueobject.StatusInReview
 		tag, _ := valueobject.NewTagName("タグ1")
 
-		// 既存の投稿
+		// 既存の投稿（下書きの内容を変更してレビューに提出する）
 		oldPost, _ := entity.NewPost(oldTitle, oldContent, userID, oldStatus)
 		oldPost.ID = postID
+		oldPost.ReviewerID = &reviewerID
 
 		// 更新後の投稿
 		updatedPost, _ := entity.NewPost(newTitle, newContent, userID, newStatus)
@@ -214,17 +587,30 @@ func TestPatchPostUsecase_Execute(t *testing.T) {
 
 		input := &PatchPostInput{
 			ID:      postID,
+			Version: 1,
+			UserID:  userID,
 			Title:   &newTitle,
 			Content: &newContent,
 			Status:  &newStatus,
 			Tags:    []valueobject.TagName{tag},
 		}
 
+		dbTag := entity.ParseTag(valueobject.NewTagID(), tag, time.Now(), time.Now())
+
 		// 既存投稿取得
 		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(oldPost, nil)
-
-		// 投稿更新
-		mockPostRepo.EXPECT().Update(context.Background(), gomock.Any()).Return(nil)
+		mockPostLockRepo.EXPECT().FindActiveForUpdate(context.Background(), postID, gomock.Any()).Return(nil, nil)
+
+		// トランザクション内の処理をモック
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockPostRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+				mockHistoryRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				mockTagRepo.EXPECT().FindOrCreateByName(ctx, gomock.Any()).Return(dbTag, nil)
+				mockPostRepo.EXPECT().SetTags(ctx, gomock.Any(), gomock.Any()).Return(nil)
+				mockAuditRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				return fn(ctx)
+			})
 
 		// 更新後の投稿取得
 		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(updatedPost, nil)
@@ -241,14 +627,15 @@ func TestPatchPostUsecase_Execute(t *testing.T) {
 	})
 
 	t.Run("投稿が存在しない場合にエラーが発生する", func(t *testing.T) {
-		usecase := NewPatchPostUsecase(mockPostRepo)
+		usecase := NewPatchPostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockHistoryRepo, entity.DefaultPostStateMachine(), mockAuditRepo, mockWebhookEventRepo, mockImageRepo)
 
 		postID := valueobject.NewPostID()
 		title, _ := valueobject.NewPostTitle("タイトル")
 
 		input := &PatchPostInput{
-			ID:    postID,
-			Title: &title,
+			ID:      postID,
+			Version: 1,
+			Title:   &title,
 		}
 
 		// 投稿が見つからない
@@ -266,7 +653,7 @@ func TestPatchPostUsecase_Execute(t *testing.T) {
 	})
 
 	t.Run("不正なステータス遷移でエラーが発生する", func(t *testing.T) {
-		usecase := NewPatchPostUsecase(mockPostRepo)
+		usecase := NewPatchPostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockHistoryRepo, entity.DefaultPostStateMachine(), mockAuditRepo, mockWebhookEventRepo, mockImageRepo)
 
 		postID := valueobject.NewPostID()
 		title, _ := valueobject.NewPostTitle("タイトル")
@@ -280,8 +667,9 @@ func TestPatchPostUsecase_Execute(t *testing.T) {
 		post.ID = postID
 
 		input := &PatchPostInput{
-			ID:     postID,
-			Status: &invalidStatus,
+			ID:      postID,
+			Version: 1,
+			Status:  &invalidStatus,
 		}
 
 		// 既存投稿取得
@@ -294,7 +682,7 @@ func TestPatchPostUsecase_Execute(t *testing.T) {
 	})
 
 	t.Run("投稿更新に失敗する", func(t *testing.T) {
-		usecase := NewPatchPostUsecase(mockPostRepo)
+		usecase := NewPatchPostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockHistoryRepo, entity.DefaultPostStateMachine(), mockAuditRepo, mockWebhookEventRepo, mockImageRepo)
 
 		postID := valueobject.NewPostID()
 		title, _ := valueobject.NewPostTitle("タイトル")
@@ -308,20 +696,208 @@ func TestPatchPostUsecase_Execute(t *testing.T) {
 		newTitle, _ := valueobject.NewPostTitle("新タイトル")
 
 		input := &PatchPostInput{
-			ID:    postID,
-			Title: &newTitle,
+			ID:      postID,
+			Version: 1,
+			Title:   &newTitle,
 		}
 
 		// 既存投稿取得
 		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(post, nil)
+		mockPostLockRepo.EXPECT().FindActiveForUpdate(context.Background(), postID, gomock.Any()).Return(nil, nil)
 
 		// 投稿更新が失敗
-		mockPostRepo.EXPECT().Update(context.Background(), gomock.Any()).
-			Return(valueobject.NewMyError(valueobject.InternalServerErrorCode, "Update failed"))
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockPostRepo.EXPECT().Update(ctx, gomock.Any()).
+					Return(valueobject.NewMyError(valueobject.InternalServerErrorCode, "Update failed"))
+				return fn(ctx)
+			})
 
 		output, err := usecase.Execute(context.Background(), input)
 
 		assert.Error(t, err)
 		assert.Nil(t, output)
 	})
+
+	t.Run("取得時のバージョンが古い場合に競合エラーが発生する", func(t *testing.T) {
+		usecase := NewPatchPostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockHistoryRepo, entity.DefaultPostStateMachine(), mockAuditRepo, mockWebhookEventRepo, mockImageRepo)
+
+		postID := valueobject.NewPostID()
+		title, _ := valueobject.NewPostTitle("タイトル")
+		content, _ := valueobject.NewPostContent("内容")
+		newTitle, _ := valueobject.NewPostTitle("新タイトル")
+
+		// 他のリクエストで更新済みの投稿
+		post, _ := entity.NewPost(title, content, valueobject.NewUserID(), valueobject.StatusDraft)
+		post.ID = postID
+		post.Version = 3
+
+		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(post, nil)
+
+		output, err := usecase.Execute(context.Background(), &PatchPostInput{
+			ID:      postID,
+			Version: 2,
+			Title:   &newTitle,
+		})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, valueobject.ConflictCode, myErr.Code)
+		assert.Equal(t, http.StatusPreconditionFailed, myErr.StatusCode())
+	})
+
+	t.Run("レビュー中の投稿の内容を変更するとエラーが発生する", func(t *testing.T) {
+		usecase := NewPatchPostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockHistoryRepo, entity.DefaultPostStateMachine(), mockAuditRepo, mockWebhookEventRepo, mockImageRepo)
+
+		title, _ := valueobject.NewPostTitle("タイトル")
+		content, _ := valueobject.NewPostContent("内容")
+		newContent, _ := valueobject.NewPostContent("レビュー後に書き換えた内容")
+		userID := valueobject.NewUserID()
+
+		post, _ := entity.NewPost(title, content, userID, valueobject.StatusDraft)
+		post.Status = valueobject.StatusInReview
+
+		mockPostRepo.EXPECT().Get(context.Background(), post.ID).Return(post, nil)
+
+		output, err := usecase.Execute(context.Background(), &PatchPostInput{
+			ID:      post.ID,
+			Version: 1,
+			UserID:  userID,
+			Content: &newContent,
+		})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, valueobject.ConflictCode, myErr.Code)
+		assert.Equal(t, "post_content_is_locked_during_review", string(myErr.Key()))
+	})
+
+	t.Run("承認済みの投稿の内容変更と公開を同時に行うとエラーが発生する", func(t *testing.T) {
+		usecase := NewPatchPostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockHistoryRepo, entity.DefaultPostStateMachine(), mockAuditRepo, mockWebhookEventRepo, mockImageRepo)
+
+		title, _ := valueobject.NewPostTitle("タイトル")
+		newTitle, _ := valueobject.NewPostTitle("承認後に書き換えたタイトル")
+		content, _ := valueobject.NewPostContent("内容")
+		newContent, _ := valueobject.NewPostContent("承認後に書き換えた内容")
+		userID := valueobject.NewUserID()
+		newStatus := valueobject.StatusPublished
+
+		post, _ := entity.NewPost(title, content, userID, valueobject.StatusDraft)
+		post.Status = valueobject.StatusApproved
+
+		mockPostRepo.EXPECT().Get(context.Background(), post.ID).Return(post, nil)
+
+		output, err := usecase.Execute(context.Background(), &PatchPostInput{
+			ID:      post.ID,
+			Version: 1,
+			UserID:  userID,
+			Roles:   []valueobject.Role{valueobject.RolePublisher},
+			Title:   &newTitle,
+			Content: &newContent,
+			Status:  &newStatus,
+		})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, "post_content_is_locked_during_review", string(myErr.Key()))
+		assert.Equal(t, valueobject.StatusApproved, post.Status)
+	})
+
+	t.Run("ロック保持者は編集ロック中でも更新できる", func(t *testing.T) {
+		usecase := NewPatchPostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockHistoryRepo, entity.DefaultPostStateMachine(), mockAuditRepo, mockWebhookEventRepo, mockImageRepo)
+
+		postID := valueobject.NewPostID()
+		title, _ := valueobject.NewPostTitle("タイトル")
+		content, _ := valueobject.NewPostContent("内容")
+		newTitle, _ := valueobject.NewPostTitle("新タイトル")
+		editorID := valueobject.NewUserID()
+
+		post, _ := entity.NewPost(title, content, valueobject.NewUserID(), valueobject.StatusDraft)
+		post.ID = postID
+
+		lock := entity.NewPostLock(postID, editorID, time.Now())
+
+		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(post, nil)
+		mockPostLockRepo.EXPECT().FindActiveForUpdate(context.Background(), postID, gomock.Any()).Return(lock, nil)
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockPostRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+				mockAuditRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				return fn(ctx)
+			})
+		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(post, nil)
+
+		output, err := usecase.Execute(context.Background(), &PatchPostInput{
+			ID:      postID,
+			Version: 1,
+			UserID:  editorID,
+			Title:   &newTitle,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, newTitle, output.Title)
+	})
+
+	t.Run("他のユーザーが編集ロックを保持している場合にエラーが発生する", func(t *testing.T) {
+		usecase := NewPatchPostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockHistoryRepo, entity.DefaultPostStateMachine(), mockAuditRepo, mockWebhookEventRepo, mockImageRepo)
+
+		postID := valueobject.NewPostID()
+		title, _ := valueobject.NewPostTitle("タイトル")
+		content, _ := valueobject.NewPostContent("内容")
+		newTitle, _ := valueobject.NewPostTitle("新タイトル")
+
+		post, _ := entity.NewPost(title, content, valueobject.NewUserID(), valueobject.StatusDraft)
+		post.ID = postID
+
+		lock := entity.NewPostLock(postID, valueobject.NewUserID(), time.Now())
+
+		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(post, nil)
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockPostLockRepo.EXPECT().FindActiveForUpdate(ctx, postID, gomock.Any()).Return(lock, nil)
+				return fn(ctx)
+			})
+
+		output, err := usecase.Execute(context.Background(), &PatchPostInput{
+			ID:      postID,
+			Version: 1,
+			UserID:  valueobject.NewUserID(),
+			Title:   &newTitle,
+		})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, valueobject.ConflictCode, myErr.Code)
+	})
+
+	t.Run("公開権限のないユーザーは公開できない", func(t *testing.T) {
+		usecase := NewPatchPostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockHistoryRepo, entity.DefaultPostStateMachine(), mockAuditRepo, mockWebhookEventRepo, mockImageRepo)
+
+		postID := valueobject.NewPostID()
+		title, _ := valueobject.NewPostTitle("タイトル")
+		content, _ := valueobject.NewPostContent("内容")
+		userID := valueobject.NewUserID()
+		newStatus := valueobject.StatusPublished
+
+		post, _ := entity.NewPost(title, content, userID, valueobject.StatusApproved)
+		post.ID = postID
+
+		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(post, nil)
+
+		output, err := usecase.Execute(context.Background(), &PatchPostInput{
+			ID:      postID,
+			Version: 1,
+			UserID:  userID,
+			Status:  &newStatus,
+		})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, valueobject.ForbiddenCode, myErr.Code)
+	})
 }
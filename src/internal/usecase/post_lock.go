@@ -0,0 +1,22 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/repository"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+// checkPostEditable は他のユーザーが投稿の編集ロックを保持していないことを確認する
+// 確認から保存までの間にロックを取得されないよう、保存と同じトランザクション内で呼び出す
+func checkPostEditable(ctx context.Context, postLockRepository repository.PostLockRepository, postID valueobject.PostID, userID valueobject.UserID, now time.Time) error {
+	lock, err := postLockRepository.FindActiveForUpdate(ctx, postID, now)
+	if err != nil {
+		return valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_post_lock"))
+	}
+	if lock == nil {
+		return nil
+	}
+	return lock.CheckEditable(userID, now)
+}
@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/repository"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+// PostSEOInput は投稿のSEO項目の入力（空の項目は未設定として扱う）
+type PostSEOInput struct {
+	Excerpt         valueobject.PostExcerpt
+	MetaDescription valueobject.MetaDescription
+	CanonicalURL    valueobject.CanonicalURL
+	// nilの場合はOGP画像を設定しない
+	OGImageID *valueobject.ImageID
+}
+
+// PostSEOOutput は投稿のSEO項目の出力
+type PostSEOOutput struct {
+	// 未設定の場合は本文から生成した抜粋
+	Excerpt         valueobject.PostExcerpt
+	MetaDescription valueobject.MetaDescription
+	CanonicalURL    valueobject.CanonicalURL
+	// 未設定の場合は nil
+	OGImage *PostOGImageOutput
+}
+
+type PostOGImageOutput struct {
+	ID  valueobject.ImageID
+	URL string
+}
+
+// applyPostSEO はSEO項目を投稿に設定する
+func applyPostSEO(ctx context.Context, imageRepository repository.ImageRepository, post *entity.Post, userID valueobject.UserID, input PostSEOInput) error {
+	ogImage, err := findOGImage(ctx, imageRepository, post, userID, input.OGImageID)
+	if err != nil {
+		return err
+	}
+
+	post.Excerpt = input.Excerpt
+	post.MetaDescription = input.MetaDescription
+	post.CanonicalURL = input.CanonicalURL
+	post.OGImage = ogImage
+	return nil
+}
+
+// findOGImage はOGP画像に指定された画像を取得する。IDが未指定または空の場合は nil を返す
+// 他のユーザーの画像を設定できないよう、投稿の画像か操作者がアップロードした画像のみ受け付ける
+func findOGImage(ctx context.Context, imageRepository repository.ImageRepository, post *entity.Post, userID valueobject.UserID, imageID *valueobject.ImageID) (*entity.Image, error) {
+	if imageID == nil || *imageID == "" {
+		return nil, nil
+	}
+
+	image, err := imageRepository.Get(ctx, *imageID)
+	if err != nil {
+		var myErr *valueobject.MyError
+		if errors.As(err, &myErr) && myErr.Code == valueobject.NotFoundCode {
+			return nil, valueobject.NewMyError(valueobject.InvalidCode, "og_image_does_not_exist")
+		}
+		return nil, valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_image"))
+	}
+
+	// 存在を推測されないよう、存在しない場合と同じエラーを返す
+	if !image.PostID.Equals(post.ID) && !image.UserID.Equals(userID) {
+		return nil, valueobject.NewMyError(valueobject.InvalidCode, "og_image_does_not_exist")
+	}
+	return image, nil
+}
+
+func toPostSEOOutput(post *entity.Post) PostSEOOutput {
+	output := PostSEOOutput{
+		Excerpt:         post.DisplayExcerpt(),
+		MetaDescription: post.MetaDescription,
+		CanonicalURL:    post.CanonicalURL,
+	}
+	if post.OGImage != nil {
+		output.OGImage = &PostOGImageOutput{
+			ID:  post.OGImage.ID,
+			URL: post.OGImage.GCSURL,
+		}
+	}
+	return output
+}
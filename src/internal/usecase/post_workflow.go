@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+
+	domaincontext "github.com/MizukiShigi/cms-go/internal/domain/context"
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/repository"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+// PostWorkflowOutput はレビュー依頼・レビュー結果の出力
+type PostWorkflowOutput struct {
+	ID         valueobject.PostID
+	Status     valueobject.PostStatus
+	ReviewerID *valueobject.UserID
+	Version    int
+}
+
+// transitPost は transit で投稿のステータスを遷移させ、更新と履歴・監査ログ・Webhookイベントの記録を同一トランザクションで行う
+func transitPost(
+	ctx context.Context,
+	transactionManager repository.TransactionManager,
+	postRepository repository.PostRepository,
+	historyRepository repository.PostWorkflowHistoryRepository,
+	auditEventRepository repository.AuditEventRepository,
+	webhookEventRepository repository.WebhookEventRepository,
+	post *entity.Post,
+	actor entity.Actor,
+	comment string,
+	transit func() error,
+) (*PostWorkflowOutput, error) {
+	fromStatus := post.Status
+	before := post.AuditFields()
+	if err := transit(); err != nil {
+		return nil, err
+	}
+
+	history, err := entity.NewPostWorkflowHistory(post.ID, fromStatus, post.Status, actor.UserID, comment)
+	if err != nil {
+		return nil, err
+	}
+
+	err = transactionManager.Transaction(ctx, func(ctx context.Context) error {
+		if err := postRepository.Update(ctx, post); err != nil {
+			return valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_update_post"))
+		}
+		domaincontext.RecordEvents(ctx, post.PullEvents()...)
+
+		if err := historyRepository.Create(ctx, history); err != nil {
+			return valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_create_post_workflow_history"))
+		}
+
+		if err := recordAudit(ctx, auditEventRepository, valueobject.AuditEntityPost, post.ID.String(), valueobject.AuditActionStatusChange, before, post.AuditFields()); err != nil {
+			return err
+		}
+
+		return enqueuePostWebhookEvent(ctx, webhookEventRepository, fromStatus, post, true)
+	})
+	if err != nil {
+		return nil, valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_update_post"))
+	}
+
+	return &PostWorkflowOutput{
+		ID:         post.ID,
+		Status:     post.Status,
+		ReviewerID: post.ReviewerID,
+		Version:    post.Version,
+	}, nil
+}
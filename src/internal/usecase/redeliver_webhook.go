@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/repository"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+type RedeliverWebhookInput struct {
+	DeliveryID int64
+}
+
+type RedeliverWebhookOutput struct {
+	Delivery *entity.WebhookDelivery
+}
+
+type RedeliverWebhookUsecase struct {
+	webhookDeliveryRepository repository.WebhookDeliveryRepository
+}
+
+func NewRedeliverWebhookUsecase(webhookDeliveryRepository repository.WebhookDeliveryRepository) *RedeliverWebhookUsecase {
+	return &RedeliverWebhookUsecase{webhookDeliveryRepository: webhookDeliveryRepository}
+}
+
+// Execute は配信と同じ内容の新しい配信を作成する。次回のディスパッチで送信される
+func (u *RedeliverWebhookUsecase) Execute(ctx context.Context, input *RedeliverWebhookInput) (*RedeliverWebhookOutput, error) {
+	ctx, span := startSpan(ctx, "RedeliverWebhookUsecase.Execute")
+	defer span.End()
+
+	delivery, err := u.webhookDeliveryRepository.Get(ctx, input.DeliveryID)
+	if err != nil {
+		return nil, valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_webhook_delivery"))
+	}
+
+	redelivery := delivery.Redeliver(time.Now())
+	if err := u.webhookDeliveryRepository.Create(ctx, redelivery); err != nil {
+		return nil, valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_create_webhook_delivery"))
+	}
+
+	return &RedeliverWebhookOutput{Delivery: redelivery}, nil
+}
@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	repositoryMock "github.com/MizukiShigi/cms-go/mocks/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRedeliverWebhookUsecase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDeliveryRepo := repositoryMock.NewMockWebhookDeliveryRepository(ctrl)
+
+	t.Run("失敗した配信を再配信できる", func(t *testing.T) {
+		usecase := NewRedeliverWebhookUsecase(mockDeliveryRepo)
+
+		subscriptionID := valueobject.NewWebhookSubscriptionID()
+		delivery := entity.NewWebhookDelivery(subscriptionID, valueobject.WebhookEventPostUpdated, []byte(`{"event":"post.updated"}`), "req-123", time.Now())
+		delivery.ID = 1
+		for i := 0; i < entity.WebhookMaxAttempts; i++ {
+			delivery.MarkFailed(nil, "connection refused", time.Now())
+		}
+
+		mockDeliveryRepo.EXPECT().Get(context.Background(), int64(1)).Return(delivery, nil)
+		mockDeliveryRepo.EXPECT().Create(context.Background(), gomock.Any()).Return(nil)
+
+		output, err := usecase.Execute(context.Background(), &RedeliverWebhookInput{DeliveryID: 1})
+
+		assert.NoError(t, err)
+		assert.Equal(t, valueobject.WebhookDeliveryPending, output.Delivery.Status)
+		assert.Equal(t, 0, output.Delivery.Attempts)
+		assert.Equal(t, subscriptionID, output.Delivery.SubscriptionID)
+		assert.Equal(t, delivery.Payload, output.Delivery.Payload)
+		assert.Equal(t, "req-123", output.Delivery.RequestID)
+		assert.Equal(t, valueobject.WebhookDeliveryFailed, delivery.Status)
+	})
+
+	t.Run("配信が存在しない場合はエラーになる", func(t *testing.T) {
+		usecase := NewRedeliverWebhookUsecase(mockDeliveryRepo)
+
+		mockDeliveryRepo.EXPECT().Get(context.Background(), int64(2)).
+			Return(nil, valueobject.NewMyError(valueobject.NotFoundCode, "Webhook delivery not found"))
+
+		output, err := usecase.Execute(context.Background(), &RedeliverWebhookInput{DeliveryID: 2})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.True(t, errors.As(err, &myErr))
+		assert.Equal(t, valueobject.NotFoundCode, myErr.Code)
+	})
+}
@@ -32,16 +32,19 @@ func NewRegisterUserUsecase(userRepository repository.UserRepository) *RegisterU
 }
 
 func (u *RegisterUserUsecase) Execute(ctx context.Context, input *RegisterUserInput) (*RegisterUserOutput, error) {
+	ctx, span := startSpan(ctx, "RegisterUserUsecase.Execute")
+	defer span.End()
+
 	email, err := valueobject.NewEmail(input.Email)
 	if err != nil {
-		return nil, valueobject.NewMyError(valueobject.InvalidCode, "Invalid email")
+		return nil, valueobject.NewMyError(valueobject.InvalidCode, "invalid_email")
 	}
 
 	existingUser, err := u.userRepository.FindByEmail(ctx, email)
 	if err != nil {
 		var myErr *valueobject.MyError
 		if !errors.As(err, &myErr) {
-			return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "Failed to find user")
+			return nil, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_find_user")
 		}
 		if myErr.Code != valueobject.NotFoundCode {
 			// 独自エラーのユーザーが存在しない場合のエラーのみ登録処理を続行
@@ -49,7 +52,7 @@ func (u *RegisterUserUsecase) Execute(ctx context.Context, input *RegisterUserIn
 		}
 	}
 	if existingUser != nil {
-		return nil, valueobject.NewMyError(valueobject.ConflictCode, "User already exists")
+		return nil, valueobject.NewMyError(valueobject.ConflictCode, "user_already_exists")
 	}
 
 	user, err := entity.NewUser(input.Name, email, input.Password)
@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/repository"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+type ReleasePostLockInput struct {
+	PostID valueobject.PostID
+	UserID valueobject.UserID
+}
+
+type ReleasePostLockUsecase struct {
+	postLockRepository repository.PostLockRepository
+}
+
+func NewReleasePostLockUsecase(postLockRepository repository.PostLockRepository) *ReleasePostLockUsecase {
+	return &ReleasePostLockUsecase{postLockRepository: postLockRepository}
+}
+
+// Execute は自分が保持する編集ロックを解放する。ロックがない場合も成功とする
+func (u *ReleasePostLockUsecase) Execute(ctx context.Context, input *ReleasePostLockInput) error {
+	ctx, span := startSpan(ctx, "ReleasePostLockUsecase.Execute")
+	defer span.End()
+
+	if err := u.postLockRepository.Release(ctx, input.PostID, input.UserID); err != nil {
+		return valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_release_post_lock"))
+	}
+
+	return nil
+}
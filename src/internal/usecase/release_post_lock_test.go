@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	repositoryMock "github.com/MizukiShigi/cms-go/mocks/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReleasePostLockUsecase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPostLockRepo := repositoryMock.NewMockPostLockRepository(ctrl)
+
+	t.Run("自分のロックの解放が成功する", func(t *testing.T) {
+		usecase := NewReleasePostLockUsecase(mockPostLockRepo)
+
+		postID := valueobject.NewPostID()
+		userID := valueobject.NewUserID()
+		mockPostLockRepo.EXPECT().Release(context.Background(), postID, userID).Return(nil)
+
+		err := usecase.Execute(context.Background(), &ReleasePostLockInput{PostID: postID, UserID: userID})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("リポジトリエラーでエラーが発生する", func(t *testing.T) {
+		usecase := NewReleasePostLockUsecase(mockPostLockRepo)
+
+		postID := valueobject.NewPostID()
+		userID := valueobject.NewUserID()
+		mockPostLockRepo.EXPECT().Release(context.Background(), postID, userID).
+			Return(valueobject.NewMyError(valueobject.InternalServerErrorCode, "Failed to release post lock"))
+
+		err := usecase.Execute(context.Background(), &ReleasePostLockInput{PostID: postID, UserID: userID})
+
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, valueobject.InternalServerErrorCode, myErr.Code)
+	})
+}
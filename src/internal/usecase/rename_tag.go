@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/repository"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+type RenameTagInput struct {
+	ID   valueobject.TagID
+	Name valueobject.TagName
+}
+
+type RenameTagOutput struct {
+	ID   valueobject.TagID
+	Name valueobject.TagName
+}
+
+type RenameTagUsecase struct {
+	transactionManager   repository.TransactionManager
+	tagRepository        repository.TagRepository
+	auditEventRepository repository.AuditEventRepository
+}
+
+func NewRenameTagUsecase(transactionManager repository.TransactionManager, tagRepository repository.TagRepository, auditEventRepository repository.AuditEventRepository) *RenameTagUsecase {
+	return &RenameTagUsecase{
+		transactionManager:   transactionManager,
+		tagRepository:        tagRepository,
+		auditEventRepository: auditEventRepository,
+	}
+}
+
+func (u *RenameTagUsecase) Execute(ctx context.Context, input *RenameTagInput) (*RenameTagOutput, error) {
+	ctx, span := startSpan(ctx, "RenameTagUsecase.Execute")
+	defer span.End()
+
+	tag, err := u.tagRepository.Get(ctx, input.ID)
+	if err != nil {
+		return nil, valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_tag"))
+	}
+
+	before := tag.AuditFields()
+	tag.Rename(input.Name)
+
+	err = u.transactionManager.Transaction(ctx, func(ctx context.Context) error {
+		if err := u.tagRepository.Update(ctx, tag); err != nil {
+			return err
+		}
+		return recordAudit(ctx, u.auditEventRepository, valueobject.AuditEntityTag, tag.ID.String(), valueobject.AuditActionUpdate, before, tag.AuditFields())
+	})
+	if err != nil {
+		return nil, valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_update_tag"))
+	}
+
+	return &RenameTagOutput{
+		ID:   tag.ID,
+		Name: tag.Name,
+	}, nil
+}
@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	repositoryMock "github.com/MizukiShigi/cms-go/mocks/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRenameTagUsecase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTransactionManager := repositoryMock.NewMockTransactionManager(ctrl)
+	mockTagRepo := repositoryMock.NewMockTagRepository(ctrl)
+	mockAuditRepo := repositoryMock.NewMockAuditEventRepository(ctrl)
+
+	t.Run("タグ名の変更が成功する", func(t *testing.T) {
+		usecase := NewRenameTagUsecase(mockTransactionManager, mockTagRepo, mockAuditRepo)
+
+		oldName, _ := valueobject.NewTagName("golang")
+		newName, _ := valueobject.NewTagName("go")
+		tag := entity.NewTagWithName(oldName)
+		tag.ID = valueobject.NewTagID()
+
+		mockTagRepo.EXPECT().Get(context.Background(), tag.ID).Return(tag, nil)
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockTagRepo.EXPECT().Update(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, updated *entity.Tag) error {
+						assert.Equal(t, newName, updated.Name)
+						return nil
+					})
+				mockAuditRepo.EXPECT().Create(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, event *entity.AuditEvent) error {
+						assert.Equal(t, []entity.AuditChange{{Field: "name", Before: "golang", After: "go"}}, event.Changes)
+						return nil
+					})
+				return fn(ctx)
+			})
+
+		output, err := usecase.Execute(context.Background(), &RenameTagInput{ID: tag.ID, Name: newName})
+
+		assert.NoError(t, err)
+		assert.Equal(t, tag.ID, output.ID)
+		assert.Equal(t, newName, output.Name)
+	})
+
+	t.Run("タグが存在しない場合にエラーが発生する", func(t *testing.T) {
+		usecase := NewRenameTagUsecase(mockTransactionManager, mockTagRepo, mockAuditRepo)
+
+		tagID := valueobject.NewTagID()
+		newName, _ := valueobject.NewTagName("go")
+
+		mockTagRepo.EXPECT().Get(context.Background(), tagID).
+			Return(nil, valueobject.NewMyError(valueobject.NotFoundCode, "Tag not found"))
+
+		output, err := usecase.Execute(context.Background(), &RenameTagInput{ID: tagID, Name: newName})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, valueobject.NotFoundCode, myErr.Code)
+	})
+
+	t.Run("同名のタグが存在する場合にエラーが発生する", func(t *testing.T) {
+		usecase := NewRenameTagUsecase(mockTransactionManager, mockTagRepo, mockAuditRepo)
+
+		oldName, _ := valueobject.NewTagName("golang")
+		newName, _ := valueobject.NewTagName("go")
+		tag := entity.NewTagWithName(oldName)
+		tag.ID = valueobject.NewTagID()
+
+		mockTagRepo.EXPECT().Get(context.Background(), tag.ID).Return(tag, nil)
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockTagRepo.EXPECT().Update(ctx, gomock.Any()).
+					Return(valueobject.NewMyError(valueobject.ConflictCode, "Tag with this name already exists"))
+				return fn(ctx)
+			})
+
+		output, err := usecase.Execute(context.Background(), &RenameTagInput{ID: tag.ID, Name: newName})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, valueobject.ConflictCode, myErr.Code)
+	})
+}
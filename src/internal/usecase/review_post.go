@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/repository"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+type ReviewPostInput struct {
+	ID       valueobject.PostID
+	UserID   valueobject.UserID
+	Roles    []valueobject.Role
+	Decision valueobject.ReviewDecision
+	// 差し戻しの場合は必須
+	Comment string
+}
+
+type ReviewPostUsecase struct {
+	transactionManager     repository.TransactionManager
+	postRepository         repository.PostRepository
+	historyRepository      repository.PostWorkflowHistoryRepository
+	postStateMachine       *entity.PostStateMachine
+	auditEventRepository   repository.AuditEventRepository
+	webhookEventRepository repository.WebhookEventRepository
+}
+
+func NewReviewPostUsecase(transactionManager repository.TransactionManager, postRepository repository.PostRepository, historyRepository repository.PostWorkflowHistoryRepository, postStateMachine *entity.PostStateMachine, auditEventRepository repository.AuditEventRepository, webhookEventRepository repository.WebhookEventRepository) *ReviewPostUsecase {
+	return &ReviewPostUsecase{
+		transactionManager:     transactionManager,
+		postRepository:         postRepository,
+		historyRepository:      historyRepository,
+		postStateMachine:       postStateMachine,
+		auditEventRepository:   auditEventRepository,
+		webhookEventRepository: webhookEventRepository,
+	}
+}
+
+// Execute は担当レビュアーとしてレビュー中の投稿を承認または差し戻す
+func (u *ReviewPostUsecase) Execute(ctx context.Context, input *ReviewPostInput) (*PostWorkflowOutput, error) {
+	ctx, span := startSpan(ctx, "ReviewPostUsecase.Execute")
+	defer span.End()
+
+	if input.Decision == valueobject.ReviewRequestChanges && strings.TrimSpace(input.Comment) == "" {
+		return nil, valueobject.NewMyError(valueobject.InvalidCode, "comment_required_for_request_changes")
+	}
+
+	post, err := u.postRepository.Get(ctx, input.ID)
+	if err != nil {
+		return nil, valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_post"))
+	}
+
+	// 遷移ルールの設定に関わらず、レビューは担当レビュアーのみ行える（投稿者による取り下げと区別する）
+	actor := entity.NewActor(input.UserID, input.Roles)
+	if err := post.CheckReviewable(actor); err != nil {
+		return nil, err
+	}
+
+	var to valueobject.PostStatus
+	switch input.Decision {
+	case valueobject.ReviewApprove:
+		to = valueobject.StatusApproved
+	case valueobject.ReviewRequestChanges:
+		to = valueobject.StatusDraft
+	default:
+		return nil, valueobject.NewMyError(valueobject.InvalidCode, "invalid_review_decision")
+	}
+
+	return transitPost(ctx, u.transactionManager, u.postRepository, u.historyRepository, u.auditEventRepository, u.webhookEventRepository, post, actor, input.Comment, func() error {
+		return u.postStateMachine.Transit(post, to, actor)
+	})
+}
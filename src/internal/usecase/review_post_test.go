@@ -0,0 +1,149 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	repositoryMock "github.com/MizukiShigi/cms-go/mocks/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReviewPostUsecase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTransactionManager := repositoryMock.NewMockTransactionManager(ctrl)
+	mockPostRepo := repositoryMock.NewMockPostRepository(ctrl)
+	mockHistoryRepo := repositoryMock.NewMockPostWorkflowHistoryRepository(ctrl)
+	mockAuditRepo := repositoryMock.NewMockAuditEventRepository(ctrl)
+	mockWebhookEventRepo := repositoryMock.NewMockWebhookEventRepository(ctrl)
+
+	reviewerRoles := []valueobject.Role{valueobject.RoleReviewer}
+
+	newInReviewPost := func(reviewerID valueobject.UserID) *entity.Post {
+		title, _ := valueobject.NewPostTitle("タイトル")
+		content, _ := valueobject.NewPostContent("内容")
+		post, _ := entity.NewPost(title, content, valueobject.NewUserID(), valueobject.StatusInReview)
+		post.ReviewerID = &reviewerID
+		return post
+	}
+
+	t.Run("担当レビュアーの承認が成功する", func(t *testing.T) {
+		usecase := NewReviewPostUsecase(mockTransactionManager, mockPostRepo, mockHistoryRepo, entity.DefaultPostStateMachine(), mockAuditRepo, mockWebhookEventRepo)
+
+		reviewerID := valueobject.NewUserID()
+		post := newInReviewPost(reviewerID)
+
+		mockPostRepo.EXPECT().Get(context.Background(), post.ID).Return(post, nil)
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockPostRepo.EXPECT().Update(ctx, post).Return(nil)
+				mockHistoryRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				mockAuditRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				return fn(ctx)
+			})
+
+		output, err := usecase.Execute(context.Background(), &ReviewPostInput{
+			ID:       post.ID,
+			UserID:   reviewerID,
+			Roles:    reviewerRoles,
+			Decision: valueobject.ReviewApprove,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, valueobject.StatusApproved, output.Status)
+	})
+
+	t.Run("コメント付きの差し戻しが成功し履歴に記録される", func(t *testing.T) {
+		usecase := NewReviewPostUsecase(mockTransactionManager, mockPostRepo, mockHistoryRepo, entity.DefaultPostStateMachine(), mockAuditRepo, mockWebhookEventRepo)
+
+		reviewerID := valueobject.NewUserID()
+		post := newInReviewPost(reviewerID)
+
+		mockPostRepo.EXPECT().Get(context.Background(), post.ID).Return(post, nil)
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockPostRepo.EXPECT().Update(ctx, post).Return(nil)
+				mockHistoryRepo.EXPECT().Create(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, history *entity.PostWorkflowHistory) error {
+						assert.Equal(t, valueobject.StatusInReview, history.FromStatus)
+						assert.Equal(t, valueobject.StatusDraft, history.ToStatus)
+						assert.Equal(t, "見出しを修正してください", history.Comment)
+						return nil
+					})
+				mockAuditRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				return fn(ctx)
+			})
+
+		output, err := usecase.Execute(context.Background(), &ReviewPostInput{
+			ID:       post.ID,
+			UserID:   reviewerID,
+			Roles:    reviewerRoles,
+			Decision: valueobject.ReviewRequestChanges,
+			Comment:  "見出しを修正してください",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, valueobject.StatusDraft, output.Status)
+	})
+
+	t.Run("コメントなしの差し戻しはエラーが発生する", func(t *testing.T) {
+		usecase := NewReviewPostUsecase(mockTransactionManager, mockPostRepo, mockHistoryRepo, entity.DefaultPostStateMachine(), mockAuditRepo, mockWebhookEventRepo)
+
+		output, err := usecase.Execute(context.Background(), &ReviewPostInput{
+			ID:       valueobject.NewPostID(),
+			UserID:   valueobject.NewUserID(),
+			Roles:    reviewerRoles,
+			Decision: valueobject.ReviewRequestChanges,
+			Comment:  "  ",
+		})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, valueobject.InvalidCode, myErr.Code)
+	})
+
+	t.Run("担当外のレビュアーは承認できない", func(t *testing.T) {
+		usecase := NewReviewPostUsecase(mockTransactionManager, mockPostRepo, mockHistoryRepo, entity.DefaultPostStateMachine(), mockAuditRepo, mockWebhookEventRepo)
+
+		post := newInReviewPost(valueobject.NewUserID())
+
+		mockPostRepo.EXPECT().Get(context.Background(), post.ID).Return(post, nil)
+
+		output, err := usecase.Execute(context.Background(), &ReviewPostInput{
+			ID:       post.ID,
+			UserID:   valueobject.NewUserID(),
+			Roles:    reviewerRoles,
+			Decision: valueobject.ReviewApprove,
+		})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, valueobject.ForbiddenCode, myErr.Code)
+	})
+
+	t.Run("投稿者は差し戻しできない", func(t *testing.T) {
+		usecase := NewReviewPostUsecase(mockTransactionManager, mockPostRepo, mockHistoryRepo, entity.DefaultPostStateMachine(), mockAuditRepo, mockWebhookEventRepo)
+
+		post := newInReviewPost(valueobject.NewUserID())
+
+		mockPostRepo.EXPECT().Get(context.Background(), post.ID).Return(post, nil)
+
+		output, err := usecase.Execute(context.Background(), &ReviewPostInput{
+			ID:       post.ID,
+			UserID:   post.UserID,
+			Decision: valueobject.ReviewRequestChanges,
+			Comment:  "取り下げ",
+		})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, valueobject.ForbiddenCode, myErr.Code)
+	})
+}
@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/repository"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+type SubmitPostForReviewInput struct {
+	ID         valueobject.PostID
+	UserID     valueobject.UserID
+	Roles      []valueobject.Role
+	ReviewerID valueobject.UserID
+	Comment    string
+}
+
+type SubmitPostForReviewUsecase struct {
+	transactionManager     repository.TransactionManager
+	postRepository         repository.PostRepository
+	historyRepository      repository.PostWorkflowHistoryRepository
+	postStateMachine       *entity.PostStateMachine
+	auditEventRepository   repository.AuditEventRepository
+	webhookEventRepository repository.WebhookEventRepository
+}
+
+func NewSubmitPostForReviewUsecase(transactionManager repository.TransactionManager, postRepository repository.PostRepository, historyRepository repository.PostWorkflowHistoryRepository, postStateMachine *entity.PostStateMachine, auditEventRepository repository.AuditEventRepository, webhookEventRepository repository.WebhookEventRepository) *SubmitPostForReviewUsecase {
+	return &SubmitPostForReviewUsecase{
+		transactionManager:     transactionManager,
+		postRepository:         postRepository,
+		historyRepository:      historyRepository,
+		postStateMachine:       postStateMachine,
+		auditEventRepository:   auditEventRepository,
+		webhookEventRepository: webhookEventRepository,
+	}
+}
+
+// Execute はレビュアーを指定して下書きをレビュー依頼する
+func (u *SubmitPostForReviewUsecase) Execute(ctx context.Context, input *SubmitPostForReviewInput) (*PostWorkflowOutput, error) {
+	ctx, span := startSpan(ctx, "SubmitPostForReviewUsecase.Execute")
+	defer span.End()
+
+	post, err := u.postRepository.Get(ctx, input.ID)
+	if err != nil {
+		return nil, valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_post"))
+	}
+
+	actor := entity.NewActor(input.UserID, input.Roles)
+	return transitPost(ctx, u.transactionManager, u.postRepository, u.historyRepository, u.auditEventRepository, u.webhookEventRepository, post, actor, input.Comment, func() error {
+		if err := post.AssignReviewer(input.ReviewerID, actor); err != nil {
+			return err
+		}
+		return u.postStateMachine.Transit(post, valueobject.StatusInReview, actor)
+	})
+}
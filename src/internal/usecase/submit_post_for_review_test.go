@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	repositoryMock "github.com/MizukiShigi/cms-go/mocks/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSubmitPostForReviewUsecase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTransactionManager := repositoryMock.NewMockTransactionManager(ctrl)
+	mockPostRepo := repositoryMock.NewMockPostRepository(ctrl)
+	mockHistoryRepo := repositoryMock.NewMockPostWorkflowHistoryRepository(ctrl)
+	mockAuditRepo := repositoryMock.NewMockAuditEventRepository(ctrl)
+	mockWebhookEventRepo := repositoryMock.NewMockWebhookEventRepository(ctrl)
+
+	t.Run("レビュー依頼が成功し履歴が記録される", func(t *testing.T) {
+		usecase := NewSubmitPostForReviewUsecase(mockTransactionManager, mockPostRepo, mockHistoryRepo, entity.DefaultPostStateMachine(), mockAuditRepo, mockWebhookEventRepo)
+
+		postID := valueobject.NewPostID()
+		authorID := valueobject.NewUserID()
+		reviewerID := valueobject.NewUserID()
+		title, _ := valueobject.NewPostTitle("タイトル")
+		content, _ := valueobject.NewPostContent("内容")
+
+		post, _ := entity.NewPost(title, content, authorID, valueobject.StatusDraft)
+		post.ID = postID
+
+		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(post, nil)
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockPostRepo.EXPECT().Update(ctx, post).Return(nil)
+				mockHistoryRepo.EXPECT().Create(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, history *entity.PostWorkflowHistory) error {
+						assert.Equal(t, valueobject.StatusDraft, history.FromStatus)
+						assert.Equal(t, valueobject.StatusInReview, history.ToStatus)
+						assert.Equal(t, authorID, history.ActorID)
+						assert.Equal(t, "確認お願いします", history.Comment)
+						return nil
+					})
+				mockAuditRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				return fn(ctx)
+			})
+
+		output, err := usecase.Execute(context.Background(), &SubmitPostForReviewInput{
+			ID:         postID,
+			UserID:     authorID,
+			ReviewerID: reviewerID,
+			Comment:    "確認お願いします",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, valueobject.StatusInReview, output.Status)
+		assert.Equal(t, reviewerID, *output.ReviewerID)
+	})
+
+	t.Run("投稿者以外はレビュー依頼できない", func(t *testing.T) {
+		usecase := NewSubmitPostForReviewUsecase(mockTransactionManager, mockPostRepo, mockHistoryRepo, entity.DefaultPostStateMachine(), mockAuditRepo, mockWebhookEventRepo)
+
+		postID := valueobject.NewPostID()
+		title, _ := valueobject.NewPostTitle("タイトル")
+		content, _ := valueobject.NewPostContent("内容")
+
+		post, _ := entity.NewPost(title, content, valueobject.NewUserID(), valueobject.StatusDraft)
+		post.ID = postID
+
+		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(post, nil)
+
+		output, err := usecase.Execute(context.Background(), &SubmitPostForReviewInput{
+			ID:         postID,
+			UserID:     valueobject.NewUserID(),
+			ReviewerID: valueobject.NewUserID(),
+		})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, valueobject.ForbiddenCode, myErr.Code)
+	})
+
+	t.Run("投稿の更新に失敗した場合にエラーが発生する", func(t *testing.T) {
+		usecase := NewSubmitPostForReviewUsecase(mockTransactionManager, mockPostRepo, mockHistoryRepo, entity.DefaultPostStateMachine(), mockAuditRepo, mockWebhookEventRepo)
+
+		postID := valueobject.NewPostID()
+		authorID := valueobject.NewUserID()
+		title, _ := valueobject.NewPostTitle("タイトル")
+		content, _ := valueobject.NewPostContent("内容")
+
+		post, _ := entity.NewPost(title, content, authorID, valueobject.StatusDraft)
+		post.ID = postID
+
+		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(post, nil)
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockPostRepo.EXPECT().Update(ctx, post).
+					Return(valueobject.NewStaleVersionError("Post has been modified by another request"))
+				return fn(ctx)
+			})
+
+		output, err := usecase.Execute(context.Background(), &SubmitPostForReviewInput{
+			ID:         postID,
+			UserID:     authorID,
+			ReviewerID: valueobject.NewUserID(),
+		})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, valueobject.ConflictCode, myErr.Code)
+	})
+}
@@ -0,0 +1,21 @@
+package usecase
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracer はユースケースの実行ごとのスパンを作成する
+var tracer = otel.Tracer("github.com/MizukiShigi/cms-go/internal/usecase")
+
+// startSpan はユースケースのスパンを開始する
+// 記録されないスパン（トレース未設定・サンプリング対象外）の場合は呼び出し元のコンテキストをそのまま使う
+func startSpan(ctx context.Context, name string) (context.Context, trace.Span) {
+	spanCtx, span := tracer.Start(ctx, name)
+	if !span.IsRecording() {
+		return ctx, span
+	}
+	return spanCtx, span
+}
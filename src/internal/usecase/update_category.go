@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/repository"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+type UpdateCategoryInput struct {
+	ID          valueobject.CategoryID
+	ParentID    *valueobject.CategoryID
+	Name        valueobject.CategoryName
+	Slug        valueobject.CategorySlug
+	Description string
+	SortOrder   int
+}
+
+type UpdateCategoryOutput struct {
+	Category *entity.Category
+}
+
+type UpdateCategoryUsecase struct {
+	transactionManager   repository.TransactionManager
+	categoryRepository   repository.CategoryRepository
+	auditEventRepository repository.AuditEventRepository
+}
+
+func NewUpdateCategoryUsecase(transactionManager repository.TransactionManager, categoryRepository repository.CategoryRepository, auditEventRepository repository.AuditEventRepository) *UpdateCategoryUsecase {
+	return &UpdateCategoryUsecase{
+		transactionManager:   transactionManager,
+		categoryRepository:   categoryRepository,
+		auditEventRepository: auditEventRepository,
+	}
+}
+
+func (u *UpdateCategoryUsecase) Execute(ctx context.Context, input *UpdateCategoryInput) (*UpdateCategoryOutput, error) {
+	ctx, span := startSpan(ctx, "UpdateCategoryUsecase.Execute")
+	defer span.End()
+
+	var category *entity.Category
+	err := u.transactionManager.Transaction(ctx, func(ctx context.Context) error {
+		// 親を付け替える場合は、祖先の取得から保存までの間に他の付け替えが割り込まないようにする
+		if input.ParentID != nil {
+			if err := u.categoryRepository.LockTree(ctx); err != nil {
+				return err
+			}
+		}
+
+		var err error
+		category, err = u.categoryRepository.Get(ctx, input.ID)
+		if err != nil {
+			return valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_category"))
+		}
+		before := category.AuditFields()
+
+		if err := category.Update(input.Name, input.Slug, input.Description, input.SortOrder); err != nil {
+			return err
+		}
+
+		var parentAncestorIDs []valueobject.CategoryID
+		if input.ParentID != nil {
+			if _, err := u.categoryRepository.Get(ctx, *input.ParentID); err != nil {
+				return valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_parent_category"))
+			}
+
+			parentAncestorIDs, err = u.categoryRepository.GetAncestorIDs(ctx, *input.ParentID)
+			if err != nil {
+				return valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_category_ancestors"))
+			}
+		}
+
+		if err := category.MoveTo(input.ParentID, parentAncestorIDs); err != nil {
+			return err
+		}
+
+		if err := u.categoryRepository.Update(ctx, category); err != nil {
+			return valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_update_category"))
+		}
+
+		return recordAudit(ctx, u.auditEventRepository, valueobject.AuditEntityCategory, category.ID.String(), valueobject.AuditActionUpdate, before, category.AuditFields())
+	})
+	if err != nil {
+		return nil, valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_update_category"))
+	}
+
+	return &UpdateCategoryOutput{Category: category}, nil
+}
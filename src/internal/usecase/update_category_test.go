@@ -0,0 +1,167 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	repositoryMock "github.com/MizukiShigi/cms-go/mocks/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUpdateCategoryUsecase_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTransactionManager := repositoryMock.NewMockTransactionManager(ctrl)
+	mockCategoryRepo := repositoryMock.NewMockCategoryRepository(ctrl)
+	mockAuditRepo := repositoryMock.NewMockAuditEventRepository(ctrl)
+
+	name, _ := valueobject.NewCategoryName("Go言語")
+	slug, _ := valueobject.NewCategorySlug("golang")
+	newName, _ := valueobject.NewCategoryName("Golang")
+	newSlug, _ := valueobject.NewCategorySlug("go")
+
+	t.Run("カテゴリ情報と親カテゴリの更新が成功する", func(t *testing.T) {
+		usecase := NewUpdateCategoryUsecase(mockTransactionManager, mockCategoryRepo, mockAuditRepo)
+
+		category, _ := entity.NewCategory(name, slug, "", 0)
+		parent, _ := entity.NewCategory(name, slug, "", 0)
+		grandParentID := valueobject.NewCategoryID()
+
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockCategoryRepo.EXPECT().LockTree(ctx).Return(nil)
+				mockCategoryRepo.EXPECT().Get(ctx, category.ID).Return(category, nil)
+				mockCategoryRepo.EXPECT().Get(ctx, parent.ID).Return(parent, nil)
+				mockCategoryRepo.EXPECT().GetAncestorIDs(ctx, parent.ID).Return([]valueobject.CategoryID{grandParentID}, nil)
+				mockCategoryRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+				mockAuditRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				return fn(ctx)
+			})
+
+		output, err := usecase.Execute(context.Background(), &UpdateCategoryInput{
+			ID:          category.ID,
+			ParentID:    &parent.ID,
+			Name:        newName,
+			Slug:        newSlug,
+			Description: "説明",
+			SortOrder:   3,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, newName, output.Category.Name)
+		assert.Equal(t, newSlug, output.Category.Slug)
+		assert.Equal(t, "説明", output.Category.Description)
+		assert.Equal(t, 3, output.Category.SortOrder)
+		assert.Equal(t, parent.ID, *output.Category.ParentID)
+	})
+
+	t.Run("子孫カテゴリを親にすると循環エラーになる", func(t *testing.T) {
+		usecase := NewUpdateCategoryUsecase(mockTransactionManager, mockCategoryRepo, mockAuditRepo)
+
+		category, _ := entity.NewCategory(name, slug, "", 0)
+		descendant, _ := entity.NewCategory(name, slug, "", 0)
+		descendant.ParentID = &category.ID
+
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockCategoryRepo.EXPECT().LockTree(ctx).Return(nil)
+				mockCategoryRepo.EXPECT().Get(ctx, category.ID).Return(category, nil)
+				mockCategoryRepo.EXPECT().Get(ctx, descendant.ID).Return(descendant, nil)
+				mockCategoryRepo.EXPECT().GetAncestorIDs(ctx, descendant.ID).Return([]valueobject.CategoryID{category.ID}, nil)
+				return fn(ctx)
+			})
+
+		output, err := usecase.Execute(context.Background(), &UpdateCategoryInput{
+			ID:       category.ID,
+			ParentID: &descendant.ID,
+			Name:     name,
+			Slug:     slug,
+		})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, valueobject.InvalidCode, myErr.Code)
+		assert.Equal(t, "category_parent_is_descendant", string(myErr.Key()))
+	})
+
+	t.Run("自身を親にすると循環エラーになる", func(t *testing.T) {
+		usecase := NewUpdateCategoryUsecase(mockTransactionManager, mockCategoryRepo, mockAuditRepo)
+
+		category, _ := entity.NewCategory(name, slug, "", 0)
+
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockCategoryRepo.EXPECT().LockTree(ctx).Return(nil)
+				mockCategoryRepo.EXPECT().Get(ctx, category.ID).Return(category, nil).Times(2)
+				mockCategoryRepo.EXPECT().GetAncestorIDs(ctx, category.ID).Return([]valueobject.CategoryID{}, nil)
+				return fn(ctx)
+			})
+
+		output, err := usecase.Execute(context.Background(), &UpdateCategoryInput{
+			ID:       category.ID,
+			ParentID: &category.ID,
+			Name:     name,
+			Slug:     slug,
+		})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, "category_cannot_be_its_own_parent", string(myErr.Key()))
+	})
+
+	t.Run("カテゴリツリーのロックに失敗した場合はエラーになる", func(t *testing.T) {
+		usecase := NewUpdateCategoryUsecase(mockTransactionManager, mockCategoryRepo, mockAuditRepo)
+
+		categoryID := valueobject.NewCategoryID()
+		parentID := valueobject.NewCategoryID()
+
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockCategoryRepo.EXPECT().LockTree(ctx).
+					Return(valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_lock_category_tree"))
+				return fn(ctx)
+			})
+
+		output, err := usecase.Execute(context.Background(), &UpdateCategoryInput{
+			ID:       categoryID,
+			ParentID: &parentID,
+			Name:     name,
+			Slug:     slug,
+		})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, "failed_to_lock_category_tree", string(myErr.Key()))
+	})
+
+	t.Run("カテゴリが存在しない場合にエラーが発生する", func(t *testing.T) {
+		usecase := NewUpdateCategoryUsecase(mockTransactionManager, mockCategoryRepo, mockAuditRepo)
+
+		categoryID := valueobject.NewCategoryID()
+
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockCategoryRepo.EXPECT().Get(ctx, categoryID).
+					Return(nil, valueobject.NewMyError(valueobject.NotFoundCode, "Category not found"))
+				return fn(ctx)
+			})
+
+		output, err := usecase.Execute(context.Background(), &UpdateCategoryInput{
+			ID:   categoryID,
+			Name: name,
+			Slug: slug,
+		})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, valueobject.NotFoundCode, myErr.Code)
+	})
+}
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"github.com/MizukiShigi/cms-go/internal/domain/entity"
@@ -10,9 +11,15 @@ import (
 )
 
 type UpdatePostUsecase struct {
-	transactionManager repository.TransactionManager
-	postRepository     repository.PostRepository
-	tagRepository      repository.TagRepository
+	transactionManager   repository.TransactionManager
+	postRepository       repository.PostRepository
+	tagRepository        repository.TagRepository
+	postLockRepository   repository.PostLockRepository
+	auditEventRepository repository.AuditEventRepository
+	// 公開中の投稿の更新をWebhookで通知するためのアウトボックス
+	webhookEventRepository repository.WebhookEventRepository
+	// OGP画像の存在確認に使用する
+	imageRepository repository.ImageRepository
 }
 
 type UpdatePostInput struct {
@@ -21,37 +28,77 @@ type UpdatePostInput struct {
 	Content valueobject.PostContent
 	Tags    []valueobject.TagName
 	Status  valueobject.PostStatus
+	// PUTのため未指定の場合はカテゴリが解除される
+	PrimaryCategoryID *valueobject.CategoryID
+	CategoryIDs       []valueobject.CategoryID
+	// PUTのため未指定の項目は未設定に戻る
+	SEO PostSEOInput
+	// クライアントが取得した時点のバージョン（If-Match）
+	Version int
+	// 編集ロックの確認に使用する更新者
+	UserID valueobject.UserID
 }
 
 type UpdatePostOutput struct {
-	ID               valueobject.PostID
-	Title            valueobject.PostTitle
-	Content          valueobject.PostContent
-	Tags             []valueobject.TagName
-	Status           valueobject.PostStatus
-	FirstPublishedAt *time.Time
-	ContentUpdatedAt *time.Time
+	ID                valueobject.PostID
+	Title             valueobject.PostTitle
+	Content           valueobject.PostContent
+	Tags              []valueobject.TagName
+	Status            valueobject.PostStatus
+	FirstPublishedAt  *time.Time
+	ContentUpdatedAt  *time.Time
+	PrimaryCategoryID *valueobject.CategoryID
+	CategoryIDs       []valueobject.CategoryID
+	SEO               PostSEOOutput
+	Version           int
 }
 
-func NewUpdatePostUsecase(transactionManager repository.TransactionManager, postRepository repository.PostRepository, tagRepository repository.TagRepository) *UpdatePostUsecase {
-	return &UpdatePostUsecase{transactionManager: transactionManager, postRepository: postRepository, tagRepository: tagRepository}
+func NewUpdatePostUsecase(transactionManager repository.TransactionManager, postRepository repository.PostRepository, tagRepository repository.TagRepository, postLockRepository repository.PostLockRepository, auditEventRepository repository.AuditEventRepository, webhookEventRepository repository.WebhookEventRepository, imageRepository repository.ImageRepository) *UpdatePostUsecase {
+	return &UpdatePostUsecase{transactionManager: transactionManager, postRepository: postRepository, tagRepository: tagRepository, postLockRepository: postLockRepository, auditEventRepository: auditEventRepository, webhookEventRepository: webhookEventRepository, imageRepository: imageRepository}
 }
 
 func (u *UpdatePostUsecase) Execute(ctx context.Context, input *UpdatePostInput) (*UpdatePostOutput, error) {
+	ctx, span := startSpan(ctx, "UpdatePostUsecase.Execute")
+	defer span.End()
+
 	post, err := u.postRepository.Get(ctx, input.ID)
 	if err != nil {
-		return nil, valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "Failed to get post"))
+		return nil, valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_post"))
+	}
+
+	if err := post.CheckVersion(input.Version); err != nil {
+		return nil, err
+	}
+
+	if err := post.CheckContentEditable(input.Title, input.Content); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	before := post.AuditFields()
+
+	if err := post.SetCategories(input.PrimaryCategoryID, input.CategoryIDs); err != nil {
+		return nil, err
+	}
+
+	if err := applyPostSEO(ctx, u.imageRepository, post, input.UserID, input.SEO); err != nil {
+		return nil, err
 	}
 
 	err = u.transactionManager.Transaction(ctx, func(ctx context.Context) error {
-		now := time.Now()
+		// 他のユーザーが編集ロックを保持している間は保存できない
+		if err := checkPostEditable(ctx, u.postLockRepository, input.ID, input.UserID, now); err != nil {
+			return err
+		}
+
 		post.Title = input.Title
 		post.Content = input.Content
 		post.ContentUpdatedAt = &now
 
 		err = u.postRepository.Update(ctx, post)
 		if err != nil {
-			return valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "Failed to update post"))
+			return valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_update_post"))
 		}
 
 		tags := make([]*entity.Tag, 0, len(input.Tags))
@@ -59,33 +106,57 @@ func (u *UpdatePostUsecase) Execute(ctx context.Context, input *UpdatePostInput)
 			inputTag := entity.NewTagWithName(tagName)
 			tag, err := u.tagRepository.FindOrCreateByName(ctx, inputTag)
 			if err != nil {
-				return valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "Failed to find or create tag"))
+				return valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_find_or_create_tag"))
+			}
+			// 表記揺れのあるタグ名は同一タグに解決されるため重複を除く
+			if slices.ContainsFunc(tags, func(t *entity.Tag) bool { return t.ID.Equals(tag.ID) }) {
+				continue
 			}
 			tags = append(tags, tag)
 		}
 
+		post.Tags = make([]valueobject.TagName, 0, len(tags))
+		for _, tag := range tags {
+			post.Tags = append(post.Tags, tag.Name)
+		}
+
 		err = u.postRepository.SetTags(ctx, post, tags)
 		if err != nil {
-			return valueobject.NewMyError(valueobject.InternalServerErrorCode, "Failed to set tags")
+			return valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_set_tags")
+		}
+
+		err = u.postRepository.SetCategories(ctx, post)
+		if err != nil {
+			return valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_set_categories"))
+		}
+
+		after := post.AuditFields()
+		if err := recordAudit(ctx, u.auditEventRepository, valueobject.AuditEntityPost, post.ID.String(), valueobject.AuditActionUpdate, before, after); err != nil {
+			return err
 		}
 
-		return nil
+		// 公開中の投稿の更新を通知する
+		return enqueuePostWebhookEvent(ctx, u.webhookEventRepository, post.Status, post, len(entity.DiffAuditFields(before, after)) > 0)
 	})
 	if err != nil {
-		return nil, valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "Failed to update post"))
+		return nil, valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_update_post"))
 	}
 
 	updatePost, err := u.postRepository.Get(ctx, input.ID)
 	if err != nil {
-		return nil, valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "Failed to get post"))
+		return nil, valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_get_post"))
 	}
 	return &UpdatePostOutput{
-		ID:               updatePost.ID,
-		Title:            updatePost.Title,
-		Content:          updatePost.Content,
-		Tags:             updatePost.Tags,
-		Status:           updatePost.Status,
-		FirstPublishedAt: updatePost.FirstPublishedAt,
-		ContentUpdatedAt: updatePost.ContentUpdatedAt,
+		ID:                updatePost.ID,
+		Title:             updatePost.Title,
+		Content:           updatePost.Content,
+		Tags:              updatePost.Tags,
+		Status:            updatePost.Status,
+		FirstPublishedAt:  updatePost.FirstPublishedAt,
+		ContentUpdatedAt:  updatePost.ContentUpdatedAt,
+		PrimaryCategoryID: updatePost.PrimaryCategoryID,
+		CategoryIDs:       updatePost.CategoryIDs,
+		SEO:               toPostSEOOutput(updatePost),
+		Version:           updatePost.Version,
 	}, nil
 }
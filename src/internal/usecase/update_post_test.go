@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"net/http"
 	"testing"
 	"time"
 
@@ -19,9 +20,13 @@ func TestUpdatePostUsecase_Execute(t *testing.T) {
 	mockTransactionManager := repositoryMock.NewMockTransactionManager(ctrl)
 	mockPostRepo := repositoryMock.NewMockPostRepository(ctrl)
 	mockTagRepo := repositoryMock.NewMockTagRepository(ctrl)
+	mockPostLockRepo := repositoryMock.NewMockPostLockRepository(ctrl)
+	mockAuditRepo := repositoryMock.NewMockAuditEventRepository(ctrl)
+	mockWebhookEventRepo := repositoryMock.NewMockWebhookEventRepository(ctrl)
+	mockImageRepo := repositoryMock.NewMockImageRepository(ctrl)
 
 	t.Run("全項目の投稿更新が成功する", func(t *testing.T) {
-		usecase := NewUpdatePostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo)
+		usecase := NewUpdatePostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockAuditRepo, mockWebhookEventRepo, mockImageRepo)
 
 		postID := valueobject.NewPostID()
 		oldTitle, _ := valueobject.NewPostTitle("旧タイトル")
@@ -47,6 +52,7 @@ func TestUpdatePostUsecase_Execute(t *testing.T) {
 
 		input := &UpdatePostInput{
 			ID:      postID,
+			Version: 1,
 			Title:   newTitle,
 			Content: newContent,
 			Tags:    []valueobject.TagName{tagName},
@@ -57,6 +63,7 @@ func TestUpdatePostUsecase_Execute(t *testing.T) {
 
 		// 既存投稿取得
 		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(oldPost, nil)
+		mockPostLockRepo.EXPECT().FindActiveForUpdate(context.Background(), postID, gomock.Any()).Return(nil, nil)
 
 		// トランザクション内の処理をモック
 		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
@@ -70,6 +77,12 @@ func TestUpdatePostUsecase_Execute(t *testing.T) {
 				// タグ設定
 				mockPostRepo.EXPECT().SetTags(ctx, gomock.Any(), gomock.Any()).Return(nil)
 
+				// カテゴリ設定
+				mockPostRepo.EXPECT().SetCategories(ctx, gomock.Any()).Return(nil)
+
+				mockAuditRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+				mockWebhookEventRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
 				return fn(ctx)
 			})
 
@@ -89,7 +102,7 @@ func TestUpdatePostUsecase_Execute(t *testing.T) {
 	})
 
 	t.Run("タグなしの投稿更新が成功する", func(t *testing.T) {
-		usecase := NewUpdatePostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo)
+		usecase := NewUpdatePostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockAuditRepo, mockWebhookEventRepo, mockImageRepo)
 
 		postID := valueobject.NewPostID()
 		oldTitle, _ := valueobject.NewPostTitle("旧タイトル")
@@ -109,6 +122,7 @@ func TestUpdatePostUsecase_Execute(t *testing.T) {
 
 		input := &UpdatePostInput{
 			ID:      postID,
+			Version: 1,
 			Title:   newTitle,
 			Content: newContent,
 			Tags:    []valueobject.TagName{},
@@ -117,6 +131,7 @@ func TestUpdatePostUsecase_Execute(t *testing.T) {
 
 		// 既存投稿取得
 		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(oldPost, nil)
+		mockPostLockRepo.EXPECT().FindActiveForUpdate(context.Background(), postID, gomock.Any()).Return(nil, nil)
 
 		// トランザクション内の処理をモック
 		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
@@ -127,6 +142,12 @@ func TestUpdatePostUsecase_Execute(t *testing.T) {
 				// タグなしなのでSetTagsのみ
 				mockPostRepo.EXPECT().SetTags(ctx, gomock.Any(), gomock.Any()).Return(nil)
 
+				// カテゴリ未指定でも解除のため設定する
+				mockPostRepo.EXPECT().SetCategories(ctx, gomock.Any()).Return(nil)
+
+				mockAuditRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+				mockWebhookEventRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
 				return fn(ctx)
 			})
 
@@ -142,8 +163,133 @@ func TestUpdatePostUsecase_Execute(t *testing.T) {
 		assert.Equal(t, newContent, output.Content)
 	})
 
+	t.Run("カテゴリ付きの投稿更新が成功する", func(t *testing.T) {
+		usecase := NewUpdatePostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockAuditRepo, mockWebhookEventRepo, mockImageRepo)
+
+		postID := valueobject.NewPostID()
+		title, _ := valueobject.NewPostTitle("タイトル")
+		content, _ := valueobject.NewPostContent("内容")
+		userID := valueobject.NewUserID()
+		status := valueobject.StatusDraft
+		primaryCategoryID := valueobject.NewCategoryID()
+		categoryIDs := []valueobject.CategoryID{valueobject.NewCategoryID()}
+
+		oldPost, _ := entity.NewPost(title, content, userID, status)
+		oldPost.ID = postID
+
+		updatedPost, _ := entity.NewPost(title, content, userID, status)
+		updatedPost.ID = postID
+		updatedPost.PrimaryCategoryID = &primaryCategoryID
+		updatedPost.CategoryIDs = categoryIDs
+
+		input := &UpdatePostInput{
+			ID:                postID,
+			Version:           1,
+			Title:             title,
+			Content:           content,
+			Status:            status,
+			PrimaryCategoryID: &primaryCategoryID,
+			CategoryIDs:       categoryIDs,
+		}
+
+		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(oldPost, nil)
+		mockPostLockRepo.EXPECT().FindActiveForUpdate(context.Background(), postID, gomock.Any()).Return(nil, nil)
+
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockPostRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+				mockPostRepo.EXPECT().SetTags(ctx, gomock.Any(), gomock.Any()).Return(nil)
+				mockPostRepo.EXPECT().SetCategories(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, post *entity.Post) error {
+						assert.Equal(t, &primaryCategoryID, post.PrimaryCategoryID)
+						assert.Equal(t, categoryIDs, post.CategoryIDs)
+						return nil
+					})
+				mockAuditRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				return fn(ctx)
+			})
+
+		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(updatedPost, nil)
+
+		output, err := usecase.Execute(context.Background(), input)
+
+		assert.NoError(t, err)
+		assert.Equal(t, &primaryCategoryID, output.PrimaryCategoryID)
+		assert.Equal(t, categoryIDs, output.CategoryIDs)
+	})
+
+	t.Run("主カテゴリと同じ副カテゴリを指定するとエラーが発生する", func(t *testing.T) {
+		usecase := NewUpdatePostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockAuditRepo, mockWebhookEventRepo, mockImageRepo)
+
+		postID := valueobject.NewPostID()
+		title, _ := valueobject.NewPostTitle("タイトル")
+		content, _ := valueobject.NewPostContent("内容")
+		userID := valueobject.NewUserID()
+		primaryCategoryID := valueobject.NewCategoryID()
+
+		oldPost, _ := entity.NewPost(title, content, userID, valueobject.StatusDraft)
+		oldPost.ID = postID
+
+		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(oldPost, nil)
+
+		output, err := usecase.Execute(context.Background(), &UpdatePostInput{
+			ID:                postID,
+			Version:           1,
+			Title:             title,
+			Content:           content,
+			PrimaryCategoryID: &primaryCategoryID,
+			CategoryIDs:       []valueobject.CategoryID{primaryCategoryID},
+		})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, valueobject.InvalidCode, myErr.Code)
+	})
+
+	t.Run("表記揺れのあるタグは1つにまとめて設定される", func(t *testing.T) {
+		usecase := NewUpdatePostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockAuditRepo, mockWebhookEventRepo, mockImageRepo)
+
+		postID := valueobject.NewPostID()
+		title, _ := valueobject.NewPostTitle("タイトル")
+		content, _ := valueobject.NewPostContent("内容")
+		userID := valueobject.NewUserID()
+		upperTag, _ := valueobject.NewTagName("Go")
+		lowerTag, _ := valueobject.NewTagName("go")
+
+		oldPost, _ := entity.NewPost(title, content, userID, valueobject.StatusDraft)
+		oldPost.ID = postID
+
+		existingTag := entity.ParseTag(valueobject.NewTagID(), lowerTag, time.Now(), time.Now())
+
+		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(oldPost, nil)
+		mockPostLockRepo.EXPECT().FindActiveForUpdate(context.Background(), postID, gomock.Any()).Return(nil, nil)
+
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockPostRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+				mockTagRepo.EXPECT().FindOrCreateByName(ctx, gomock.Any()).Return(existingTag, nil).Times(2)
+				mockPostRepo.EXPECT().SetTags(ctx, gomock.Any(), []*entity.Tag{existingTag}).Return(nil)
+				mockPostRepo.EXPECT().SetCategories(ctx, gomock.Any()).Return(nil)
+				mockAuditRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+				return fn(ctx)
+			})
+
+		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(oldPost, nil)
+
+		_, err := usecase.Execute(context.Background(), &UpdatePostInput{
+			ID:      postID,
+			Version: 1,
+			Title:   title,
+			Content: content,
+			Tags:    []valueobject.TagName{upperTag, lowerTag},
+		})
+
+		assert.NoError(t, err)
+	})
+
 	t.Run("投稿が存在しない場合にエラーが発生する", func(t *testing.T) {
-		usecase := NewUpdatePostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo)
+		usecase := NewUpdatePostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockAuditRepo, mockWebhookEventRepo, mockImageRepo)
 
 		postID := valueobject.NewPostID()
 		title, _ := valueobject.NewPostTitle("タイトル")
@@ -151,6 +297,7 @@ func TestUpdatePostUsecase_Execute(t *testing.T) {
 
 		input := &UpdatePostInput{
 			ID:      postID,
+			Version: 1,
 			Title:   title,
 			Content: content,
 			Tags:    []valueobject.TagName{},
@@ -168,7 +315,7 @@ func TestUpdatePostUsecase_Execute(t *testing.T) {
 	})
 
 	t.Run("投稿更新に失敗する", func(t *testing.T) {
-		usecase := NewUpdatePostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo)
+		usecase := NewUpdatePostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockAuditRepo, mockWebhookEventRepo, mockImageRepo)
 
 		postID := valueobject.NewPostID()
 		title, _ := valueobject.NewPostTitle("タイトル")
@@ -180,6 +327,7 @@ func TestUpdatePostUsecase_Execute(t *testing.T) {
 
 		input := &UpdatePostInput{
 			ID:      postID,
+			Version: 1,
 			Title:   title,
 			Content: content,
 			Tags:    []valueobject.TagName{},
@@ -188,6 +336,7 @@ func TestUpdatePostUsecase_Execute(t *testing.T) {
 
 		// 既存投稿取得
 		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(post, nil)
+		mockPostLockRepo.EXPECT().FindActiveForUpdate(context.Background(), postID, gomock.Any()).Return(nil, nil)
 
 		// トランザクション内で投稿更新が失敗
 		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
@@ -205,7 +354,7 @@ func TestUpdatePostUsecase_Execute(t *testing.T) {
 	})
 
 	t.Run("タグ作成に失敗する", func(t *testing.T) {
-		usecase := NewUpdatePostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo)
+		usecase := NewUpdatePostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockAuditRepo, mockWebhookEventRepo, mockImageRepo)
 
 		postID := valueobject.NewPostID()
 		title, _ := valueobject.NewPostTitle("タイトル")
@@ -218,6 +367,7 @@ func TestUpdatePostUsecase_Execute(t *testing.T) {
 
 		input := &UpdatePostInput{
 			ID:      postID,
+			Version: 1,
 			Title:   title,
 			Content: content,
 			Tags:    []valueobject.TagName{tagName},
@@ -226,6 +376,7 @@ func TestUpdatePostUsecase_Execute(t *testing.T) {
 
 		// 既存投稿取得
 		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(post, nil)
+		mockPostLockRepo.EXPECT().FindActiveForUpdate(context.Background(), postID, gomock.Any()).Return(nil, nil)
 
 		// トランザクション内でタグ作成が失敗
 		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
@@ -242,4 +393,183 @@ func TestUpdatePostUsecase_Execute(t *testing.T) {
 		assert.Error(t, err)
 		assert.Nil(t, output)
 	})
+
+	t.Run("取得時のバージョンが古い場合に競合エラーが発生する", func(t *testing.T) {
+		usecase := NewUpdatePostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockAuditRepo, mockWebhookEventRepo, mockImageRepo)
+
+		postID := valueobject.NewPostID()
+		title, _ := valueobject.NewPostTitle("タイトル")
+		content, _ := valueobject.NewPostContent("内容")
+
+		// 他のリクエストで更新済みの投稿
+		post, _ := entity.NewPost(title, content, valueobject.NewUserID(), valueobject.StatusDraft)
+		post.ID = postID
+		post.Version = 2
+
+		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(post, nil)
+
+		output, err := usecase.Execute(context.Background(), &UpdatePostInput{
+			ID:      postID,
+			Version: 1,
+			Title:   title,
+			Content: content,
+		})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, valueobject.ConflictCode, myErr.Code)
+		assert.Equal(t, http.StatusPreconditionFailed, myErr.StatusCode())
+	})
+
+	t.Run("他のユーザーの投稿の画像をOGP画像に指定するとエラーが発生する", func(t *testing.T) {
+		usecase := NewUpdatePostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockAuditRepo, mockWebhookEventRepo, mockImageRepo)
+
+		title, _ := valueobject.NewPostTitle("タイトル")
+		content, _ := valueobject.NewPostContent("内容")
+		userID := valueobject.NewUserID()
+
+		post, _ := entity.NewPost(title, content, userID, valueobject.StatusDraft)
+		image := entity.NewImage(valueobject.ImageFilename("og.png"), "stored.png", "https://storage.example.com/og.png", valueobject.NewPostID(), valueobject.NewUserID(), 0)
+
+		mockPostRepo.EXPECT().Get(context.Background(), post.ID).Return(post, nil)
+		mockImageRepo.EXPECT().Get(context.Background(), image.ID).Return(image, nil)
+
+		output, err := usecase.Execute(context.Background(), &UpdatePostInput{
+			ID:      post.ID,
+			UserID:  userID,
+			Version: 1,
+			Title:   title,
+			Content: content,
+			Status:  valueobject.StatusDraft,
+			SEO:     PostSEOInput{OGImageID: &image.ID},
+		})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, valueobject.InvalidCode, myErr.Code)
+		assert.Equal(t, "og_image_does_not_exist", string(myErr.Key()))
+	})
+
+	t.Run("レビュー中の投稿の本文を変更するとエラーが発生する", func(t *testing.T) {
+		usecase := NewUpdatePostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockAuditRepo, mockWebhookEventRepo, mockImageRepo)
+
+		title, _ := valueobject.NewPostTitle("タイトル")
+		content, _ := valueobject.NewPostContent("内容")
+		newContent, _ := valueobject.NewPostContent("レビュー後に書き換えた内容")
+
+		post, _ := entity.NewPost(title, content, valueobject.NewUserID(), valueobject.StatusDraft)
+		post.Status = valueobject.StatusInReview
+
+		mockPostRepo.EXPECT().Get(context.Background(), post.ID).Return(post, nil)
+
+		output, err := usecase.Execute(context.Background(), &UpdatePostInput{
+			ID:      post.ID,
+			Version: 1,
+			Title:   title,
+			Content: newContent,
+		})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, valueobject.ConflictCode, myErr.Code)
+		assert.Equal(t, "post_content_is_locked_during_review", string(myErr.Key()))
+	})
+
+	t.Run("承認済みの投稿のタイトルを変更するとエラーが発生する", func(t *testing.T) {
+		usecase := NewUpdatePostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockAuditRepo, mockWebhookEventRepo, mockImageRepo)
+
+		title, _ := valueobject.NewPostTitle("タイトル")
+		newTitle, _ := valueobject.NewPostTitle("承認後に書き換えたタイトル")
+		content, _ := valueobject.NewPostContent("内容")
+
+		post, _ := entity.NewPost(title, content, valueobject.NewUserID(), valueobject.StatusDraft)
+		post.Status = valueobject.StatusApproved
+
+		mockPostRepo.EXPECT().Get(context.Background(), post.ID).Return(post, nil)
+
+		output, err := usecase.Execute(context.Background(), &UpdatePostInput{
+			ID:      post.ID,
+			Version: 1,
+			Title:   newTitle,
+			Content: content,
+		})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, valueobject.ConflictCode, myErr.Code)
+		assert.Equal(t, "post_content_is_locked_during_review", string(myErr.Key()))
+	})
+
+	t.Run("更新時に他のリクエストと競合した場合に競合エラーが発生する", func(t *testing.T) {
+		usecase := NewUpdatePostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockAuditRepo, mockWebhookEventRepo, mockImageRepo)
+
+		postID := valueobject.NewPostID()
+		title, _ := valueobject.NewPostTitle("タイトル")
+		content, _ := valueobject.NewPostContent("内容")
+
+		post, _ := entity.NewPost(title, content, valueobject.NewUserID(), valueobject.StatusDraft)
+		post.ID = postID
+
+		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(post, nil)
+		mockPostLockRepo.EXPECT().FindActiveForUpdate(context.Background(), postID, gomock.Any()).Return(nil, nil)
+
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				// 取得後に別のリクエストがバージョンを進めた
+				mockPostRepo.EXPECT().Update(ctx, gomock.Any()).
+					Return(valueobject.NewStaleVersionError("Post has been modified by another request"))
+				return fn(ctx)
+			})
+
+		output, err := usecase.Execute(context.Background(), &UpdatePostInput{
+			ID:      postID,
+			Version: 1,
+			Title:   title,
+			Content: content,
+		})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, valueobject.ConflictCode, myErr.Code)
+		assert.Equal(t, http.StatusPreconditionFailed, myErr.StatusCode())
+	})
+
+	t.Run("他のユーザーが編集ロックを保持している場合にエラーが発生する", func(t *testing.T) {
+		usecase := NewUpdatePostUsecase(mockTransactionManager, mockPostRepo, mockTagRepo, mockPostLockRepo, mockAuditRepo, mockWebhookEventRepo, mockImageRepo)
+
+		postID := valueobject.NewPostID()
+		title, _ := valueobject.NewPostTitle("タイトル")
+		content, _ := valueobject.NewPostContent("内容")
+
+		post, _ := entity.NewPost(title, content, valueobject.NewUserID(), valueobject.StatusDraft)
+		post.ID = postID
+
+		// 別の編集者がロック中
+		lock := entity.NewPostLock(postID, valueobject.NewUserID(), time.Now())
+
+		mockPostRepo.EXPECT().Get(context.Background(), postID).Return(post, nil)
+		mockTransactionManager.EXPECT().Transaction(context.Background(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				mockPostLockRepo.EXPECT().FindActiveForUpdate(ctx, postID, gomock.Any()).Return(lock, nil)
+				return fn(ctx)
+			})
+
+		output, err := usecase.Execute(context.Background(), &UpdatePostInput{
+			ID:      postID,
+			Version: 1,
+			UserID:  valueobject.NewUserID(),
+			Title:   title,
+			Content: content,
+		})
+
+		assert.Nil(t, output)
+		var myErr *valueobject.MyError
+		assert.ErrorAs(t, err, &myErr)
+		assert.Equal(t, valueobject.ConflictCode, myErr.Code)
+	})
 }
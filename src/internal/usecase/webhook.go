@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	domaincontext "github.com/MizukiShigi/cms-go/internal/domain/context"
+	"github.com/MizukiShigi/cms-go/internal/domain/entity"
+	"github.com/MizukiShigi/cms-go/internal/domain/repository"
+	"github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+)
+
+// enqueuePostWebhookEvent は投稿の公開・更新・非公開に応じたWebhookイベントをアウトボックスに書き込む
+// 変更操作と同じトランザクション内で呼び出す。通知対象の変更でない場合は何もしない
+func enqueuePostWebhookEvent(
+	ctx context.Context,
+	webhookEventRepository repository.WebhookEventRepository,
+	fromStatus valueobject.PostStatus,
+	post *entity.Post,
+	changed bool,
+) error {
+	eventType, ok := entity.DetectPostWebhookEvent(fromStatus, post.Status, changed)
+	if !ok {
+		return nil
+	}
+
+	event, err := entity.NewPostWebhookEvent(eventType, post, domaincontext.GetRequestID(ctx), time.Now())
+	if err != nil {
+		return err
+	}
+
+	if err := webhookEventRepository.Create(ctx, event); err != nil {
+		return valueobject.ReturnMyError(err, valueobject.NewMyError(valueobject.InternalServerErrorCode, "failed_to_create_webhook_event"))
+	}
+	return nil
+}
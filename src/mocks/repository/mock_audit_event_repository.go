@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/repository/audit_event_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/repository/audit_event_repository.go -destination=mocks/repository/mock_audit_event_repository.go -package=repository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/MizukiShigi/cms-go/internal/domain/entity"
+	repository "github.com/MizukiShigi/cms-go/internal/domain/repository"
+	mock "go.uber.org/mock/gomock"
+)
+
+// MockAuditEventRepository is a mock of AuditEventRepository interface.
+type MockAuditEventRepository struct {
+	ctrl     *mock.Controller
+	recorder *MockAuditEventRepositoryMockRecorder
+}
+
+// MockAuditEventRepositoryMockRecorder is the mock recorder for MockAuditEventRepository.
+type MockAuditEventRepositoryMockRecorder struct {
+	mock *MockAuditEventRepository
+}
+
+// NewMockAuditEventRepository creates a new mock instance.
+func NewMockAuditEventRepository(ctrl *mock.Controller) *MockAuditEventRepository {
+	mock := &MockAuditEventRepository{ctrl: ctrl}
+	mock.recorder = &MockAuditEventRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditEventRepository) EXPECT() *MockAuditEventRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockAuditEventRepository) Create(ctx context.Context, event *entity.AuditEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAuditEventRepositoryMockRecorder) Create(ctx, event any) *mock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAuditEventRepository)(nil).Create), ctx, event)
+}
+
+// List mocks base method.
+func (m *MockAuditEventRepository) List(ctx context.Context, options *repository.ListAuditEventsOptions) ([]*entity.AuditEvent, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, options)
+	ret0, _ := ret[0].([]*entity.AuditEvent)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockAuditEventRepositoryMockRecorder) List(ctx, options any) *mock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAuditEventRepository)(nil).List), ctx, options)
+}
@@ -0,0 +1,143 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/repository/category_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/repository/category_repository.go -destination=mocks/repository/mock_category_repository.go -package=repository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/MizukiShigi/cms-go/internal/domain/entity"
+	valueobject "github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	mock "go.uber.org/mock/gomock"
+)
+
+// MockCategoryRepository is a mock of CategoryRepository interface.
+type MockCategoryRepository struct {
+	ctrl     *mock.Controller
+	recorder *MockCategoryRepositoryMockRecorder
+}
+
+// MockCategoryRepositoryMockRecorder is the mock recorder for MockCategoryRepository.
+type MockCategoryRepositoryMockRecorder struct {
+	mock *MockCategoryRepository
+}
+
+// NewMockCategoryRepository creates a new mock instance.
+func NewMockCategoryRepository(ctrl *mock.Controller) *MockCategoryRepository {
+	mock := &MockCategoryRepository{ctrl: ctrl}
+	mock.recorder = &MockCategoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCategoryRepository) EXPECT() *MockCategoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCategoryRepository) Create(ctx context.Context, category *entity.Category) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, category)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCategoryRepositoryMockRecorder) Create(ctx, category any) *mock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCategoryRepository)(nil).Create), ctx, category)
+}
+
+// Delete mocks base method.
+func (m *MockCategoryRepository) Delete(ctx context.Context, id valueobject.CategoryID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCategoryRepositoryMockRecorder) Delete(ctx, id any) *mock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCategoryRepository)(nil).Delete), ctx, id)
+}
+
+// Get mocks base method.
+func (m *MockCategoryRepository) Get(ctx context.Context, id valueobject.CategoryID) (*entity.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*entity.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockCategoryRepositoryMockRecorder) Get(ctx, id any) *mock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCategoryRepository)(nil).Get), ctx, id)
+}
+
+// GetAncestorIDs mocks base method.
+func (m *MockCategoryRepository) GetAncestorIDs(ctx context.Context, id valueobject.CategoryID) ([]valueobject.CategoryID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAncestorIDs", ctx, id)
+	ret0, _ := ret[0].([]valueobject.CategoryID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAncestorIDs indicates an expected call of GetAncestorIDs.
+func (mr *MockCategoryRepositoryMockRecorder) GetAncestorIDs(ctx, id any) *mock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAncestorIDs", reflect.TypeOf((*MockCategoryRepository)(nil).GetAncestorIDs), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockCategoryRepository) List(ctx context.Context) ([]*entity.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]*entity.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockCategoryRepositoryMockRecorder) List(ctx any) *mock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCategoryRepository)(nil).List), ctx)
+}
+
+// LockTree mocks base method.
+func (m *MockCategoryRepository) LockTree(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockTree", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockTree indicates an expected call of LockTree.
+func (mr *MockCategoryRepositoryMockRecorder) LockTree(ctx any) *mock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockTree", reflect.TypeOf((*MockCategoryRepository)(nil).LockTree), ctx)
+}
+
+// Update mocks base method.
+func (m *MockCategoryRepository) Update(ctx context.Context, category *entity.Category) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, category)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCategoryRepositoryMockRecorder) Update(ctx, category any) *mock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCategoryRepository)(nil).Update), ctx, category)
+}
@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	entity "github.com/MizukiShigi/cms-go/internal/domain/entity"
+	valueobject "github.com/MizukiShigi/cms-go/internal/domain/valueobject"
 	mock "go.uber.org/mock/gomock"
 )
 
@@ -48,8 +49,23 @@ func (m *MockImageRepository) Create(ctx context.Context, image *entity.Image) e
 	return ret0
 }
 
-// CreateExpectation mocks the Create method expectation.
-func (mr *MockImageRepositoryMockRecorder) Create(ctx, image interface{}) *mock.Call {
+// Create indicates an expected call of Create.
+func (mr *MockImageRepositoryMockRecorder) Create(ctx, image any) *mock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockImageRepository)(nil).Create), ctx, image)
 }
+
+// Get mocks base method.
+func (m *MockImageRepository) Get(ctx context.Context, id valueobject.ImageID) (*entity.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*entity.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockImageRepositoryMockRecorder) Get(ctx, id any) *mock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockImageRepository)(nil).Get), ctx, id)
+}
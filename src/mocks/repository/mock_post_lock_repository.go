@@ -0,0 +1,129 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/repository/post_lock_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/repository/post_lock_repository.go -destination=mocks/repository/mock_post_lock_repository.go -package=repository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/MizukiShigi/cms-go/internal/domain/entity"
+	valueobject "github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	mock "go.uber.org/mock/gomock"
+)
+
+// MockPostLockRepository is a mock of PostLockRepository interface.
+type MockPostLockRepository struct {
+	ctrl     *mock.Controller
+	recorder *MockPostLockRepositoryMockRecorder
+}
+
+// MockPostLockRepositoryMockRecorder is the mock recorder for MockPostLockRepository.
+type MockPostLockRepositoryMockRecorder struct {
+	mock *MockPostLockRepository
+}
+
+// NewMockPostLockRepository creates a new mock instance.
+func NewMockPostLockRepository(ctrl *mock.Controller) *MockPostLockRepository {
+	mock := &MockPostLockRepository{ctrl: ctrl}
+	mock.recorder = &MockPostLockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPostLockRepository) EXPECT() *MockPostLockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Acquire mocks base method.
+func (m *MockPostLockRepository) Acquire(ctx context.Context, lock *entity.PostLock) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Acquire", ctx, lock)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Acquire indicates an expected call of Acquire.
+func (mr *MockPostLockRepositoryMockRecorder) Acquire(ctx, lock any) *mock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Acquire", reflect.TypeOf((*MockPostLockRepository)(nil).Acquire), ctx, lock)
+}
+
+// Extend mocks base method.
+func (m *MockPostLockRepository) Extend(ctx context.Context, lock *entity.PostLock) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Extend", ctx, lock)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Extend indicates an expected call of Extend.
+func (mr *MockPostLockRepositoryMockRecorder) Extend(ctx, lock any) *mock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Extend", reflect.TypeOf((*MockPostLockRepository)(nil).Extend), ctx, lock)
+}
+
+// FindActive mocks base method.
+func (m *MockPostLockRepository) FindActive(ctx context.Context, postID valueobject.PostID, now time.Time) (*entity.PostLock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActive", ctx, postID, now)
+	ret0, _ := ret[0].(*entity.PostLock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActive indicates an expected call of FindActive.
+func (mr *MockPostLockRepositoryMockRecorder) FindActive(ctx, postID, now any) *mock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActive", reflect.TypeOf((*MockPostLockRepository)(nil).FindActive), ctx, postID, now)
+}
+
+// FindActiveForUpdate mocks base method.
+func (m *MockPostLockRepository) FindActiveForUpdate(ctx context.Context, postID valueobject.PostID, now time.Time) (*entity.PostLock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActiveForUpdate", ctx, postID, now)
+	ret0, _ := ret[0].(*entity.PostLock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActiveForUpdate indicates an expected call of FindActiveForUpdate.
+func (mr *MockPostLockRepositoryMockRecorder) FindActiveForUpdate(ctx, postID, now any) *mock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActiveForUpdate", reflect.TypeOf((*MockPostLockRepository)(nil).FindActiveForUpdate), ctx, postID, now)
+}
+
+// ForceRelease mocks base method.
+func (m *MockPostLockRepository) ForceRelease(ctx context.Context, postID valueobject.PostID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForceRelease", ctx, postID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ForceRelease indicates an expected call of ForceRelease.
+func (mr *MockPostLockRepositoryMockRecorder) ForceRelease(ctx, postID any) *mock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceRelease", reflect.TypeOf((*MockPostLockRepository)(nil).ForceRelease), ctx, postID)
+}
+
+// Release mocks base method.
+func (m *MockPostLockRepository) Release(ctx context.Context, postID valueobject.PostID, userID valueobject.UserID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, postID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockPostLockRepositoryMockRecorder) Release(ctx, postID, userID any) *mock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockPostLockRepository)(nil).Release), ctx, postID, userID)
+}
@@ -88,6 +88,35 @@ func (mr *MockPostRepositoryMockRecorder) List(ctx, options any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPostRepository)(nil).List), ctx, options)
 }
 
+// ListSitemapEntries mocks base method.
+func (m *MockPostRepository) ListSitemapEntries(ctx context.Context) ([]*repository.SitemapPostEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSitemapEntries", ctx)
+	ret0, _ := ret[0].([]*repository.SitemapPostEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSitemapEntries indicates an expected call of ListSitemapEntries.
+func (mr *MockPostRepositoryMockRecorder) ListSitemapEntries(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSitemapEntries", reflect.TypeOf((*MockPostRepository)(nil).ListSitemapEntries), ctx)
+}
+
+// SetCategories mocks base method.
+func (m *MockPostRepository) SetCategories(ctx context.Context, post *entity.Post) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCategories", ctx, post)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCategories indicates an expected call of SetCategories.
+func (mr *MockPostRepositoryMockRecorder) SetCategories(ctx, post any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCategories", reflect.TypeOf((*MockPostRepository)(nil).SetCategories), ctx, post)
+}
+
 // SetTags mocks base method.
 func (m *MockPostRepository) SetTags(ctx context.Context, post *entity.Post, tags []*entity.Tag) error {
 	m.ctrl.T.Helper()
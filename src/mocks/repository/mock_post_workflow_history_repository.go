@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/repository/post_workflow_history_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/repository/post_workflow_history_repository.go -destination=mocks/repository/mock_post_workflow_history_repository.go -package=repository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/MizukiShigi/cms-go/internal/domain/entity"
+	valueobject "github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	mock "go.uber.org/mock/gomock"
+)
+
+// MockPostWorkflowHistoryRepository is a mock of PostWorkflowHistoryRepository interface.
+type MockPostWorkflowHistoryRepository struct {
+	ctrl     *mock.Controller
+	recorder *MockPostWorkflowHistoryRepositoryMockRecorder
+}
+
+// MockPostWorkflowHistoryRepositoryMockRecorder is the mock recorder for MockPostWorkflowHistoryRepository.
+type MockPostWorkflowHistoryRepositoryMockRecorder struct {
+	mock *MockPostWorkflowHistoryRepository
+}
+
+// NewMockPostWorkflowHistoryRepository creates a new mock instance.
+func NewMockPostWorkflowHistoryRepository(ctrl *mock.Controller) *MockPostWorkflowHistoryRepository {
+	mock := &MockPostWorkflowHistoryRepository{ctrl: ctrl}
+	mock.recorder = &MockPostWorkflowHistoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPostWorkflowHistoryRepository) EXPECT() *MockPostWorkflowHistoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockPostWorkflowHistoryRepository) Create(ctx context.Context, history *entity.PostWorkflowHistory) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, history)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPostWorkflowHistoryRepositoryMockRecorder) Create(ctx, history any) *mock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPostWorkflowHistoryRepository)(nil).Create), ctx, history)
+}
+
+// ListByPostID mocks base method.
+func (m *MockPostWorkflowHistoryRepository) ListByPostID(ctx context.Context, postID valueobject.PostID) ([]*entity.PostWorkflowHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByPostID", ctx, postID)
+	ret0, _ := ret[0].([]*entity.PostWorkflowHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByPostID indicates an expected call of ListByPostID.
+func (mr *MockPostWorkflowHistoryRepositoryMockRecorder) ListByPostID(ctx, postID any) *mock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByPostID", reflect.TypeOf((*MockPostWorkflowHistoryRepository)(nil).ListByPostID), ctx, postID)
+}
@@ -14,6 +14,8 @@ import (
 	reflect "reflect"
 
 	entity "github.com/MizukiShigi/cms-go/internal/domain/entity"
+	repository "github.com/MizukiShigi/cms-go/internal/domain/repository"
+	valueobject "github.com/MizukiShigi/cms-go/internal/domain/valueobject"
 	mock "go.uber.org/mock/gomock"
 )
 
@@ -40,6 +42,21 @@ func (m *MockTagRepository) EXPECT() *MockTagRepositoryMockRecorder {
 	return m.recorder
 }
 
+// DeleteUnused mocks base method.
+func (m *MockTagRepository) DeleteUnused(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUnused", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUnused indicates an expected call of DeleteUnused.
+func (mr *MockTagRepositoryMockRecorder) DeleteUnused(ctx any) *mock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUnused", reflect.TypeOf((*MockTagRepository)(nil).DeleteUnused), ctx)
+}
+
 // FindOrCreateByName mocks base method.
 func (m *MockTagRepository) FindOrCreateByName(ctx context.Context, tag *entity.Tag) (*entity.Tag, error) {
 	m.ctrl.T.Helper()
@@ -54,3 +71,62 @@ func (mr *MockTagRepositoryMockRecorder) FindOrCreateByName(ctx, tag any) *mock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOrCreateByName", reflect.TypeOf((*MockTagRepository)(nil).FindOrCreateByName), ctx, tag)
 }
+
+// Get mocks base method.
+func (m *MockTagRepository) Get(ctx context.Context, id valueobject.TagID) (*entity.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*entity.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockTagRepositoryMockRecorder) Get(ctx, id any) *mock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTagRepository)(nil).Get), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockTagRepository) List(ctx context.Context, options *repository.ListTagsOptions) ([]*repository.TagWithPostCount, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, options)
+	ret0, _ := ret[0].([]*repository.TagWithPostCount)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockTagRepositoryMockRecorder) List(ctx, options any) *mock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockTagRepository)(nil).List), ctx, options)
+}
+
+// Merge mocks base method.
+func (m *MockTagRepository) Merge(ctx context.Context, sourceID, targetID valueobject.TagID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Merge", ctx, sourceID, targetID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Merge indicates an expected call of Merge.
+func (mr *MockTagRepositoryMockRecorder) Merge(ctx, sourceID, targetID any) *mock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Merge", reflect.TypeOf((*MockTagRepository)(nil).Merge), ctx, sourceID, targetID)
+}
+
+// Update mocks base method.
+func (m *MockTagRepository) Update(ctx context.Context, tag *entity.Tag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, tag)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTagRepositoryMockRecorder) Update(ctx, tag any) *mock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTagRepository)(nil).Update), ctx, tag)
+}
@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/repository/webhook_delivery_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/repository/webhook_delivery_repository.go -destination=mocks/repository/mock_webhook_delivery_repository.go -package=repository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/MizukiShigi/cms-go/internal/domain/entity"
+	valueobject "github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	mock "go.uber.org/mock/gomock"
+)
+
+// MockWebhookDeliveryRepository is a mock of WebhookDeliveryRepository interface.
+type MockWebhookDeliveryRepository struct {
+	ctrl     *mock.Controller
+	recorder *MockWebhookDeliveryRepositoryMockRecorder
+}
+
+// MockWebhookDeliveryRepositoryMockRecorder is the mock recorder for MockWebhookDeliveryRepository.
+type MockWebhookDeliveryRepositoryMockRecorder struct {
+	mock *MockWebhookDeliveryRepository
+}
+
+// NewMockWebhookDeliveryRepository creates a new mock instance.
+func NewMockWebhookDeliveryRepository(ctrl *mock.Controller) *MockWebhookDeliveryRepository {
+	mock := &MockWebhookDeliveryRepository{ctrl: ctrl}
+	mock.recorder = &MockWebhookDeliveryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookDeliveryRepository) EXPECT() *MockWebhookDeliveryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimDue mocks base method.
+func (m *MockWebhookDeliveryRepository) ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*entity.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDue", ctx, now, leaseUntil, limit)
+	ret0, _ := ret[0].([]*entity.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDue indicates an expected call of ClaimDue.
+func (mr *MockWebhookDeliveryRepositoryMockRecorder) ClaimDue(ctx, now, leaseUntil, limit any) *mock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDue", reflect.TypeOf((*MockWebhookDeliveryRepository)(nil).ClaimDue), ctx, now, leaseUntil, limit)
+}
+
+// Create mocks base method.
+func (m *MockWebhookDeliveryRepository) Create(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWebhookDeliveryRepositoryMockRecorder) Create(ctx, delivery any) *mock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWebhookDeliveryRepository)(nil).Create), ctx, delivery)
+}
+
+// Get mocks base method.
+func (m *MockWebhookDeliveryRepository) Get(ctx context.Context, id int64) (*entity.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*entity.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockWebhookDeliveryRepositoryMockRecorder) Get(ctx, id any) *mock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockWebhookDeliveryRepository)(nil).Get), ctx, id)
+}
+
+// ListBySubscription mocks base method.
+func (m *MockWebhookDeliveryRepository) ListBySubscription(ctx context.Context, subscriptionID valueobject.WebhookSubscriptionID, limit, offset int) ([]*entity.WebhookDelivery, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBySubscription", ctx, subscriptionID, limit, offset)
+	ret0, _ := ret[0].([]*entity.WebhookDelivery)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListBySubscription indicates an expected call of ListBySubscription.
+func (mr *MockWebhookDeliveryRepositoryMockRecorder) ListBySubscription(ctx, subscriptionID, limit, offset any) *mock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBySubscription", reflect.TypeOf((*MockWebhookDeliveryRepository)(nil).ListBySubscription), ctx, subscriptionID, limit, offset)
+}
+
+// Update mocks base method.
+func (m *MockWebhookDeliveryRepository) Update(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockWebhookDeliveryRepositoryMockRecorder) Update(ctx, delivery any) *mock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWebhookDeliveryRepository)(nil).Update), ctx, delivery)
+}
@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/repository/webhook_event_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/repository/webhook_event_repository.go -destination=mocks/repository/mock_webhook_event_repository.go -package=repository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/MizukiShigi/cms-go/internal/domain/entity"
+	mock "go.uber.org/mock/gomock"
+)
+
+// MockWebhookEventRepository is a mock of WebhookEventRepository interface.
+type MockWebhookEventRepository struct {
+	ctrl     *mock.Controller
+	recorder *MockWebhookEventRepositoryMockRecorder
+}
+
+// MockWebhookEventRepositoryMockRecorder is the mock recorder for MockWebhookEventRepository.
+type MockWebhookEventRepositoryMockRecorder struct {
+	mock *MockWebhookEventRepository
+}
+
+// NewMockWebhookEventRepository creates a new mock instance.
+func NewMockWebhookEventRepository(ctrl *mock.Controller) *MockWebhookEventRepository {
+	mock := &MockWebhookEventRepository{ctrl: ctrl}
+	mock.recorder = &MockWebhookEventRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookEventRepository) EXPECT() *MockWebhookEventRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockWebhookEventRepository) Create(ctx context.Context, event *entity.WebhookEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWebhookEventRepositoryMockRecorder) Create(ctx, event any) *mock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWebhookEventRepository)(nil).Create), ctx, event)
+}
+
+// ListUndispatched mocks base method.
+func (m *MockWebhookEventRepository) ListUndispatched(ctx context.Context, limit int) ([]*entity.WebhookEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUndispatched", ctx, limit)
+	ret0, _ := ret[0].([]*entity.WebhookEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUndispatched indicates an expected call of ListUndispatched.
+func (mr *MockWebhookEventRepositoryMockRecorder) ListUndispatched(ctx, limit any) *mock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUndispatched", reflect.TypeOf((*MockWebhookEventRepository)(nil).ListUndispatched), ctx, limit)
+}
+
+// MarkDispatched mocks base method.
+func (m *MockWebhookEventRepository) MarkDispatched(ctx context.Context, id int64, dispatchedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDispatched", ctx, id, dispatchedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkDispatched indicates an expected call of MarkDispatched.
+func (mr *MockWebhookEventRepositoryMockRecorder) MarkDispatched(ctx, id, dispatchedAt any) *mock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDispatched", reflect.TypeOf((*MockWebhookEventRepository)(nil).MarkDispatched), ctx, id, dispatchedAt)
+}
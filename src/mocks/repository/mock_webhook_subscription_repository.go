@@ -0,0 +1,115 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/repository/webhook_subscription_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/repository/webhook_subscription_repository.go -destination=mocks/repository/mock_webhook_subscription_repository.go -package=repository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/MizukiShigi/cms-go/internal/domain/entity"
+	valueobject "github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	mock "go.uber.org/mock/gomock"
+)
+
+// MockWebhookSubscriptionRepository is a mock of WebhookSubscriptionRepository interface.
+type MockWebhookSubscriptionRepository struct {
+	ctrl     *mock.Controller
+	recorder *MockWebhookSubscriptionRepositoryMockRecorder
+}
+
+// MockWebhookSubscriptionRepositoryMockRecorder is the mock recorder for MockWebhookSubscriptionRepository.
+type MockWebhookSubscriptionRepositoryMockRecorder struct {
+	mock *MockWebhookSubscriptionRepository
+}
+
+// NewMockWebhookSubscriptionRepository creates a new mock instance.
+func NewMockWebhookSubscriptionRepository(ctrl *mock.Controller) *MockWebhookSubscriptionRepository {
+	mock := &MockWebhookSubscriptionRepository{ctrl: ctrl}
+	mock.recorder = &MockWebhookSubscriptionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookSubscriptionRepository) EXPECT() *MockWebhookSubscriptionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockWebhookSubscriptionRepository) Create(ctx context.Context, subscription *entity.WebhookSubscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, subscription)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWebhookSubscriptionRepositoryMockRecorder) Create(ctx, subscription any) *mock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWebhookSubscriptionRepository)(nil).Create), ctx, subscription)
+}
+
+// Delete mocks base method.
+func (m *MockWebhookSubscriptionRepository) Delete(ctx context.Context, id valueobject.WebhookSubscriptionID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockWebhookSubscriptionRepositoryMockRecorder) Delete(ctx, id any) *mock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWebhookSubscriptionRepository)(nil).Delete), ctx, id)
+}
+
+// Get mocks base method.
+func (m *MockWebhookSubscriptionRepository) Get(ctx context.Context, id valueobject.WebhookSubscriptionID) (*entity.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*entity.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockWebhookSubscriptionRepositoryMockRecorder) Get(ctx, id any) *mock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockWebhookSubscriptionRepository)(nil).Get), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockWebhookSubscriptionRepository) List(ctx context.Context) ([]*entity.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]*entity.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockWebhookSubscriptionRepositoryMockRecorder) List(ctx any) *mock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockWebhookSubscriptionRepository)(nil).List), ctx)
+}
+
+// ListByEventType mocks base method.
+func (m *MockWebhookSubscriptionRepository) ListByEventType(ctx context.Context, eventType valueobject.WebhookEventType) ([]*entity.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByEventType", ctx, eventType)
+	ret0, _ := ret[0].([]*entity.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByEventType indicates an expected call of ListByEventType.
+func (mr *MockWebhookSubscriptionRepositoryMockRecorder) ListByEventType(ctx, eventType any) *mock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByEventType", reflect.TypeOf((*MockWebhookSubscriptionRepository)(nil).ListByEventType), ctx, eventType)
+}
@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/service/metrics.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/service/metrics.go -destination=mocks/service/mock_metrics.go -package=service
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	reflect "reflect"
+	time "time"
+
+	mock "go.uber.org/mock/gomock"
+)
+
+// MockMetrics is a mock of Metrics interface.
+type MockMetrics struct {
+	ctrl     *mock.Controller
+	recorder *MockMetricsMockRecorder
+}
+
+// MockMetricsMockRecorder is the mock recorder for MockMetrics.
+type MockMetricsMockRecorder struct {
+	mock *MockMetrics
+}
+
+// NewMockMetrics creates a new mock instance.
+func NewMockMetrics(ctrl *mock.Controller) *MockMetrics {
+	mock := &MockMetrics{ctrl: ctrl}
+	mock.recorder = &MockMetricsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMetrics) EXPECT() *MockMetricsMockRecorder {
+	return m.recorder
+}
+
+// IncDomainEvent mocks base method.
+func (m *MockMetrics) IncDomainEvent(name string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IncDomainEvent", name)
+}
+
+// IncDomainEvent indicates an expected call of IncDomainEvent.
+func (mr *MockMetricsMockRecorder) IncDomainEvent(name any) *mock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncDomainEvent", reflect.TypeOf((*MockMetrics)(nil).IncDomainEvent), name)
+}
+
+// IncTransactionRollback mocks base method.
+func (m *MockMetrics) IncTransactionRollback(reason string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IncTransactionRollback", reason)
+}
+
+// IncTransactionRollback indicates an expected call of IncTransactionRollback.
+func (mr *MockMetricsMockRecorder) IncTransactionRollback(reason any) *mock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncTransactionRollback", reflect.TypeOf((*MockMetrics)(nil).IncTransactionRollback), reason)
+}
+
+// ObserveHTTPRequest mocks base method.
+func (m *MockMetrics) ObserveHTTPRequest(method, route string, statusCode int, duration time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ObserveHTTPRequest", method, route, statusCode, duration)
+}
+
+// ObserveHTTPRequest indicates an expected call of ObserveHTTPRequest.
+func (mr *MockMetricsMockRecorder) ObserveHTTPRequest(method, route, statusCode, duration any) *mock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObserveHTTPRequest", reflect.TypeOf((*MockMetrics)(nil).ObserveHTTPRequest), method, route, statusCode, duration)
+}
+
+// ObserveStorageUpload mocks base method.
+func (m *MockMetrics) ObserveStorageUpload(bytes int64, duration time.Duration, err error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ObserveStorageUpload", bytes, duration, err)
+}
+
+// ObserveStorageUpload indicates an expected call of ObserveStorageUpload.
+func (mr *MockMetricsMockRecorder) ObserveStorageUpload(bytes, duration, err any) *mock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObserveStorageUpload", reflect.TypeOf((*MockMetrics)(nil).ObserveStorageUpload), bytes, duration, err)
+}
@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/service/rate_limit_store.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/service/rate_limit_store.go -destination=mocks/service/mock_rate_limit_store.go -package=service
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/MizukiShigi/cms-go/internal/domain/entity"
+	valueobject "github.com/MizukiShigi/cms-go/internal/domain/valueobject"
+	mock "go.uber.org/mock/gomock"
+)
+
+// MockRateLimitStore is a mock of RateLimitStore interface.
+type MockRateLimitStore struct {
+	ctrl     *mock.Controller
+	recorder *MockRateLimitStoreMockRecorder
+}
+
+// MockRateLimitStoreMockRecorder is the mock recorder for MockRateLimitStore.
+type MockRateLimitStoreMockRecorder struct {
+	mock *MockRateLimitStore
+}
+
+// NewMockRateLimitStore creates a new mock instance.
+func NewMockRateLimitStore(ctrl *mock.Controller) *MockRateLimitStore {
+	mock := &MockRateLimitStore{ctrl: ctrl}
+	mock.recorder = &MockRateLimitStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRateLimitStore) EXPECT() *MockRateLimitStoreMockRecorder {
+	return m.recorder
+}
+
+// Reset mocks base method.
+func (m *MockRateLimitStore) Reset(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reset", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reset indicates an expected call of Reset.
+func (mr *MockRateLimitStoreMockRecorder) Reset(ctx, key any) *mock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockRateLimitStore)(nil).Reset), ctx, key)
+}
+
+// Take mocks base method.
+func (m *MockRateLimitStore) Take(ctx context.Context, key string, policy valueobject.RateLimitPolicy) (entity.RateLimitDecision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Take", ctx, key, policy)
+	ret0, _ := ret[0].(entity.RateLimitDecision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Take indicates an expected call of Take.
+func (mr *MockRateLimitStoreMockRecorder) Take(ctx, key, policy any) *mock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Take", reflect.TypeOf((*MockRateLimitStore)(nil).Take), ctx, key, policy)
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/service/webhook_sender.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/service/webhook_sender.go -destination=mocks/service/mock_webhook_sender.go -package=service
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	service "github.com/MizukiShigi/cms-go/internal/domain/service"
+	mock "go.uber.org/mock/gomock"
+)
+
+// MockWebhookSender is a mock of WebhookSender interface.
+type MockWebhookSender struct {
+	ctrl     *mock.Controller
+	recorder *MockWebhookSenderMockRecorder
+}
+
+// MockWebhookSenderMockRecorder is the mock recorder for MockWebhookSender.
+type MockWebhookSenderMockRecorder struct {
+	mock *MockWebhookSender
+}
+
+// NewMockWebhookSender creates a new mock instance.
+func NewMockWebhookSender(ctrl *mock.Controller) *MockWebhookSender {
+	mock := &MockWebhookSender{ctrl: ctrl}
+	mock.recorder = &MockWebhookSenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookSender) EXPECT() *MockWebhookSenderMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockWebhookSender) Send(ctx context.Context, request service.WebhookRequest) (*service.WebhookResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, request)
+	ret0, _ := ret[0].(*service.WebhookResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockWebhookSenderMockRecorder) Send(ctx, request any) *mock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockWebhookSender)(nil).Send), ctx, request)
+}